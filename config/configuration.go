@@ -11,17 +11,23 @@ import (
 )
 
 type Configuration struct {
-	App      AppConfiguration      `mapstructure:"app"`
-	Database DatabaseConfiguration `mapstructure:"database"`
-	Redis    RedisConfiguration    `mapstructure:"redis"`
-	JWT      JWTConfiguration      `mapstructure:"jwt"`
+	App        AppConfiguration        `mapstructure:"app"`
+	Database   DatabaseConfiguration   `mapstructure:"database"`
+	Redis      RedisConfiguration      `mapstructure:"redis"`
+	JWT        JWTConfiguration        `mapstructure:"jwt"`
+	Task       TaskConfiguration       `mapstructure:"task"`
+	Attachment AttachmentConfiguration `mapstructure:"attachment"`
+	BlobStore  BlobStoreConfiguration  `mapstructure:"blobstore"`
+	Reminder   ReminderConfiguration   `mapstructure:"reminder"`
+	Notifier   NotifierConfiguration   `mapstructure:"notifier"`
 }
 
 type AppConfiguration struct {
-	Env      string `mapstructure:"env"`
-	Name     string `mapstructure:"name"`
-	Port     string `mapstructure:"port"`
-	LogLevel string `mapstructure:"log_level"`
+	Env          string `mapstructure:"env"`
+	Name         string `mapstructure:"name"`
+	Port         string `mapstructure:"port"`
+	LogLevel     string `mapstructure:"log_level"`
+	CursorSecret string `mapstructure:"cursor_secret"`
 }
 
 type DatabaseConfiguration struct {
@@ -44,6 +50,44 @@ type JWTConfiguration struct {
 	ExpiresIn time.Duration `mapstructure:"expires_in"`
 }
 
+type TaskConfiguration struct {
+	DefaultStatus  string              `mapstructure:"default_status"`
+	Transitions    map[string][]string `mapstructure:"transitions"`
+	RequireIfMatch bool                `mapstructure:"require_if_match"`
+	TrashRetention time.Duration       `mapstructure:"trash_retention"`
+	OnParentDelete string              `mapstructure:"on_parent_delete"`
+}
+
+type AttachmentConfiguration struct {
+	MaxSize      int64    `mapstructure:"max_size"`
+	AllowedTypes []string `mapstructure:"allowed_types"`
+}
+
+type BlobStoreConfiguration struct {
+	Driver    string `mapstructure:"driver"`
+	Path      string `mapstructure:"path"`
+	Endpoint  string `mapstructure:"endpoint"`
+	Bucket    string `mapstructure:"bucket"`
+	Region    string `mapstructure:"region"`
+	AccessKey string `mapstructure:"access_key"`
+	SecretKey string `mapstructure:"secret_key"`
+}
+
+type ReminderConfiguration struct {
+	Embedded     bool          `mapstructure:"embedded"`
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	BatchSize    int           `mapstructure:"batch_size"`
+	Lease        time.Duration `mapstructure:"lease"`
+	MaxAttempts  int           `mapstructure:"max_attempts"`
+}
+
+type NotifierConfiguration struct {
+	Driver  string        `mapstructure:"driver"`
+	URL     string        `mapstructure:"url"`
+	Secret  string        `mapstructure:"secret"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
 var (
 	configuration *Configuration
 	once          sync.Once
@@ -0,0 +1,236 @@
+package attachment
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/usecase/attachment"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/response/general"
+)
+
+var errRangeNotSatisfiable = errors.New("range not satisfiable")
+
+type AttachmentHandler interface {
+	Create(e echo.Context) (err error)
+	GetByTaskID(e echo.Context) (err error)
+	Download(e echo.Context) (err error)
+	Delete(e echo.Context) (err error)
+}
+
+type Handler struct {
+	usecase attachment.AttachmentUsecase
+}
+
+func New(usecase attachment.AttachmentUsecase) AttachmentHandler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) Create(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Attachment] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	file, err := e.FormFile("file")
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Attachment] error when get form file", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "file is required"))
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Attachment] error when open form file", slog.String("error", err.Error()))
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+	defer src.Close()
+
+	result, err := h.usecase.Create(ctx, taskId, model.AttachmentUpload{
+		Filename: file.Filename,
+		Size:     file.Size,
+		Body:     src,
+	})
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "created success"
+	return e.JSON(http.StatusCreated, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) GetByTaskID(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Attachment] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	result, err := h.usecase.GetByTaskID(ctx, taskId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "get data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) Download(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Attachment] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	attachmentId, err := strconv.ParseInt(e.Param("attachment_id"), 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Attachment] error when convert attachment_id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param attachment_id"))
+	}
+
+	result, err := h.usecase.GetByID(ctx, taskId, attachmentId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	offset, length, partial, err := parseRange(e.Request().Header.Get("Range"), result.Size)
+	if err != nil {
+		e.Response().Header().Set("Content-Range", fmt.Sprintf("bytes */%d", result.Size))
+		return e.JSON(http.StatusRequestedRangeNotSatisfiable, general.Set(false, nil, nil, nil, "range not satisfiable"))
+	}
+
+	limit := int64(-1)
+	if partial {
+		limit = length
+	}
+
+	body, err := h.usecase.Open(ctx, result, offset, limit)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+	defer body.Close()
+
+	header := e.Response().Header()
+	header.Set("Accept-Ranges", "bytes")
+	header.Set(echo.HeaderContentLength, strconv.FormatInt(length, 10))
+	header.Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": result.Filename}))
+	header.Set("ETag", `"`+result.Checksum+`"`)
+
+	status := http.StatusOK
+	if partial {
+		status = http.StatusPartialContent
+		header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, offset+length-1, result.Size))
+	}
+
+	return e.Stream(status, result.ContentType, body)
+}
+
+func (h *Handler) Delete(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Attachment] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	attachmentId, err := strconv.ParseInt(e.Param("attachment_id"), 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Attachment] error when convert attachment_id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param attachment_id"))
+	}
+
+	err = h.usecase.Delete(ctx, taskId, attachmentId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "delete data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, nil, nil))
+}
+
+func parseRange(header string, size int64) (offset, length int64, partial bool, err error) {
+	spec, ok := strings.CutPrefix(header, "bytes=")
+	if !ok || strings.Contains(spec, ",") {
+		return 0, size, false, nil
+	}
+
+	first, last, ok := strings.Cut(strings.TrimSpace(spec), "-")
+	if !ok {
+		return 0, size, false, nil
+	}
+
+	if first == "" {
+		suffix, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || suffix < 0 {
+			return 0, size, false, nil
+		}
+
+		if suffix == 0 || size == 0 {
+			return 0, 0, false, errRangeNotSatisfiable
+		}
+
+		suffix = min(suffix, size)
+		return size - suffix, suffix, true, nil
+	}
+
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 {
+		return 0, size, false, nil
+	}
+
+	if start >= size {
+		return 0, 0, false, errRangeNotSatisfiable
+	}
+
+	end := size - 1
+	if last != "" {
+		end, err = strconv.ParseInt(last, 10, 64)
+		if err != nil || end < start {
+			return 0, size, false, nil
+		}
+
+		end = min(end, size-1)
+	}
+
+	return start, end - start + 1, true, nil
+}
@@ -0,0 +1,446 @@
+package attachment_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfhlv/go-task/internal/handler/attachment"
+	"github.com/rzfhlv/go-task/internal/model"
+	attachmentmocks "github.com/rzfhlv/go-task/internal/usecase/attachment/mocks"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	attachmentModel = model.Attachment{
+		ID:          1,
+		TaskID:      2,
+		UserID:      1,
+		Filename:    "notes.txt",
+		ContentType: "text/plain; charset=utf-8",
+		Size:        11,
+		Checksum:    "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+	}
+)
+
+func multipartBody(field, filename, content string) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile(field, filename)
+	part.Write([]byte(content))
+	writer.Close()
+
+	return body, writer.FormDataContentType()
+}
+
+func TestHandlerAttachmentCreate(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		field      string
+		mockDeps   func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "2",
+			field:     "file",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.On("Create", mock.Anything, attachmentModel.TaskID, mock.MatchedBy(func(upload model.AttachmentUpload) bool {
+					return upload.Filename == "notes.txt" && upload.Size == 11
+				})).Return(attachmentModel, nil)
+			},
+			statusCode: http.StatusCreated,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when attachment exceeds the maximum size",
+			pathParam: "2",
+			field:     "file",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.On("Create", mock.Anything, attachmentModel.TaskID, mock.Anything).Return(model.Attachment{}, errs.NewErrs(http.StatusRequestEntityTooLarge, "attachment exceeds the maximum size"))
+			},
+			statusCode: http.StatusRequestEntityTooLarge,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call create usecase",
+			pathParam: "2",
+			field:     "file",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.On("Create", mock.Anything, attachmentModel.TaskID, mock.Anything).Return(model.Attachment{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when file is missing",
+			pathParam: "2",
+			field:     "document",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "dua",
+			field:     "file",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attachmentUsecase := attachmentmocks.MockAttachmentUsecase{}
+
+			tt.mockDeps(&attachmentUsecase)
+
+			handler := attachment.New(&attachmentUsecase)
+
+			body, contentType := multipartBody(tt.field, "notes.txt", "hello world")
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+tt.pathParam+"/attachments", body)
+			req.Header.Add(echo.HeaderContentType, contentType)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Create(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerAttachmentGetByTaskID(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		mockDeps   func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "2",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.On("GetByTaskID", mock.Anything, attachmentModel.TaskID).Return([]model.Attachment{attachmentModel}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when task is not found",
+			pathParam: "2",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.On("GetByTaskID", mock.Anything, attachmentModel.TaskID).Return([]model.Attachment{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call get by task id usecase",
+			pathParam: "2",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.On("GetByTaskID", mock.Anything, attachmentModel.TaskID).Return([]model.Attachment{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "dua",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.AssertNotCalled(t, "GetByTaskID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attachmentUsecase := attachmentmocks.MockAttachmentUsecase{}
+
+			tt.mockDeps(&attachmentUsecase)
+
+			handler := attachment.New(&attachmentUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/v1/tasks/"+tt.pathParam+"/attachments", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.GetByTaskID(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerAttachmentDownload(t *testing.T) {
+	content := "hello world"
+	open := func(offset, length int64) io.ReadCloser {
+		if length < 0 {
+			return io.NopCloser(strings.NewReader(content[offset:]))
+		}
+
+		return io.NopCloser(strings.NewReader(content[offset : offset+length]))
+	}
+
+	tests := []struct {
+		name             string
+		pathParam        string
+		attachmentParam  string
+		rangeHeader      string
+		mockDeps         func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase)
+		statusCode       int
+		wantBody         string
+		wantContentRange string
+	}{
+		{
+			name:            "success",
+			pathParam:       "2",
+			attachmentParam: "1",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.On("GetByID", mock.Anything, attachmentModel.TaskID, attachmentModel.ID).Return(attachmentModel, nil)
+				attachmentUsecase.On("Open", mock.Anything, attachmentModel, int64(0), int64(-1)).Return(open(0, -1), nil)
+			},
+			statusCode: http.StatusOK,
+			wantBody:   content,
+		},
+		{
+			name:            "success with range",
+			pathParam:       "2",
+			attachmentParam: "1",
+			rangeHeader:     "bytes=0-4",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.On("GetByID", mock.Anything, attachmentModel.TaskID, attachmentModel.ID).Return(attachmentModel, nil)
+				attachmentUsecase.On("Open", mock.Anything, attachmentModel, int64(0), int64(5)).Return(open(0, 5), nil)
+			},
+			statusCode:       http.StatusPartialContent,
+			wantBody:         "hello",
+			wantContentRange: "bytes 0-4/11",
+		},
+		{
+			name:            "success with open ended range",
+			pathParam:       "2",
+			attachmentParam: "1",
+			rangeHeader:     "bytes=6-",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.On("GetByID", mock.Anything, attachmentModel.TaskID, attachmentModel.ID).Return(attachmentModel, nil)
+				attachmentUsecase.On("Open", mock.Anything, attachmentModel, int64(6), int64(5)).Return(open(6, 5), nil)
+			},
+			statusCode:       http.StatusPartialContent,
+			wantBody:         "world",
+			wantContentRange: "bytes 6-10/11",
+		},
+		{
+			name:            "success with suffix range",
+			pathParam:       "2",
+			attachmentParam: "1",
+			rangeHeader:     "bytes=-3",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.On("GetByID", mock.Anything, attachmentModel.TaskID, attachmentModel.ID).Return(attachmentModel, nil)
+				attachmentUsecase.On("Open", mock.Anything, attachmentModel, int64(8), int64(3)).Return(open(8, 3), nil)
+			},
+			statusCode:       http.StatusPartialContent,
+			wantBody:         "rld",
+			wantContentRange: "bytes 8-10/11",
+		},
+		{
+			name:            "success when range is malformed",
+			pathParam:       "2",
+			attachmentParam: "1",
+			rangeHeader:     "bytes=a-b",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.On("GetByID", mock.Anything, attachmentModel.TaskID, attachmentModel.ID).Return(attachmentModel, nil)
+				attachmentUsecase.On("Open", mock.Anything, attachmentModel, int64(0), int64(-1)).Return(open(0, -1), nil)
+			},
+			statusCode: http.StatusOK,
+			wantBody:   content,
+		},
+		{
+			name:            "error when range is not satisfiable",
+			pathParam:       "2",
+			attachmentParam: "1",
+			rangeHeader:     "bytes=20-",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.On("GetByID", mock.Anything, attachmentModel.TaskID, attachmentModel.ID).Return(attachmentModel, nil)
+				attachmentUsecase.AssertNotCalled(t, "Open")
+			},
+			statusCode:       http.StatusRequestedRangeNotSatisfiable,
+			wantContentRange: "bytes */11",
+		},
+		{
+			name:            "error when blob is not found",
+			pathParam:       "2",
+			attachmentParam: "1",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.On("GetByID", mock.Anything, attachmentModel.TaskID, attachmentModel.ID).Return(attachmentModel, nil)
+				attachmentUsecase.On("Open", mock.Anything, attachmentModel, int64(0), int64(-1)).Return(nil, errs.NewErrs(http.StatusNotFound, "attachment not found"))
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:            "error when call get by id usecase",
+			pathParam:       "2",
+			attachmentParam: "1",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.On("GetByID", mock.Anything, attachmentModel.TaskID, attachmentModel.ID).Return(model.Attachment{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+		},
+		{
+			name:            "error when parse request attachment path param",
+			pathParam:       "2",
+			attachmentParam: "satu",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.AssertNotCalled(t, "GetByID")
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:            "error when parse request path param",
+			pathParam:       "dua",
+			attachmentParam: "1",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.AssertNotCalled(t, "GetByID")
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attachmentUsecase := attachmentmocks.MockAttachmentUsecase{}
+
+			tt.mockDeps(&attachmentUsecase)
+
+			handler := attachment.New(&attachmentUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/v1/tasks/"+tt.pathParam+"/attachments/"+tt.attachmentParam, nil)
+			if tt.rangeHeader != "" {
+				req.Header.Set("Range", tt.rangeHeader)
+			}
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id", "attachment_id")
+			ctx.SetParamValues(tt.pathParam, tt.attachmentParam)
+
+			err := handler.Download(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantContentRange, rec.Header().Get("Content-Range"))
+			if tt.wantBody != "" {
+				assert.Equal(t, tt.wantBody, rec.Body.String())
+				assert.Equal(t, "bytes", rec.Header().Get("Accept-Ranges"))
+				assert.Equal(t, attachmentModel.ContentType, rec.Header().Get(echo.HeaderContentType))
+				assert.Equal(t, `attachment; filename=notes.txt`, rec.Header().Get(echo.HeaderContentDisposition))
+			}
+		})
+	}
+}
+
+func TestHandlerAttachmentDelete(t *testing.T) {
+	tests := []struct {
+		name            string
+		pathParam       string
+		attachmentParam string
+		mockDeps        func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase)
+		statusCode      int
+		wantErr         error
+	}{
+		{
+			name:            "success",
+			pathParam:       "2",
+			attachmentParam: "1",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.On("Delete", mock.Anything, attachmentModel.TaskID, attachmentModel.ID).Return(nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:            "error when user is not the uploader",
+			pathParam:       "2",
+			attachmentParam: "1",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.On("Delete", mock.Anything, attachmentModel.TaskID, attachmentModel.ID).Return(errs.NewErrs(http.StatusForbidden, "only the uploader can delete this attachment"))
+			},
+			statusCode: http.StatusForbidden,
+			wantErr:    nil,
+		},
+		{
+			name:            "error when call delete usecase",
+			pathParam:       "2",
+			attachmentParam: "1",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.On("Delete", mock.Anything, attachmentModel.TaskID, attachmentModel.ID).Return(errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:            "error when parse request attachment path param",
+			pathParam:       "2",
+			attachmentParam: "satu",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.AssertNotCalled(t, "Delete")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:            "error when parse request path param",
+			pathParam:       "dua",
+			attachmentParam: "1",
+			mockDeps: func(attachmentUsecase *attachmentmocks.MockAttachmentUsecase) {
+				attachmentUsecase.AssertNotCalled(t, "Delete")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attachmentUsecase := attachmentmocks.MockAttachmentUsecase{}
+
+			tt.mockDeps(&attachmentUsecase)
+
+			handler := attachment.New(&attachmentUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/v1/tasks/"+tt.pathParam+"/attachments/"+tt.attachmentParam, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id", "attachment_id")
+			ctx.SetParamValues(tt.pathParam, tt.attachmentParam)
+
+			err := handler.Delete(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	echo "github.com/labstack/echo/v4"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAttachmentHandler is an autogenerated mock type for the AttachmentHandler type
+type MockAttachmentHandler struct {
+	mock.Mock
+}
+
+type MockAttachmentHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachmentHandler) EXPECT() *MockAttachmentHandler_Expecter {
+	return &MockAttachmentHandler_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: e
+func (_m *MockAttachmentHandler) Create(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAttachmentHandler_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockAttachmentHandler_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockAttachmentHandler_Expecter) Create(e interface{}) *MockAttachmentHandler_Create_Call {
+	return &MockAttachmentHandler_Create_Call{Call: _e.mock.On("Create", e)}
+}
+
+func (_c *MockAttachmentHandler_Create_Call) Run(run func(e echo.Context)) *MockAttachmentHandler_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentHandler_Create_Call) Return(err error) *MockAttachmentHandler_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAttachmentHandler_Create_Call) RunAndReturn(run func(echo.Context) error) *MockAttachmentHandler_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: e
+func (_m *MockAttachmentHandler) Delete(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAttachmentHandler_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockAttachmentHandler_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockAttachmentHandler_Expecter) Delete(e interface{}) *MockAttachmentHandler_Delete_Call {
+	return &MockAttachmentHandler_Delete_Call{Call: _e.mock.On("Delete", e)}
+}
+
+func (_c *MockAttachmentHandler_Delete_Call) Run(run func(e echo.Context)) *MockAttachmentHandler_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentHandler_Delete_Call) Return(err error) *MockAttachmentHandler_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAttachmentHandler_Delete_Call) RunAndReturn(run func(echo.Context) error) *MockAttachmentHandler_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Download provides a mock function with given fields: e
+func (_m *MockAttachmentHandler) Download(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Download")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAttachmentHandler_Download_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Download'
+type MockAttachmentHandler_Download_Call struct {
+	*mock.Call
+}
+
+// Download is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockAttachmentHandler_Expecter) Download(e interface{}) *MockAttachmentHandler_Download_Call {
+	return &MockAttachmentHandler_Download_Call{Call: _e.mock.On("Download", e)}
+}
+
+func (_c *MockAttachmentHandler_Download_Call) Run(run func(e echo.Context)) *MockAttachmentHandler_Download_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentHandler_Download_Call) Return(err error) *MockAttachmentHandler_Download_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAttachmentHandler_Download_Call) RunAndReturn(run func(echo.Context) error) *MockAttachmentHandler_Download_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTaskID provides a mock function with given fields: e
+func (_m *MockAttachmentHandler) GetByTaskID(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTaskID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAttachmentHandler_GetByTaskID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTaskID'
+type MockAttachmentHandler_GetByTaskID_Call struct {
+	*mock.Call
+}
+
+// GetByTaskID is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockAttachmentHandler_Expecter) GetByTaskID(e interface{}) *MockAttachmentHandler_GetByTaskID_Call {
+	return &MockAttachmentHandler_GetByTaskID_Call{Call: _e.mock.On("GetByTaskID", e)}
+}
+
+func (_c *MockAttachmentHandler_GetByTaskID_Call) Run(run func(e echo.Context)) *MockAttachmentHandler_GetByTaskID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentHandler_GetByTaskID_Call) Return(err error) *MockAttachmentHandler_GetByTaskID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAttachmentHandler_GetByTaskID_Call) RunAndReturn(run func(echo.Context) error) *MockAttachmentHandler_GetByTaskID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAttachmentHandler creates a new instance of MockAttachmentHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachmentHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachmentHandler {
+	mock := &MockAttachmentHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,170 @@
+package comment
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/usecase/comment"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/param"
+	"github.com/rzfhlv/go-task/pkg/response/general"
+)
+
+type CommentHandler interface {
+	Create(e echo.Context) (err error)
+	GetByTaskID(e echo.Context) (err error)
+	Update(e echo.Context) (err error)
+	Delete(e echo.Context) (err error)
+}
+
+type Handler struct {
+	usecase comment.CommentUsecase
+}
+
+func New(usecase comment.CommentUsecase) CommentHandler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) Create(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Comment] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	comment := model.Comment{}
+	err = e.Bind(&comment)
+	if err != nil {
+		return e.JSON(http.StatusUnprocessableEntity, general.Set(false, nil, nil, nil, "invalid json"))
+	}
+
+	err = e.Validate(comment)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Comment] error when validate the request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, err.Error()))
+	}
+
+	result, err := h.usecase.Create(ctx, taskId, comment)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "created success"
+	return e.JSON(http.StatusCreated, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) GetByTaskID(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+	param := param.Param{}
+	param.Limit = 10
+	param.Page = 1
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Comment] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	err = (&echo.DefaultBinder{}).BindQueryParams(e, &param)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Comment] error when bind query param request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid query param request"))
+	}
+
+	result, err := h.usecase.GetByTaskID(ctx, taskId, &param)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "get data success"
+	meta := general.BuildMeta(param, len(result))
+	return e.JSON(http.StatusOK, general.Set(true, &msg, meta, result, nil))
+}
+
+func (h *Handler) Update(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Comment] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	commentId, err := strconv.ParseInt(e.Param("comment_id"), 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Comment] error when convert comment_id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param comment_id"))
+	}
+
+	comment := model.Comment{}
+	err = e.Bind(&comment)
+	if err != nil {
+		return e.JSON(http.StatusUnprocessableEntity, general.Set(false, nil, nil, nil, "invalid json"))
+	}
+
+	err = e.Validate(comment)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Comment] error when validate the request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, err.Error()))
+	}
+
+	comment.ID = commentId
+	result, err := h.usecase.Update(ctx, taskId, comment)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "update data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) Delete(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Comment] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	commentId, err := strconv.ParseInt(e.Param("comment_id"), 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Comment] error when convert comment_id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param comment_id"))
+	}
+
+	err = h.usecase.Delete(ctx, taskId, commentId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "delete data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, nil, nil))
+}
@@ -0,0 +1,403 @@
+package comment_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rzfhlv/go-task/internal/handler/comment"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/presenter/rest"
+	commentmocks "github.com/rzfhlv/go-task/internal/usecase/comment/mocks"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/param"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	commentModel = model.Comment{
+		ID:     1,
+		TaskID: 2,
+		UserID: 1,
+		Body:   "looks good to me",
+	}
+)
+
+func TestHandlerCommentCreate(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		reqBody    string
+		mockDeps   func(commentUsecase *commentmocks.MockCommentUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "2",
+			reqBody:   `{"body":"looks good to me"}`,
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.On("Create", mock.Anything, commentModel.TaskID, model.Comment{Body: commentModel.Body}).Return(commentModel, nil)
+			},
+			statusCode: http.StatusCreated,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when task is not found",
+			pathParam: "2",
+			reqBody:   `{"body":"looks good to me"}`,
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.On("Create", mock.Anything, commentModel.TaskID, mock.Anything).Return(model.Comment{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call create usecase",
+			pathParam: "2",
+			reqBody:   `{"body":"looks good to me"}`,
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.On("Create", mock.Anything, commentModel.TaskID, mock.Anything).Return(model.Comment{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when validate request",
+			pathParam: "2",
+			reqBody:   `{}`,
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind request",
+			pathParam: "2",
+			reqBody:   `{"body":1}`,
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "dua",
+			reqBody:   `{"body":"looks good to me"}`,
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commentUsecase := commentmocks.MockCommentUsecase{}
+
+			tt.mockDeps(&commentUsecase)
+
+			handler := comment.New(&commentUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+tt.pathParam+"/comments", strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Create(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerCommentGetByTaskID(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		query      string
+		mockDeps   func(commentUsecase *commentmocks.MockCommentUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "2",
+			query:     "?limit=5",
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.On("GetByTaskID", mock.Anything, commentModel.TaskID, mock.MatchedBy(func(param *param.Param) bool {
+					return param.Limit == 5
+				})).Return([]model.Comment{commentModel}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when cursor is invalid",
+			pathParam: "2",
+			query:     "?cursor=invalid",
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.On("GetByTaskID", mock.Anything, commentModel.TaskID, mock.Anything).Return([]model.Comment{}, errs.NewErrs(http.StatusBadRequest, "invalid cursor"))
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call get by task id usecase",
+			pathParam: "2",
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.On("GetByTaskID", mock.Anything, commentModel.TaskID, mock.Anything).Return([]model.Comment{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind query param",
+			pathParam: "2",
+			query:     "?limit=lima",
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.AssertNotCalled(t, "GetByTaskID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "dua",
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.AssertNotCalled(t, "GetByTaskID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commentUsecase := commentmocks.MockCommentUsecase{}
+
+			tt.mockDeps(&commentUsecase)
+
+			handler := comment.New(&commentUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/v1/tasks/"+tt.pathParam+"/comments"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.GetByTaskID(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerCommentUpdate(t *testing.T) {
+	tests := []struct {
+		name         string
+		pathParam    string
+		commentParam string
+		reqBody      string
+		mockDeps     func(commentUsecase *commentmocks.MockCommentUsecase)
+		statusCode   int
+		wantErr      error
+	}{
+		{
+			name:         "success",
+			pathParam:    "2",
+			commentParam: "1",
+			reqBody:      `{"body":"edited"}`,
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.On("Update", mock.Anything, commentModel.TaskID, model.Comment{ID: commentModel.ID, Body: "edited"}).Return(commentModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:         "error when user is not the author",
+			pathParam:    "2",
+			commentParam: "1",
+			reqBody:      `{"body":"edited"}`,
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.On("Update", mock.Anything, commentModel.TaskID, mock.Anything).Return(model.Comment{}, errs.NewErrs(http.StatusForbidden, "only the author can modify this comment"))
+			},
+			statusCode: http.StatusForbidden,
+			wantErr:    nil,
+		},
+		{
+			name:         "error when call update usecase",
+			pathParam:    "2",
+			commentParam: "1",
+			reqBody:      `{"body":"edited"}`,
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.On("Update", mock.Anything, commentModel.TaskID, mock.Anything).Return(model.Comment{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:         "error when validate request",
+			pathParam:    "2",
+			commentParam: "1",
+			reqBody:      `{"body":""}`,
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.AssertNotCalled(t, "Update")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:         "error when bind request",
+			pathParam:    "2",
+			commentParam: "1",
+			reqBody:      `{"body":1}`,
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.AssertNotCalled(t, "Update")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+		{
+			name:         "error when parse request comment path param",
+			pathParam:    "2",
+			commentParam: "satu",
+			reqBody:      `{"body":"edited"}`,
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.AssertNotCalled(t, "Update")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:         "error when parse request path param",
+			pathParam:    "dua",
+			commentParam: "1",
+			reqBody:      `{"body":"edited"}`,
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.AssertNotCalled(t, "Update")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commentUsecase := commentmocks.MockCommentUsecase{}
+
+			tt.mockDeps(&commentUsecase)
+
+			handler := comment.New(&commentUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPut, "/v1/tasks/"+tt.pathParam+"/comments/"+tt.commentParam, strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id", "comment_id")
+			ctx.SetParamValues(tt.pathParam, tt.commentParam)
+
+			err := handler.Update(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerCommentDelete(t *testing.T) {
+	tests := []struct {
+		name         string
+		pathParam    string
+		commentParam string
+		mockDeps     func(commentUsecase *commentmocks.MockCommentUsecase)
+		statusCode   int
+		wantErr      error
+	}{
+		{
+			name:         "success",
+			pathParam:    "2",
+			commentParam: "1",
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.On("Delete", mock.Anything, commentModel.TaskID, commentModel.ID).Return(nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:         "error when comment is not found",
+			pathParam:    "2",
+			commentParam: "1",
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.On("Delete", mock.Anything, commentModel.TaskID, commentModel.ID).Return(errs.NewErrs(http.StatusNotFound, "comment not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:         "error when call delete usecase",
+			pathParam:    "2",
+			commentParam: "1",
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.On("Delete", mock.Anything, commentModel.TaskID, commentModel.ID).Return(errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:         "error when parse request comment path param",
+			pathParam:    "2",
+			commentParam: "satu",
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.AssertNotCalled(t, "Delete")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:         "error when parse request path param",
+			pathParam:    "dua",
+			commentParam: "1",
+			mockDeps: func(commentUsecase *commentmocks.MockCommentUsecase) {
+				commentUsecase.AssertNotCalled(t, "Delete")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commentUsecase := commentmocks.MockCommentUsecase{}
+
+			tt.mockDeps(&commentUsecase)
+
+			handler := comment.New(&commentUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/v1/tasks/"+tt.pathParam+"/comments/"+tt.commentParam, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id", "comment_id")
+			ctx.SetParamValues(tt.pathParam, tt.commentParam)
+
+			err := handler.Delete(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
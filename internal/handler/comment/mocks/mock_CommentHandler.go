@@ -0,0 +1,219 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	echo "github.com/labstack/echo/v4"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCommentHandler is an autogenerated mock type for the CommentHandler type
+type MockCommentHandler struct {
+	mock.Mock
+}
+
+type MockCommentHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCommentHandler) EXPECT() *MockCommentHandler_Expecter {
+	return &MockCommentHandler_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: e
+func (_m *MockCommentHandler) Create(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentHandler_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockCommentHandler_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockCommentHandler_Expecter) Create(e interface{}) *MockCommentHandler_Create_Call {
+	return &MockCommentHandler_Create_Call{Call: _e.mock.On("Create", e)}
+}
+
+func (_c *MockCommentHandler_Create_Call) Run(run func(e echo.Context)) *MockCommentHandler_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockCommentHandler_Create_Call) Return(err error) *MockCommentHandler_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCommentHandler_Create_Call) RunAndReturn(run func(echo.Context) error) *MockCommentHandler_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: e
+func (_m *MockCommentHandler) Delete(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentHandler_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockCommentHandler_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockCommentHandler_Expecter) Delete(e interface{}) *MockCommentHandler_Delete_Call {
+	return &MockCommentHandler_Delete_Call{Call: _e.mock.On("Delete", e)}
+}
+
+func (_c *MockCommentHandler_Delete_Call) Run(run func(e echo.Context)) *MockCommentHandler_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockCommentHandler_Delete_Call) Return(err error) *MockCommentHandler_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCommentHandler_Delete_Call) RunAndReturn(run func(echo.Context) error) *MockCommentHandler_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTaskID provides a mock function with given fields: e
+func (_m *MockCommentHandler) GetByTaskID(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTaskID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentHandler_GetByTaskID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTaskID'
+type MockCommentHandler_GetByTaskID_Call struct {
+	*mock.Call
+}
+
+// GetByTaskID is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockCommentHandler_Expecter) GetByTaskID(e interface{}) *MockCommentHandler_GetByTaskID_Call {
+	return &MockCommentHandler_GetByTaskID_Call{Call: _e.mock.On("GetByTaskID", e)}
+}
+
+func (_c *MockCommentHandler_GetByTaskID_Call) Run(run func(e echo.Context)) *MockCommentHandler_GetByTaskID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockCommentHandler_GetByTaskID_Call) Return(err error) *MockCommentHandler_GetByTaskID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCommentHandler_GetByTaskID_Call) RunAndReturn(run func(echo.Context) error) *MockCommentHandler_GetByTaskID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: e
+func (_m *MockCommentHandler) Update(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentHandler_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockCommentHandler_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockCommentHandler_Expecter) Update(e interface{}) *MockCommentHandler_Update_Call {
+	return &MockCommentHandler_Update_Call{Call: _e.mock.On("Update", e)}
+}
+
+func (_c *MockCommentHandler_Update_Call) Run(run func(e echo.Context)) *MockCommentHandler_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockCommentHandler_Update_Call) Return(err error) *MockCommentHandler_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCommentHandler_Update_Call) RunAndReturn(run func(echo.Context) error) *MockCommentHandler_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCommentHandler creates a new instance of MockCommentHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCommentHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCommentHandler {
+	mock := &MockCommentHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,163 @@
+package label
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/usecase/label"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+	"github.com/rzfhlv/go-task/pkg/response/general"
+)
+
+type LabelHandler interface {
+	Create(e echo.Context) (err error)
+	GetByUserID(e echo.Context) (err error)
+	GetByID(e echo.Context) (err error)
+	Update(e echo.Context) (err error)
+	Delete(e echo.Context) (err error)
+}
+
+type Handler struct {
+	usecase label.LabelUsecase
+}
+
+func New(usecase label.LabelUsecase) LabelHandler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) Create(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+	label := model.Label{}
+	err = e.Bind(&label)
+	if err != nil {
+		return e.JSON(http.StatusUnprocessableEntity, general.Set(false, nil, nil, nil, "invalid json"))
+	}
+
+	err = e.Validate(label)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Label] error when validate the request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, err.Error()))
+	}
+
+	result, err := h.usecase.Create(ctx, label)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "created success"
+	return e.JSON(http.StatusCreated, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) GetByUserID(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Handler.Label] error when get id from context")
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "missing user id in context"))
+	}
+
+	result, err := h.usecase.GetByUserID(ctx, userId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "get data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) GetByID(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	labelId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Label] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	result, err := h.usecase.GetByID(ctx, labelId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "get data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) Update(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	labelId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Label] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	label := model.Label{}
+	err = e.Bind(&label)
+	if err != nil {
+		return e.JSON(http.StatusUnprocessableEntity, general.Set(false, nil, nil, nil, "invalid json"))
+	}
+
+	err = e.Validate(label)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Label] error when validate the request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, err.Error()))
+	}
+
+	label.ID = labelId
+	result, err := h.usecase.Update(ctx, label)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "update data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) Delete(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	labelId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Label] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	err = h.usecase.Delete(ctx, labelId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "delete data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, nil, nil))
+}
@@ -0,0 +1,432 @@
+package label_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rzfhlv/go-task/internal/handler/label"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/presenter/rest"
+	labelmocks "github.com/rzfhlv/go-task/internal/usecase/label/mocks"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	labelModel = model.Label{
+		ID:     1,
+		Name:   "urgent",
+		Colour: "#ff0000",
+		UserID: 1,
+	}
+)
+
+func TestHandlerLabelCreate(t *testing.T) {
+	tests := []struct {
+		name       string
+		reqBody    string
+		mockDeps   func(labelUsecase *labelmocks.MockLabelUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:    "success",
+			reqBody: `{"name":"urgent","colour":"#ff0000"}`,
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.On("Create", mock.Anything, model.Label{Name: "urgent", Colour: "#ff0000"}).Return(labelModel, nil)
+			},
+			statusCode: http.StatusCreated,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when label already exists",
+			reqBody: `{"name":"urgent"}`,
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.On("Create", mock.Anything, mock.Anything).Return(model.Label{}, errs.NewErrs(http.StatusConflict, "label already exists"))
+			},
+			statusCode: http.StatusConflict,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when call create usecase",
+			reqBody: `{"name":"urgent"}`,
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.On("Create", mock.Anything, mock.Anything).Return(model.Label{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when validate colour",
+			reqBody: `{"name":"urgent","colour":"red"}`,
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when colour has alpha channel",
+			reqBody: `{"name":"urgent","colour":"#ff0000aa"}`,
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when colour is shorthand",
+			reqBody: `{"name":"urgent","colour":"#f00"}`,
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when validate request",
+			reqBody: `{}`,
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when bind request",
+			reqBody: `{"name":1}`,
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labelUsecase := labelmocks.MockLabelUsecase{}
+
+			tt.mockDeps(&labelUsecase)
+
+			handler := label.New(&labelUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPost, "/v1/labels", strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.Create(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerLabelGetByUserID(t *testing.T) {
+	tests := []struct {
+		name       string
+		mockCtx    func(ctx context.Context) context.Context
+		mockDeps   func(labelUsecase *labelmocks.MockLabelUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, labelModel.UserID)
+			},
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.On("GetByUserID", mock.Anything, labelModel.UserID).Return([]model.Label{labelModel}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name: "error when call get by user id usecase",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, labelModel.UserID)
+			},
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.On("GetByUserID", mock.Anything, labelModel.UserID).Return([]model.Label{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name: "error when user id is missing",
+			mockCtx: func(ctx context.Context) context.Context {
+				return ctx
+			},
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.AssertNotCalled(t, "GetByUserID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labelUsecase := labelmocks.MockLabelUsecase{}
+
+			tt.mockDeps(&labelUsecase)
+
+			handler := label.New(&labelUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/v1/labels", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			baseContext := ctx.Request().Context()
+			baseContext = tt.mockCtx(baseContext)
+			ctx.SetRequest(ctx.Request().WithContext(baseContext))
+
+			err := handler.GetByUserID(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerLabelGetByID(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		mockDeps   func(labelUsecase *labelmocks.MockLabelUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.On("GetByID", mock.Anything, labelModel.ID).Return(labelModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when label is not found",
+			pathParam: "1",
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.On("GetByID", mock.Anything, labelModel.ID).Return(model.Label{}, errs.NewErrs(http.StatusNotFound, "label not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call get by id usecase",
+			pathParam: "1",
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.On("GetByID", mock.Anything, labelModel.ID).Return(model.Label{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.AssertNotCalled(t, "GetByID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labelUsecase := labelmocks.MockLabelUsecase{}
+
+			tt.mockDeps(&labelUsecase)
+
+			handler := label.New(&labelUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/v1/labels/"+tt.pathParam, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.GetByID(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerLabelUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		reqBody    string
+		mockDeps   func(labelUsecase *labelmocks.MockLabelUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			reqBody:   `{"name":"critical"}`,
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.On("Update", mock.Anything, model.Label{ID: 1, Name: "critical"}).Return(labelModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when label name already exists",
+			pathParam: "1",
+			reqBody:   `{"name":"critical"}`,
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.On("Update", mock.Anything, mock.Anything).Return(model.Label{}, errs.NewErrs(http.StatusConflict, "label already exists"))
+			},
+			statusCode: http.StatusConflict,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call update usecase",
+			pathParam: "1",
+			reqBody:   `{"name":"critical"}`,
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.On("Update", mock.Anything, mock.Anything).Return(model.Label{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when validate request",
+			pathParam: "1",
+			reqBody:   `{}`,
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.AssertNotCalled(t, "Update")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind request",
+			pathParam: "1",
+			reqBody:   `{"name":1}`,
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.AssertNotCalled(t, "Update")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			reqBody:   `{"name":"critical"}`,
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.AssertNotCalled(t, "Update")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labelUsecase := labelmocks.MockLabelUsecase{}
+
+			tt.mockDeps(&labelUsecase)
+
+			handler := label.New(&labelUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPut, "/v1/labels/"+tt.pathParam, strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Update(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerLabelDelete(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		mockDeps   func(labelUsecase *labelmocks.MockLabelUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.On("Delete", mock.Anything, labelModel.ID).Return(nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when label is not found",
+			pathParam: "1",
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.On("Delete", mock.Anything, labelModel.ID).Return(errs.NewErrs(http.StatusNotFound, "label not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call delete usecase",
+			pathParam: "1",
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.On("Delete", mock.Anything, labelModel.ID).Return(errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			mockDeps: func(labelUsecase *labelmocks.MockLabelUsecase) {
+				labelUsecase.AssertNotCalled(t, "Delete")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labelUsecase := labelmocks.MockLabelUsecase{}
+
+			tt.mockDeps(&labelUsecase)
+
+			handler := label.New(&labelUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/v1/labels/"+tt.pathParam, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Delete(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
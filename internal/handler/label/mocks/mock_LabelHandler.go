@@ -0,0 +1,266 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	echo "github.com/labstack/echo/v4"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockLabelHandler is an autogenerated mock type for the LabelHandler type
+type MockLabelHandler struct {
+	mock.Mock
+}
+
+type MockLabelHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLabelHandler) EXPECT() *MockLabelHandler_Expecter {
+	return &MockLabelHandler_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: e
+func (_m *MockLabelHandler) Create(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLabelHandler_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockLabelHandler_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockLabelHandler_Expecter) Create(e interface{}) *MockLabelHandler_Create_Call {
+	return &MockLabelHandler_Create_Call{Call: _e.mock.On("Create", e)}
+}
+
+func (_c *MockLabelHandler_Create_Call) Run(run func(e echo.Context)) *MockLabelHandler_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockLabelHandler_Create_Call) Return(err error) *MockLabelHandler_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLabelHandler_Create_Call) RunAndReturn(run func(echo.Context) error) *MockLabelHandler_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: e
+func (_m *MockLabelHandler) Delete(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLabelHandler_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockLabelHandler_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockLabelHandler_Expecter) Delete(e interface{}) *MockLabelHandler_Delete_Call {
+	return &MockLabelHandler_Delete_Call{Call: _e.mock.On("Delete", e)}
+}
+
+func (_c *MockLabelHandler_Delete_Call) Run(run func(e echo.Context)) *MockLabelHandler_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockLabelHandler_Delete_Call) Return(err error) *MockLabelHandler_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLabelHandler_Delete_Call) RunAndReturn(run func(echo.Context) error) *MockLabelHandler_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: e
+func (_m *MockLabelHandler) GetByID(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLabelHandler_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockLabelHandler_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockLabelHandler_Expecter) GetByID(e interface{}) *MockLabelHandler_GetByID_Call {
+	return &MockLabelHandler_GetByID_Call{Call: _e.mock.On("GetByID", e)}
+}
+
+func (_c *MockLabelHandler_GetByID_Call) Run(run func(e echo.Context)) *MockLabelHandler_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockLabelHandler_GetByID_Call) Return(err error) *MockLabelHandler_GetByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLabelHandler_GetByID_Call) RunAndReturn(run func(echo.Context) error) *MockLabelHandler_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUserID provides a mock function with given fields: e
+func (_m *MockLabelHandler) GetByUserID(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLabelHandler_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
+type MockLabelHandler_GetByUserID_Call struct {
+	*mock.Call
+}
+
+// GetByUserID is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockLabelHandler_Expecter) GetByUserID(e interface{}) *MockLabelHandler_GetByUserID_Call {
+	return &MockLabelHandler_GetByUserID_Call{Call: _e.mock.On("GetByUserID", e)}
+}
+
+func (_c *MockLabelHandler_GetByUserID_Call) Run(run func(e echo.Context)) *MockLabelHandler_GetByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockLabelHandler_GetByUserID_Call) Return(err error) *MockLabelHandler_GetByUserID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLabelHandler_GetByUserID_Call) RunAndReturn(run func(echo.Context) error) *MockLabelHandler_GetByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: e
+func (_m *MockLabelHandler) Update(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLabelHandler_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockLabelHandler_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockLabelHandler_Expecter) Update(e interface{}) *MockLabelHandler_Update_Call {
+	return &MockLabelHandler_Update_Call{Call: _e.mock.On("Update", e)}
+}
+
+func (_c *MockLabelHandler_Update_Call) Run(run func(e echo.Context)) *MockLabelHandler_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockLabelHandler_Update_Call) Return(err error) *MockLabelHandler_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLabelHandler_Update_Call) RunAndReturn(run func(echo.Context) error) *MockLabelHandler_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLabelHandler creates a new instance of MockLabelHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLabelHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLabelHandler {
+	mock := &MockLabelHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
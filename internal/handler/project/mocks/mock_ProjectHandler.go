@@ -0,0 +1,357 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	echo "github.com/labstack/echo/v4"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockProjectHandler is an autogenerated mock type for the ProjectHandler type
+type MockProjectHandler struct {
+	mock.Mock
+}
+
+type MockProjectHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectHandler) EXPECT() *MockProjectHandler_Expecter {
+	return &MockProjectHandler_Expecter{mock: &_m.Mock}
+}
+
+// Archive provides a mock function with given fields: e
+func (_m *MockProjectHandler) Archive(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Archive")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProjectHandler_Archive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Archive'
+type MockProjectHandler_Archive_Call struct {
+	*mock.Call
+}
+
+// Archive is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockProjectHandler_Expecter) Archive(e interface{}) *MockProjectHandler_Archive_Call {
+	return &MockProjectHandler_Archive_Call{Call: _e.mock.On("Archive", e)}
+}
+
+func (_c *MockProjectHandler_Archive_Call) Run(run func(e echo.Context)) *MockProjectHandler_Archive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockProjectHandler_Archive_Call) Return(err error) *MockProjectHandler_Archive_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProjectHandler_Archive_Call) RunAndReturn(run func(echo.Context) error) *MockProjectHandler_Archive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: e
+func (_m *MockProjectHandler) Create(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProjectHandler_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockProjectHandler_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockProjectHandler_Expecter) Create(e interface{}) *MockProjectHandler_Create_Call {
+	return &MockProjectHandler_Create_Call{Call: _e.mock.On("Create", e)}
+}
+
+func (_c *MockProjectHandler_Create_Call) Run(run func(e echo.Context)) *MockProjectHandler_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockProjectHandler_Create_Call) Return(err error) *MockProjectHandler_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProjectHandler_Create_Call) RunAndReturn(run func(echo.Context) error) *MockProjectHandler_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: e
+func (_m *MockProjectHandler) Delete(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProjectHandler_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockProjectHandler_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockProjectHandler_Expecter) Delete(e interface{}) *MockProjectHandler_Delete_Call {
+	return &MockProjectHandler_Delete_Call{Call: _e.mock.On("Delete", e)}
+}
+
+func (_c *MockProjectHandler_Delete_Call) Run(run func(e echo.Context)) *MockProjectHandler_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockProjectHandler_Delete_Call) Return(err error) *MockProjectHandler_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProjectHandler_Delete_Call) RunAndReturn(run func(echo.Context) error) *MockProjectHandler_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: e
+func (_m *MockProjectHandler) GetByID(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProjectHandler_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockProjectHandler_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockProjectHandler_Expecter) GetByID(e interface{}) *MockProjectHandler_GetByID_Call {
+	return &MockProjectHandler_GetByID_Call{Call: _e.mock.On("GetByID", e)}
+}
+
+func (_c *MockProjectHandler_GetByID_Call) Run(run func(e echo.Context)) *MockProjectHandler_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockProjectHandler_GetByID_Call) Return(err error) *MockProjectHandler_GetByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProjectHandler_GetByID_Call) RunAndReturn(run func(echo.Context) error) *MockProjectHandler_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUserID provides a mock function with given fields: e
+func (_m *MockProjectHandler) GetByUserID(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProjectHandler_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
+type MockProjectHandler_GetByUserID_Call struct {
+	*mock.Call
+}
+
+// GetByUserID is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockProjectHandler_Expecter) GetByUserID(e interface{}) *MockProjectHandler_GetByUserID_Call {
+	return &MockProjectHandler_GetByUserID_Call{Call: _e.mock.On("GetByUserID", e)}
+}
+
+func (_c *MockProjectHandler_GetByUserID_Call) Run(run func(e echo.Context)) *MockProjectHandler_GetByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockProjectHandler_GetByUserID_Call) Return(err error) *MockProjectHandler_GetByUserID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProjectHandler_GetByUserID_Call) RunAndReturn(run func(echo.Context) error) *MockProjectHandler_GetByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unarchive provides a mock function with given fields: e
+func (_m *MockProjectHandler) Unarchive(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unarchive")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProjectHandler_Unarchive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unarchive'
+type MockProjectHandler_Unarchive_Call struct {
+	*mock.Call
+}
+
+// Unarchive is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockProjectHandler_Expecter) Unarchive(e interface{}) *MockProjectHandler_Unarchive_Call {
+	return &MockProjectHandler_Unarchive_Call{Call: _e.mock.On("Unarchive", e)}
+}
+
+func (_c *MockProjectHandler_Unarchive_Call) Run(run func(e echo.Context)) *MockProjectHandler_Unarchive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockProjectHandler_Unarchive_Call) Return(err error) *MockProjectHandler_Unarchive_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProjectHandler_Unarchive_Call) RunAndReturn(run func(echo.Context) error) *MockProjectHandler_Unarchive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: e
+func (_m *MockProjectHandler) Update(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProjectHandler_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockProjectHandler_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockProjectHandler_Expecter) Update(e interface{}) *MockProjectHandler_Update_Call {
+	return &MockProjectHandler_Update_Call{Call: _e.mock.On("Update", e)}
+}
+
+func (_c *MockProjectHandler_Update_Call) Run(run func(e echo.Context)) *MockProjectHandler_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockProjectHandler_Update_Call) Return(err error) *MockProjectHandler_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProjectHandler_Update_Call) RunAndReturn(run func(echo.Context) error) *MockProjectHandler_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectHandler creates a new instance of MockProjectHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectHandler {
+	mock := &MockProjectHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
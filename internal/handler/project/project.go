@@ -0,0 +1,222 @@
+package project
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/usecase/project"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+	"github.com/rzfhlv/go-task/pkg/param"
+	"github.com/rzfhlv/go-task/pkg/response/general"
+)
+
+type ProjectHandler interface {
+	Create(e echo.Context) (err error)
+	GetByUserID(e echo.Context) (err error)
+	GetByID(e echo.Context) (err error)
+	Update(e echo.Context) (err error)
+	Archive(e echo.Context) (err error)
+	Unarchive(e echo.Context) (err error)
+	Delete(e echo.Context) (err error)
+}
+
+type Handler struct {
+	usecase project.ProjectUsecase
+}
+
+func New(usecase project.ProjectUsecase) ProjectHandler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) Create(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+	project := model.Project{}
+	err = e.Bind(&project)
+	if err != nil {
+		return e.JSON(http.StatusUnprocessableEntity, general.Set(false, nil, nil, nil, "invalid json"))
+	}
+
+	err = e.Validate(project)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Project] error when validate the request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, err.Error()))
+	}
+
+	result, err := h.usecase.Create(ctx, project)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "created success"
+	return e.JSON(http.StatusCreated, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) GetByUserID(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+	param := param.Param{}
+	param.Limit = 10
+	param.Page = 1
+
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Handler.Project] error when get id from context")
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "missing user id in context"))
+	}
+
+	err = (&echo.DefaultBinder{}).BindQueryParams(e, &param)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Project] error when bind query param request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid query param request"))
+	}
+
+	result, err := h.usecase.GetByUserID(ctx, userId, &param)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "get data success"
+	meta := general.BuildMeta(param, len(result))
+	return e.JSON(http.StatusOK, general.Set(true, &msg, meta, result, nil))
+}
+
+func (h *Handler) GetByID(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	projectId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Project] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	result, err := h.usecase.GetByID(ctx, projectId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "get data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) Update(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	projectId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Project] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	project := model.Project{}
+	err = e.Bind(&project)
+	if err != nil {
+		return e.JSON(http.StatusUnprocessableEntity, general.Set(false, nil, nil, nil, "invalid json"))
+	}
+
+	err = e.Validate(project)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Project] error when validate the request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, err.Error()))
+	}
+
+	project.ID = projectId
+	result, err := h.usecase.Update(ctx, project)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "update data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) Archive(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	projectId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Project] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	result, err := h.usecase.Archive(ctx, projectId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "archive data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) Unarchive(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	projectId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Project] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	result, err := h.usecase.Unarchive(ctx, projectId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "unarchive data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) Delete(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	projectId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Project] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	err = h.usecase.Delete(ctx, projectId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "delete data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, nil, nil))
+}
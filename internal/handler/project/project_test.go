@@ -0,0 +1,573 @@
+package project_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rzfhlv/go-task/internal/handler/project"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/presenter/rest"
+	projectmocks "github.com/rzfhlv/go-task/internal/usecase/project/mocks"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+	"github.com/rzfhlv/go-task/pkg/param"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	projectModel = model.Project{
+		ID:          1,
+		Name:        "Launch",
+		Description: "q3 launch",
+		UserID:      1,
+		TaskCounts:  map[string]int64{},
+	}
+)
+
+func TestHandlerProjectCreate(t *testing.T) {
+	tests := []struct {
+		name       string
+		reqBody    string
+		mockDeps   func(projectUsecase *projectmocks.MockProjectUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:    "success",
+			reqBody: `{"name":"Launch","description":"q3 launch"}`,
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("Create", mock.Anything, model.Project{Name: "Launch", Description: "q3 launch"}).Return(projectModel, nil)
+			},
+			statusCode: http.StatusCreated,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when call create usecase",
+			reqBody: `{"name":"Launch"}`,
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("Create", mock.Anything, mock.Anything).Return(model.Project{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when user id is missing",
+			reqBody: `{"name":"Launch"}`,
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("Create", mock.Anything, mock.Anything).Return(model.Project{}, errs.NewErrs(http.StatusForbidden, "forbidden access"))
+			},
+			statusCode: http.StatusForbidden,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when validate request",
+			reqBody: `{}`,
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when bind request",
+			reqBody: `{"name":1}`,
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectUsecase := projectmocks.MockProjectUsecase{}
+
+			tt.mockDeps(&projectUsecase)
+
+			handler := project.New(&projectUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPost, "/v1/projects", strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.Create(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerProjectGetByUserID(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		mockCtx    func(ctx context.Context) context.Context
+		mockDeps   func(projectUsecase *projectmocks.MockProjectUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:  "success",
+			query: "?page=1&limit=10",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, projectModel.UserID)
+			},
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("GetByUserID", mock.Anything, projectModel.UserID, mock.Anything).Return([]model.Project{projectModel}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:  "success with archived projects",
+			query: "?archived=true",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, projectModel.UserID)
+			},
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("GetByUserID", mock.Anything, projectModel.UserID, mock.MatchedBy(func(p *param.Param) bool {
+					return p.Archived
+				})).Return([]model.Project{}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:  "error when call get by user id usecase",
+			query: "",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, projectModel.UserID)
+			},
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("GetByUserID", mock.Anything, projectModel.UserID, mock.Anything).Return([]model.Project{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:  "error when bind query param",
+			query: "?archived=maybe",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, projectModel.UserID)
+			},
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.AssertNotCalled(t, "GetByUserID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:  "error when user id is missing",
+			query: "",
+			mockCtx: func(ctx context.Context) context.Context {
+				return ctx
+			},
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.AssertNotCalled(t, "GetByUserID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectUsecase := projectmocks.MockProjectUsecase{}
+
+			tt.mockDeps(&projectUsecase)
+
+			handler := project.New(&projectUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/v1/projects"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			baseContext := ctx.Request().Context()
+			baseContext = tt.mockCtx(baseContext)
+			ctx.SetRequest(ctx.Request().WithContext(baseContext))
+
+			err := handler.GetByUserID(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerProjectGetByID(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		mockDeps   func(projectUsecase *projectmocks.MockProjectUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("GetByID", mock.Anything, projectModel.ID).Return(projectModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when project is not found",
+			pathParam: "1",
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("GetByID", mock.Anything, projectModel.ID).Return(model.Project{}, errs.NewErrs(http.StatusNotFound, "project not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call get by id usecase",
+			pathParam: "1",
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("GetByID", mock.Anything, projectModel.ID).Return(model.Project{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.AssertNotCalled(t, "GetByID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectUsecase := projectmocks.MockProjectUsecase{}
+
+			tt.mockDeps(&projectUsecase)
+
+			handler := project.New(&projectUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/v1/projects/"+tt.pathParam, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.GetByID(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerProjectUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		reqBody    string
+		mockDeps   func(projectUsecase *projectmocks.MockProjectUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			reqBody:   `{"name":"Relaunch","description":"q4 launch"}`,
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("Update", mock.Anything, model.Project{ID: 1, Name: "Relaunch", Description: "q4 launch"}).Return(projectModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when project is not found",
+			pathParam: "1",
+			reqBody:   `{"name":"Relaunch"}`,
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("Update", mock.Anything, mock.Anything).Return(model.Project{}, errs.NewErrs(http.StatusNotFound, "project not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call update usecase",
+			pathParam: "1",
+			reqBody:   `{"name":"Relaunch"}`,
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("Update", mock.Anything, mock.Anything).Return(model.Project{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when validate request",
+			pathParam: "1",
+			reqBody:   `{}`,
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.AssertNotCalled(t, "Update")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind request",
+			pathParam: "1",
+			reqBody:   `{"name":1}`,
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.AssertNotCalled(t, "Update")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			reqBody:   `{"name":"Relaunch"}`,
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.AssertNotCalled(t, "Update")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectUsecase := projectmocks.MockProjectUsecase{}
+
+			tt.mockDeps(&projectUsecase)
+
+			handler := project.New(&projectUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPut, "/v1/projects/"+tt.pathParam, strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Update(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerProjectArchive(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		mockDeps   func(projectUsecase *projectmocks.MockProjectUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("Archive", mock.Anything, projectModel.ID).Return(projectModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when project is already archived",
+			pathParam: "1",
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("Archive", mock.Anything, projectModel.ID).Return(model.Project{}, errs.NewErrs(http.StatusConflict, "project is already archived"))
+			},
+			statusCode: http.StatusConflict,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call archive usecase",
+			pathParam: "1",
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("Archive", mock.Anything, projectModel.ID).Return(model.Project{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.AssertNotCalled(t, "Archive")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectUsecase := projectmocks.MockProjectUsecase{}
+
+			tt.mockDeps(&projectUsecase)
+
+			handler := project.New(&projectUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/v1/projects/"+tt.pathParam+"/archive", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Archive(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerProjectUnarchive(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		mockDeps   func(projectUsecase *projectmocks.MockProjectUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("Unarchive", mock.Anything, projectModel.ID).Return(projectModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when project is not archived",
+			pathParam: "1",
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("Unarchive", mock.Anything, projectModel.ID).Return(model.Project{}, errs.NewErrs(http.StatusConflict, "project is not archived"))
+			},
+			statusCode: http.StatusConflict,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call unarchive usecase",
+			pathParam: "1",
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("Unarchive", mock.Anything, projectModel.ID).Return(model.Project{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.AssertNotCalled(t, "Unarchive")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectUsecase := projectmocks.MockProjectUsecase{}
+
+			tt.mockDeps(&projectUsecase)
+
+			handler := project.New(&projectUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/v1/projects/"+tt.pathParam+"/unarchive", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Unarchive(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerProjectDelete(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		mockDeps   func(projectUsecase *projectmocks.MockProjectUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("Delete", mock.Anything, projectModel.ID).Return(nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when project is not found",
+			pathParam: "1",
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("Delete", mock.Anything, projectModel.ID).Return(errs.NewErrs(http.StatusNotFound, "project not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call delete usecase",
+			pathParam: "1",
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.On("Delete", mock.Anything, projectModel.ID).Return(errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			mockDeps: func(projectUsecase *projectmocks.MockProjectUsecase) {
+				projectUsecase.AssertNotCalled(t, "Delete")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectUsecase := projectmocks.MockProjectUsecase{}
+
+			tt.mockDeps(&projectUsecase)
+
+			handler := project.New(&projectUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/v1/projects/"+tt.pathParam, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Delete(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	echo "github.com/labstack/echo/v4"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockReminderHandler is an autogenerated mock type for the ReminderHandler type
+type MockReminderHandler struct {
+	mock.Mock
+}
+
+type MockReminderHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReminderHandler) EXPECT() *MockReminderHandler_Expecter {
+	return &MockReminderHandler_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: e
+func (_m *MockReminderHandler) Create(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReminderHandler_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockReminderHandler_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockReminderHandler_Expecter) Create(e interface{}) *MockReminderHandler_Create_Call {
+	return &MockReminderHandler_Create_Call{Call: _e.mock.On("Create", e)}
+}
+
+func (_c *MockReminderHandler_Create_Call) Run(run func(e echo.Context)) *MockReminderHandler_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockReminderHandler_Create_Call) Return(err error) *MockReminderHandler_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReminderHandler_Create_Call) RunAndReturn(run func(echo.Context) error) *MockReminderHandler_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: e
+func (_m *MockReminderHandler) Delete(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReminderHandler_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockReminderHandler_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockReminderHandler_Expecter) Delete(e interface{}) *MockReminderHandler_Delete_Call {
+	return &MockReminderHandler_Delete_Call{Call: _e.mock.On("Delete", e)}
+}
+
+func (_c *MockReminderHandler_Delete_Call) Run(run func(e echo.Context)) *MockReminderHandler_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockReminderHandler_Delete_Call) Return(err error) *MockReminderHandler_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReminderHandler_Delete_Call) RunAndReturn(run func(echo.Context) error) *MockReminderHandler_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTaskID provides a mock function with given fields: e
+func (_m *MockReminderHandler) GetByTaskID(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTaskID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReminderHandler_GetByTaskID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTaskID'
+type MockReminderHandler_GetByTaskID_Call struct {
+	*mock.Call
+}
+
+// GetByTaskID is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockReminderHandler_Expecter) GetByTaskID(e interface{}) *MockReminderHandler_GetByTaskID_Call {
+	return &MockReminderHandler_GetByTaskID_Call{Call: _e.mock.On("GetByTaskID", e)}
+}
+
+func (_c *MockReminderHandler_GetByTaskID_Call) Run(run func(e echo.Context)) *MockReminderHandler_GetByTaskID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockReminderHandler_GetByTaskID_Call) Return(err error) *MockReminderHandler_GetByTaskID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReminderHandler_GetByTaskID_Call) RunAndReturn(run func(echo.Context) error) *MockReminderHandler_GetByTaskID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReminderHandler creates a new instance of MockReminderHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReminderHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReminderHandler {
+	mock := &MockReminderHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
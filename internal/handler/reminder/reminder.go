@@ -0,0 +1,116 @@
+package reminder
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/usecase/reminder"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/response/general"
+)
+
+type ReminderHandler interface {
+	Create(e echo.Context) (err error)
+	GetByTaskID(e echo.Context) (err error)
+	Delete(e echo.Context) (err error)
+}
+
+type Handler struct {
+	usecase reminder.ReminderUsecase
+}
+
+func New(usecase reminder.ReminderUsecase) ReminderHandler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) Create(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Reminder] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	reminder := model.Reminder{}
+	err = e.Bind(&reminder)
+	if err != nil {
+		return e.JSON(http.StatusUnprocessableEntity, general.Set(false, nil, nil, nil, "invalid json"))
+	}
+
+	err = e.Validate(reminder)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Reminder] error when validate the request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, err.Error()))
+	}
+
+	result, err := h.usecase.Create(ctx, taskId, reminder)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "created success"
+	return e.JSON(http.StatusCreated, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) GetByTaskID(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Reminder] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	result, err := h.usecase.GetByTaskID(ctx, taskId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "get data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) Delete(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Reminder] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	reminderId, err := strconv.ParseInt(e.Param("reminder_id"), 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Reminder] error when convert reminder_id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param reminder_id"))
+	}
+
+	err = h.usecase.Delete(ctx, taskId, reminderId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "delete data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, nil, nil))
+}
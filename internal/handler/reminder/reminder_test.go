@@ -0,0 +1,309 @@
+package reminder_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rzfhlv/go-task/internal/handler/reminder"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/presenter/rest"
+	remindermocks "github.com/rzfhlv/go-task/internal/usecase/reminder/mocks"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	remindAt = time.Date(2030, time.January, 5, 9, 0, 0, 0, time.UTC)
+	offset   = 30
+
+	reminderModel = model.Reminder{
+		ID:       1,
+		TaskID:   2,
+		UserID:   1,
+		RemindAt: &remindAt,
+		FireAt:   &remindAt,
+	}
+)
+
+func TestHandlerReminderCreate(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		reqBody    string
+		mockDeps   func(reminderUsecase *remindermocks.MockReminderUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success with remind at",
+			pathParam: "2",
+			reqBody:   `{"remind_at":"2030-01-05T09:00:00Z"}`,
+			mockDeps: func(reminderUsecase *remindermocks.MockReminderUsecase) {
+				reminderUsecase.On("Create", mock.Anything, reminderModel.TaskID, model.Reminder{RemindAt: &remindAt}).Return(reminderModel, nil)
+			},
+			statusCode: http.StatusCreated,
+			wantErr:    nil,
+		},
+		{
+			name:      "success with offset",
+			pathParam: "2",
+			reqBody:   `{"offset_minutes":30}`,
+			mockDeps: func(reminderUsecase *remindermocks.MockReminderUsecase) {
+				reminderUsecase.On("Create", mock.Anything, reminderModel.TaskID, model.Reminder{OffsetMinutes: &offset}).Return(reminderModel, nil)
+			},
+			statusCode: http.StatusCreated,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when offset is used without due date",
+			pathParam: "2",
+			reqBody:   `{"offset_minutes":30}`,
+			mockDeps: func(reminderUsecase *remindermocks.MockReminderUsecase) {
+				reminderUsecase.On("Create", mock.Anything, reminderModel.TaskID, mock.Anything).Return(model.Reminder{}, errs.NewErrs(http.StatusBadRequest, "due_at is required for offset reminder"))
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call create usecase",
+			pathParam: "2",
+			reqBody:   `{"remind_at":"2030-01-05T09:00:00Z"}`,
+			mockDeps: func(reminderUsecase *remindermocks.MockReminderUsecase) {
+				reminderUsecase.On("Create", mock.Anything, reminderModel.TaskID, mock.Anything).Return(model.Reminder{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when validate request without time",
+			pathParam: "2",
+			reqBody:   `{}`,
+			mockDeps: func(reminderUsecase *remindermocks.MockReminderUsecase) {
+				reminderUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when validate request with both remind at and offset",
+			pathParam: "2",
+			reqBody:   `{"remind_at":"2030-01-05T09:00:00Z","offset_minutes":30}`,
+			mockDeps: func(reminderUsecase *remindermocks.MockReminderUsecase) {
+				reminderUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when validate request with negative offset",
+			pathParam: "2",
+			reqBody:   `{"offset_minutes":-5}`,
+			mockDeps: func(reminderUsecase *remindermocks.MockReminderUsecase) {
+				reminderUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind request",
+			pathParam: "2",
+			reqBody:   `{"remind_at":1}`,
+			mockDeps: func(reminderUsecase *remindermocks.MockReminderUsecase) {
+				reminderUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "dua",
+			reqBody:   `{"remind_at":"2030-01-05T09:00:00Z"}`,
+			mockDeps: func(reminderUsecase *remindermocks.MockReminderUsecase) {
+				reminderUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reminderUsecase := remindermocks.MockReminderUsecase{}
+
+			tt.mockDeps(&reminderUsecase)
+
+			handler := reminder.New(&reminderUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+tt.pathParam+"/reminders", strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Create(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerReminderGetByTaskID(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		mockDeps   func(reminderUsecase *remindermocks.MockReminderUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "2",
+			mockDeps: func(reminderUsecase *remindermocks.MockReminderUsecase) {
+				reminderUsecase.On("GetByTaskID", mock.Anything, reminderModel.TaskID).Return([]model.Reminder{reminderModel}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when task is not found",
+			pathParam: "2",
+			mockDeps: func(reminderUsecase *remindermocks.MockReminderUsecase) {
+				reminderUsecase.On("GetByTaskID", mock.Anything, reminderModel.TaskID).Return([]model.Reminder{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call get usecase",
+			pathParam: "2",
+			mockDeps: func(reminderUsecase *remindermocks.MockReminderUsecase) {
+				reminderUsecase.On("GetByTaskID", mock.Anything, reminderModel.TaskID).Return([]model.Reminder{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "dua",
+			mockDeps: func(reminderUsecase *remindermocks.MockReminderUsecase) {
+				reminderUsecase.AssertNotCalled(t, "GetByTaskID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reminderUsecase := remindermocks.MockReminderUsecase{}
+
+			tt.mockDeps(&reminderUsecase)
+
+			handler := reminder.New(&reminderUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/v1/tasks/"+tt.pathParam+"/reminders", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.GetByTaskID(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerReminderDelete(t *testing.T) {
+	tests := []struct {
+		name          string
+		pathParam     string
+		reminderParam string
+		mockDeps      func(reminderUsecase *remindermocks.MockReminderUsecase)
+		statusCode    int
+		wantErr       error
+	}{
+		{
+			name:          "success",
+			pathParam:     "2",
+			reminderParam: "1",
+			mockDeps: func(reminderUsecase *remindermocks.MockReminderUsecase) {
+				reminderUsecase.On("Delete", mock.Anything, reminderModel.TaskID, reminderModel.ID).Return(nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:          "error when reminder is not found",
+			pathParam:     "2",
+			reminderParam: "1",
+			mockDeps: func(reminderUsecase *remindermocks.MockReminderUsecase) {
+				reminderUsecase.On("Delete", mock.Anything, reminderModel.TaskID, reminderModel.ID).Return(errs.NewErrs(http.StatusNotFound, "reminder not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:          "error when call delete usecase",
+			pathParam:     "2",
+			reminderParam: "1",
+			mockDeps: func(reminderUsecase *remindermocks.MockReminderUsecase) {
+				reminderUsecase.On("Delete", mock.Anything, reminderModel.TaskID, reminderModel.ID).Return(errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:          "error when parse reminder path param",
+			pathParam:     "2",
+			reminderParam: "satu",
+			mockDeps: func(reminderUsecase *remindermocks.MockReminderUsecase) {
+				reminderUsecase.AssertNotCalled(t, "Delete")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:          "error when parse request path param",
+			pathParam:     "dua",
+			reminderParam: "1",
+			mockDeps: func(reminderUsecase *remindermocks.MockReminderUsecase) {
+				reminderUsecase.AssertNotCalled(t, "Delete")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reminderUsecase := remindermocks.MockReminderUsecase{}
+
+			tt.mockDeps(&reminderUsecase)
+
+			handler := reminder.New(&reminderUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/v1/tasks/"+tt.pathParam+"/reminders/"+tt.reminderParam, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id", "reminder_id")
+			ctx.SetParamValues(tt.pathParam, tt.reminderParam)
+
+			err := handler.Delete(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -20,6 +20,282 @@ func (_m *MockTaskHandler) EXPECT() *MockTaskHandler_Expecter {
 	return &MockTaskHandler_Expecter{mock: &_m.Mock}
 }
 
+// AddChecklistItem provides a mock function with given fields: e
+func (_m *MockTaskHandler) AddChecklistItem(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddChecklistItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_AddChecklistItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddChecklistItem'
+type MockTaskHandler_AddChecklistItem_Call struct {
+	*mock.Call
+}
+
+// AddChecklistItem is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) AddChecklistItem(e interface{}) *MockTaskHandler_AddChecklistItem_Call {
+	return &MockTaskHandler_AddChecklistItem_Call{Call: _e.mock.On("AddChecklistItem", e)}
+}
+
+func (_c *MockTaskHandler_AddChecklistItem_Call) Run(run func(e echo.Context)) *MockTaskHandler_AddChecklistItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_AddChecklistItem_Call) Return(err error) *MockTaskHandler_AddChecklistItem_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_AddChecklistItem_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_AddChecklistItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddDependency provides a mock function with given fields: e
+func (_m *MockTaskHandler) AddDependency(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDependency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_AddDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDependency'
+type MockTaskHandler_AddDependency_Call struct {
+	*mock.Call
+}
+
+// AddDependency is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) AddDependency(e interface{}) *MockTaskHandler_AddDependency_Call {
+	return &MockTaskHandler_AddDependency_Call{Call: _e.mock.On("AddDependency", e)}
+}
+
+func (_c *MockTaskHandler_AddDependency_Call) Run(run func(e echo.Context)) *MockTaskHandler_AddDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_AddDependency_Call) Return(err error) *MockTaskHandler_AddDependency_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_AddDependency_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_AddDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AttachLabel provides a mock function with given fields: e
+func (_m *MockTaskHandler) AttachLabel(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_AttachLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachLabel'
+type MockTaskHandler_AttachLabel_Call struct {
+	*mock.Call
+}
+
+// AttachLabel is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) AttachLabel(e interface{}) *MockTaskHandler_AttachLabel_Call {
+	return &MockTaskHandler_AttachLabel_Call{Call: _e.mock.On("AttachLabel", e)}
+}
+
+func (_c *MockTaskHandler_AttachLabel_Call) Run(run func(e echo.Context)) *MockTaskHandler_AttachLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_AttachLabel_Call) Return(err error) *MockTaskHandler_AttachLabel_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_AttachLabel_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_AttachLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Board provides a mock function with given fields: e
+func (_m *MockTaskHandler) Board(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Board")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_Board_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Board'
+type MockTaskHandler_Board_Call struct {
+	*mock.Call
+}
+
+// Board is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) Board(e interface{}) *MockTaskHandler_Board_Call {
+	return &MockTaskHandler_Board_Call{Call: _e.mock.On("Board", e)}
+}
+
+func (_c *MockTaskHandler_Board_Call) Run(run func(e echo.Context)) *MockTaskHandler_Board_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_Board_Call) Return(err error) *MockTaskHandler_Board_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_Board_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_Board_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Bulk provides a mock function with given fields: e
+func (_m *MockTaskHandler) Bulk(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Bulk")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_Bulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Bulk'
+type MockTaskHandler_Bulk_Call struct {
+	*mock.Call
+}
+
+// Bulk is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) Bulk(e interface{}) *MockTaskHandler_Bulk_Call {
+	return &MockTaskHandler_Bulk_Call{Call: _e.mock.On("Bulk", e)}
+}
+
+func (_c *MockTaskHandler_Bulk_Call) Run(run func(e echo.Context)) *MockTaskHandler_Bulk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_Bulk_Call) Return(err error) *MockTaskHandler_Bulk_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_Bulk_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_Bulk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Burndown provides a mock function with given fields: e
+func (_m *MockTaskHandler) Burndown(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Burndown")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_Burndown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Burndown'
+type MockTaskHandler_Burndown_Call struct {
+	*mock.Call
+}
+
+// Burndown is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) Burndown(e interface{}) *MockTaskHandler_Burndown_Call {
+	return &MockTaskHandler_Burndown_Call{Call: _e.mock.On("Burndown", e)}
+}
+
+func (_c *MockTaskHandler_Burndown_Call) Run(run func(e echo.Context)) *MockTaskHandler_Burndown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_Burndown_Call) Return(err error) *MockTaskHandler_Burndown_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_Burndown_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_Burndown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: e
 func (_m *MockTaskHandler) Create(e echo.Context) error {
 	ret := _m.Called(e)
@@ -112,6 +388,52 @@ func (_c *MockTaskHandler_Delete_Call) RunAndReturn(run func(echo.Context) error
 	return _c
 }
 
+// DetachLabel provides a mock function with given fields: e
+func (_m *MockTaskHandler) DetachLabel(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_DetachLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetachLabel'
+type MockTaskHandler_DetachLabel_Call struct {
+	*mock.Call
+}
+
+// DetachLabel is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) DetachLabel(e interface{}) *MockTaskHandler_DetachLabel_Call {
+	return &MockTaskHandler_DetachLabel_Call{Call: _e.mock.On("DetachLabel", e)}
+}
+
+func (_c *MockTaskHandler_DetachLabel_Call) Run(run func(e echo.Context)) *MockTaskHandler_DetachLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_DetachLabel_Call) Return(err error) *MockTaskHandler_DetachLabel_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_DetachLabel_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_DetachLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByID provides a mock function with given fields: e
 func (_m *MockTaskHandler) GetByID(e echo.Context) error {
 	ret := _m.Called(e)
@@ -158,12 +480,12 @@ func (_c *MockTaskHandler_GetByID_Call) RunAndReturn(run func(echo.Context) erro
 	return _c
 }
 
-// GetByUserID provides a mock function with given fields: e
-func (_m *MockTaskHandler) GetByUserID(e echo.Context) error {
+// GetByProjectID provides a mock function with given fields: e
+func (_m *MockTaskHandler) GetByProjectID(e echo.Context) error {
 	ret := _m.Called(e)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByUserID")
+		panic("no return value specified for GetByProjectID")
 	}
 
 	var r0 error
@@ -176,30 +498,766 @@ func (_m *MockTaskHandler) GetByUserID(e echo.Context) error {
 	return r0
 }
 
-// MockTaskHandler_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
-type MockTaskHandler_GetByUserID_Call struct {
+// MockTaskHandler_GetByProjectID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByProjectID'
+type MockTaskHandler_GetByProjectID_Call struct {
 	*mock.Call
 }
 
-// GetByUserID is a helper method to define mock.On call
+// GetByProjectID is a helper method to define mock.On call
 //   - e echo.Context
-func (_e *MockTaskHandler_Expecter) GetByUserID(e interface{}) *MockTaskHandler_GetByUserID_Call {
-	return &MockTaskHandler_GetByUserID_Call{Call: _e.mock.On("GetByUserID", e)}
+func (_e *MockTaskHandler_Expecter) GetByProjectID(e interface{}) *MockTaskHandler_GetByProjectID_Call {
+	return &MockTaskHandler_GetByProjectID_Call{Call: _e.mock.On("GetByProjectID", e)}
 }
 
-func (_c *MockTaskHandler_GetByUserID_Call) Run(run func(e echo.Context)) *MockTaskHandler_GetByUserID_Call {
+func (_c *MockTaskHandler_GetByProjectID_Call) Run(run func(e echo.Context)) *MockTaskHandler_GetByProjectID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(echo.Context))
 	})
 	return _c
 }
 
-func (_c *MockTaskHandler_GetByUserID_Call) Return(err error) *MockTaskHandler_GetByUserID_Call {
+func (_c *MockTaskHandler_GetByProjectID_Call) Return(err error) *MockTaskHandler_GetByProjectID_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockTaskHandler_GetByUserID_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_GetByUserID_Call {
+func (_c *MockTaskHandler_GetByProjectID_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_GetByProjectID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUserID provides a mock function with given fields: e
+func (_m *MockTaskHandler) GetByUserID(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
+type MockTaskHandler_GetByUserID_Call struct {
+	*mock.Call
+}
+
+// GetByUserID is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) GetByUserID(e interface{}) *MockTaskHandler_GetByUserID_Call {
+	return &MockTaskHandler_GetByUserID_Call{Call: _e.mock.On("GetByUserID", e)}
+}
+
+func (_c *MockTaskHandler_GetByUserID_Call) Run(run func(e echo.Context)) *MockTaskHandler_GetByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_GetByUserID_Call) Return(err error) *MockTaskHandler_GetByUserID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_GetByUserID_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_GetByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByWorkspaceID provides a mock function with given fields: e
+func (_m *MockTaskHandler) GetByWorkspaceID(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByWorkspaceID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_GetByWorkspaceID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByWorkspaceID'
+type MockTaskHandler_GetByWorkspaceID_Call struct {
+	*mock.Call
+}
+
+// GetByWorkspaceID is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) GetByWorkspaceID(e interface{}) *MockTaskHandler_GetByWorkspaceID_Call {
+	return &MockTaskHandler_GetByWorkspaceID_Call{Call: _e.mock.On("GetByWorkspaceID", e)}
+}
+
+func (_c *MockTaskHandler_GetByWorkspaceID_Call) Run(run func(e echo.Context)) *MockTaskHandler_GetByWorkspaceID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_GetByWorkspaceID_Call) Return(err error) *MockTaskHandler_GetByWorkspaceID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_GetByWorkspaceID_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_GetByWorkspaceID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDependencies provides a mock function with given fields: e
+func (_m *MockTaskHandler) GetDependencies(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDependencies")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_GetDependencies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDependencies'
+type MockTaskHandler_GetDependencies_Call struct {
+	*mock.Call
+}
+
+// GetDependencies is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) GetDependencies(e interface{}) *MockTaskHandler_GetDependencies_Call {
+	return &MockTaskHandler_GetDependencies_Call{Call: _e.mock.On("GetDependencies", e)}
+}
+
+func (_c *MockTaskHandler_GetDependencies_Call) Run(run func(e echo.Context)) *MockTaskHandler_GetDependencies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_GetDependencies_Call) Return(err error) *MockTaskHandler_GetDependencies_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_GetDependencies_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_GetDependencies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDependencyGraph provides a mock function with given fields: e
+func (_m *MockTaskHandler) GetDependencyGraph(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDependencyGraph")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_GetDependencyGraph_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDependencyGraph'
+type MockTaskHandler_GetDependencyGraph_Call struct {
+	*mock.Call
+}
+
+// GetDependencyGraph is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) GetDependencyGraph(e interface{}) *MockTaskHandler_GetDependencyGraph_Call {
+	return &MockTaskHandler_GetDependencyGraph_Call{Call: _e.mock.On("GetDependencyGraph", e)}
+}
+
+func (_c *MockTaskHandler_GetDependencyGraph_Call) Run(run func(e echo.Context)) *MockTaskHandler_GetDependencyGraph_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_GetDependencyGraph_Call) Return(err error) *MockTaskHandler_GetDependencyGraph_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_GetDependencyGraph_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_GetDependencyGraph_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHistory provides a mock function with given fields: e
+func (_m *MockTaskHandler) GetHistory(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_GetHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHistory'
+type MockTaskHandler_GetHistory_Call struct {
+	*mock.Call
+}
+
+// GetHistory is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) GetHistory(e interface{}) *MockTaskHandler_GetHistory_Call {
+	return &MockTaskHandler_GetHistory_Call{Call: _e.mock.On("GetHistory", e)}
+}
+
+func (_c *MockTaskHandler_GetHistory_Call) Run(run func(e echo.Context)) *MockTaskHandler_GetHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_GetHistory_Call) Return(err error) *MockTaskHandler_GetHistory_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_GetHistory_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_GetHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubtasks provides a mock function with given fields: e
+func (_m *MockTaskHandler) GetSubtasks(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubtasks")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_GetSubtasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubtasks'
+type MockTaskHandler_GetSubtasks_Call struct {
+	*mock.Call
+}
+
+// GetSubtasks is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) GetSubtasks(e interface{}) *MockTaskHandler_GetSubtasks_Call {
+	return &MockTaskHandler_GetSubtasks_Call{Call: _e.mock.On("GetSubtasks", e)}
+}
+
+func (_c *MockTaskHandler_GetSubtasks_Call) Run(run func(e echo.Context)) *MockTaskHandler_GetSubtasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_GetSubtasks_Call) Return(err error) *MockTaskHandler_GetSubtasks_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_GetSubtasks_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_GetSubtasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrashByUserID provides a mock function with given fields: e
+func (_m *MockTaskHandler) GetTrashByUserID(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrashByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_GetTrashByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrashByUserID'
+type MockTaskHandler_GetTrashByUserID_Call struct {
+	*mock.Call
+}
+
+// GetTrashByUserID is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) GetTrashByUserID(e interface{}) *MockTaskHandler_GetTrashByUserID_Call {
+	return &MockTaskHandler_GetTrashByUserID_Call{Call: _e.mock.On("GetTrashByUserID", e)}
+}
+
+func (_c *MockTaskHandler_GetTrashByUserID_Call) Run(run func(e echo.Context)) *MockTaskHandler_GetTrashByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_GetTrashByUserID_Call) Return(err error) *MockTaskHandler_GetTrashByUserID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_GetTrashByUserID_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_GetTrashByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Move provides a mock function with given fields: e
+func (_m *MockTaskHandler) Move(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Move")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_Move_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Move'
+type MockTaskHandler_Move_Call struct {
+	*mock.Call
+}
+
+// Move is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) Move(e interface{}) *MockTaskHandler_Move_Call {
+	return &MockTaskHandler_Move_Call{Call: _e.mock.On("Move", e)}
+}
+
+func (_c *MockTaskHandler_Move_Call) Run(run func(e echo.Context)) *MockTaskHandler_Move_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_Move_Call) Return(err error) *MockTaskHandler_Move_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_Move_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_Move_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Patch provides a mock function with given fields: e
+func (_m *MockTaskHandler) Patch(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type MockTaskHandler_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) Patch(e interface{}) *MockTaskHandler_Patch_Call {
+	return &MockTaskHandler_Patch_Call{Call: _e.mock.On("Patch", e)}
+}
+
+func (_c *MockTaskHandler_Patch_Call) Run(run func(e echo.Context)) *MockTaskHandler_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_Patch_Call) Return(err error) *MockTaskHandler_Patch_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_Patch_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_Patch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveChecklistItem provides a mock function with given fields: e
+func (_m *MockTaskHandler) RemoveChecklistItem(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveChecklistItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_RemoveChecklistItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveChecklistItem'
+type MockTaskHandler_RemoveChecklistItem_Call struct {
+	*mock.Call
+}
+
+// RemoveChecklistItem is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) RemoveChecklistItem(e interface{}) *MockTaskHandler_RemoveChecklistItem_Call {
+	return &MockTaskHandler_RemoveChecklistItem_Call{Call: _e.mock.On("RemoveChecklistItem", e)}
+}
+
+func (_c *MockTaskHandler_RemoveChecklistItem_Call) Run(run func(e echo.Context)) *MockTaskHandler_RemoveChecklistItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_RemoveChecklistItem_Call) Return(err error) *MockTaskHandler_RemoveChecklistItem_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_RemoveChecklistItem_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_RemoveChecklistItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveDependency provides a mock function with given fields: e
+func (_m *MockTaskHandler) RemoveDependency(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveDependency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_RemoveDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveDependency'
+type MockTaskHandler_RemoveDependency_Call struct {
+	*mock.Call
+}
+
+// RemoveDependency is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) RemoveDependency(e interface{}) *MockTaskHandler_RemoveDependency_Call {
+	return &MockTaskHandler_RemoveDependency_Call{Call: _e.mock.On("RemoveDependency", e)}
+}
+
+func (_c *MockTaskHandler_RemoveDependency_Call) Run(run func(e echo.Context)) *MockTaskHandler_RemoveDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_RemoveDependency_Call) Return(err error) *MockTaskHandler_RemoveDependency_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_RemoveDependency_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_RemoveDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReorderChecklist provides a mock function with given fields: e
+func (_m *MockTaskHandler) ReorderChecklist(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorderChecklist")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_ReorderChecklist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorderChecklist'
+type MockTaskHandler_ReorderChecklist_Call struct {
+	*mock.Call
+}
+
+// ReorderChecklist is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) ReorderChecklist(e interface{}) *MockTaskHandler_ReorderChecklist_Call {
+	return &MockTaskHandler_ReorderChecklist_Call{Call: _e.mock.On("ReorderChecklist", e)}
+}
+
+func (_c *MockTaskHandler_ReorderChecklist_Call) Run(run func(e echo.Context)) *MockTaskHandler_ReorderChecklist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_ReorderChecklist_Call) Return(err error) *MockTaskHandler_ReorderChecklist_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_ReorderChecklist_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_ReorderChecklist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: e
+func (_m *MockTaskHandler) Restore(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockTaskHandler_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) Restore(e interface{}) *MockTaskHandler_Restore_Call {
+	return &MockTaskHandler_Restore_Call{Call: _e.mock.On("Restore", e)}
+}
+
+func (_c *MockTaskHandler_Restore_Call) Run(run func(e echo.Context)) *MockTaskHandler_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_Restore_Call) Return(err error) *MockTaskHandler_Restore_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_Restore_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revert provides a mock function with given fields: e
+func (_m *MockTaskHandler) Revert(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_Revert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revert'
+type MockTaskHandler_Revert_Call struct {
+	*mock.Call
+}
+
+// Revert is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) Revert(e interface{}) *MockTaskHandler_Revert_Call {
+	return &MockTaskHandler_Revert_Call{Call: _e.mock.On("Revert", e)}
+}
+
+func (_c *MockTaskHandler_Revert_Call) Run(run func(e echo.Context)) *MockTaskHandler_Revert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_Revert_Call) Return(err error) *MockTaskHandler_Revert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_Revert_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_Revert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ToggleChecklistItem provides a mock function with given fields: e
+func (_m *MockTaskHandler) ToggleChecklistItem(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ToggleChecklistItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_ToggleChecklistItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToggleChecklistItem'
+type MockTaskHandler_ToggleChecklistItem_Call struct {
+	*mock.Call
+}
+
+// ToggleChecklistItem is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) ToggleChecklistItem(e interface{}) *MockTaskHandler_ToggleChecklistItem_Call {
+	return &MockTaskHandler_ToggleChecklistItem_Call{Call: _e.mock.On("ToggleChecklistItem", e)}
+}
+
+func (_c *MockTaskHandler_ToggleChecklistItem_Call) Run(run func(e echo.Context)) *MockTaskHandler_ToggleChecklistItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_ToggleChecklistItem_Call) Return(err error) *MockTaskHandler_ToggleChecklistItem_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_ToggleChecklistItem_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_ToggleChecklistItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Transition provides a mock function with given fields: e
+func (_m *MockTaskHandler) Transition(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskHandler_Transition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transition'
+type MockTaskHandler_Transition_Call struct {
+	*mock.Call
+}
+
+// Transition is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTaskHandler_Expecter) Transition(e interface{}) *MockTaskHandler_Transition_Call {
+	return &MockTaskHandler_Transition_Call{Call: _e.mock.On("Transition", e)}
+}
+
+func (_c *MockTaskHandler_Transition_Call) Run(run func(e echo.Context)) *MockTaskHandler_Transition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTaskHandler_Transition_Call) Return(err error) *MockTaskHandler_Transition_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTaskHandler_Transition_Call) RunAndReturn(run func(echo.Context) error) *MockTaskHandler_Transition_Call {
 	_c.Call.Return(run)
 	return _c
 }
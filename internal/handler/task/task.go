@@ -1,14 +1,20 @@
 package task
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/rzfhlv/go-task/internal/model"
 	"github.com/rzfhlv/go-task/internal/usecase/task"
 	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/etag"
 	"github.com/rzfhlv/go-task/pkg/middleware/auth"
 	"github.com/rzfhlv/go-task/pkg/param"
 	"github.com/rzfhlv/go-task/pkg/response/general"
@@ -20,8 +26,37 @@ type TaskHandler interface {
 	GetByID(e echo.Context) (err error)
 	Update(e echo.Context) (err error)
 	Delete(e echo.Context) (err error)
+	Transition(e echo.Context) (err error)
+	Patch(e echo.Context) (err error)
+	GetTrashByUserID(e echo.Context) (err error)
+	Restore(e echo.Context) (err error)
+	Bulk(e echo.Context) (err error)
+	GetSubtasks(e echo.Context) (err error)
+	GetByProjectID(e echo.Context) (err error)
+	GetByWorkspaceID(e echo.Context) (err error)
+	GetDependencies(e echo.Context) (err error)
+	AddDependency(e echo.Context) (err error)
+	RemoveDependency(e echo.Context) (err error)
+	GetDependencyGraph(e echo.Context) (err error)
+	AttachLabel(e echo.Context) (err error)
+	DetachLabel(e echo.Context) (err error)
+	AddChecklistItem(e echo.Context) (err error)
+	ToggleChecklistItem(e echo.Context) (err error)
+	ReorderChecklist(e echo.Context) (err error)
+	RemoveChecklistItem(e echo.Context) (err error)
+	GetHistory(e echo.Context) (err error)
+	Revert(e echo.Context) (err error)
+	Burndown(e echo.Context) (err error)
+	Move(e echo.Context) (err error)
+	Board(e echo.Context) (err error)
 }
 
+const (
+	mimeApplicationMergePatchJSON = "application/merge-patch+json"
+	headerETag                    = "ETag"
+	headerIfMatch                 = "If-Match"
+)
+
 type Handler struct {
 	usecase task.TaskUsecase
 }
@@ -77,6 +112,8 @@ func (h *Handler) GetByUserID(e echo.Context) (err error) {
 		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid query param request"))
 	}
 
+	param.CursorMode = e.QueryParams().Has("cursor")
+
 	result, err := h.usecase.GetByUserID(ctx, userId, &param)
 	if err != nil {
 		if httpErr, ok := err.(*errs.HttpError); ok {
@@ -101,7 +138,24 @@ func (h *Handler) GetByID(e echo.Context) (err error) {
 		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
 	}
 
-	result, err := h.usecase.GetByID(ctx, taskId)
+	withChildren := false
+	for _, include := range strings.Split(e.QueryParam("include"), ",") {
+		switch strings.TrimSpace(include) {
+		case "":
+		case "children":
+			withChildren = true
+		default:
+			return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid query param include"))
+		}
+	}
+
+	var result model.Task
+	if withChildren {
+		result, err = h.usecase.GetByIDWithChildren(ctx, taskId)
+	} else {
+		result, err = h.usecase.GetByID(ctx, taskId)
+	}
+
 	if err != nil {
 		if httpErr, ok := err.(*errs.HttpError); ok {
 			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
@@ -111,6 +165,7 @@ func (h *Handler) GetByID(e echo.Context) (err error) {
 	}
 
 	msg := "get data success"
+	e.Response().Header().Set(headerETag, etag.Format(result.Version))
 	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
 }
 
@@ -124,6 +179,18 @@ func (h *Handler) Update(e echo.Context) (err error) {
 		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
 	}
 
+	scope := e.QueryParam("scope")
+	if scope != "" && scope != model.TaskScopeThis && scope != model.TaskScopeFuture {
+		slog.ErrorContext(ctx, "[Handler.Task] error unknown update scope", slog.String("scope", scope))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid query param scope"))
+	}
+
+	ifMatch, err := etag.Parse(e.Request().Header.Get(headerIfMatch))
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when parse if-match header", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid if-match header"))
+	}
+
 	task := model.Task{}
 	err = e.Bind(&task)
 	if err != nil {
@@ -136,8 +203,14 @@ func (h *Handler) Update(e echo.Context) (err error) {
 		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, err.Error()))
 	}
 
+	update := h.usecase.Update
+	if scope == model.TaskScopeFuture {
+		update = h.usecase.UpdateSeries
+	}
+
 	task.ID = taskId
-	result, err := h.usecase.Update(ctx, task)
+	task.IfMatch = ifMatch
+	result, err := update(ctx, task)
 	if err != nil {
 		if httpErr, ok := err.(*errs.HttpError); ok {
 			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
@@ -147,6 +220,7 @@ func (h *Handler) Update(e echo.Context) (err error) {
 	}
 
 	msg := "update data success"
+	e.Response().Header().Set(headerETag, etag.Format(result.Version))
 	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
 }
 
@@ -160,7 +234,22 @@ func (h *Handler) Delete(e echo.Context) (err error) {
 		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
 	}
 
-	err = h.usecase.Delete(ctx, taskId)
+	ifMatch, err := etag.Parse(e.Request().Header.Get(headerIfMatch))
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when parse if-match header", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid if-match header"))
+	}
+
+	permanent := false
+	if value := e.QueryParam("permanent"); value != "" {
+		permanent, err = strconv.ParseBool(value)
+		if err != nil {
+			slog.ErrorContext(ctx, "[Handler.Task] error when convert permanent query param to bool", slog.String("error", err.Error()))
+			return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid query param permanent"))
+		}
+	}
+
+	err = h.usecase.Delete(ctx, taskId, ifMatch, permanent)
 	if err != nil {
 		if httpErr, ok := err.(*errs.HttpError); ok {
 			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
@@ -172,3 +261,773 @@ func (h *Handler) Delete(e echo.Context) (err error) {
 	msg := "delete data success"
 	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, nil, nil))
 }
+
+func (h *Handler) Transition(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	transition := model.TaskTransition{}
+	err = e.Bind(&transition)
+	if err != nil {
+		return e.JSON(http.StatusUnprocessableEntity, general.Set(false, nil, nil, nil, "invalid json"))
+	}
+
+	err = e.Validate(transition)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when validate the request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, err.Error()))
+	}
+
+	result, err := h.usecase.Transition(ctx, taskId, transition.Status)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "transition success"
+	e.Response().Header().Set(headerETag, etag.Format(result.Version))
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) Patch(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	ifMatch, err := etag.Parse(e.Request().Header.Get(headerIfMatch))
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when parse if-match header", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid if-match header"))
+	}
+
+	contentType := e.Request().Header.Get(echo.HeaderContentType)
+	if !strings.HasPrefix(contentType, mimeApplicationMergePatchJSON) && !strings.HasPrefix(contentType, echo.MIMEApplicationJSON) {
+		return e.JSON(http.StatusUnsupportedMediaType, general.Set(false, nil, nil, nil, "unsupported media type"))
+	}
+
+	body, err := io.ReadAll(e.Request().Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when read request body", slog.String("error", err.Error()))
+		return e.JSON(http.StatusUnprocessableEntity, general.Set(false, nil, nil, nil, "invalid json"))
+	}
+
+	document := map[string]json.RawMessage{}
+	patch := model.TaskPatch{}
+	if json.Unmarshal(body, &document) != nil || json.Unmarshal(body, &patch) != nil {
+		return e.JSON(http.StatusUnprocessableEntity, general.Set(false, nil, nil, nil, "invalid json"))
+	}
+
+	for field, value := range document {
+		nullable, ok := model.TaskPatchNullable[field]
+		if !ok {
+			return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, fmt.Sprintf("%s is not patchable", field)))
+		}
+
+		if !nullable && string(value) == "null" {
+			return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, fmt.Sprintf("%s cannot be null", field)))
+		}
+
+		patch.Fields = append(patch.Fields, field)
+	}
+	slices.Sort(patch.Fields)
+	patch.IfMatch = ifMatch
+
+	err = e.Validate(patch)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when validate the request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, err.Error()))
+	}
+
+	result, err := h.usecase.Patch(ctx, taskId, patch)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "patch data success"
+	e.Response().Header().Set(headerETag, etag.Format(result.Version))
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) GetTrashByUserID(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+	param := param.Param{}
+	param.Limit = 10
+	param.Page = 1
+
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Handler.Task] error when get id from context")
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "missing user id in context"))
+	}
+
+	err = (&echo.DefaultBinder{}).BindQueryParams(e, &param)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when bind query param request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid query param request"))
+	}
+
+	param.CursorMode = e.QueryParams().Has("cursor")
+
+	result, err := h.usecase.GetTrashByUserID(ctx, userId, &param)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "get data success"
+	meta := general.BuildMeta(param, len(result))
+	return e.JSON(http.StatusOK, general.Set(true, &msg, meta, result, nil))
+}
+
+func (h *Handler) Restore(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	result, err := h.usecase.Restore(ctx, taskId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "restore data success"
+	e.Response().Header().Set(headerETag, etag.Format(result.Version))
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) Bulk(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+	request := model.TaskBulkRequest{}
+	err = e.Bind(&request)
+	if err != nil {
+		return e.JSON(http.StatusUnprocessableEntity, general.Set(false, nil, nil, nil, "invalid json"))
+	}
+
+	err = e.Validate(request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when validate the request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, err.Error()))
+	}
+
+	result, err := h.usecase.Bulk(ctx, request)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, result, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "bulk operation success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) GetSubtasks(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+	param := param.Param{}
+	param.Limit = 10
+	param.Page = 1
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	err = (&echo.DefaultBinder{}).BindQueryParams(e, &param)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when bind query param request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid query param request"))
+	}
+
+	param.CursorMode = e.QueryParams().Has("cursor")
+
+	result, err := h.usecase.GetSubtasks(ctx, taskId, &param)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "get data success"
+	meta := general.BuildMeta(param, len(result))
+	return e.JSON(http.StatusOK, general.Set(true, &msg, meta, result, nil))
+}
+
+func (h *Handler) GetByProjectID(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+	param := param.Param{}
+	param.Limit = 10
+	param.Page = 1
+
+	id := e.Param("id")
+	projectId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	err = (&echo.DefaultBinder{}).BindQueryParams(e, &param)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when bind query param request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid query param request"))
+	}
+
+	param.CursorMode = e.QueryParams().Has("cursor")
+
+	result, err := h.usecase.GetByProjectID(ctx, projectId, &param)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "get data success"
+	meta := general.BuildMeta(param, len(result))
+	return e.JSON(http.StatusOK, general.Set(true, &msg, meta, result, nil))
+}
+
+func (h *Handler) GetByWorkspaceID(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+	param := param.Param{}
+	param.Limit = 10
+	param.Page = 1
+
+	id := e.Param("id")
+	workspaceId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	err = (&echo.DefaultBinder{}).BindQueryParams(e, &param)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when bind query param request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid query param request"))
+	}
+
+	param.CursorMode = e.QueryParams().Has("cursor")
+
+	result, err := h.usecase.GetByWorkspaceID(ctx, workspaceId, &param)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "get data success"
+	meta := general.BuildMeta(param, len(result))
+	return e.JSON(http.StatusOK, general.Set(true, &msg, meta, result, nil))
+}
+
+func (h *Handler) GetDependencies(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	result, err := h.usecase.GetDependencies(ctx, taskId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "get data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) AddDependency(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	request := model.TaskDependencyRequest{}
+	err = e.Bind(&request)
+	if err != nil {
+		return e.JSON(http.StatusUnprocessableEntity, general.Set(false, nil, nil, nil, "invalid json"))
+	}
+
+	err = e.Validate(request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when validate the request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, err.Error()))
+	}
+
+	result, err := h.usecase.AddDependency(ctx, taskId, request)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "created success"
+	return e.JSON(http.StatusCreated, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) RemoveDependency(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	blockerId, err := strconv.ParseInt(e.Param("blocker_id"), 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert blocker_id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param blocker_id"))
+	}
+
+	err = h.usecase.RemoveDependency(ctx, taskId, blockerId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "delete data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, nil, nil))
+}
+
+func (h *Handler) GetDependencyGraph(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	result, err := h.usecase.GetDependencyGraph(ctx, taskId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "get data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) AttachLabel(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	request := model.TaskLabelRequest{}
+	err = e.Bind(&request)
+	if err != nil {
+		return e.JSON(http.StatusUnprocessableEntity, general.Set(false, nil, nil, nil, "invalid json"))
+	}
+
+	err = e.Validate(request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when validate the request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, err.Error()))
+	}
+
+	result, err := h.usecase.AttachLabel(ctx, taskId, request)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "attach label success"
+	e.Response().Header().Set(headerETag, etag.Format(result.Version))
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) DetachLabel(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	labelId, err := strconv.ParseInt(e.Param("label_id"), 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert label_id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param label_id"))
+	}
+
+	result, err := h.usecase.DetachLabel(ctx, taskId, labelId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "detach label success"
+	e.Response().Header().Set(headerETag, etag.Format(result.Version))
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) AddChecklistItem(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	request := model.TaskChecklistRequest{}
+	err = e.Bind(&request)
+	if err != nil {
+		return e.JSON(http.StatusUnprocessableEntity, general.Set(false, nil, nil, nil, "invalid json"))
+	}
+
+	err = e.Validate(request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when validate the request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, err.Error()))
+	}
+
+	result, err := h.usecase.AddChecklistItem(ctx, taskId, request)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "created success"
+	return e.JSON(http.StatusCreated, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) ToggleChecklistItem(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	itemId, err := strconv.ParseInt(e.Param("item_id"), 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert item_id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param item_id"))
+	}
+
+	result, err := h.usecase.ToggleChecklistItem(ctx, taskId, itemId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "update data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) ReorderChecklist(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	request := model.TaskChecklistOrderRequest{}
+	err = e.Bind(&request)
+	if err != nil {
+		return e.JSON(http.StatusUnprocessableEntity, general.Set(false, nil, nil, nil, "invalid json"))
+	}
+
+	err = e.Validate(request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when validate the request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, err.Error()))
+	}
+
+	result, err := h.usecase.ReorderChecklist(ctx, taskId, request)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "update data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) RemoveChecklistItem(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	itemId, err := strconv.ParseInt(e.Param("item_id"), 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert item_id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param item_id"))
+	}
+
+	err = h.usecase.RemoveChecklistItem(ctx, taskId, itemId)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "delete data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, nil, nil))
+}
+
+func (h *Handler) GetHistory(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+	param := param.Param{}
+	param.Limit = 10
+	param.Page = 1
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	err = (&echo.DefaultBinder{}).BindQueryParams(e, &param)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when bind query param request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid query param request"))
+	}
+
+	result, err := h.usecase.GetHistory(ctx, taskId, &param)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "get data success"
+	meta := general.BuildMeta(param, len(result))
+	return e.JSON(http.StatusOK, general.Set(true, &msg, meta, result, nil))
+}
+
+func (h *Handler) Revert(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	revision, err := strconv.ParseInt(e.QueryParam("to"), 10, 64)
+	if err != nil || revision < 1 {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert to query param to int", slog.String("to", e.QueryParam("to")))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid query param to"))
+	}
+
+	force := false
+	if value := e.QueryParam("force"); value != "" {
+		force, err = strconv.ParseBool(value)
+		if err != nil {
+			slog.ErrorContext(ctx, "[Handler.Task] error when convert force query param to bool", slog.String("error", err.Error()))
+			return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid query param force"))
+		}
+	}
+
+	ifMatch, err := etag.Parse(e.Request().Header.Get(headerIfMatch))
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when parse if-match header", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid if-match header"))
+	}
+
+	result, err := h.usecase.Revert(ctx, taskId, revision, ifMatch, force)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "revert data success"
+	e.Response().Header().Set(headerETag, etag.Format(result.Version))
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) Burndown(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	request := model.TaskBurndownRequest{}
+	err = (&echo.DefaultBinder{}).BindQueryParams(e, &request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when bind query param request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid query param request"))
+	}
+
+	err = e.Validate(request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when validate the request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, err.Error()))
+	}
+
+	result, err := h.usecase.Burndown(ctx, request)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "get data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) Move(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	id := e.Param("id")
+	taskId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when convert id param to int", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid path param id"))
+	}
+
+	move := model.TaskMove{}
+	err = e.Bind(&move)
+	if err != nil {
+		return e.JSON(http.StatusUnprocessableEntity, general.Set(false, nil, nil, nil, "invalid json"))
+	}
+
+	err = e.Validate(move)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when validate the request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, err.Error()))
+	}
+
+	result, err := h.usecase.Move(ctx, taskId, move)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "move success"
+	e.Response().Header().Set(headerETag, etag.Format(result.Version))
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
+
+func (h *Handler) Board(e echo.Context) (err error) {
+	ctx := e.Request().Context()
+
+	request := model.TaskBoardRequest{}
+	err = (&echo.DefaultBinder{}).BindQueryParams(e, &request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when bind query param request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, "invalid query param request"))
+	}
+
+	err = e.Validate(request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Handler.Task] error when validate the request", slog.String("error", err.Error()))
+		return e.JSON(http.StatusBadRequest, general.Set(false, nil, nil, nil, err.Error()))
+	}
+
+	result, err := h.usecase.Board(ctx, request)
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return e.JSON(httpErr.StatusCode, general.Set(false, nil, nil, nil, httpErr.Message))
+		}
+
+		return e.JSON(http.StatusInternalServerError, general.Set(false, nil, nil, nil, "something went wrong"))
+	}
+
+	msg := "get data success"
+	return e.JSON(http.StatusOK, general.Set(true, &msg, nil, result, nil))
+}
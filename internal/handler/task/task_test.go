@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
@@ -15,6 +16,7 @@ import (
 	"github.com/rzfhlv/go-task/internal/presenter/rest"
 	taskmocks "github.com/rzfhlv/go-task/internal/usecase/task/mocks"
 	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/etag"
 	"github.com/rzfhlv/go-task/pkg/middleware/auth"
 	"github.com/rzfhlv/go-task/pkg/param"
 	"github.com/stretchr/testify/assert"
@@ -34,6 +36,7 @@ var (
 		Description: "for test",
 		Status:      "todo",
 		UserID:      1,
+		Version:     1,
 	}
 )
 
@@ -95,6 +98,15 @@ func TestHandlerTaskCreate(t *testing.T) {
 			statusCode: http.StatusUnprocessableEntity,
 			wantErr:    nil,
 		},
+		{
+			name:    "error when validate priority",
+			reqBody: `{"title": "Task 1", "description": "for test", "priority": "critical"}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -150,6 +162,22 @@ func TestHandlerTaskGetByUserID(t *testing.T) {
 			statusCode: http.StatusOK,
 			wantErr:    nil,
 		},
+		{
+			name:     "success with empty cursor starts cursor mode",
+			reqParam: "?cursor=&limit=5",
+			mockCtx: func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+				return ctx
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByUserID", mock.Anything, taskModel.UserID, mock.MatchedBy(func(p *param.Param) bool {
+					return p.CursorMode && p.Limit == 5
+				})).
+					Return([]model.Task{taskModel}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
 		{
 			name:     "error when call login usecase",
 			reqParam: "?page=2",
@@ -194,6 +222,54 @@ func TestHandlerTaskGetByUserID(t *testing.T) {
 			statusCode: http.StatusForbidden,
 			wantErr:    nil,
 		},
+		{
+			name:     "success with due date filter",
+			reqParam: "?overdue=true&priority=high,urgent&due_before=2023-08-20T12:00:00Z",
+			mockCtx: func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+				return ctx
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByUserID", mock.Anything, taskModel.UserID, mock.MatchedBy(func(p *param.Param) bool {
+					return p.Overdue &&
+						p.Priority == "high,urgent" &&
+						p.DueBefore != nil && p.DueBefore.Equal(time.Date(2023, time.August, 20, 12, 0, 0, 0, time.UTC)) &&
+						p.DueAfter == nil
+				})).
+					Return([]model.Task{taskModel}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:     "success with sort and search",
+			reqParam: "?sort=-updated_at,title&q=release&status=todo",
+			mockCtx: func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+				return ctx
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByUserID", mock.Anything, taskModel.UserID, mock.MatchedBy(func(p *param.Param) bool {
+					return p.Sort == "-updated_at,title" && p.Q == "release" && p.Status == "todo"
+				})).
+					Return([]model.Task{taskModel}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:     "error when binding due date param",
+			reqParam: "?due_before=tomorrow",
+			mockCtx: func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+				return ctx
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetByUserID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
 		{
 			name:     "error when binding request param",
 			reqParam: "?page=satu",
@@ -252,9 +328,11 @@ func TestHandlerTaskGetByID(t *testing.T) {
 	tests := []struct {
 		name       string
 		pathParam  string
+		query      string
 		mockCtx    func(ctx context.Context) context.Context
 		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
 		statusCode int
+		wantETag   string
 		wantErr    error
 	}{
 		{
@@ -275,6 +353,7 @@ func TestHandlerTaskGetByID(t *testing.T) {
 					Return(taskModel, nil)
 			},
 			statusCode: http.StatusOK,
+			wantETag:   `"1"`,
 			wantErr:    nil,
 		},
 		{
@@ -330,6 +409,35 @@ func TestHandlerTaskGetByID(t *testing.T) {
 			statusCode: http.StatusBadRequest,
 			wantErr:    nil,
 		},
+		{
+			name:      "success with children",
+			pathParam: "1",
+			query:     "?include=children",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByIDWithChildren", mock.Anything, taskModel.ID).Return(taskModel, nil)
+				taskUsecase.AssertNotCalled(t, "GetByID")
+			},
+			statusCode: http.StatusOK,
+			wantETag:   `"1"`,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when include query param is invalid",
+			pathParam: "1",
+			query:     "?include=parent",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetByID")
+				taskUsecase.AssertNotCalled(t, "GetByIDWithChildren")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -342,7 +450,7 @@ func TestHandlerTaskGetByID(t *testing.T) {
 
 			e := echo.New()
 			e.Validator = &rest.CustomValidator{Validator: validator.New()}
-			req := httptest.NewRequest(http.MethodGet, "/v1/task/"+tt.pathParam, nil)
+			req := httptest.NewRequest(http.MethodGet, "/v1/task/"+tt.pathParam+tt.query, nil)
 			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
 			rec := httptest.NewRecorder()
 			ctx := e.NewContext(req, rec)
@@ -355,6 +463,7 @@ func TestHandlerTaskGetByID(t *testing.T) {
 
 			err := handler.GetByID(ctx)
 			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantETag, rec.Header().Get("ETag"))
 			assert.Equal(t, tt.wantErr, err)
 		})
 	}
@@ -365,29 +474,77 @@ func TestHandlerTaskUpdate(t *testing.T) {
 		ID:          1,
 		Title:       "Task 1",
 		Description: "for test",
-		Status:      "completed",
+		Status:      "done",
 	}
 
 	taskModelCompleted := model.Task{
 		ID:          1,
 		Title:       "Task 1",
 		Description: "for test",
-		Status:      "completed",
+		Status:      "done",
 		UserID:      1,
 	}
 
 	tests := []struct {
 		name       string
 		reqBody    string
+		ifMatch    string
 		pathParam  string
+		query      string
 		mockCtx    func(ctx context.Context) context.Context
 		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
 		statusCode int
 		wantErr    error
 	}{
+		{
+			name:      "success update all future occurrences",
+			reqBody:   `{"title": "Task 1", "description": "for test", "status": "done", "recurrence": "FREQ=WEEKLY"}`,
+			pathParam: "1",
+			query:     "?scope=future",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				request := updatedRequest
+				request.Recurrence = "FREQ=WEEKLY"
+				taskUsecase.On("UpdateSeries", mock.Anything, request).Return(taskModelCompleted, nil)
+				taskUsecase.AssertNotCalled(t, "Update")
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when update all future occurrences of a task that is not recurring",
+			reqBody:   `{"title": "Task 1", "description": "for test", "status": "done"}`,
+			pathParam: "1",
+			query:     "?scope=future",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("UpdateSeries", mock.Anything, updatedRequest).Return(model.Task{}, errs.NewErrs(http.StatusBadRequest, "task is not recurring"))
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when scope is unknown",
+			reqBody:   `{"title": "Task 1", "description": "for test", "status": "done"}`,
+			pathParam: "1",
+			query:     "?scope=all",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Update")
+				taskUsecase.AssertNotCalled(t, "UpdateSeries")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
 		{
 			name:      "success",
-			reqBody:   `{"title": "Task 1", "description": "for test", "status": "completed"}`,
+			reqBody:   `{"title": "Task 1", "description": "for test", "status": "done"}`,
 			pathParam: "1",
 			mockCtx: func(ctx context.Context) context.Context {
 				ctx = context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
@@ -406,7 +563,7 @@ func TestHandlerTaskUpdate(t *testing.T) {
 		},
 		{
 			name:      "error when call update usecase",
-			reqBody:   `{"title": "Task 1", "description": "for test", "status": "completed"}`,
+			reqBody:   `{"title": "Task 1", "description": "for test", "status": "done"}`,
 			pathParam: "1",
 			mockCtx: func(ctx context.Context) context.Context {
 				ctx = context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
@@ -425,7 +582,7 @@ func TestHandlerTaskUpdate(t *testing.T) {
 		},
 		{
 			name:      "error when call update usecase with custom error",
-			reqBody:   `{"title": "Task 1", "description": "for test", "status": "completed"}`,
+			reqBody:   `{"title": "Task 1", "description": "for test", "status": "done"}`,
 			pathParam: "1",
 			mockCtx: func(ctx context.Context) context.Context {
 				ctx = context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
@@ -442,9 +599,70 @@ func TestHandlerTaskUpdate(t *testing.T) {
 			statusCode: http.StatusForbidden,
 			wantErr:    nil,
 		},
+		{
+			name:      "success with if-match header",
+			reqBody:   `{"title": "Task 1", "description": "for test", "status": "done"}`,
+			ifMatch:   `"3"`,
+			pathParam: "1",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Update", mock.Anything, mock.MatchedBy(func(ts model.Task) bool {
+					return ts.IfMatch.Matches(3) && !ts.IfMatch.Matches(2)
+				})).Return(taskModelCompleted, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when task version is stale",
+			reqBody:   `{"title": "Task 1", "description": "for test", "status": "done"}`,
+			ifMatch:   `"2"`,
+			pathParam: "1",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Update", mock.Anything, mock.Anything).
+					Return(model.Task{}, errs.NewErrs(http.StatusPreconditionFailed, "task has been modified"))
+			},
+			statusCode: http.StatusPreconditionFailed,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when if-match header is weak",
+			reqBody:   `{"title": "Task 1", "description": "for test", "status": "done"}`,
+			ifMatch:   `W/"3"`,
+			pathParam: "1",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Update", mock.Anything, mock.MatchedBy(func(ts model.Task) bool {
+					return ts.IfMatch.Present && !ts.IfMatch.Matches(3)
+				})).Return(model.Task{}, errs.NewErrs(http.StatusPreconditionFailed, "task has been modified"))
+			},
+			statusCode: http.StatusPreconditionFailed,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse if-match header",
+			reqBody:   `{"title": "Task 1", "description": "for test", "status": "done"}`,
+			ifMatch:   `"3`,
+			pathParam: "1",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Update")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
 		{
 			name:      "error when validate request",
-			reqBody:   `{"title": "", "description": "for test", "status": "completed"}`,
+			reqBody:   `{"title": "", "description": "for test", "status": "done"}`,
 			pathParam: "1",
 			mockCtx: func(ctx context.Context) context.Context {
 				ctx = context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
@@ -472,7 +690,7 @@ func TestHandlerTaskUpdate(t *testing.T) {
 		},
 		{
 			name:      "error when binding request",
-			reqBody:   `{"title": "Task 1", "description": "for test", "status": "completed"}`,
+			reqBody:   `{"title": "Task 1", "description": "for test", "status": "done"}`,
 			pathParam: "satu",
 			mockCtx: func(ctx context.Context) context.Context {
 				ctx = context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
@@ -496,8 +714,9 @@ func TestHandlerTaskUpdate(t *testing.T) {
 
 			e := echo.New()
 			e.Validator = &rest.CustomValidator{Validator: validator.New()}
-			req := httptest.NewRequest(http.MethodPut, "/v1/task/"+tt.pathParam, strings.NewReader(tt.reqBody))
+			req := httptest.NewRequest(http.MethodPut, "/v1/task/"+tt.pathParam+tt.query, strings.NewReader(tt.reqBody))
 			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Add("If-Match", tt.ifMatch)
 			rec := httptest.NewRecorder()
 			ctx := e.NewContext(req, rec)
 
@@ -517,6 +736,8 @@ func TestHandlerTaskUpdate(t *testing.T) {
 func TestHandlerTaskDelete(t *testing.T) {
 	tests := []struct {
 		name       string
+		ifMatch    string
+		reqParam   string
 		pathParam  string
 		mockCtx    func(ctx context.Context) context.Context
 		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
@@ -537,7 +758,7 @@ func TestHandlerTaskDelete(t *testing.T) {
 					return val == taskModel.UserID && ok
 				}), mock.MatchedBy(func(id int64) bool {
 					return id == taskModel.ID
-				})).
+				}), etag.Match{}, false).
 					Return(nil)
 			},
 			statusCode: http.StatusOK,
@@ -557,7 +778,7 @@ func TestHandlerTaskDelete(t *testing.T) {
 					return val == taskModel.UserID && ok
 				}), mock.MatchedBy(func(id int64) bool {
 					return id == taskModel.ID
-				})).
+				}), etag.Match{}, false).
 					Return(errors.New("some error"))
 			},
 			statusCode: http.StatusInternalServerError,
@@ -577,12 +798,64 @@ func TestHandlerTaskDelete(t *testing.T) {
 					return val == taskModel.UserID && ok
 				}), mock.MatchedBy(func(id int64) bool {
 					return id == taskModel.ID
-				})).
+				}), etag.Match{}, false).
 					Return(errs.NewErrs(http.StatusForbidden, "forbidden access"))
 			},
 			statusCode: http.StatusForbidden,
 			wantErr:    nil,
 		},
+		{
+			name:      "success with if-match header",
+			ifMatch:   `"2"`,
+			pathParam: "1",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Delete", mock.Anything, taskModel.ID, etag.Exact(2), false).Return(nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "success delete permanently",
+			reqParam:  "?permanent=true",
+			pathParam: "1",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Delete", mock.Anything, taskModel.ID, etag.Match{}, true).Return(nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse permanent query param",
+			reqParam:  "?permanent=yes",
+			pathParam: "1",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Delete")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse if-match header",
+			ifMatch:   "2",
+			pathParam: "1",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Delete")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
 		{
 			name:      "error when parse request path param",
 			pathParam: "satu",
@@ -608,8 +881,9 @@ func TestHandlerTaskDelete(t *testing.T) {
 
 			e := echo.New()
 			e.Validator = &rest.CustomValidator{Validator: validator.New()}
-			req := httptest.NewRequest(http.MethodDelete, "/v1/task/"+tt.pathParam, nil)
+			req := httptest.NewRequest(http.MethodDelete, "/v1/task/"+tt.pathParam+tt.reqParam, nil)
 			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Add("If-Match", tt.ifMatch)
 			rec := httptest.NewRecorder()
 			ctx := e.NewContext(req, rec)
 
@@ -625,3 +899,2156 @@ func TestHandlerTaskDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestHandlerTaskTransition(t *testing.T) {
+	taskModelInProgress := model.Task{
+		ID:          1,
+		Title:       "Task 1",
+		Description: "for test",
+		Status:      "in_progress",
+		UserID:      1,
+	}
+
+	tests := []struct {
+		name       string
+		reqBody    string
+		pathParam  string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			reqBody:   `{"status": "in_progress"}`,
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Transition", mock.Anything, taskModel.ID, "in_progress").
+					Return(taskModelInProgress, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call transition usecase",
+			reqBody:   `{"status": "in_progress"}`,
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Transition", mock.Anything, taskModel.ID, "in_progress").
+					Return(model.Task{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call transition usecase with conflict",
+			reqBody:   `{"status": "done"}`,
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Transition", mock.Anything, taskModel.ID, "done").
+					Return(model.Task{}, errs.NewErrs(http.StatusConflict, "cannot transition task from todo to done"))
+			},
+			statusCode: http.StatusConflict,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when validate request",
+			reqBody:   `{"status": "finished"}`,
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Transition")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when binding request",
+			reqBody:   `{`,
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Transition")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			reqBody:   `{"status": "in_progress"}`,
+			pathParam: "satu",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Transition")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPost, "/v1/task/"+tt.pathParam+"/transition", strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Transition(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskPatch(t *testing.T) {
+	title := "Task 2"
+
+	tests := []struct {
+		name        string
+		reqBody     string
+		contentType string
+		ifMatch     string
+		pathParam   string
+		mockDeps    func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode  int
+		wantErr     error
+	}{
+		{
+			name:        "success",
+			reqBody:     `{"title": "Task 2", "due_at": null}`,
+			contentType: "application/merge-patch+json",
+			pathParam:   "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Patch", mock.Anything, taskModel.ID, model.TaskPatch{
+					Title:  &title,
+					Fields: []string{"due_at", "title"},
+				}).Return(taskModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:        "success with json content type",
+			reqBody:     `{"title": "Task 2"}`,
+			contentType: echo.MIMEApplicationJSON,
+			pathParam:   "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Patch", mock.Anything, taskModel.ID, model.TaskPatch{
+					Title:  &title,
+					Fields: []string{"title"},
+				}).Return(taskModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:        "success when unassign task",
+			reqBody:     `{"assignee_id": null}`,
+			contentType: "application/merge-patch+json",
+			pathParam:   "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Patch", mock.Anything, taskModel.ID, model.TaskPatch{
+					Fields: []string{"assignee_id"},
+				}).Return(taskModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:        "success with if-match header",
+			reqBody:     `{"title": "Task 2"}`,
+			contentType: "application/merge-patch+json",
+			ifMatch:     `"4"`,
+			pathParam:   "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Patch", mock.Anything, taskModel.ID, model.TaskPatch{
+					Title:   &title,
+					Fields:  []string{"title"},
+					IfMatch: etag.Exact(4),
+				}).Return(taskModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:        "error when parse if-match header",
+			reqBody:     `{"title": "Task 2"}`,
+			contentType: "application/merge-patch+json",
+			ifMatch:     `"latest"`,
+			pathParam:   "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Patch")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:        "error when call patch usecase",
+			reqBody:     `{"status": "done"}`,
+			contentType: "application/merge-patch+json",
+			pathParam:   "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Patch", mock.Anything, taskModel.ID, mock.Anything).
+					Return(model.Task{}, errs.NewErrs(http.StatusConflict, "cannot transition task from todo to done"))
+			},
+			statusCode: http.StatusConflict,
+			wantErr:    nil,
+		},
+		{
+			name:        "error when call patch usecase with unknown error",
+			reqBody:     `{"status": "done"}`,
+			contentType: "application/merge-patch+json",
+			pathParam:   "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Patch", mock.Anything, taskModel.ID, mock.Anything).
+					Return(model.Task{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:        "error when required field is null",
+			reqBody:     `{"title": null}`,
+			contentType: "application/merge-patch+json",
+			pathParam:   "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Patch")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:        "error when field is not patchable",
+			reqBody:     `{"user_id": 2}`,
+			contentType: "application/merge-patch+json",
+			pathParam:   "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Patch")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:        "error when validate request",
+			reqBody:     `{"priority": "critical"}`,
+			contentType: "application/merge-patch+json",
+			pathParam:   "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Patch")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:        "error when document is not an object",
+			reqBody:     `["title"]`,
+			contentType: "application/merge-patch+json",
+			pathParam:   "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Patch")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+		{
+			name:        "error when content type is not supported",
+			reqBody:     `title=Task`,
+			contentType: echo.MIMEApplicationForm,
+			pathParam:   "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Patch")
+			},
+			statusCode: http.StatusUnsupportedMediaType,
+			wantErr:    nil,
+		},
+		{
+			name:        "error when parse request path param",
+			reqBody:     `{"title": "Task 2"}`,
+			contentType: "application/merge-patch+json",
+			pathParam:   "satu",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Patch")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPatch, "/v1/task/"+tt.pathParam, strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, tt.contentType)
+			req.Header.Add("If-Match", tt.ifMatch)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Patch(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskGetTrashByUserID(t *testing.T) {
+	tests := []struct {
+		name       string
+		reqParam   string
+		mockCtx    func(ctx context.Context) context.Context
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:     "success",
+			reqParam: "?sort=-deleted_at",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetTrashByUserID", mock.Anything, taskModel.UserID, mock.MatchedBy(func(p *param.Param) bool {
+					return p.Sort == "-deleted_at"
+				})).Return([]model.Task{taskModel}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:     "error when call get trash usecase",
+			reqParam: "",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetTrashByUserID", mock.Anything, taskModel.UserID, mock.Anything).
+					Return([]model.Task{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:     "error when call get trash usecase with custom error",
+			reqParam: "",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetTrashByUserID", mock.Anything, taskModel.UserID, mock.Anything).
+					Return([]model.Task{}, errs.NewErrs(http.StatusBadRequest, "invalid cursor"))
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:     "error when bind query param",
+			reqParam: "?page=satu",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, int64(taskModel.UserID))
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetTrashByUserID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:     "error when get user id from context",
+			reqParam: "",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.JtiKey, int64(taskModel.UserID))
+			},
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetTrashByUserID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodGet, "/v1/tasks/trash"+tt.reqParam, nil)
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			baseContext := ctx.Request().Context()
+			baseContext = tt.mockCtx(baseContext)
+			ctx.SetRequest(ctx.Request().WithContext(baseContext))
+
+			err := handler.GetTrashByUserID(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskRestore(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantETag   string
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Restore", mock.Anything, taskModel.ID).Return(taskModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantETag:   `"1"`,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call restore usecase",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Restore", mock.Anything, taskModel.ID).Return(model.Task{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call restore usecase with custom error",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Restore", mock.Anything, taskModel.ID).
+					Return(model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found in trash"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Restore")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+tt.pathParam+"/restore", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Restore(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantETag, rec.Header().Get("ETag"))
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskBulk(t *testing.T) {
+	results := []model.TaskBulkResult{
+		{Index: 0, Op: model.TaskBulkDelete, ID: 1, Status: model.TaskBulkStatusSuccess, Code: http.StatusOK},
+	}
+
+	tests := []struct {
+		name       string
+		reqBody    string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:    "success",
+			reqBody: `{"operations": [{"op": "delete", "id": 1}]}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Bulk", mock.Anything, model.TaskBulkRequest{
+					Operations: []model.TaskBulkOperation{{Op: model.TaskBulkDelete, ID: 1}},
+				}).Return(results, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when atomic operation is rolled back",
+			reqBody: `{"atomic": true, "operations": [{"op": "transition", "id": 1, "status": "done"}]}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Bulk", mock.Anything, mock.MatchedBy(func(r model.TaskBulkRequest) bool {
+					return r.Atomic
+				})).Return(results, errs.NewErrs(http.StatusConflict, "bulk operation rolled back"))
+			},
+			statusCode: http.StatusConflict,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when call bulk usecase",
+			reqBody: `{"operations": [{"op": "delete", "id": 1}]}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Bulk", mock.Anything, mock.Anything).Return(nil, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when operation has no task",
+			reqBody: `{"operations": [{"op": "create"}]}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Bulk")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when operation is unknown",
+			reqBody: `{"operations": [{"op": "archive", "id": 1}]}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Bulk")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when operations are empty",
+			reqBody: `{"operations": []}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Bulk")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when binding request",
+			reqBody: `{`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Bulk")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPost, "/v1/tasks/bulk", strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.Bulk(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskGetSubtasks(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		reqParam   string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			reqParam:  "?status=done",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetSubtasks", mock.Anything, taskModel.ID, mock.MatchedBy(func(p *param.Param) bool {
+					return p.Status == "done" && p.Limit == 10 && p.Page == 1
+				})).Return([]model.Task{taskModel}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call get subtasks usecase",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetSubtasks", mock.Anything, taskModel.ID, mock.Anything).
+					Return([]model.Task{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parent task is not found",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetSubtasks", mock.Anything, taskModel.ID, mock.Anything).
+					Return([]model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetSubtasks")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind query param",
+			pathParam: "1",
+			reqParam:  "?page=satu",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetSubtasks")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodGet, "/v1/tasks/"+tt.pathParam+"/subtasks"+tt.reqParam, nil)
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.GetSubtasks(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskGetDependencies(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetDependencies", mock.Anything, taskModel.ID).
+					Return(model.TaskDependencies{BlockedBy: []model.Task{taskModel}, Blocks: []model.Task{}}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call get dependencies usecase",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetDependencies", mock.Anything, taskModel.ID).
+					Return(model.TaskDependencies{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetDependencies")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/v1/tasks/"+tt.pathParam+"/dependencies", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.GetDependencies(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskAddDependency(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		reqBody    string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			reqBody:   `{"blocker_id":2}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("AddDependency", mock.Anything, taskModel.ID, model.TaskDependencyRequest{BlockerID: 2}).
+					Return(model.TaskDependency{BlockerID: 2, BlockedID: 1}, nil)
+			},
+			statusCode: http.StatusCreated,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when dependency creates a cycle",
+			pathParam: "1",
+			reqBody:   `{"blocker_id":2}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("AddDependency", mock.Anything, taskModel.ID, model.TaskDependencyRequest{BlockerID: 2}).
+					Return(model.TaskDependency{}, errs.NewErrs(http.StatusConflict, "task dependency would create a cycle"))
+			},
+			statusCode: http.StatusConflict,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call add dependency usecase",
+			pathParam: "1",
+			reqBody:   `{"blocker_id":2}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("AddDependency", mock.Anything, taskModel.ID, mock.Anything).
+					Return(model.TaskDependency{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when validate request",
+			pathParam: "1",
+			reqBody:   `{}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "AddDependency")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind request",
+			pathParam: "1",
+			reqBody:   `{"blocker_id":"dua"}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "AddDependency")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			reqBody:   `{"blocker_id":2}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "AddDependency")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+tt.pathParam+"/dependencies", strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.AddDependency(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskRemoveDependency(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		blockerId  string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			blockerId: "2",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("RemoveDependency", mock.Anything, taskModel.ID, int64(2)).Return(nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when dependency is not found",
+			pathParam: "1",
+			blockerId: "2",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("RemoveDependency", mock.Anything, taskModel.ID, int64(2)).
+					Return(errs.NewErrs(http.StatusNotFound, "task dependency not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			blockerId: "2",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "RemoveDependency")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse blocker id path param",
+			pathParam: "1",
+			blockerId: "dua",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "RemoveDependency")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/v1/tasks/"+tt.pathParam+"/dependencies/"+tt.blockerId, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id", "blocker_id")
+			ctx.SetParamValues(tt.pathParam, tt.blockerId)
+
+			err := handler.RemoveDependency(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskGetDependencyGraph(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetDependencyGraph", mock.Anything, taskModel.ID).
+					Return(model.TaskDependencyGraph{Nodes: []model.Task{taskModel}, Edges: []model.TaskDependency{}}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call get dependency graph usecase",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetDependencyGraph", mock.Anything, taskModel.ID).
+					Return(model.TaskDependencyGraph{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetDependencyGraph")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/v1/tasks/"+tt.pathParam+"/dependency-graph", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.GetDependencyGraph(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskAttachLabel(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		reqBody    string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			reqBody:   `{"label_id":3}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("AttachLabel", mock.Anything, taskModel.ID, model.TaskLabelRequest{LabelID: 3}).Return(taskModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when label is not found",
+			pathParam: "1",
+			reqBody:   `{"label_id":3}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("AttachLabel", mock.Anything, taskModel.ID, model.TaskLabelRequest{LabelID: 3}).
+					Return(model.Task{}, errs.NewErrs(http.StatusBadRequest, "label not found"))
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call attach label usecase",
+			pathParam: "1",
+			reqBody:   `{"label_id":3}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("AttachLabel", mock.Anything, taskModel.ID, mock.Anything).Return(model.Task{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when validate request",
+			pathParam: "1",
+			reqBody:   `{}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "AttachLabel")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind request",
+			pathParam: "1",
+			reqBody:   `{"label_id":"tiga"}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "AttachLabel")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			reqBody:   `{"label_id":3}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "AttachLabel")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+tt.pathParam+"/labels", strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.AttachLabel(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskDetachLabel(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		labelId    string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			labelId:   "3",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("DetachLabel", mock.Anything, taskModel.ID, int64(3)).Return(taskModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when label is not attached",
+			pathParam: "1",
+			labelId:   "3",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("DetachLabel", mock.Anything, taskModel.ID, int64(3)).
+					Return(model.Task{}, errs.NewErrs(http.StatusNotFound, "task label not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call detach label usecase",
+			pathParam: "1",
+			labelId:   "3",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("DetachLabel", mock.Anything, taskModel.ID, int64(3)).Return(model.Task{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			labelId:   "3",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "DetachLabel")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse label id path param",
+			pathParam: "1",
+			labelId:   "tiga",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "DetachLabel")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/v1/tasks/"+tt.pathParam+"/labels/"+tt.labelId, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id", "label_id")
+			ctx.SetParamValues(tt.pathParam, tt.labelId)
+
+			err := handler.DetachLabel(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskGetByProjectID(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		reqParam   string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "7",
+			reqParam:  "?status=todo",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByProjectID", mock.Anything, int64(7), mock.MatchedBy(func(p *param.Param) bool {
+					return p.Status == "todo" && p.Limit == 10 && p.Page == 1
+				})).Return([]model.Task{taskModel}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when project is not found",
+			pathParam: "7",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByProjectID", mock.Anything, int64(7), mock.Anything).
+					Return([]model.Task{}, errs.NewErrs(http.StatusNotFound, "project not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call get by project id usecase",
+			pathParam: "7",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByProjectID", mock.Anything, int64(7), mock.Anything).
+					Return([]model.Task{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "tujuh",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetByProjectID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind query param",
+			pathParam: "7",
+			reqParam:  "?page=satu",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetByProjectID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/v1/projects/"+tt.pathParam+"/tasks"+tt.reqParam, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.GetByProjectID(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskGetByWorkspaceID(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		reqParam   string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "7",
+			reqParam:  "?status=todo",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByWorkspaceID", mock.Anything, int64(7), mock.MatchedBy(func(p *param.Param) bool {
+					return p.Status == "todo" && p.Limit == 10 && p.Page == 1
+				})).Return([]model.Task{taskModel}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when workspace is not found",
+			pathParam: "7",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByWorkspaceID", mock.Anything, int64(7), mock.Anything).
+					Return([]model.Task{}, errs.NewErrs(http.StatusNotFound, "workspace not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call get by workspace id usecase",
+			pathParam: "7",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByWorkspaceID", mock.Anything, int64(7), mock.Anything).
+					Return([]model.Task{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "tujuh",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetByWorkspaceID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind query param",
+			pathParam: "7",
+			reqParam:  "?page=satu",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetByWorkspaceID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/v1/workspaces/"+tt.pathParam+"/tasks"+tt.reqParam, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.GetByWorkspaceID(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskAddChecklistItem(t *testing.T) {
+	item := model.TaskChecklistItem{ID: 2, TaskID: taskModel.ID, Text: "write tests", Position: 1}
+
+	tests := []struct {
+		name       string
+		pathParam  string
+		reqBody    string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			reqBody:   `{"text":"write tests"}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("AddChecklistItem", mock.Anything, taskModel.ID, model.TaskChecklistRequest{Text: "write tests"}).Return(item, nil)
+			},
+			statusCode: http.StatusCreated,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when task is not found",
+			pathParam: "1",
+			reqBody:   `{"text":"write tests"}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("AddChecklistItem", mock.Anything, taskModel.ID, mock.Anything).
+					Return(model.TaskChecklistItem{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call add checklist item usecase",
+			pathParam: "1",
+			reqBody:   `{"text":"write tests"}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("AddChecklistItem", mock.Anything, taskModel.ID, mock.Anything).Return(model.TaskChecklistItem{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when validate request",
+			pathParam: "1",
+			reqBody:   `{"text":""}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "AddChecklistItem")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind request",
+			pathParam: "1",
+			reqBody:   `{"text":1}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "AddChecklistItem")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			reqBody:   `{"text":"write tests"}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "AddChecklistItem")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+tt.pathParam+"/checklist", strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.AddChecklistItem(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskToggleChecklistItem(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		itemId     string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			itemId:    "2",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("ToggleChecklistItem", mock.Anything, taskModel.ID, int64(2)).
+					Return(model.TaskChecklistItem{ID: 2, TaskID: taskModel.ID, Checked: true}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when checklist item is not found",
+			pathParam: "1",
+			itemId:    "2",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("ToggleChecklistItem", mock.Anything, taskModel.ID, int64(2)).
+					Return(model.TaskChecklistItem{}, errs.NewErrs(http.StatusNotFound, "checklist item not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call toggle checklist item usecase",
+			pathParam: "1",
+			itemId:    "2",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("ToggleChecklistItem", mock.Anything, taskModel.ID, int64(2)).Return(model.TaskChecklistItem{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			itemId:    "2",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "ToggleChecklistItem")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse item id path param",
+			pathParam: "1",
+			itemId:    "dua",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "ToggleChecklistItem")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+tt.pathParam+"/checklist/"+tt.itemId+"/toggle", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id", "item_id")
+			ctx.SetParamValues(tt.pathParam, tt.itemId)
+
+			err := handler.ToggleChecklistItem(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskReorderChecklist(t *testing.T) {
+	checklist := []model.TaskChecklistItem{
+		{ID: 2, TaskID: taskModel.ID, Text: "write tests", Position: 1},
+		{ID: 1, TaskID: taskModel.ID, Text: "review", Position: 2},
+	}
+
+	tests := []struct {
+		name       string
+		pathParam  string
+		reqBody    string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			reqBody:   `{"item_ids":[2,1]}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("ReorderChecklist", mock.Anything, taskModel.ID, model.TaskChecklistOrderRequest{ItemIDs: []int64{2, 1}}).Return(checklist, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when item ids are not a permutation",
+			pathParam: "1",
+			reqBody:   `{"item_ids":[2]}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("ReorderChecklist", mock.Anything, taskModel.ID, mock.Anything).
+					Return([]model.TaskChecklistItem{}, errs.NewErrs(http.StatusBadRequest, "item_ids must list every checklist item exactly once"))
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call reorder checklist usecase",
+			pathParam: "1",
+			reqBody:   `{"item_ids":[2,1]}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("ReorderChecklist", mock.Anything, taskModel.ID, mock.Anything).Return([]model.TaskChecklistItem{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when validate request",
+			pathParam: "1",
+			reqBody:   `{"item_ids":[]}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "ReorderChecklist")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind request",
+			pathParam: "1",
+			reqBody:   `{"item_ids":"dua"}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "ReorderChecklist")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			reqBody:   `{"item_ids":[2,1]}`,
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "ReorderChecklist")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPut, "/v1/tasks/"+tt.pathParam+"/checklist/order", strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.ReorderChecklist(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskRemoveChecklistItem(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		itemId     string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			itemId:    "2",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("RemoveChecklistItem", mock.Anything, taskModel.ID, int64(2)).Return(nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when checklist item is not found",
+			pathParam: "1",
+			itemId:    "2",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("RemoveChecklistItem", mock.Anything, taskModel.ID, int64(2)).
+					Return(errs.NewErrs(http.StatusNotFound, "checklist item not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call remove checklist item usecase",
+			pathParam: "1",
+			itemId:    "2",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("RemoveChecklistItem", mock.Anything, taskModel.ID, int64(2)).Return(errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse item id path param",
+			pathParam: "1",
+			itemId:    "dua",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "RemoveChecklistItem")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/v1/tasks/"+tt.pathParam+"/checklist/"+tt.itemId, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id", "item_id")
+			ctx.SetParamValues(tt.pathParam, tt.itemId)
+
+			err := handler.RemoveChecklistItem(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskGetHistory(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		reqParam   string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			reqParam:  "?page=2&limit=5",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetHistory", mock.Anything, taskModel.ID, mock.MatchedBy(func(p *param.Param) bool {
+					return p.Limit == 5 && p.Page == 2
+				})).Return([]model.TaskHistory{{ID: 1, TaskID: taskModel.ID, Revision: 1, Action: model.TaskActionCreate}}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call get history usecase",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetHistory", mock.Anything, taskModel.ID, mock.Anything).
+					Return([]model.TaskHistory{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when task is not found",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetHistory", mock.Anything, taskModel.ID, mock.Anything).
+					Return([]model.TaskHistory{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetHistory")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind query param",
+			pathParam: "1",
+			reqParam:  "?page=satu",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetHistory")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodGet, "/v1/tasks/"+tt.pathParam+"/history"+tt.reqParam, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.GetHistory(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskRevert(t *testing.T) {
+	tests := []struct {
+		name       string
+		ifMatch    string
+		reqParam   string
+		pathParam  string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			reqParam:  "?to=2",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Revert", mock.Anything, taskModel.ID, int64(2), etag.Match{}, false).Return(taskModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "success with force and if-match",
+			ifMatch:   `"3"`,
+			reqParam:  "?to=2&force=true",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Revert", mock.Anything, taskModel.ID, int64(2), etag.Exact(3), true).Return(taskModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when revert conflicts with later change",
+			reqParam:  "?to=2",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Revert", mock.Anything, taskModel.ID, int64(2), etag.Match{}, false).
+					Return(model.Task{}, errs.NewErrs(http.StatusConflict, "revert conflicts with later changes to title, use force to revert"))
+			},
+			statusCode: http.StatusConflict,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call revert usecase",
+			reqParam:  "?to=2",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Revert", mock.Anything, taskModel.ID, int64(2), etag.Match{}, false).Return(model.Task{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse if-match header",
+			ifMatch:   "satu",
+			reqParam:  "?to=2",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Revert")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse force query param",
+			reqParam:  "?to=2&force=maybe",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Revert")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when to query param is missing",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Revert")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when to query param is not positive",
+			reqParam:  "?to=0",
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Revert")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			reqParam:  "?to=2",
+			pathParam: "satu",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Revert")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+tt.pathParam+"/revert"+tt.reqParam, nil)
+			req.Header.Add("If-Match", tt.ifMatch)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Revert(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskBurndown(t *testing.T) {
+	projectId := int64(7)
+
+	tests := []struct {
+		name       string
+		reqParam   string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:     "success",
+			reqParam: "?project_id=7&from=2023-08-01&to=2023-08-14&metric=points",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Burndown", mock.Anything, model.TaskBurndownRequest{ProjectID: &projectId, From: "2023-08-01", To: "2023-08-14", Metric: model.TaskBurndownMetricPoints}).Return(model.TaskBurndown{}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:     "error when project is not found",
+			reqParam: "?project_id=7&from=2023-08-01&to=2023-08-14",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Burndown", mock.Anything, mock.Anything).Return(model.TaskBurndown{}, errs.NewErrs(http.StatusNotFound, "project not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:     "error when call burndown usecase",
+			reqParam: "?from=2023-08-01&to=2023-08-14",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Burndown", mock.Anything, mock.Anything).Return(model.TaskBurndown{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:     "error when validate request with unknown metric",
+			reqParam: "?from=2023-08-01&to=2023-08-14&metric=hours",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Burndown")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:     "error when validate request without range",
+			reqParam: "?project_id=7",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Burndown")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:     "error when bind query param",
+			reqParam: "?project_id=tujuh&from=2023-08-01&to=2023-08-14",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Burndown")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodGet, "/v1/tasks/burndown"+tt.reqParam, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.Burndown(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskMove(t *testing.T) {
+	prevId := int64(2)
+	nextId := int64(3)
+	moved := model.Task{ID: 1, Title: "Task 1", Description: "for test", Status: "in_progress", Position: "V00001V", UserID: 1, Version: 3}
+
+	tests := []struct {
+		name       string
+		reqBody    string
+		pathParam  string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			reqBody:   `{"status": "in_progress", "prev_id": 2, "next_id": 3}`,
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Move", mock.Anything, taskModel.ID, model.TaskMove{Status: "in_progress", PrevID: &prevId, NextID: &nextId}).
+					Return(moved, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call move usecase",
+			reqBody:   `{"status": "in_progress"}`,
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Move", mock.Anything, taskModel.ID, model.TaskMove{Status: "in_progress"}).
+					Return(model.Task{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call move usecase with bad neighbour",
+			reqBody:   `{"status": "in_progress", "prev_id": 2}`,
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Move", mock.Anything, taskModel.ID, model.TaskMove{Status: "in_progress", PrevID: &prevId}).
+					Return(model.Task{}, errs.NewErrs(http.StatusBadRequest, "neighbour task not found"))
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when validate request",
+			reqBody:   `{"prev_id": 2}`,
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Move")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when binding request",
+			reqBody:   `{`,
+			pathParam: "1",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Move")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			reqBody:   `{"status": "in_progress"}`,
+			pathParam: "satu",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Move")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+tt.pathParam+"/move", strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Move(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTaskBoard(t *testing.T) {
+	projectId := int64(7)
+	board := model.TaskBoard{Columns: []model.TaskBoardColumn{
+		{Status: "todo", Total: 1, Tasks: []model.Task{taskModel}},
+	}}
+
+	tests := []struct {
+		name       string
+		reqParam   string
+		mockDeps   func(taskUsecase *taskmocks.MockTaskUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:     "success",
+			reqParam: "?project_id=7&assignee=me&limit=20",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Board", mock.Anything, model.TaskBoardRequest{ProjectID: &projectId, Assignee: "me", Limit: 20}).
+					Return(board, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:     "error when call board usecase",
+			reqParam: "",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Board", mock.Anything, model.TaskBoardRequest{}).
+					Return(model.TaskBoard{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:     "error when call board usecase with missing project",
+			reqParam: "?project_id=7",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("Board", mock.Anything, model.TaskBoardRequest{ProjectID: &projectId}).
+					Return(model.TaskBoard{}, errs.NewErrs(http.StatusNotFound, "project not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:     "error when validate request",
+			reqParam: "?limit=500",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Board")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:     "error when bind query param",
+			reqParam: "?project_id=tujuh",
+			mockDeps: func(taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "Board")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&taskUsecase)
+
+			handler := task.New(&taskUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodGet, "/v1/board"+tt.reqParam, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.Board(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,311 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	echo "github.com/labstack/echo/v4"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTimeEntryHandler is an autogenerated mock type for the TimeEntryHandler type
+type MockTimeEntryHandler struct {
+	mock.Mock
+}
+
+type MockTimeEntryHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTimeEntryHandler) EXPECT() *MockTimeEntryHandler_Expecter {
+	return &MockTimeEntryHandler_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: e
+func (_m *MockTimeEntryHandler) Create(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTimeEntryHandler_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockTimeEntryHandler_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTimeEntryHandler_Expecter) Create(e interface{}) *MockTimeEntryHandler_Create_Call {
+	return &MockTimeEntryHandler_Create_Call{Call: _e.mock.On("Create", e)}
+}
+
+func (_c *MockTimeEntryHandler_Create_Call) Run(run func(e echo.Context)) *MockTimeEntryHandler_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryHandler_Create_Call) Return(err error) *MockTimeEntryHandler_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTimeEntryHandler_Create_Call) RunAndReturn(run func(echo.Context) error) *MockTimeEntryHandler_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: e
+func (_m *MockTimeEntryHandler) Delete(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTimeEntryHandler_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockTimeEntryHandler_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTimeEntryHandler_Expecter) Delete(e interface{}) *MockTimeEntryHandler_Delete_Call {
+	return &MockTimeEntryHandler_Delete_Call{Call: _e.mock.On("Delete", e)}
+}
+
+func (_c *MockTimeEntryHandler_Delete_Call) Run(run func(e echo.Context)) *MockTimeEntryHandler_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryHandler_Delete_Call) Return(err error) *MockTimeEntryHandler_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTimeEntryHandler_Delete_Call) RunAndReturn(run func(echo.Context) error) *MockTimeEntryHandler_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTaskID provides a mock function with given fields: e
+func (_m *MockTimeEntryHandler) GetByTaskID(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTaskID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTimeEntryHandler_GetByTaskID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTaskID'
+type MockTimeEntryHandler_GetByTaskID_Call struct {
+	*mock.Call
+}
+
+// GetByTaskID is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTimeEntryHandler_Expecter) GetByTaskID(e interface{}) *MockTimeEntryHandler_GetByTaskID_Call {
+	return &MockTimeEntryHandler_GetByTaskID_Call{Call: _e.mock.On("GetByTaskID", e)}
+}
+
+func (_c *MockTimeEntryHandler_GetByTaskID_Call) Run(run func(e echo.Context)) *MockTimeEntryHandler_GetByTaskID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryHandler_GetByTaskID_Call) Return(err error) *MockTimeEntryHandler_GetByTaskID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTimeEntryHandler_GetByTaskID_Call) RunAndReturn(run func(echo.Context) error) *MockTimeEntryHandler_GetByTaskID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Report provides a mock function with given fields: e
+func (_m *MockTimeEntryHandler) Report(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Report")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTimeEntryHandler_Report_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Report'
+type MockTimeEntryHandler_Report_Call struct {
+	*mock.Call
+}
+
+// Report is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTimeEntryHandler_Expecter) Report(e interface{}) *MockTimeEntryHandler_Report_Call {
+	return &MockTimeEntryHandler_Report_Call{Call: _e.mock.On("Report", e)}
+}
+
+func (_c *MockTimeEntryHandler_Report_Call) Run(run func(e echo.Context)) *MockTimeEntryHandler_Report_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryHandler_Report_Call) Return(err error) *MockTimeEntryHandler_Report_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTimeEntryHandler_Report_Call) RunAndReturn(run func(echo.Context) error) *MockTimeEntryHandler_Report_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: e
+func (_m *MockTimeEntryHandler) Start(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTimeEntryHandler_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type MockTimeEntryHandler_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTimeEntryHandler_Expecter) Start(e interface{}) *MockTimeEntryHandler_Start_Call {
+	return &MockTimeEntryHandler_Start_Call{Call: _e.mock.On("Start", e)}
+}
+
+func (_c *MockTimeEntryHandler_Start_Call) Run(run func(e echo.Context)) *MockTimeEntryHandler_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryHandler_Start_Call) Return(err error) *MockTimeEntryHandler_Start_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTimeEntryHandler_Start_Call) RunAndReturn(run func(echo.Context) error) *MockTimeEntryHandler_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with given fields: e
+func (_m *MockTimeEntryHandler) Stop(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTimeEntryHandler_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type MockTimeEntryHandler_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockTimeEntryHandler_Expecter) Stop(e interface{}) *MockTimeEntryHandler_Stop_Call {
+	return &MockTimeEntryHandler_Stop_Call{Call: _e.mock.On("Stop", e)}
+}
+
+func (_c *MockTimeEntryHandler_Stop_Call) Run(run func(e echo.Context)) *MockTimeEntryHandler_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryHandler_Stop_Call) Return(err error) *MockTimeEntryHandler_Stop_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTimeEntryHandler_Stop_Call) RunAndReturn(run func(echo.Context) error) *MockTimeEntryHandler_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTimeEntryHandler creates a new instance of MockTimeEntryHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTimeEntryHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTimeEntryHandler {
+	mock := &MockTimeEntryHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
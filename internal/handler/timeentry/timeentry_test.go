@@ -0,0 +1,535 @@
+package timeentry_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rzfhlv/go-task/internal/handler/timeentry"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/presenter/rest"
+	timeentrymocks "github.com/rzfhlv/go-task/internal/usecase/timeentry/mocks"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/param"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	startedAt = time.Date(2023, time.August, 15, 9, 0, 0, 0, time.UTC)
+	endedAt   = startedAt.Add(90 * time.Minute)
+
+	timeEntryModel = model.TimeEntry{
+		ID:              1,
+		TaskID:          2,
+		UserID:          1,
+		StartedAt:       startedAt,
+		EndedAt:         &endedAt,
+		DurationSeconds: 5400,
+		Source:          model.TimeEntrySourceManual,
+	}
+)
+
+func TestHandlerTimeEntryStart(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		reqBody    string
+		mockDeps   func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "2",
+			reqBody:   `{"note":"standup"}`,
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.On("Start", mock.Anything, timeEntryModel.TaskID, model.TimerRequest{Note: "standup"}).Return(timeEntryModel, nil)
+			},
+			statusCode: http.StatusCreated,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when timer is already running",
+			pathParam: "2",
+			reqBody:   `{}`,
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.On("Start", mock.Anything, timeEntryModel.TaskID, model.TimerRequest{}).Return(model.TimeEntry{}, errs.NewErrs(http.StatusConflict, "timer is already running on task 7"))
+			},
+			statusCode: http.StatusConflict,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call start usecase",
+			pathParam: "2",
+			reqBody:   `{}`,
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.On("Start", mock.Anything, timeEntryModel.TaskID, mock.Anything).Return(model.TimeEntry{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when validate request",
+			pathParam: "2",
+			reqBody:   `{"note":"` + strings.Repeat("a", 1001) + `"}`,
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.AssertNotCalled(t, "Start")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind request",
+			pathParam: "2",
+			reqBody:   `{"note":1}`,
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.AssertNotCalled(t, "Start")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "dua",
+			reqBody:   `{}`,
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.AssertNotCalled(t, "Start")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeEntryUsecase := timeentrymocks.MockTimeEntryUsecase{}
+
+			tt.mockDeps(&timeEntryUsecase)
+
+			handler := timeentry.New(&timeEntryUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+tt.pathParam+"/timer/start", strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Start(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTimeEntryStop(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		mockDeps   func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "2",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.On("Stop", mock.Anything, timeEntryModel.TaskID).Return(timeEntryModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when no timer is running",
+			pathParam: "2",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.On("Stop", mock.Anything, timeEntryModel.TaskID).Return(model.TimeEntry{}, errs.NewErrs(http.StatusNotFound, "no running timer on this task"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call stop usecase",
+			pathParam: "2",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.On("Stop", mock.Anything, timeEntryModel.TaskID).Return(model.TimeEntry{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "dua",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.AssertNotCalled(t, "Stop")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeEntryUsecase := timeentrymocks.MockTimeEntryUsecase{}
+
+			tt.mockDeps(&timeEntryUsecase)
+
+			handler := timeentry.New(&timeEntryUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+tt.pathParam+"/timer/stop", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Stop(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTimeEntryCreate(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		reqBody    string
+		mockDeps   func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "2",
+			reqBody:   `{"started_at":"2023-08-15T09:00:00Z","ended_at":"2023-08-15T10:30:00Z"}`,
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.On("Create", mock.Anything, timeEntryModel.TaskID, model.TimeEntryRequest{StartedAt: &startedAt, EndedAt: &endedAt}).Return(timeEntryModel, nil)
+			},
+			statusCode: http.StatusCreated,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when ended at is before started at",
+			pathParam: "2",
+			reqBody:   `{"started_at":"2023-08-15T10:30:00Z","ended_at":"2023-08-15T09:00:00Z"}`,
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.On("Create", mock.Anything, timeEntryModel.TaskID, mock.Anything).Return(model.TimeEntry{}, errs.NewErrs(http.StatusBadRequest, "ended_at must be after started_at"))
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call create usecase",
+			pathParam: "2",
+			reqBody:   `{"started_at":"2023-08-15T09:00:00Z","ended_at":"2023-08-15T10:30:00Z"}`,
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.On("Create", mock.Anything, timeEntryModel.TaskID, mock.Anything).Return(model.TimeEntry{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when validate request",
+			pathParam: "2",
+			reqBody:   `{"started_at":"2023-08-15T09:00:00Z"}`,
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind request",
+			pathParam: "2",
+			reqBody:   `{"started_at":"yesterday"}`,
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "dua",
+			reqBody:   `{"started_at":"2023-08-15T09:00:00Z","ended_at":"2023-08-15T10:30:00Z"}`,
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeEntryUsecase := timeentrymocks.MockTimeEntryUsecase{}
+
+			tt.mockDeps(&timeEntryUsecase)
+
+			handler := timeentry.New(&timeEntryUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPost, "/v1/tasks/"+tt.pathParam+"/time-entries", strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Create(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTimeEntryGetByTaskID(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		query      string
+		mockDeps   func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "2",
+			query:     "?page=2&limit=5",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.On("GetByTaskID", mock.Anything, timeEntryModel.TaskID, mock.MatchedBy(func(param *param.Param) bool {
+					return param.Page == 2 && param.Limit == 5
+				})).Return([]model.TimeEntry{timeEntryModel}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when task is not found",
+			pathParam: "2",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.On("GetByTaskID", mock.Anything, timeEntryModel.TaskID, mock.Anything).Return([]model.TimeEntry{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call get usecase",
+			pathParam: "2",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.On("GetByTaskID", mock.Anything, timeEntryModel.TaskID, mock.Anything).Return([]model.TimeEntry{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind query param",
+			pathParam: "2",
+			query:     "?page=satu",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.AssertNotCalled(t, "GetByTaskID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "dua",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.AssertNotCalled(t, "GetByTaskID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeEntryUsecase := timeentrymocks.MockTimeEntryUsecase{}
+
+			tt.mockDeps(&timeEntryUsecase)
+
+			handler := timeentry.New(&timeEntryUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/v1/tasks/"+tt.pathParam+"/time-entries"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.GetByTaskID(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTimeEntryDelete(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		entryParam string
+		mockDeps   func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:       "success",
+			pathParam:  "2",
+			entryParam: "1",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.On("Delete", mock.Anything, timeEntryModel.TaskID, timeEntryModel.ID).Return(nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:       "error when time entry is not found",
+			pathParam:  "2",
+			entryParam: "1",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.On("Delete", mock.Anything, timeEntryModel.TaskID, timeEntryModel.ID).Return(errs.NewErrs(http.StatusNotFound, "time entry not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:       "error when call delete usecase",
+			pathParam:  "2",
+			entryParam: "1",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.On("Delete", mock.Anything, timeEntryModel.TaskID, timeEntryModel.ID).Return(errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:       "error when parse entry path param",
+			pathParam:  "2",
+			entryParam: "satu",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.AssertNotCalled(t, "Delete")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:       "error when parse request path param",
+			pathParam:  "dua",
+			entryParam: "1",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.AssertNotCalled(t, "Delete")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeEntryUsecase := timeentrymocks.MockTimeEntryUsecase{}
+
+			tt.mockDeps(&timeEntryUsecase)
+
+			handler := timeentry.New(&timeEntryUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/v1/tasks/"+tt.pathParam+"/time-entries/"+tt.entryParam, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id", "entry_id")
+			ctx.SetParamValues(tt.pathParam, tt.entryParam)
+
+			err := handler.Delete(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerTimeEntryReport(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		mockDeps   func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:  "success",
+			query: "?from=2023-08-01&to=2023-08-31&group_by=project",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.On("Report", mock.Anything, model.TimeReportRequest{From: "2023-08-01", To: "2023-08-31", GroupBy: model.TimeReportGroupProject}).Return(model.TimeReport{GroupBy: model.TimeReportGroupProject}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:  "error when range is too long",
+			query: "?from=2022-01-01&to=2023-08-31",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.On("Report", mock.Anything, mock.Anything).Return(model.TimeReport{}, errs.NewErrs(http.StatusBadRequest, "report range must not exceed 366 days"))
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:  "error when call report usecase",
+			query: "?from=2023-08-01&to=2023-08-31",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.On("Report", mock.Anything, mock.Anything).Return(model.TimeReport{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:  "error when validate request without range",
+			query: "?from=2023-08-01",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.AssertNotCalled(t, "Report")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:  "error when validate request with unknown group",
+			query: "?from=2023-08-01&to=2023-08-31&group_by=week",
+			mockDeps: func(timeEntryUsecase *timeentrymocks.MockTimeEntryUsecase) {
+				timeEntryUsecase.AssertNotCalled(t, "Report")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeEntryUsecase := timeentrymocks.MockTimeEntryUsecase{}
+
+			tt.mockDeps(&timeEntryUsecase)
+
+			handler := timeentry.New(&timeEntryUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodGet, "/v1/time-entries"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.Report(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,449 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	echo "github.com/labstack/echo/v4"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWorkspaceHandler is an autogenerated mock type for the WorkspaceHandler type
+type MockWorkspaceHandler struct {
+	mock.Mock
+}
+
+type MockWorkspaceHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWorkspaceHandler) EXPECT() *MockWorkspaceHandler_Expecter {
+	return &MockWorkspaceHandler_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: e
+func (_m *MockWorkspaceHandler) Create(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkspaceHandler_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockWorkspaceHandler_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockWorkspaceHandler_Expecter) Create(e interface{}) *MockWorkspaceHandler_Create_Call {
+	return &MockWorkspaceHandler_Create_Call{Call: _e.mock.On("Create", e)}
+}
+
+func (_c *MockWorkspaceHandler_Create_Call) Run(run func(e echo.Context)) *MockWorkspaceHandler_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceHandler_Create_Call) Return(err error) *MockWorkspaceHandler_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWorkspaceHandler_Create_Call) RunAndReturn(run func(echo.Context) error) *MockWorkspaceHandler_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: e
+func (_m *MockWorkspaceHandler) Delete(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkspaceHandler_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockWorkspaceHandler_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockWorkspaceHandler_Expecter) Delete(e interface{}) *MockWorkspaceHandler_Delete_Call {
+	return &MockWorkspaceHandler_Delete_Call{Call: _e.mock.On("Delete", e)}
+}
+
+func (_c *MockWorkspaceHandler_Delete_Call) Run(run func(e echo.Context)) *MockWorkspaceHandler_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceHandler_Delete_Call) Return(err error) *MockWorkspaceHandler_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWorkspaceHandler_Delete_Call) RunAndReturn(run func(echo.Context) error) *MockWorkspaceHandler_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: e
+func (_m *MockWorkspaceHandler) GetByID(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkspaceHandler_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockWorkspaceHandler_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockWorkspaceHandler_Expecter) GetByID(e interface{}) *MockWorkspaceHandler_GetByID_Call {
+	return &MockWorkspaceHandler_GetByID_Call{Call: _e.mock.On("GetByID", e)}
+}
+
+func (_c *MockWorkspaceHandler_GetByID_Call) Run(run func(e echo.Context)) *MockWorkspaceHandler_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceHandler_GetByID_Call) Return(err error) *MockWorkspaceHandler_GetByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWorkspaceHandler_GetByID_Call) RunAndReturn(run func(echo.Context) error) *MockWorkspaceHandler_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUserID provides a mock function with given fields: e
+func (_m *MockWorkspaceHandler) GetByUserID(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkspaceHandler_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
+type MockWorkspaceHandler_GetByUserID_Call struct {
+	*mock.Call
+}
+
+// GetByUserID is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockWorkspaceHandler_Expecter) GetByUserID(e interface{}) *MockWorkspaceHandler_GetByUserID_Call {
+	return &MockWorkspaceHandler_GetByUserID_Call{Call: _e.mock.On("GetByUserID", e)}
+}
+
+func (_c *MockWorkspaceHandler_GetByUserID_Call) Run(run func(e echo.Context)) *MockWorkspaceHandler_GetByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceHandler_GetByUserID_Call) Return(err error) *MockWorkspaceHandler_GetByUserID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWorkspaceHandler_GetByUserID_Call) RunAndReturn(run func(echo.Context) error) *MockWorkspaceHandler_GetByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMembers provides a mock function with given fields: e
+func (_m *MockWorkspaceHandler) GetMembers(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMembers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkspaceHandler_GetMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMembers'
+type MockWorkspaceHandler_GetMembers_Call struct {
+	*mock.Call
+}
+
+// GetMembers is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockWorkspaceHandler_Expecter) GetMembers(e interface{}) *MockWorkspaceHandler_GetMembers_Call {
+	return &MockWorkspaceHandler_GetMembers_Call{Call: _e.mock.On("GetMembers", e)}
+}
+
+func (_c *MockWorkspaceHandler_GetMembers_Call) Run(run func(e echo.Context)) *MockWorkspaceHandler_GetMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceHandler_GetMembers_Call) Return(err error) *MockWorkspaceHandler_GetMembers_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWorkspaceHandler_GetMembers_Call) RunAndReturn(run func(echo.Context) error) *MockWorkspaceHandler_GetMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Invite provides a mock function with given fields: e
+func (_m *MockWorkspaceHandler) Invite(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Invite")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkspaceHandler_Invite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invite'
+type MockWorkspaceHandler_Invite_Call struct {
+	*mock.Call
+}
+
+// Invite is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockWorkspaceHandler_Expecter) Invite(e interface{}) *MockWorkspaceHandler_Invite_Call {
+	return &MockWorkspaceHandler_Invite_Call{Call: _e.mock.On("Invite", e)}
+}
+
+func (_c *MockWorkspaceHandler_Invite_Call) Run(run func(e echo.Context)) *MockWorkspaceHandler_Invite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceHandler_Invite_Call) Return(err error) *MockWorkspaceHandler_Invite_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWorkspaceHandler_Invite_Call) RunAndReturn(run func(echo.Context) error) *MockWorkspaceHandler_Invite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: e
+func (_m *MockWorkspaceHandler) RemoveMember(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkspaceHandler_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type MockWorkspaceHandler_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockWorkspaceHandler_Expecter) RemoveMember(e interface{}) *MockWorkspaceHandler_RemoveMember_Call {
+	return &MockWorkspaceHandler_RemoveMember_Call{Call: _e.mock.On("RemoveMember", e)}
+}
+
+func (_c *MockWorkspaceHandler_RemoveMember_Call) Run(run func(e echo.Context)) *MockWorkspaceHandler_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceHandler_RemoveMember_Call) Return(err error) *MockWorkspaceHandler_RemoveMember_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWorkspaceHandler_RemoveMember_Call) RunAndReturn(run func(echo.Context) error) *MockWorkspaceHandler_RemoveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: e
+func (_m *MockWorkspaceHandler) Update(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkspaceHandler_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockWorkspaceHandler_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockWorkspaceHandler_Expecter) Update(e interface{}) *MockWorkspaceHandler_Update_Call {
+	return &MockWorkspaceHandler_Update_Call{Call: _e.mock.On("Update", e)}
+}
+
+func (_c *MockWorkspaceHandler_Update_Call) Run(run func(e echo.Context)) *MockWorkspaceHandler_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceHandler_Update_Call) Return(err error) *MockWorkspaceHandler_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWorkspaceHandler_Update_Call) RunAndReturn(run func(echo.Context) error) *MockWorkspaceHandler_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMember provides a mock function with given fields: e
+func (_m *MockWorkspaceHandler) UpdateMember(e echo.Context) error {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(echo.Context) error); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkspaceHandler_UpdateMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMember'
+type MockWorkspaceHandler_UpdateMember_Call struct {
+	*mock.Call
+}
+
+// UpdateMember is a helper method to define mock.On call
+//   - e echo.Context
+func (_e *MockWorkspaceHandler_Expecter) UpdateMember(e interface{}) *MockWorkspaceHandler_UpdateMember_Call {
+	return &MockWorkspaceHandler_UpdateMember_Call{Call: _e.mock.On("UpdateMember", e)}
+}
+
+func (_c *MockWorkspaceHandler_UpdateMember_Call) Run(run func(e echo.Context)) *MockWorkspaceHandler_UpdateMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceHandler_UpdateMember_Call) Return(err error) *MockWorkspaceHandler_UpdateMember_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWorkspaceHandler_UpdateMember_Call) RunAndReturn(run func(echo.Context) error) *MockWorkspaceHandler_UpdateMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWorkspaceHandler creates a new instance of MockWorkspaceHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWorkspaceHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWorkspaceHandler {
+	mock := &MockWorkspaceHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,781 @@
+package workspace_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/rzfhlv/go-task/internal/handler/workspace"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/presenter/rest"
+	workspacemocks "github.com/rzfhlv/go-task/internal/usecase/workspace/mocks"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	workspaceModel = model.Workspace{
+		ID:      1,
+		Name:    "Platform",
+		OwnerID: 1,
+		Role:    model.WorkspaceRoleOwner,
+	}
+
+	memberModel = model.WorkspaceMember{
+		WorkspaceID: 1,
+		UserID:      2,
+		Name:        "Jane",
+		Email:       "jane@example.com",
+		Role:        model.WorkspaceRoleEditor,
+	}
+)
+
+func TestHandlerWorkspaceCreate(t *testing.T) {
+	tests := []struct {
+		name       string
+		reqBody    string
+		mockDeps   func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:    "success",
+			reqBody: `{"name":"Platform"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("Create", mock.Anything, model.Workspace{Name: "Platform"}).Return(workspaceModel, nil)
+			},
+			statusCode: http.StatusCreated,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when user id is missing",
+			reqBody: `{"name":"Platform"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("Create", mock.Anything, mock.Anything).Return(model.Workspace{}, errs.NewErrs(http.StatusForbidden, "forbidden access"))
+			},
+			statusCode: http.StatusForbidden,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when call create usecase",
+			reqBody: `{"name":"Platform"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("Create", mock.Anything, mock.Anything).Return(model.Workspace{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when validate name length",
+			reqBody: `{"name":"` + strings.Repeat("a", 101) + `"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when validate request",
+			reqBody: `{}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when bind request",
+			reqBody: `{"name":1}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "Create")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceUsecase := workspacemocks.MockWorkspaceUsecase{}
+
+			tt.mockDeps(&workspaceUsecase)
+
+			handler := workspace.New(&workspaceUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPost, "/v1/workspaces", strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			err := handler.Create(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerWorkspaceGetByUserID(t *testing.T) {
+	tests := []struct {
+		name       string
+		mockCtx    func(ctx context.Context) context.Context
+		mockDeps   func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, workspaceModel.OwnerID)
+			},
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("GetByUserID", mock.Anything, workspaceModel.OwnerID).Return([]model.Workspace{workspaceModel}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name: "error when call get by user id usecase",
+			mockCtx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, workspaceModel.OwnerID)
+			},
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("GetByUserID", mock.Anything, workspaceModel.OwnerID).Return([]model.Workspace{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name: "error when user id is missing",
+			mockCtx: func(ctx context.Context) context.Context {
+				return ctx
+			},
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "GetByUserID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceUsecase := workspacemocks.MockWorkspaceUsecase{}
+
+			tt.mockDeps(&workspaceUsecase)
+
+			handler := workspace.New(&workspaceUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/v1/workspaces", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			baseContext := ctx.Request().Context()
+			baseContext = tt.mockCtx(baseContext)
+			ctx.SetRequest(ctx.Request().WithContext(baseContext))
+
+			err := handler.GetByUserID(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerWorkspaceGetByID(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		mockDeps   func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("GetByID", mock.Anything, workspaceModel.ID).Return(workspaceModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when workspace is not found",
+			pathParam: "1",
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("GetByID", mock.Anything, workspaceModel.ID).Return(model.Workspace{}, errs.NewErrs(http.StatusNotFound, "workspace not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call get by id usecase",
+			pathParam: "1",
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("GetByID", mock.Anything, workspaceModel.ID).Return(model.Workspace{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "GetByID")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceUsecase := workspacemocks.MockWorkspaceUsecase{}
+
+			tt.mockDeps(&workspaceUsecase)
+
+			handler := workspace.New(&workspaceUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/v1/workspaces/"+tt.pathParam, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.GetByID(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerWorkspaceUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		reqBody    string
+		mockDeps   func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			reqBody:   `{"name":"Core"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("Update", mock.Anything, model.Workspace{ID: 1, Name: "Core"}).Return(workspaceModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when role is not owner",
+			pathParam: "1",
+			reqBody:   `{"name":"Core"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("Update", mock.Anything, mock.Anything).Return(model.Workspace{}, errs.NewErrs(http.StatusForbidden, "insufficient workspace role"))
+			},
+			statusCode: http.StatusForbidden,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call update usecase",
+			pathParam: "1",
+			reqBody:   `{"name":"Core"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("Update", mock.Anything, mock.Anything).Return(model.Workspace{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when validate request",
+			pathParam: "1",
+			reqBody:   `{}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "Update")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind request",
+			pathParam: "1",
+			reqBody:   `{"name":1}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "Update")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			reqBody:   `{"name":"Core"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "Update")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceUsecase := workspacemocks.MockWorkspaceUsecase{}
+
+			tt.mockDeps(&workspaceUsecase)
+
+			handler := workspace.New(&workspaceUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPut, "/v1/workspaces/"+tt.pathParam, strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Update(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerWorkspaceDelete(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		mockDeps   func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("Delete", mock.Anything, workspaceModel.ID).Return(nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when workspace is not found",
+			pathParam: "1",
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("Delete", mock.Anything, workspaceModel.ID).Return(errs.NewErrs(http.StatusNotFound, "workspace not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call delete usecase",
+			pathParam: "1",
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("Delete", mock.Anything, workspaceModel.ID).Return(errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "Delete")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceUsecase := workspacemocks.MockWorkspaceUsecase{}
+
+			tt.mockDeps(&workspaceUsecase)
+
+			handler := workspace.New(&workspaceUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/v1/workspaces/"+tt.pathParam, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Delete(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerWorkspaceGetMembers(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		mockDeps   func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("GetMembers", mock.Anything, workspaceModel.ID).Return([]model.WorkspaceMember{memberModel}, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when workspace is not found",
+			pathParam: "1",
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("GetMembers", mock.Anything, workspaceModel.ID).Return([]model.WorkspaceMember{}, errs.NewErrs(http.StatusNotFound, "workspace not found"))
+			},
+			statusCode: http.StatusNotFound,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call get members usecase",
+			pathParam: "1",
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("GetMembers", mock.Anything, workspaceModel.ID).Return([]model.WorkspaceMember{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "GetMembers")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceUsecase := workspacemocks.MockWorkspaceUsecase{}
+
+			tt.mockDeps(&workspaceUsecase)
+
+			handler := workspace.New(&workspaceUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/v1/workspaces/"+tt.pathParam+"/members", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.GetMembers(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerWorkspaceInvite(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		reqBody    string
+		mockDeps   func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			reqBody:   `{"email":"jane@example.com","role":"editor"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("Invite", mock.Anything, workspaceModel.ID, model.WorkspaceInvitation{Email: "jane@example.com", Role: "editor"}).Return(memberModel, nil)
+			},
+			statusCode: http.StatusCreated,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when user is already a member",
+			pathParam: "1",
+			reqBody:   `{"email":"jane@example.com","role":"editor"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("Invite", mock.Anything, workspaceModel.ID, mock.Anything).Return(model.WorkspaceMember{}, errs.NewErrs(http.StatusConflict, "user is already a member"))
+			},
+			statusCode: http.StatusConflict,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call invite usecase",
+			pathParam: "1",
+			reqBody:   `{"email":"jane@example.com","role":"editor"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("Invite", mock.Anything, workspaceModel.ID, mock.Anything).Return(model.WorkspaceMember{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when validate role",
+			pathParam: "1",
+			reqBody:   `{"email":"jane@example.com","role":"owner"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "Invite")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind request",
+			pathParam: "1",
+			reqBody:   `{"email":1}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "Invite")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			reqBody:   `{"email":"jane@example.com","role":"editor"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "Invite")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceUsecase := workspacemocks.MockWorkspaceUsecase{}
+
+			tt.mockDeps(&workspaceUsecase)
+
+			handler := workspace.New(&workspaceUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPost, "/v1/workspaces/"+tt.pathParam+"/invitations", strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id")
+			ctx.SetParamValues(tt.pathParam)
+
+			err := handler.Invite(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerWorkspaceUpdateMember(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		userParam  string
+		reqBody    string
+		mockDeps   func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			userParam: "2",
+			reqBody:   `{"role":"viewer"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("UpdateMember", mock.Anything, workspaceModel.ID, memberModel.UserID, "viewer").Return(memberModel, nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when changing owner role",
+			pathParam: "1",
+			userParam: "1",
+			reqBody:   `{"role":"viewer"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("UpdateMember", mock.Anything, workspaceModel.ID, workspaceModel.OwnerID, "viewer").Return(model.WorkspaceMember{}, errs.NewErrs(http.StatusConflict, "workspace owner role cannot be changed"))
+			},
+			statusCode: http.StatusConflict,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call update member usecase",
+			pathParam: "1",
+			userParam: "2",
+			reqBody:   `{"role":"viewer"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("UpdateMember", mock.Anything, workspaceModel.ID, memberModel.UserID, "viewer").Return(model.WorkspaceMember{}, errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when validate request",
+			pathParam: "1",
+			userParam: "2",
+			reqBody:   `{"role":"admin"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "UpdateMember")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when bind request",
+			pathParam: "1",
+			userParam: "2",
+			reqBody:   `{"role":1}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "UpdateMember")
+			},
+			statusCode: http.StatusUnprocessableEntity,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param user id",
+			pathParam: "1",
+			userParam: "dua",
+			reqBody:   `{"role":"viewer"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "UpdateMember")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			userParam: "2",
+			reqBody:   `{"role":"viewer"}`,
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "UpdateMember")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceUsecase := workspacemocks.MockWorkspaceUsecase{}
+
+			tt.mockDeps(&workspaceUsecase)
+
+			handler := workspace.New(&workspaceUsecase)
+
+			e := echo.New()
+			e.Validator = &rest.CustomValidator{Validator: validator.New()}
+			req := httptest.NewRequest(http.MethodPut, "/v1/workspaces/"+tt.pathParam+"/members/"+tt.userParam, strings.NewReader(tt.reqBody))
+			req.Header.Add(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id", "user_id")
+			ctx.SetParamValues(tt.pathParam, tt.userParam)
+
+			err := handler.UpdateMember(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestHandlerWorkspaceRemoveMember(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathParam  string
+		userParam  string
+		mockDeps   func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase)
+		statusCode int
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			pathParam: "1",
+			userParam: "2",
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("RemoveMember", mock.Anything, workspaceModel.ID, memberModel.UserID).Return(nil)
+			},
+			statusCode: http.StatusOK,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when removing owner",
+			pathParam: "1",
+			userParam: "1",
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("RemoveMember", mock.Anything, workspaceModel.ID, workspaceModel.OwnerID).Return(errs.NewErrs(http.StatusConflict, "workspace owner cannot be removed"))
+			},
+			statusCode: http.StatusConflict,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when call remove member usecase",
+			pathParam: "1",
+			userParam: "2",
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.On("RemoveMember", mock.Anything, workspaceModel.ID, memberModel.UserID).Return(errors.New("some error"))
+			},
+			statusCode: http.StatusInternalServerError,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param user id",
+			pathParam: "1",
+			userParam: "dua",
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "RemoveMember")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when parse request path param",
+			pathParam: "satu",
+			userParam: "2",
+			mockDeps: func(workspaceUsecase *workspacemocks.MockWorkspaceUsecase) {
+				workspaceUsecase.AssertNotCalled(t, "RemoveMember")
+			},
+			statusCode: http.StatusBadRequest,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceUsecase := workspacemocks.MockWorkspaceUsecase{}
+
+			tt.mockDeps(&workspaceUsecase)
+
+			handler := workspace.New(&workspaceUsecase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/v1/workspaces/"+tt.pathParam+"/members/"+tt.userParam, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+			ctx.SetParamNames("id", "user_id")
+			ctx.SetParamValues(tt.pathParam, tt.userParam)
+
+			err := handler.RemoveMember(ctx)
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
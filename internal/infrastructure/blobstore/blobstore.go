@@ -0,0 +1,37 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/rzfhlv/go-task/config"
+)
+
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+var (
+	ErrNotFound   = errors.New("blob not found")
+	ErrInvalidKey = errors.New("invalid blob key")
+)
+
+type BlobStore interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+func New(ctx context.Context, blobConfig config.BlobStoreConfiguration) (BlobStore, error) {
+	switch blobConfig.Driver {
+	case "", DriverLocal:
+		return NewLocal(blobConfig.Path)
+	case DriverS3:
+		return NewS3(blobConfig)
+	default:
+		return nil, fmt.Errorf("unsupported blobstore driver %q", blobConfig.Driver)
+	}
+}
@@ -0,0 +1,85 @@
+package blobstore_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/rzfhlv/go-task/internal/infrastructure/blobstore"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalPutAndGet(t *testing.T) {
+	store, err := blobstore.NewLocal(t.TempDir())
+	assert.NoError(t, err)
+
+	err = store.Put(context.Background(), "tasks/1/spec.txt", strings.NewReader("hello world"), 11, "text/plain")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		offset     int64
+		length     int64
+		wantResult string
+	}{
+		{
+			name:       "success with full content",
+			offset:     0,
+			length:     -1,
+			wantResult: "hello world",
+		},
+		{
+			name:       "success with range",
+			offset:     6,
+			length:     5,
+			wantResult: "world",
+		},
+		{
+			name:       "success with open ended range",
+			offset:     6,
+			length:     -1,
+			wantResult: "world",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader, err := store.Get(context.Background(), "tasks/1/spec.txt", tt.offset, tt.length)
+			assert.NoError(t, err)
+			defer reader.Close()
+
+			result, err := io.ReadAll(reader)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantResult, string(result))
+		})
+	}
+}
+
+func TestLocalDelete(t *testing.T) {
+	store, err := blobstore.NewLocal(t.TempDir())
+	assert.NoError(t, err)
+
+	err = store.Put(context.Background(), "tasks/1/spec.txt", strings.NewReader("hello"), 5, "text/plain")
+	assert.NoError(t, err)
+
+	err = store.Delete(context.Background(), "tasks/1/spec.txt")
+	assert.NoError(t, err)
+
+	_, err = store.Get(context.Background(), "tasks/1/spec.txt", 0, -1)
+	assert.Equal(t, blobstore.ErrNotFound, err)
+
+	err = store.Delete(context.Background(), "tasks/1/spec.txt")
+	assert.NoError(t, err)
+}
+
+func TestLocalInvalidKey(t *testing.T) {
+	store, err := blobstore.NewLocal(t.TempDir())
+	assert.NoError(t, err)
+
+	err = store.Put(context.Background(), "../escape.txt", strings.NewReader("hello"), 5, "text/plain")
+	assert.Equal(t, blobstore.ErrInvalidKey, err)
+
+	_, err = store.Get(context.Background(), "/etc/passwd", 0, -1)
+	assert.Equal(t, blobstore.ErrInvalidKey, err)
+}
@@ -0,0 +1,228 @@
+package blobstore_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rzfhlv/go-task/config"
+	"github.com/rzfhlv/go-task/internal/infrastructure/blobstore"
+	"github.com/stretchr/testify/assert"
+)
+
+var s3Config = config.BlobStoreConfiguration{
+	Driver:    blobstore.DriverS3,
+	Bucket:    "gotask",
+	Region:    "us-east-1",
+	AccessKey: "access",
+	SecretKey: "secret",
+}
+
+type s3StandIn struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (s *s3StandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.verify(r) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	key, ok := strings.CutPrefix(r.URL.Path, "/"+s3Config.Bucket+"/")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		s.objects[key] = body
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet:
+		body, ok := s.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		spec, ok := strings.CutPrefix(r.Header.Get("Range"), "bytes=")
+		if !ok {
+			w.Write(body)
+			return
+		}
+
+		first, last, _ := strings.Cut(spec, "-")
+		start, _ := strconv.Atoi(first)
+		end := len(body) - 1
+		if last != "" {
+			end, _ = strconv.Atoi(last)
+		}
+
+		w.WriteHeader(http.StatusPartialContent)
+		w.Write(body[start : end+1])
+	case http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *s3StandIn) verify(r *http.Request) bool {
+	timestamp := r.Header.Get("X-Amz-Date")
+	if len(timestamp) != 16 {
+		return false
+	}
+
+	canonical := fmt.Sprintf("%s\n%s\n%s\nhost:%s\nx-amz-content-sha256:%s\nx-amz-date:%s\n\nhost;x-amz-content-sha256;x-amz-date\n%s",
+		r.Method, r.URL.EscapedPath(), r.URL.RawQuery, r.Host, r.Header.Get("X-Amz-Content-Sha256"), timestamp, r.Header.Get("X-Amz-Content-Sha256"))
+	scope := timestamp[:8] + "/" + s3Config.Region + "/s3/aws4_request"
+	digest := sha256.Sum256([]byte(canonical))
+
+	key := []byte("AWS4" + s3Config.SecretKey)
+	for _, part := range []string{timestamp[:8], s3Config.Region, "s3", "aws4_request"} {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(part))
+		key = mac.Sum(nil)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("AWS4-HMAC-SHA256\n" + timestamp + "\n" + scope + "\n" + hex.EncodeToString(digest[:])))
+
+	want := fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=%s",
+		s3Config.AccessKey, scope, hex.EncodeToString(mac.Sum(nil)))
+
+	return r.Header.Get("Authorization") == want
+}
+
+func newS3(t *testing.T) *blobstore.S3 {
+	server := httptest.NewServer(&s3StandIn{objects: map[string][]byte{}})
+	t.Cleanup(server.Close)
+
+	cfg := s3Config
+	cfg.Endpoint = server.URL
+
+	store, err := blobstore.NewS3(cfg)
+	assert.NoError(t, err)
+
+	return store
+}
+
+func TestS3PutAndGet(t *testing.T) {
+	store := newS3(t)
+
+	err := store.Put(context.Background(), "tasks/1/spec sheet.txt", strings.NewReader("hello world"), 11, "text/plain")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		offset     int64
+		length     int64
+		wantResult string
+	}{
+		{
+			name:       "success with full content",
+			offset:     0,
+			length:     -1,
+			wantResult: "hello world",
+		},
+		{
+			name:       "success with range",
+			offset:     0,
+			length:     5,
+			wantResult: "hello",
+		},
+		{
+			name:       "success with open ended range",
+			offset:     6,
+			length:     -1,
+			wantResult: "world",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader, err := store.Get(context.Background(), "tasks/1/spec sheet.txt", tt.offset, tt.length)
+			assert.NoError(t, err)
+			defer reader.Close()
+
+			result, err := io.ReadAll(reader)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantResult, string(result))
+		})
+	}
+}
+
+func TestS3Delete(t *testing.T) {
+	store := newS3(t)
+
+	err := store.Put(context.Background(), "tasks/1/spec.txt", strings.NewReader("hello"), 5, "text/plain")
+	assert.NoError(t, err)
+
+	err = store.Delete(context.Background(), "tasks/1/spec.txt")
+	assert.NoError(t, err)
+
+	_, err = store.Get(context.Background(), "tasks/1/spec.txt", 0, -1)
+	assert.Equal(t, blobstore.ErrNotFound, err)
+}
+
+func TestS3Unauthorized(t *testing.T) {
+	server := httptest.NewServer(&s3StandIn{objects: map[string][]byte{}})
+	defer server.Close()
+
+	cfg := s3Config
+	cfg.Endpoint = server.URL
+	cfg.SecretKey = "wrong"
+
+	store, err := blobstore.NewS3(cfg)
+	assert.NoError(t, err)
+
+	err = store.Put(context.Background(), "tasks/1/spec.txt", strings.NewReader("hello"), 5, "text/plain")
+	assert.Error(t, err)
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.BlobStoreConfiguration
+		wantErr bool
+	}{
+		{
+			name: "success with local driver",
+			cfg:  config.BlobStoreConfiguration{Driver: blobstore.DriverLocal, Path: t.TempDir()},
+		},
+		{
+			name: "success with s3 driver",
+			cfg:  config.BlobStoreConfiguration{Driver: blobstore.DriverS3, Endpoint: "http://localhost:9000", Bucket: "gotask"},
+		},
+		{
+			name:    "error when s3 bucket is missing",
+			cfg:     config.BlobStoreConfiguration{Driver: blobstore.DriverS3, Endpoint: "http://localhost:9000"},
+			wantErr: true,
+		},
+		{
+			name:    "error when driver is unsupported",
+			cfg:     config.BlobStoreConfiguration{Driver: "ftp"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := blobstore.New(context.Background(), tt.cfg)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -4,18 +4,24 @@ import (
 	"context"
 
 	"github.com/rzfhlv/go-task/config"
+	"github.com/rzfhlv/go-task/internal/infrastructure/blobstore"
 	"github.com/rzfhlv/go-task/internal/infrastructure/memstore"
+	"github.com/rzfhlv/go-task/internal/infrastructure/notifier"
 	"github.com/rzfhlv/go-task/internal/infrastructure/sqlstore"
 )
 
 type Infrastructure interface {
 	SQLStore() *sqlstore.SQLStore
 	MemStore() *memstore.Memstore
+	BlobStore() blobstore.BlobStore
+	Notifier() notifier.Notifier
 }
 
 type Infra struct {
-	sqlStore *sqlstore.SQLStore
-	memStore *memstore.Memstore
+	sqlStore  *sqlstore.SQLStore
+	memStore  *memstore.Memstore
+	blobStore blobstore.BlobStore
+	notifier  notifier.Notifier
 }
 
 func New(ctx context.Context, cfg *config.Configuration) (Infrastructure, error) {
@@ -29,9 +35,21 @@ func New(ctx context.Context, cfg *config.Configuration) (Infrastructure, error)
 		return nil, err
 	}
 
+	blobStore, err := blobstore.New(ctx, cfg.BlobStore)
+	if err != nil {
+		return nil, err
+	}
+
+	notifierClient, err := notifier.New(ctx, cfg.Notifier)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Infra{
-		sqlStore: sqlStore,
-		memStore: memStore,
+		sqlStore:  sqlStore,
+		memStore:  memStore,
+		blobStore: blobStore,
+		notifier:  notifierClient,
 	}, nil
 }
 
@@ -42,3 +60,11 @@ func (i *Infra) SQLStore() *sqlstore.SQLStore {
 func (i *Infra) MemStore() *memstore.Memstore {
 	return i.memStore
 }
+
+func (i *Infra) BlobStore() blobstore.BlobStore {
+	return i.blobStore
+}
+
+func (i *Infra) Notifier() notifier.Notifier {
+	return i.notifier
+}
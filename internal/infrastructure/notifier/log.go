@@ -0,0 +1,25 @@
+package notifier
+
+import (
+	"context"
+	"log/slog"
+)
+
+type Log struct{}
+
+func NewLog() *Log {
+	return &Log{}
+}
+
+func (l *Log) Notify(ctx context.Context, message Message) error {
+	slog.InfoContext(ctx, "[Notifier.Log] "+message.Event,
+		slog.Int64("id", message.ID),
+		slog.String("idempotency_key", message.IdempotencyKey),
+		slog.Int64("user_id", message.UserID),
+		slog.Int64("task_id", message.TaskID),
+		slog.String("title", message.Title),
+		slog.Time("fire_at", message.FireAt),
+	)
+
+	return nil
+}
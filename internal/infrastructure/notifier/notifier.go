@@ -0,0 +1,42 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rzfhlv/go-task/config"
+)
+
+const (
+	DriverLog     = "log"
+	DriverWebhook = "webhook"
+)
+
+const EventTaskReminder = "task.reminder"
+
+type Message struct {
+	Event          string     `json:"event"`
+	ID             int64      `json:"id"`
+	IdempotencyKey string     `json:"idempotency_key"`
+	UserID         int64      `json:"user_id"`
+	TaskID         int64      `json:"task_id"`
+	Title          string     `json:"title"`
+	DueAt          *time.Time `json:"due_at"`
+	FireAt         time.Time  `json:"fire_at"`
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, message Message) error
+}
+
+func New(ctx context.Context, notifierConfig config.NotifierConfiguration) (Notifier, error) {
+	switch notifierConfig.Driver {
+	case "", DriverLog:
+		return NewLog(), nil
+	case DriverWebhook:
+		return NewWebhook(notifierConfig)
+	default:
+		return nil, fmt.Errorf("unsupported notifier driver %q", notifierConfig.Driver)
+	}
+}
@@ -0,0 +1,87 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/rzfhlv/go-task/config"
+)
+
+const (
+	webhookSignatureHeader = "X-Signature"
+	webhookEventHeader     = "X-Event"
+	webhookIdempotencyKey  = "Idempotency-Key"
+	webhookDefaultTimeout  = 5 * time.Second
+)
+
+type Webhook struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+func NewWebhook(notifierConfig config.NotifierConfiguration) (*Webhook, error) {
+	endpoint, err := url.Parse(notifierConfig.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid notifier url %q", notifierConfig.URL)
+	}
+
+	timeout := notifierConfig.Timeout
+	if timeout <= 0 {
+		timeout = webhookDefaultTimeout
+	}
+
+	return &Webhook{
+		url:    endpoint.String(),
+		secret: notifierConfig.Secret,
+		client: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (w *Webhook) Notify(ctx context.Context, message Message) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookEventHeader, message.Event)
+	if message.IdempotencyKey != "" {
+		req.Header.Set(webhookIdempotencyKey, message.IdempotencyKey)
+	}
+
+	if w.secret != "" {
+		mac := hmac.New(sha256.New, []byte(w.secret))
+		mac.Write(body)
+		req.Header.Set(webhookSignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package notifier_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rzfhlv/go-task/config"
+	"github.com/rzfhlv/go-task/internal/infrastructure/notifier"
+	"github.com/stretchr/testify/assert"
+)
+
+var message = notifier.Message{
+	Event:          notifier.EventTaskReminder,
+	ID:             1,
+	IdempotencyKey: "task.reminder:1",
+	UserID:         1,
+	TaskID:         2,
+	Title:          "write report",
+	FireAt:         time.Date(2026, time.January, 5, 9, 0, 0, 0, time.UTC),
+}
+
+func TestWebhookNotify(t *testing.T) {
+	tests := []struct {
+		name       string
+		secret     string
+		statusCode int
+		wantErr    bool
+	}{
+		{
+			name:       "success with signature",
+			secret:     "secret",
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name:       "success without signature",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "error when webhook responds with failure",
+			secret:     "secret",
+			statusCode: http.StatusBadGateway,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+
+				received := notifier.Message{}
+				assert.NoError(t, json.Unmarshal(body, &received))
+				assert.Equal(t, message, received)
+				assert.Equal(t, notifier.EventTaskReminder, r.Header.Get("X-Event"))
+				assert.Equal(t, message.IdempotencyKey, r.Header.Get("Idempotency-Key"))
+
+				if tt.secret == "" {
+					assert.Empty(t, r.Header.Get("X-Signature"))
+				} else {
+					mac := hmac.New(sha256.New, []byte(tt.secret))
+					mac.Write(body)
+					assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), r.Header.Get("X-Signature"))
+				}
+
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			webhook, err := notifier.NewWebhook(config.NotifierConfiguration{URL: server.URL, Secret: tt.secret})
+			assert.NoError(t, err)
+
+			err = webhook.Notify(context.Background(), message)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  config.NotifierConfiguration
+		wantErr bool
+	}{
+		{
+			name:   "success with default driver",
+			config: config.NotifierConfiguration{},
+		},
+		{
+			name:   "success with webhook driver",
+			config: config.NotifierConfiguration{Driver: notifier.DriverWebhook, URL: "http://localhost:9090/hooks"},
+		},
+		{
+			name:    "error when webhook url is invalid",
+			config:  config.NotifierConfiguration{Driver: notifier.DriverWebhook, URL: "localhost"},
+			wantErr: true,
+		},
+		{
+			name:    "error when driver is unsupported",
+			config:  config.NotifierConfiguration{Driver: "smtp"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := notifier.New(context.Background(), tt.config)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,2 @@
+ALTER TABLE tasks DROP CONSTRAINT IF EXISTS chk_tasks_status;
+ALTER TABLE tasks ALTER COLUMN status DROP DEFAULT;
\ No newline at end of file
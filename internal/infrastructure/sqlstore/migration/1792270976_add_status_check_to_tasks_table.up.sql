@@ -0,0 +1,7 @@
+UPDATE tasks SET status = lower(trim(status));
+UPDATE tasks SET status = 'done' WHERE status IN ('completed', 'complete', 'finished');
+UPDATE tasks SET status = 'todo' WHERE status NOT IN ('todo', 'in_progress', 'blocked', 'done', 'cancelled');
+
+ALTER TABLE tasks ALTER COLUMN status SET DEFAULT 'todo';
+ALTER TABLE tasks ADD CONSTRAINT chk_tasks_status
+    CHECK (status IN ('todo', 'in_progress', 'blocked', 'done', 'cancelled'));
\ No newline at end of file
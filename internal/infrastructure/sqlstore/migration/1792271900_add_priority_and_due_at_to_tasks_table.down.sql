@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_tasks_user_id_priority;
+DROP INDEX IF EXISTS idx_tasks_user_id_due_at;
+
+ALTER TABLE tasks DROP CONSTRAINT IF EXISTS chk_tasks_priority;
+ALTER TABLE tasks DROP COLUMN IF EXISTS due_at;
+ALTER TABLE tasks DROP COLUMN IF EXISTS priority;
\ No newline at end of file
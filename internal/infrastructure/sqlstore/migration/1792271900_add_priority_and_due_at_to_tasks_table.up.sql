@@ -0,0 +1,7 @@
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS priority VARCHAR(255) NOT NULL DEFAULT 'medium';
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS due_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE tasks ADD CONSTRAINT chk_tasks_priority
+    CHECK (priority IN ('low', 'medium', 'high', 'urgent'));
+
+CREATE INDEX IF NOT EXISTS idx_tasks_user_id_due_at ON tasks (user_id, due_at) WHERE due_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_tasks_user_id_priority ON tasks (user_id, priority);
\ No newline at end of file
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_tasks_user_id_updated_at;
+DROP INDEX IF EXISTS idx_tasks_user_id_status;
+DROP INDEX IF EXISTS idx_tasks_search_vector;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS search_vector;
\ No newline at end of file
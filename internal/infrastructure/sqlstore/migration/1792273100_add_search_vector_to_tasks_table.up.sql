@@ -0,0 +1,9 @@
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_search_vector ON tasks USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_tasks_user_id_status ON tasks (user_id, status);
+CREATE INDEX IF NOT EXISTS idx_tasks_user_id_updated_at ON tasks (user_id, updated_at);
\ No newline at end of file
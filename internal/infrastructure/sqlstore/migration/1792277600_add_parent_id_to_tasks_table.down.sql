@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_tasks_parent_id;
+
+ALTER TABLE tasks DROP CONSTRAINT IF EXISTS chk_tasks_parent_id;
+ALTER TABLE tasks DROP COLUMN IF EXISTS parent_id;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS parent_id BIGINT REFERENCES tasks (id) ON DELETE SET NULL;
+ALTER TABLE tasks ADD CONSTRAINT chk_tasks_parent_id CHECK (parent_id <> id);
+
+CREATE INDEX IF NOT EXISTS idx_tasks_parent_id ON tasks (parent_id) WHERE parent_id IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS labels (
+    id BIGSERIAL,
+    name VARCHAR(50) NOT NULL,
+    colour VARCHAR(7) NOT NULL,
+    user_id BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY(id),
+
+    CONSTRAINT fk_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT uq_labels_user_id_name UNIQUE (user_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS task_labels (
+    task_id BIGINT NOT NULL,
+    label_id BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY(task_id, label_id),
+
+    CONSTRAINT fk_task
+        FOREIGN KEY (task_id)
+        REFERENCES tasks(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_label
+        FOREIGN KEY (label_id)
+        REFERENCES labels(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_labels_label_id ON task_labels (label_id);
\ No newline at end of file
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_tasks_workspace_id;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS workspace_id;
+
+DROP TABLE IF EXISTS workspace_members;
+
+DROP TABLE IF EXISTS workspaces;
\ No newline at end of file
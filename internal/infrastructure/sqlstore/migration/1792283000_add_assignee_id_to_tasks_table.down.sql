@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_tasks_assignee_id_assigned_at;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS assigned_at;
+ALTER TABLE tasks DROP COLUMN IF EXISTS assignee_id;
\ No newline at end of file
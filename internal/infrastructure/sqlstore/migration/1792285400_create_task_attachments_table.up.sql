@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS task_attachments (
+    id BIGSERIAL,
+    task_id BIGINT,
+    user_id BIGINT NOT NULL,
+    filename VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    checksum CHAR(64) NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY(id),
+
+    CONSTRAINT fk_task
+        FOREIGN KEY (task_id)
+        REFERENCES tasks(id)
+        ON DELETE SET NULL,
+
+    CONSTRAINT fk_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_attachments_task_id ON task_attachments (task_id, id);
+CREATE INDEX IF NOT EXISTS idx_task_attachments_orphaned ON task_attachments (id) WHERE task_id IS NULL;
\ No newline at end of file
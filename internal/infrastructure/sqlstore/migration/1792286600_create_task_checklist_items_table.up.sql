@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS task_checklist_items (
+    id BIGSERIAL,
+    task_id BIGINT NOT NULL,
+    text VARCHAR(500) NOT NULL,
+    checked BOOLEAN NOT NULL DEFAULT FALSE,
+    position INT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY(id),
+
+    CONSTRAINT fk_task
+        FOREIGN KEY (task_id)
+        REFERENCES tasks(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_checklist_items_task_id_position ON task_checklist_items (task_id, position);
\ No newline at end of file
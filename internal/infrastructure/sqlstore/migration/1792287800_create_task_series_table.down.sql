@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS task_occurrences;
+DROP TABLE IF EXISTS task_series;
\ No newline at end of file
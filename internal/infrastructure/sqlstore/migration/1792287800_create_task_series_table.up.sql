@@ -0,0 +1,39 @@
+CREATE TABLE IF NOT EXISTS task_series (
+    id BIGSERIAL,
+    rule VARCHAR(255) NOT NULL,
+    dtstart TIMESTAMP WITH TIME ZONE NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description VARCHAR(255) NOT NULL,
+    priority VARCHAR(255) NOT NULL,
+    project_id BIGINT REFERENCES projects (id) ON DELETE SET NULL,
+    workspace_id BIGINT REFERENCES workspaces (id) ON DELETE CASCADE,
+    assignee_id BIGINT REFERENCES users (id) ON DELETE SET NULL,
+    user_id BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY(id),
+
+    CONSTRAINT fk_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS task_occurrences (
+    task_id BIGINT NOT NULL,
+    series_id BIGINT NOT NULL,
+    occurrence INT NOT NULL,
+    scheduled_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY(task_id),
+    UNIQUE (series_id, occurrence),
+
+    CONSTRAINT fk_task
+        FOREIGN KEY (task_id)
+        REFERENCES tasks(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_series
+        FOREIGN KEY (series_id)
+        REFERENCES task_series(id)
+        ON DELETE CASCADE
+);
\ No newline at end of file
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS task_reminders (
+    id BIGSERIAL,
+    task_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    remind_at TIMESTAMP WITH TIME ZONE,
+    offset_minutes INT,
+    attempts INT NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP WITH TIME ZONE,
+    sent_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY(id),
+    CHECK ((remind_at IS NULL) <> (offset_minutes IS NULL)),
+
+    CONSTRAINT fk_task
+        FOREIGN KEY (task_id)
+        REFERENCES tasks(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_reminders_pending ON task_reminders (task_id) WHERE sent_at IS NULL;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS task_history;
\ No newline at end of file
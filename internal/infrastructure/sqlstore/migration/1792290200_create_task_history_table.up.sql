@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS task_history (
+    id BIGSERIAL,
+    task_id BIGINT NOT NULL,
+    revision BIGINT NOT NULL,
+    action VARCHAR(32) NOT NULL,
+    actor_id BIGINT NOT NULL,
+    changes JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY(id),
+    UNIQUE (task_id, revision),
+
+    CONSTRAINT fk_task
+        FOREIGN KEY (task_id)
+        REFERENCES tasks(id)
+        ON DELETE CASCADE
+);
\ No newline at end of file
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS time_entries (
+    id BIGSERIAL,
+    task_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ended_at TIMESTAMP WITH TIME ZONE,
+    note TEXT NOT NULL DEFAULT '',
+    source VARCHAR(16) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY(id),
+    CHECK (ended_at IS NULL OR ended_at >= started_at),
+
+    CONSTRAINT fk_task
+        FOREIGN KEY (task_id)
+        REFERENCES tasks(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_time_entries_running ON time_entries (user_id) WHERE ended_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_time_entries_task_id ON time_entries (task_id, started_at);
+CREATE INDEX IF NOT EXISTS idx_time_entries_user_id ON time_entries (user_id, started_at);
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE tasks DROP COLUMN IF EXISTS story_points;
+ALTER TABLE tasks DROP COLUMN IF EXISTS estimate_minutes;
\ No newline at end of file
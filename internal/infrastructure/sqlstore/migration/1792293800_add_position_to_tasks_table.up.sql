@@ -0,0 +1,5 @@
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS position TEXT COLLATE "C" NOT NULL DEFAULT '';
+
+UPDATE tasks SET position = 'U' || lpad(id::TEXT, 12, '0') || 'U' WHERE position = '';
+
+CREATE INDEX IF NOT EXISTS idx_tasks_status_position ON tasks (status, position, id);
\ No newline at end of file
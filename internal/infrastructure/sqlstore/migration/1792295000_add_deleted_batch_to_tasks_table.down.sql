@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_tasks_deleted_batch;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS deleted_batch;
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS deleted_batch BIGINT;
+
+UPDATE tasks SET deleted_batch = id WHERE deleted_at IS NOT NULL AND deleted_batch IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_deleted_batch ON tasks (deleted_batch) WHERE deleted_batch IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_tasks_detached_from;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS detached_from;
\ No newline at end of file
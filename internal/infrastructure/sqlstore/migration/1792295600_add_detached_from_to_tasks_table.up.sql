@@ -0,0 +1,3 @@
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS detached_from BIGINT;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_detached_from ON tasks (detached_from) WHERE detached_from IS NOT NULL;
\ No newline at end of file
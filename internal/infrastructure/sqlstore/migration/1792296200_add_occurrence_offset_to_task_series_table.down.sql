@@ -0,0 +1 @@
+ALTER TABLE task_series DROP COLUMN IF EXISTS occurrence_offset;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE task_series ADD COLUMN IF NOT EXISTS occurrence_offset INT NOT NULL DEFAULT 0;
\ No newline at end of file
@@ -0,0 +1,3 @@
+DELETE FROM task_history WHERE NOT EXISTS (SELECT 1 FROM tasks WHERE tasks.id = task_history.task_id);
+
+ALTER TABLE task_history ADD CONSTRAINT fk_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE task_history DROP CONSTRAINT IF EXISTS fk_task;
\ No newline at end of file
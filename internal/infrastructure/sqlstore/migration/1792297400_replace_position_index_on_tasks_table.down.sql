@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_tasks_user_status_position;
+
+DROP INDEX IF EXISTS idx_tasks_workspace_status_position;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_status_position ON tasks (status, position, id);
\ No newline at end of file
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_tasks_status_position;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_workspace_status_position ON tasks (workspace_id, status, position, id) WHERE workspace_id IS NOT NULL AND deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_user_status_position ON tasks (user_id, status, position, id) WHERE workspace_id IS NULL AND deleted_at IS NULL;
\ No newline at end of file
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"io"
+	"time"
+)
+
+const AttachmentMaxSize = 10 << 20
+
+var AttachmentAllowedTypes = []string{
+	"image/png",
+	"image/jpeg",
+	"image/gif",
+	"image/webp",
+	"application/pdf",
+	"text/plain",
+}
+
+type Attachment struct {
+	ID          int64     `json:"id" db:"id"`
+	TaskID      int64     `json:"task_id" db:"task_id"`
+	UserID      int64     `json:"user_id" db:"user_id"`
+	Filename    string    `json:"filename" db:"filename"`
+	ContentType string    `json:"content_type" db:"content_type"`
+	Size        int64     `json:"size" db:"size"`
+	Checksum    string    `json:"checksum" db:"checksum"`
+	StorageKey  string    `json:"-" db:"storage_key"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+}
+
+type AttachmentUpload struct {
+	Filename string
+	Size     int64
+	Body     io.Reader
+}
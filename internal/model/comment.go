@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+type Comment struct {
+	ID        int64     `json:"id,omitempty" db:"id"`
+	TaskID    int64     `json:"task_id" db:"task_id"`
+	UserID    int64     `json:"user_id" db:"user_id"`
+	Body      string    `json:"body" db:"body" validate:"required,max=5000"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
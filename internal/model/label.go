@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+const LabelDefaultColour = "#808080"
+
+const (
+	LabelModeAny = "any"
+	LabelModeAll = "all"
+)
+
+type Label struct {
+	ID        int64     `json:"id,omitempty" db:"id"`
+	Name      string    `json:"name" db:"name" validate:"required,max=50"`
+	Colour    string    `json:"colour" db:"colour" validate:"omitempty,len=7,hexcolor"`
+	UserID    int64     `json:"-" db:"user_id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
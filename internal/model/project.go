@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+type Project struct {
+	ID          int64            `json:"id,omitempty" db:"id"`
+	Name        string           `json:"name" db:"name" validate:"required,max=100"`
+	Description string           `json:"description" db:"description"`
+	UserID      int64            `json:"-" db:"user_id"`
+	ArchivedAt  *time.Time       `json:"archived_at" db:"archived_at"`
+	CreatedAt   time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at" db:"updated_at"`
+	TaskCounts  map[string]int64 `json:"task_counts" db:"-"`
+}
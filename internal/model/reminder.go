@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+const (
+	ReminderBatchSize   = 100
+	ReminderLease       = time.Minute
+	ReminderMaxAttempts = 5
+)
+
+type Reminder struct {
+	ID            int64      `json:"id,omitempty" db:"id"`
+	TaskID        int64      `json:"task_id" db:"task_id"`
+	UserID        int64      `json:"user_id" db:"user_id"`
+	RemindAt      *time.Time `json:"remind_at,omitempty" db:"remind_at" validate:"required_without=OffsetMinutes,excluded_with=OffsetMinutes"`
+	OffsetMinutes *int       `json:"offset_minutes,omitempty" db:"offset_minutes" validate:"omitnil,min=0,max=525600"`
+	FireAt        *time.Time `json:"fire_at" db:"fire_at"`
+	SentAt        *time.Time `json:"sent_at" db:"sent_at"`
+	CreatedAt     time.Time  `json:"created_at" db:"created_at"`
+}
+
+type DueReminder struct {
+	ID          int64      `db:"id"`
+	TaskID      int64      `db:"task_id"`
+	UserID      int64      `db:"user_id"`
+	Title       string     `db:"title"`
+	DueAt       *time.Time `db:"due_at"`
+	FireAt      time.Time  `db:"fire_at"`
+	Attempts    int        `db:"attempts"`
+	LockedUntil time.Time  `db:"locked_until"`
+}
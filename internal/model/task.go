@@ -1,13 +1,373 @@
 package model
 
-import "time"
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/rzfhlv/go-task/pkg/etag"
+)
+
+const TaskTrashRetention = 30 * 24 * time.Hour
+
+const (
+	TaskStatusTodo       = "todo"
+	TaskStatusInProgress = "in_progress"
+	TaskStatusBlocked    = "blocked"
+	TaskStatusDone       = "done"
+	TaskStatusCancelled  = "cancelled"
+)
+
+const (
+	TaskPriorityLow    = "low"
+	TaskPriorityMedium = "medium"
+	TaskPriorityHigh   = "high"
+	TaskPriorityUrgent = "urgent"
+)
+
+const (
+	TaskAssigneeMe   = "me"
+	TaskAssigneeNone = "none"
+)
+
+const (
+	TaskParentDeleteCascade  = "cascade"
+	TaskParentDeleteReparent = "reparent"
+)
+
+const (
+	TaskScopeThis   = "this"
+	TaskScopeFuture = "future"
+)
+
+const (
+	TaskActionCreate     = "create"
+	TaskActionUpdate     = "update"
+	TaskActionTransition = "transition"
+	TaskActionDelete     = "delete"
+	TaskActionDestroy    = "destroy"
+	TaskActionPurge      = "purge"
+	TaskActionRestore    = "restore"
+	TaskActionRevert     = "revert"
+)
+
+const (
+	TaskBurndownMetricPoints  = "points"
+	TaskBurndownMetricMinutes = "minutes"
+
+	TaskBurndownMaxDays = 366
+)
+
+const TaskBoardDefaultLimit = 50
+
+const (
+	TaskBulkCreate     = "create"
+	TaskBulkUpdate     = "update"
+	TaskBulkDelete     = "delete"
+	TaskBulkTransition = "transition"
+)
+
+const (
+	TaskBulkStatusSuccess    = "success"
+	TaskBulkStatusFailed     = "failed"
+	TaskBulkStatusRolledBack = "rolled_back"
+	TaskBulkStatusSkipped    = "skipped"
+)
+
+var TaskBoardStatuses = []string{TaskStatusTodo, TaskStatusInProgress, TaskStatusBlocked, TaskStatusDone, TaskStatusCancelled}
+var TaskPatchNullable = map[string]bool{
+	"title":            false,
+	"description":      false,
+	"status":           false,
+	"priority":         false,
+	"due_at":           true,
+	"parent_id":        true,
+	"project_id":       true,
+	"assignee_id":      true,
+	"estimate_minutes": true,
+	"story_points":     true,
+}
+
+var TaskHistoryFields = []string{"title", "description", "status", "priority", "due_at", "parent_id", "project_id", "assignee_id", "estimate_minutes", "story_points"}
+
+var TaskPriorityRank = map[string]int{
+	TaskPriorityLow:    1,
+	TaskPriorityMedium: 2,
+	TaskPriorityHigh:   3,
+	TaskPriorityUrgent: 4,
+}
+
+var TaskTransitions = map[string][]string{
+	TaskStatusTodo:       {TaskStatusInProgress, TaskStatusBlocked, TaskStatusCancelled},
+	TaskStatusInProgress: {TaskStatusTodo, TaskStatusBlocked, TaskStatusDone, TaskStatusCancelled},
+	TaskStatusBlocked:    {TaskStatusTodo, TaskStatusInProgress, TaskStatusCancelled},
+	TaskStatusDone:       {TaskStatusInProgress},
+	TaskStatusCancelled:  {TaskStatusTodo},
+}
 
 type Task struct {
-	ID          int64     `json:"id,omitempty" db:"id"`
-	Title       string    `json:"title" db:"title" validate:"required"`
-	Description string    `json:"description" db:"description" validate:"required"`
-	Status      string    `json:"status" db:"status"`
+	ID              int64               `json:"id,omitempty" db:"id"`
+	Title           string              `json:"title" db:"title" validate:"required"`
+	Description     string              `json:"description" db:"description" validate:"required"`
+	Status          string              `json:"status" db:"status" validate:"omitempty,oneof=todo in_progress blocked done cancelled"`
+	Priority        string              `json:"priority" db:"priority" validate:"omitempty,oneof=low medium high urgent"`
+	DueAt           *time.Time          `json:"due_at" db:"due_at"`
+	ParentID        *int64              `json:"parent_id" db:"parent_id"`
+	ProjectID       *int64              `json:"project_id" db:"project_id"`
+	WorkspaceID     *int64              `json:"workspace_id" db:"workspace_id"`
+	AssigneeID      *int64              `json:"assignee_id" db:"assignee_id"`
+	AssignedAt      *time.Time          `json:"assigned_at" db:"assigned_at"`
+	EstimateMinutes *int                `json:"estimate_minutes" db:"estimate_minutes" validate:"omitnil,min=0"`
+	StoryPoints     *int                `json:"story_points" db:"story_points" validate:"omitnil,min=0,max=1000"`
+	Position        string              `json:"position" db:"position"`
+	UserID          int64               `json:"-" db:"user_id"`
+	CreatedAt       time.Time           `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time           `json:"updated_at" db:"updated_at"`
+	Version         int64               `json:"version" db:"version"`
+	IfMatch         etag.Match          `json:"-" db:"-"`
+	DeletedAt       *time.Time          `json:"deleted_at,omitempty" db:"deleted_at"`
+	Progress        *TaskProgress       `json:"progress,omitempty" db:"-"`
+	Children        []Task              `json:"children,omitempty" db:"-"`
+	Labels          []Label             `json:"labels,omitempty" db:"-"`
+	CommentCount    int64               `json:"comment_count" db:"-"`
+	Checklist       []TaskChecklistItem `json:"checklist,omitempty" db:"-"`
+	ChecklistDone   int64               `json:"checklist_done" db:"-"`
+	ChecklistTotal  int64               `json:"checklist_total" db:"-"`
+	TrackedSeconds  int64               `json:"tracked_seconds" db:"-"`
+	Recurrence      string              `json:"recurrence,omitempty" db:"-" validate:"omitempty,max=255"`
+	SeriesID        *int64              `json:"series_id,omitempty" db:"-"`
+	Occurrence      int                 `json:"occurrence,omitempty" db:"-"`
+}
+
+type TaskProgress struct {
+	Done  int64 `json:"done" db:"done"`
+	Total int64 `json:"total" db:"total"`
+}
+
+type TaskTransition struct {
+	Status string `json:"status" validate:"required,oneof=todo in_progress blocked done cancelled"`
+}
+
+type TaskMove struct {
+	Status string `json:"status" validate:"required,oneof=todo in_progress blocked done cancelled"`
+	PrevID *int64 `json:"prev_id"`
+	NextID *int64 `json:"next_id"`
+}
+
+type TaskLane struct {
+	WorkspaceID *int64
+	UserID      int64
+	Status      string
+}
+
+type TaskPatch struct {
+	Title           *string    `json:"title" validate:"omitnil,min=1"`
+	Description     *string    `json:"description" validate:"omitnil,min=1"`
+	Status          *string    `json:"status" validate:"omitnil,oneof=todo in_progress blocked done cancelled"`
+	Priority        *string    `json:"priority" validate:"omitnil,oneof=low medium high urgent"`
+	DueAt           *time.Time `json:"due_at"`
+	ParentID        *int64     `json:"parent_id"`
+	ProjectID       *int64     `json:"project_id"`
+	AssigneeID      *int64     `json:"assignee_id"`
+	AssignedAt      *time.Time `json:"-"`
+	EstimateMinutes *int       `json:"estimate_minutes" validate:"omitnil,min=0"`
+	StoryPoints     *int       `json:"story_points" validate:"omitnil,min=0,max=1000"`
+	Position        *string    `json:"-"`
+	Fields          []string   `json:"-"`
+	UpdatedAt       time.Time  `json:"-"`
+	Version         int64      `json:"-"`
+	IfMatch         etag.Match `json:"-"`
+}
+
+func (p TaskPatch) Has(field string) bool {
+	for _, f := range p.Fields {
+		if f == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+type TaskBulkRequest struct {
+	Atomic     bool                `json:"atomic"`
+	Operations []TaskBulkOperation `json:"operations" validate:"required,min=1,max=100,dive"`
+}
+
+type TaskBulkOperation struct {
+	Op        string `json:"op" validate:"required,oneof=create update delete transition"`
+	ID        int64  `json:"id" validate:"required_unless=Op create"`
+	Version   int64  `json:"version"`
+	Permanent bool   `json:"permanent"`
+	Status    string `json:"status" validate:"required_if=Op transition,omitempty,oneof=todo in_progress blocked done cancelled"`
+	Task      *Task  `json:"task" validate:"required_if=Op create,required_if=Op update"`
+}
+
+type TaskBulkResult struct {
+	Index  int    `json:"index"`
+	Op     string `json:"op"`
+	ID     int64  `json:"id,omitempty"`
+	Status string `json:"status"`
+	Code   int    `json:"code,omitempty"`
+	Task   *Task  `json:"task,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+type TaskDependency struct {
+	BlockerID int64     `json:"blocker_id" db:"blocker_id"`
+	BlockedID int64     `json:"blocked_id" db:"blocked_id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+type TaskDependencyRequest struct {
+	BlockerID int64 `json:"blocker_id" validate:"required"`
+}
+
+type TaskLabelRequest struct {
+	LabelID int64 `json:"label_id" validate:"required"`
+}
+
+type TaskChecklistItem struct {
+	ID        int64     `json:"id" db:"id"`
+	TaskID    int64     `json:"task_id" db:"task_id"`
+	Text      string    `json:"text" db:"text"`
+	Checked   bool      `json:"checked" db:"checked"`
+	Position  int       `json:"position" db:"position"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+type TaskChecklistRequest struct {
+	Text string `json:"text" validate:"required,max=500"`
+}
+
+type TaskChecklistOrderRequest struct {
+	ItemIDs []int64 `json:"item_ids" validate:"required,min=1"`
+}
+
+type TaskChecklistSummary struct {
+	Done  int64 `db:"done"`
+	Total int64 `db:"total"`
+}
+
+type TaskSeries struct {
+	ID          int64     `json:"id" db:"id"`
+	Rule        string    `json:"rule" db:"rule"`
+	DTStart     time.Time `json:"dtstart" db:"dtstart"`
+	Title       string    `json:"title" db:"title"`
+	Description string    `json:"description" db:"description"`
+	Priority    string    `json:"priority" db:"priority"`
+	ProjectID   *int64    `json:"project_id" db:"project_id"`
+	WorkspaceID *int64    `json:"workspace_id" db:"workspace_id"`
+	AssigneeID  *int64    `json:"assignee_id" db:"assignee_id"`
+	Offset      int       `json:"-" db:"occurrence_offset"`
 	UserID      int64     `json:"-" db:"user_id"`
 	CreatedAt   time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
 }
+
+type TaskOccurrence struct {
+	TaskID      int64     `db:"task_id"`
+	SeriesID    int64     `db:"series_id"`
+	Occurrence  int       `db:"occurrence"`
+	ScheduledAt time.Time `db:"scheduled_at"`
+	Rule        string    `db:"rule"`
+	Latest      int       `db:"latest"`
+}
+
+type TaskDependencies struct {
+	BlockedBy []Task `json:"blocked_by"`
+	Blocks    []Task `json:"blocks"`
+}
+
+type TaskDependencyGraph struct {
+	Nodes []Task           `json:"nodes"`
+	Edges []TaskDependency `json:"edges"`
+}
+
+type TaskHistory struct {
+	ID        int64       `json:"id" db:"id"`
+	TaskID    int64       `json:"task_id" db:"task_id"`
+	Revision  int64       `json:"revision" db:"revision"`
+	Action    string      `json:"action" db:"action"`
+	ActorID   int64       `json:"actor_id" db:"actor_id"`
+	Changes   TaskChanges `json:"changes" db:"changes"`
+	CreatedAt time.Time   `json:"created_at" db:"created_at"`
+}
+
+type TaskChange struct {
+	Field string          `json:"field"`
+	Old   json.RawMessage `json:"old"`
+	New   json.RawMessage `json:"new"`
+}
+
+type TaskChanges []TaskChange
+
+func (c TaskChanges) Value() (driver.Value, error) {
+	if c == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal(c)
+}
+
+func (c *TaskChanges) Scan(src any) error {
+	var data []byte
+	switch value := src.(type) {
+	case []byte:
+		data = value
+	case string:
+		data = []byte(value)
+	case nil:
+		*c = TaskChanges{}
+		return nil
+	default:
+		return errors.New("unsupported task changes type")
+	}
+
+	return json.Unmarshal(data, c)
+}
+
+type TaskBurndownRequest struct {
+	ProjectID *int64 `query:"project_id"`
+	From      string `query:"from" validate:"required"`
+	To        string `query:"to" validate:"required"`
+	Metric    string `query:"metric" validate:"omitempty,oneof=points minutes"`
+}
+
+type TaskBurndown struct {
+	ProjectID *int64              `json:"project_id"`
+	From      string              `json:"from"`
+	To        string              `json:"to"`
+	Metric    string              `json:"metric"`
+	Points    []TaskBurndownPoint `json:"points"`
+}
+
+type TaskBurndownPoint struct {
+	Date      string  `json:"date"`
+	Scope     int64   `json:"scope"`
+	Completed int64   `json:"completed"`
+	Remaining int64   `json:"remaining"`
+	Ideal     float64 `json:"ideal"`
+}
+
+type TaskBoardRequest struct {
+	ProjectID *int64 `query:"project_id"`
+	Priority  string `query:"priority"`
+	Labels    string `query:"labels"`
+	LabelMode string `query:"label_mode"`
+	Assignee  string `query:"assignee"`
+	Q         string `query:"q"`
+	Limit     int    `query:"limit" validate:"omitempty,min=1,max=200"`
+}
+
+type TaskBoard struct {
+	Columns []TaskBoardColumn `json:"columns"`
+}
+
+type TaskBoardColumn struct {
+	Status string `json:"status"`
+	Total  int64  `json:"total"`
+	Tasks  []Task `json:"tasks"`
+}
@@ -0,0 +1,57 @@
+package model
+
+import "time"
+
+const (
+	TimeEntrySourceTimer  = "timer"
+	TimeEntrySourceManual = "manual"
+
+	TimeReportGroupTask    = "task"
+	TimeReportGroupProject = "project"
+	TimeReportGroupDay     = "day"
+
+	TimeReportMaxRange = 366 * 24 * time.Hour
+)
+
+type TimeEntry struct {
+	ID              int64      `json:"id,omitempty" db:"id"`
+	TaskID          int64      `json:"task_id" db:"task_id"`
+	UserID          int64      `json:"user_id" db:"user_id"`
+	StartedAt       time.Time  `json:"started_at" db:"started_at"`
+	EndedAt         *time.Time `json:"ended_at" db:"ended_at"`
+	DurationSeconds int64      `json:"duration_seconds" db:"duration_seconds"`
+	Note            string     `json:"note" db:"note"`
+	Source          string     `json:"source" db:"source"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+}
+
+type TimerRequest struct {
+	Note string `json:"note" validate:"max=1000"`
+}
+
+type TimeEntryRequest struct {
+	StartedAt *time.Time `json:"started_at" validate:"required"`
+	EndedAt   *time.Time `json:"ended_at" validate:"required"`
+	Note      string     `json:"note" validate:"max=1000"`
+}
+
+type TimeReportRequest struct {
+	From    string `query:"from" validate:"required"`
+	To      string `query:"to" validate:"required"`
+	GroupBy string `query:"group_by" validate:"omitempty,oneof=task project day"`
+}
+
+type TimeReport struct {
+	From         time.Time        `json:"from"`
+	To           time.Time        `json:"to"`
+	GroupBy      string           `json:"group_by"`
+	TotalSeconds int64            `json:"total_seconds"`
+	Items        []TimeReportItem `json:"items"`
+}
+
+type TimeReportItem struct {
+	Key          string `json:"key" db:"key"`
+	Name         string `json:"name" db:"name"`
+	TotalSeconds int64  `json:"total_seconds" db:"total_seconds"`
+	Entries      int64  `json:"entries" db:"entries"`
+}
@@ -0,0 +1,46 @@
+package model
+
+import "time"
+
+const (
+	WorkspaceRoleOwner  = "owner"
+	WorkspaceRoleEditor = "editor"
+	WorkspaceRoleViewer = "viewer"
+)
+
+var WorkspaceRoleRank = map[string]int{
+	WorkspaceRoleViewer: 1,
+	WorkspaceRoleEditor: 2,
+	WorkspaceRoleOwner:  3,
+}
+
+type Workspace struct {
+	ID        int64     `json:"id,omitempty" db:"id"`
+	Name      string    `json:"name" db:"name" validate:"required,max=100"`
+	OwnerID   int64     `json:"owner_id" db:"owner_id"`
+	Role      string    `json:"role,omitempty" db:"role"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+type WorkspaceMember struct {
+	WorkspaceID int64     `json:"workspace_id" db:"workspace_id"`
+	UserID      int64     `json:"user_id" db:"user_id"`
+	Name        string    `json:"name" db:"name"`
+	Email       string    `json:"email" db:"email"`
+	Role        string    `json:"role" db:"role"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+}
+
+type WorkspaceInvitation struct {
+	Email string `json:"email" validate:"required,email"`
+	Role  string `json:"role" validate:"required,oneof=editor viewer"`
+}
+
+type WorkspaceMemberRole struct {
+	Role string `json:"role" validate:"required,oneof=editor viewer"`
+}
+
+func WorkspaceRoleAllows(role, required string) bool {
+	return WorkspaceRoleRank[role] >= WorkspaceRoleRank[required] && WorkspaceRoleRank[role] > 0
+}
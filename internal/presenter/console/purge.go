@@ -0,0 +1,61 @@
+package console
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/rzfhlv/go-task/config"
+	"github.com/rzfhlv/go-task/internal/infrastructure"
+	"github.com/rzfhlv/go-task/internal/repository/attachment"
+	"github.com/rzfhlv/go-task/internal/repository/task"
+	attachmentusecase "github.com/rzfhlv/go-task/internal/usecase/attachment"
+	taskusecase "github.com/rzfhlv/go-task/internal/usecase/task"
+	"github.com/spf13/cobra"
+)
+
+var retention time.Duration
+
+var purgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "Permanently remove trashed tasks older than the retention and their attachments",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+		cfg := config.Get()
+		if retention > 0 {
+			cfg.Task.TrashRetention = retention
+		}
+
+		infra, err := infrastructure.New(ctx, cfg)
+		if err != nil {
+			log.Fatalf("fail to load infrastructure: %v", err)
+		}
+		defer infra.SQLStore().Close()
+		defer infra.MemStore().Close()
+
+		taskRepository := task.New(infra.SQLStore().GetDB())
+		taskUsecase := taskusecase.New(taskRepository, cfg)
+
+		total, err := taskUsecase.Purge(ctx)
+		if err != nil {
+			log.Fatalf("fail to purge trashed tasks: %v", err)
+		}
+
+		attachmentRepository := attachment.New(infra.SQLStore().GetDB())
+		attachmentUsecase := attachmentusecase.New(attachmentRepository, taskUsecase, infra.BlobStore(), cfg)
+
+		removed, err := attachmentUsecase.Cleanup(ctx)
+		if err != nil {
+			log.Fatalf("fail to clean up attachments: %v", err)
+		}
+
+		fmt.Printf("Purge success, %d tasks and %d attachments removed\n", total, removed)
+	},
+}
+
+func init() {
+	purgeCmd.Flags().DurationVar(&retention, "retention", 0, "override the configured trash retention")
+
+	rootCmd.AddCommand(purgeCmd)
+}
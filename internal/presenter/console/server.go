@@ -12,6 +12,7 @@ import (
 	"github.com/rzfhlv/go-task/config"
 	"github.com/rzfhlv/go-task/internal/infrastructure"
 	"github.com/rzfhlv/go-task/internal/presenter/rest"
+	"github.com/rzfhlv/go-task/internal/presenter/scheduler"
 	"github.com/spf13/cobra"
 )
 
@@ -36,6 +37,16 @@ func init() {
 				}
 			}()
 
+			// start reminder scheduler
+			schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+			schedulerDone := make(chan struct{})
+			go func() {
+				defer close(schedulerDone)
+				if cfg.Reminder.Embedded {
+					scheduler.Init(infra, cfg).Run(schedulerCtx)
+				}
+			}()
+
 			// graceful shutdown
 			quit := make(chan os.Signal, 1)
 			signal.Notify(quit, os.Interrupt)
@@ -46,6 +57,9 @@ func init() {
 				e.Logger.Fatal(err)
 			}
 
+			stopScheduler()
+			<-schedulerDone
+
 			if err := infra.SQLStore().Close(); err != nil {
 				e.Logger.Fatal(err)
 			}
@@ -0,0 +1,35 @@
+package console
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+
+	"github.com/rzfhlv/go-task/config"
+	"github.com/rzfhlv/go-task/internal/infrastructure"
+	"github.com/rzfhlv/go-task/internal/presenter/scheduler"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(&cobra.Command{
+		Use:   "worker",
+		Short: "Start the reminder scheduler without the REST API server",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			cfg := config.Get()
+			infra, err := infrastructure.New(ctx, cfg)
+			if err != nil {
+				log.Fatalf("fail to load infrastructure: %v", err)
+			}
+			defer infra.SQLStore().Close()
+			defer infra.MemStore().Close()
+
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+			defer stop()
+
+			scheduler.Init(infra, cfg).Run(ctx)
+		},
+	})
+}
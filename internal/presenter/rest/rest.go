@@ -8,18 +8,39 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/rzfhlv/go-task/config"
+	attachmenthandler "github.com/rzfhlv/go-task/internal/handler/attachment"
+	commenthandler "github.com/rzfhlv/go-task/internal/handler/comment"
+	labelhandler "github.com/rzfhlv/go-task/internal/handler/label"
 	loginhandler "github.com/rzfhlv/go-task/internal/handler/login"
 	logouthandler "github.com/rzfhlv/go-task/internal/handler/logout"
+	projecthandler "github.com/rzfhlv/go-task/internal/handler/project"
 	registerhandler "github.com/rzfhlv/go-task/internal/handler/register"
+	reminderhandler "github.com/rzfhlv/go-task/internal/handler/reminder"
 	taskhandler "github.com/rzfhlv/go-task/internal/handler/task"
+	timeentryhandler "github.com/rzfhlv/go-task/internal/handler/timeentry"
+	workspacehandler "github.com/rzfhlv/go-task/internal/handler/workspace"
 	"github.com/rzfhlv/go-task/internal/infrastructure"
+	"github.com/rzfhlv/go-task/internal/repository/attachment"
 	"github.com/rzfhlv/go-task/internal/repository/cache"
+	"github.com/rzfhlv/go-task/internal/repository/comment"
+	"github.com/rzfhlv/go-task/internal/repository/label"
+	"github.com/rzfhlv/go-task/internal/repository/project"
+	"github.com/rzfhlv/go-task/internal/repository/reminder"
 	"github.com/rzfhlv/go-task/internal/repository/task"
+	"github.com/rzfhlv/go-task/internal/repository/timeentry"
 	"github.com/rzfhlv/go-task/internal/repository/user"
+	"github.com/rzfhlv/go-task/internal/repository/workspace"
+	attachmentusecase "github.com/rzfhlv/go-task/internal/usecase/attachment"
+	commentusecase "github.com/rzfhlv/go-task/internal/usecase/comment"
+	labelusecase "github.com/rzfhlv/go-task/internal/usecase/label"
 	"github.com/rzfhlv/go-task/internal/usecase/login"
 	"github.com/rzfhlv/go-task/internal/usecase/logout"
+	projectusecase "github.com/rzfhlv/go-task/internal/usecase/project"
 	"github.com/rzfhlv/go-task/internal/usecase/register"
+	reminderusecase "github.com/rzfhlv/go-task/internal/usecase/reminder"
 	taskusecase "github.com/rzfhlv/go-task/internal/usecase/task"
+	timeentryusecase "github.com/rzfhlv/go-task/internal/usecase/timeentry"
+	workspaceusecase "github.com/rzfhlv/go-task/internal/usecase/workspace"
 	"github.com/rzfhlv/go-task/pkg/hasher"
 	"github.com/rzfhlv/go-task/pkg/jwt"
 	"github.com/rzfhlv/go-task/pkg/middleware/auth"
@@ -61,6 +82,13 @@ func Init(infra infrastructure.Infrastructure, cfg *config.Configuration) (e *ec
 	userRepository := user.New(sqlStore.GetDB())
 	cacheRepository := cache.New(memStore.GetClient())
 	taskRepository := task.New(sqlStore.GetDB())
+	labelRepository := label.New(sqlStore.GetDB())
+	projectRepository := project.New(sqlStore.GetDB())
+	workspaceRepository := workspace.New(sqlStore.GetDB())
+	commentRepository := comment.New(sqlStore.GetDB())
+	attachmentRepository := attachment.New(sqlStore.GetDB())
+	reminderRepository := reminder.New(sqlStore.GetDB())
+	timeEntryRepository := timeentry.New(sqlStore.GetDB())
 
 	hasher := hasher.HasherPassword{}
 	jwt := jwt.New(cfg)
@@ -76,9 +104,30 @@ func Init(infra infrastructure.Infrastructure, cfg *config.Configuration) (e *ec
 	logoutUsecase := logout.New(cacheRepository)
 	logoutHandler := logouthandler.New(logoutUsecase)
 
-	taskUsecase := taskusecase.New(taskRepository)
+	taskUsecase := taskusecase.New(taskRepository, cfg)
 	taskHandler := taskhandler.New(taskUsecase)
 
+	labelUsecase := labelusecase.New(labelRepository)
+	labelHandler := labelhandler.New(labelUsecase)
+
+	projectUsecase := projectusecase.New(projectRepository)
+	projectHandler := projecthandler.New(projectUsecase)
+
+	workspaceUsecase := workspaceusecase.New(workspaceRepository)
+	workspaceHandler := workspacehandler.New(workspaceUsecase)
+
+	commentUsecase := commentusecase.New(commentRepository, taskUsecase, cfg)
+	commentHandler := commenthandler.New(commentUsecase)
+
+	attachmentUsecase := attachmentusecase.New(attachmentRepository, taskUsecase, infra.BlobStore(), cfg)
+	attachmentHandler := attachmenthandler.New(attachmentUsecase)
+
+	reminderUsecase := reminderusecase.New(reminderRepository, taskUsecase, infra.Notifier(), cfg)
+	reminderHandler := reminderhandler.New(reminderUsecase)
+
+	timeEntryUsecase := timeentryusecase.New(timeEntryRepository, taskUsecase)
+	timeEntryHandler := timeentryhandler.New(timeEntryUsecase)
+
 	route := e.Group("/v1")
 	route.POST("/register", registerHandler.Register)
 	route.POST("/login", loginHandler.Login)
@@ -87,9 +136,80 @@ func Init(infra infrastructure.Infrastructure, cfg *config.Configuration) (e *ec
 	task := route.Group("/tasks", middleware.Bearer)
 	task.POST("", taskHandler.Create)
 	task.GET("", taskHandler.GetByUserID)
+	task.GET("/trash", taskHandler.GetTrashByUserID)
+	task.POST("/bulk", taskHandler.Bulk)
+	task.GET("/burndown", taskHandler.Burndown)
 	task.GET("/:id", taskHandler.GetByID)
 	task.PUT("/:id", taskHandler.Update)
+	task.PATCH("/:id", taskHandler.Patch)
 	task.DELETE("/:id", taskHandler.Delete)
+	task.POST("/:id/transition", taskHandler.Transition)
+	task.POST("/:id/move", taskHandler.Move)
+	task.POST("/:id/restore", taskHandler.Restore)
+	task.GET("/:id/history", taskHandler.GetHistory)
+	task.POST("/:id/revert", taskHandler.Revert)
+	task.GET("/:id/subtasks", taskHandler.GetSubtasks)
+	task.GET("/:id/dependencies", taskHandler.GetDependencies)
+	task.POST("/:id/dependencies", taskHandler.AddDependency)
+	task.DELETE("/:id/dependencies/:blocker_id", taskHandler.RemoveDependency)
+	task.GET("/:id/dependency-graph", taskHandler.GetDependencyGraph)
+	task.POST("/:id/labels", taskHandler.AttachLabel)
+	task.DELETE("/:id/labels/:label_id", taskHandler.DetachLabel)
+	task.POST("/:id/checklist", taskHandler.AddChecklistItem)
+	task.PUT("/:id/checklist/order", taskHandler.ReorderChecklist)
+	task.POST("/:id/checklist/:item_id/toggle", taskHandler.ToggleChecklistItem)
+	task.DELETE("/:id/checklist/:item_id", taskHandler.RemoveChecklistItem)
+	task.GET("/:id/comments", commentHandler.GetByTaskID)
+	task.POST("/:id/comments", commentHandler.Create)
+	task.PUT("/:id/comments/:comment_id", commentHandler.Update)
+	task.DELETE("/:id/comments/:comment_id", commentHandler.Delete)
+	task.GET("/:id/attachments", attachmentHandler.GetByTaskID)
+	task.POST("/:id/attachments", attachmentHandler.Create)
+	task.GET("/:id/attachments/:attachment_id", attachmentHandler.Download)
+	task.DELETE("/:id/attachments/:attachment_id", attachmentHandler.Delete)
+	task.GET("/:id/reminders", reminderHandler.GetByTaskID)
+	task.POST("/:id/reminders", reminderHandler.Create)
+	task.DELETE("/:id/reminders/:reminder_id", reminderHandler.Delete)
+	task.POST("/:id/timer/start", timeEntryHandler.Start)
+	task.POST("/:id/timer/stop", timeEntryHandler.Stop)
+	task.GET("/:id/time-entries", timeEntryHandler.GetByTaskID)
+	task.POST("/:id/time-entries", timeEntryHandler.Create)
+	task.DELETE("/:id/time-entries/:entry_id", timeEntryHandler.Delete)
+
+	timeEntries := route.Group("/time-entries", middleware.Bearer)
+	timeEntries.GET("", timeEntryHandler.Report)
+
+	board := route.Group("/board", middleware.Bearer)
+	board.GET("", taskHandler.Board)
+
+	labels := route.Group("/labels", middleware.Bearer)
+	labels.POST("", labelHandler.Create)
+	labels.GET("", labelHandler.GetByUserID)
+	labels.GET("/:id", labelHandler.GetByID)
+	labels.PUT("/:id", labelHandler.Update)
+	labels.DELETE("/:id", labelHandler.Delete)
+
+	projects := route.Group("/projects", middleware.Bearer)
+	projects.POST("", projectHandler.Create)
+	projects.GET("", projectHandler.GetByUserID)
+	projects.GET("/:id", projectHandler.GetByID)
+	projects.PUT("/:id", projectHandler.Update)
+	projects.DELETE("/:id", projectHandler.Delete)
+	projects.POST("/:id/archive", projectHandler.Archive)
+	projects.POST("/:id/unarchive", projectHandler.Unarchive)
+	projects.GET("/:id/tasks", taskHandler.GetByProjectID)
+
+	workspaces := route.Group("/workspaces", middleware.Bearer)
+	workspaces.POST("", workspaceHandler.Create)
+	workspaces.GET("", workspaceHandler.GetByUserID)
+	workspaces.GET("/:id", workspaceHandler.GetByID)
+	workspaces.PUT("/:id", workspaceHandler.Update)
+	workspaces.DELETE("/:id", workspaceHandler.Delete)
+	workspaces.GET("/:id/members", workspaceHandler.GetMembers)
+	workspaces.POST("/:id/invitations", workspaceHandler.Invite)
+	workspaces.PUT("/:id/members/:user_id", workspaceHandler.UpdateMember)
+	workspaces.DELETE("/:id/members/:user_id", workspaceHandler.RemoveMember)
+	workspaces.GET("/:id/tasks", taskHandler.GetByWorkspaceID)
 
 	return
 }
@@ -0,0 +1,72 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/rzfhlv/go-task/config"
+	"github.com/rzfhlv/go-task/internal/infrastructure"
+	"github.com/rzfhlv/go-task/internal/repository/reminder"
+	"github.com/rzfhlv/go-task/internal/repository/task"
+	reminderusecase "github.com/rzfhlv/go-task/internal/usecase/reminder"
+	taskusecase "github.com/rzfhlv/go-task/internal/usecase/task"
+)
+
+const defaultPollInterval = 15 * time.Second
+
+type Scheduler struct {
+	reminderUsecase reminderusecase.ReminderUsecase
+	interval        time.Duration
+}
+
+func New(reminderUsecase reminderusecase.ReminderUsecase, interval time.Duration) *Scheduler {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	return &Scheduler{
+		reminderUsecase: reminderUsecase,
+		interval:        interval,
+	}
+}
+
+func Init(infra infrastructure.Infrastructure, cfg *config.Configuration) *Scheduler {
+	taskRepository := task.New(infra.SQLStore().GetDB())
+	taskUsecase := taskusecase.New(taskRepository, cfg)
+
+	reminderRepository := reminder.New(infra.SQLStore().GetDB())
+	reminderUsecase := reminderusecase.New(reminderRepository, taskUsecase, infra.Notifier(), cfg)
+
+	return New(reminderUsecase, cfg.Reminder.PollInterval)
+}
+
+func (s *Scheduler) Run(ctx context.Context) {
+	slog.InfoContext(ctx, "[Scheduler] started", slog.Duration("interval", s.interval))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "[Scheduler] stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) tick(ctx context.Context) {
+	sent, err := s.reminderUsecase.Dispatch(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Scheduler] error when dispatch reminders", slog.String("error", err.Error()))
+		return
+	}
+
+	if sent > 0 {
+		slog.InfoContext(ctx, "[Scheduler] reminders dispatched", slog.Int("sent", sent))
+	}
+}
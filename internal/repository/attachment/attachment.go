@@ -0,0 +1,119 @@
+package attachment
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rzfhlv/go-task/internal/model"
+)
+
+var (
+	createAttachmentQuery = `INSERT INTO task_attachments
+		(task_id, user_id, filename, content_type, size, checksum, storage_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, task_id, user_id, filename, content_type, size, checksum, storage_key, created_at`
+
+	getAttachmentByTaskIDQuery = `SELECT 
+		id, task_id, user_id, filename, content_type, size, checksum, storage_key, created_at
+		FROM task_attachments
+		WHERE task_id = $1
+		ORDER BY id`
+
+	getAttachmentByIDQuery = `SELECT 
+		id, task_id, user_id, filename, content_type, size, checksum, storage_key, created_at
+		FROM task_attachments
+		WHERE id = $1 AND task_id = $2`
+
+	detachAttachmentQuery = `UPDATE task_attachments SET task_id = NULL WHERE id = $1 AND task_id = $2`
+
+	getOrphanedAttachmentQuery = `SELECT id, storage_key
+		FROM task_attachments
+		WHERE task_id IS NULL
+		ORDER BY id LIMIT $1`
+
+	destroyAttachmentQuery = `DELETE FROM task_attachments WHERE id = $1 AND task_id IS NULL`
+)
+
+type AttachmentRepository interface {
+	Create(ctx context.Context, attachment model.Attachment) (model.Attachment, error)
+	GetByTaskID(ctx context.Context, taskId int64) ([]model.Attachment, error)
+	GetByID(ctx context.Context, id, taskId int64) (model.Attachment, error)
+	Delete(ctx context.Context, id, taskId int64) error
+	GetOrphaned(ctx context.Context, limit int) ([]model.Attachment, error)
+	Destroy(ctx context.Context, id int64) error
+}
+
+type Attachment struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) AttachmentRepository {
+	return &Attachment{
+		db: db,
+	}
+}
+
+func (a *Attachment) Create(ctx context.Context, attachment model.Attachment) (model.Attachment, error) {
+	result := model.Attachment{}
+	err := a.db.Get(&result, createAttachmentQuery, attachment.TaskID, attachment.UserID, attachment.Filename,
+		attachment.ContentType, attachment.Size, attachment.Checksum, attachment.StorageKey)
+	if err != nil {
+		return model.Attachment{}, err
+	}
+
+	return result, nil
+}
+
+func (a *Attachment) GetByTaskID(ctx context.Context, taskId int64) ([]model.Attachment, error) {
+	result := []model.Attachment{}
+	err := a.db.Select(&result, getAttachmentByTaskIDQuery, taskId)
+	if err != nil {
+		return []model.Attachment{}, err
+	}
+
+	return result, nil
+}
+
+func (a *Attachment) GetByID(ctx context.Context, id, taskId int64) (model.Attachment, error) {
+	result := model.Attachment{}
+	err := a.db.Get(&result, getAttachmentByIDQuery, id, taskId)
+	if err != nil {
+		return model.Attachment{}, err
+	}
+
+	return result, nil
+}
+
+func (a *Attachment) Delete(ctx context.Context, id, taskId int64) error {
+	result, err := a.db.Exec(detachAttachmentQuery, id, taskId)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (a *Attachment) GetOrphaned(ctx context.Context, limit int) ([]model.Attachment, error) {
+	result := []model.Attachment{}
+	err := a.db.Select(&result, getOrphanedAttachmentQuery, limit)
+	if err != nil {
+		return []model.Attachment{}, err
+	}
+
+	return result, nil
+}
+
+func (a *Attachment) Destroy(ctx context.Context, id int64) error {
+	_, err := a.db.Exec(destroyAttachmentQuery, id)
+	return err
+}
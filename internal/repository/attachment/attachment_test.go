@@ -0,0 +1,386 @@
+package attachment_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/repository/attachment"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	now = time.Date(2023, time.August, 15, 12, 0, 0, 0, time.UTC)
+
+	attachmentModel = model.Attachment{
+		ID:          1,
+		TaskID:      2,
+		UserID:      int64(1),
+		Filename:    "spec.pdf",
+		ContentType: "application/pdf",
+		Size:        1024,
+		Checksum:    "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+		StorageKey:  "tasks/2/0123456789abcdef",
+		CreatedAt:   now,
+	}
+
+	attachmentColumns = []string{"id", "task_id", "user_id", "filename", "content_type", "size", "checksum", "storage_key", "created_at"}
+)
+
+func attachmentRow(rows *sqlmock.Rows) *sqlmock.Rows {
+	return rows.AddRow(attachmentModel.ID, attachmentModel.TaskID, attachmentModel.UserID, attachmentModel.Filename,
+		attachmentModel.ContentType, attachmentModel.Size, attachmentModel.Checksum, attachmentModel.StorageKey, now)
+}
+
+func TestAttachmentCreate(t *testing.T) {
+	query := `INSERT INTO task_attachments
+		(task_id, user_id, filename, content_type, size, checksum, storage_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, task_id, user_id, filename, content_type, size, checksum, storage_key, created_at`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Attachment
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(attachmentModel.TaskID, attachmentModel.UserID, attachmentModel.Filename, attachmentModel.ContentType,
+						attachmentModel.Size, attachmentModel.Checksum, attachmentModel.StorageKey).
+					WillReturnRows(attachmentRow(sqlmock.NewRows(attachmentColumns)))
+			},
+			wantResult: attachmentModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when create attachment",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(attachmentModel.TaskID, attachmentModel.UserID, attachmentModel.Filename, attachmentModel.ContentType,
+						attachmentModel.Size, attachmentModel.Checksum, attachmentModel.StorageKey).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: model.Attachment{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := attachment.New(db)
+			result, err := r.Create(context.Background(), attachmentModel)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestAttachmentGetByTaskID(t *testing.T) {
+	query := `SELECT 
+		id, task_id, user_id, filename, content_type, size, checksum, storage_key, created_at
+		FROM task_attachments
+		WHERE task_id = $1
+		ORDER BY id`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.Attachment
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(attachmentModel.TaskID).
+					WillReturnRows(attachmentRow(sqlmock.NewRows(attachmentColumns)))
+			},
+			wantResult: []model.Attachment{attachmentModel},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get attachments",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(attachmentModel.TaskID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.Attachment{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := attachment.New(db)
+			result, err := r.GetByTaskID(context.Background(), attachmentModel.TaskID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestAttachmentGetByID(t *testing.T) {
+	query := `SELECT 
+		id, task_id, user_id, filename, content_type, size, checksum, storage_key, created_at
+		FROM task_attachments
+		WHERE id = $1 AND task_id = $2`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Attachment
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(attachmentModel.ID, attachmentModel.TaskID).
+					WillReturnRows(attachmentRow(sqlmock.NewRows(attachmentColumns)))
+			},
+			wantResult: attachmentModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when attachment is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(attachmentModel.ID, attachmentModel.TaskID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantResult: model.Attachment{},
+			wantErr:    sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := attachment.New(db)
+			result, err := r.GetByID(context.Background(), attachmentModel.ID, attachmentModel.TaskID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestAttachmentDelete(t *testing.T) {
+	query := `UPDATE task_attachments SET task_id = NULL WHERE id = $1 AND task_id = $2`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(attachmentModel.ID, attachmentModel.TaskID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when attachment is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(attachmentModel.ID, attachmentModel.TaskID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name: "error when delete attachment",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(attachmentModel.ID, attachmentModel.TaskID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := attachment.New(db)
+			err := r.Delete(context.Background(), attachmentModel.ID, attachmentModel.TaskID)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestAttachmentGetOrphaned(t *testing.T) {
+	query := `SELECT id, storage_key
+		FROM task_attachments
+		WHERE task_id IS NULL
+		ORDER BY id LIMIT $1`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.Attachment
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "storage_key"}).
+					AddRow(attachmentModel.ID, attachmentModel.StorageKey)
+
+				s.ExpectQuery(query).
+					WithArgs(100).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.Attachment{{ID: attachmentModel.ID, StorageKey: attachmentModel.StorageKey}},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get orphaned attachments",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(100).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.Attachment{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := attachment.New(db)
+			result, err := r.GetOrphaned(context.Background(), 100)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestAttachmentDestroy(t *testing.T) {
+	query := `DELETE FROM task_attachments WHERE id = $1 AND task_id IS NULL`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(attachmentModel.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when destroy attachment",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(attachmentModel.ID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := attachment.New(db)
+			err := r.Destroy(context.Background(), attachmentModel.ID)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
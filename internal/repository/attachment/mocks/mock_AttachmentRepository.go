@@ -0,0 +1,365 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/rzfhlv/go-task/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAttachmentRepository is an autogenerated mock type for the AttachmentRepository type
+type MockAttachmentRepository struct {
+	mock.Mock
+}
+
+type MockAttachmentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachmentRepository) EXPECT() *MockAttachmentRepository_Expecter {
+	return &MockAttachmentRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *MockAttachmentRepository) Create(ctx context.Context, _a1 model.Attachment) (model.Attachment, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Attachment) (model.Attachment, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Attachment) model.Attachment); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Attachment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Attachment) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachmentRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockAttachmentRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Attachment
+func (_e *MockAttachmentRepository_Expecter) Create(ctx interface{}, _a1 interface{}) *MockAttachmentRepository_Create_Call {
+	return &MockAttachmentRepository_Create_Call{Call: _e.mock.On("Create", ctx, _a1)}
+}
+
+func (_c *MockAttachmentRepository_Create_Call) Run(run func(ctx context.Context, _a1 model.Attachment)) *MockAttachmentRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Attachment))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentRepository_Create_Call) Return(_a0 model.Attachment, _a1 error) *MockAttachmentRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachmentRepository_Create_Call) RunAndReturn(run func(context.Context, model.Attachment) (model.Attachment, error)) *MockAttachmentRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, taskId
+func (_m *MockAttachmentRepository) Delete(ctx context.Context, id int64, taskId int64) error {
+	ret := _m.Called(ctx, id, taskId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, id, taskId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAttachmentRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockAttachmentRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - taskId int64
+func (_e *MockAttachmentRepository_Expecter) Delete(ctx interface{}, id interface{}, taskId interface{}) *MockAttachmentRepository_Delete_Call {
+	return &MockAttachmentRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, taskId)}
+}
+
+func (_c *MockAttachmentRepository_Delete_Call) Run(run func(ctx context.Context, id int64, taskId int64)) *MockAttachmentRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentRepository_Delete_Call) Return(_a0 error) *MockAttachmentRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAttachmentRepository_Delete_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockAttachmentRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Destroy provides a mock function with given fields: ctx, id
+func (_m *MockAttachmentRepository) Destroy(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Destroy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAttachmentRepository_Destroy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Destroy'
+type MockAttachmentRepository_Destroy_Call struct {
+	*mock.Call
+}
+
+// Destroy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockAttachmentRepository_Expecter) Destroy(ctx interface{}, id interface{}) *MockAttachmentRepository_Destroy_Call {
+	return &MockAttachmentRepository_Destroy_Call{Call: _e.mock.On("Destroy", ctx, id)}
+}
+
+func (_c *MockAttachmentRepository_Destroy_Call) Run(run func(ctx context.Context, id int64)) *MockAttachmentRepository_Destroy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentRepository_Destroy_Call) Return(_a0 error) *MockAttachmentRepository_Destroy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAttachmentRepository_Destroy_Call) RunAndReturn(run func(context.Context, int64) error) *MockAttachmentRepository_Destroy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id, taskId
+func (_m *MockAttachmentRepository) GetByID(ctx context.Context, id int64, taskId int64) (model.Attachment, error) {
+	ret := _m.Called(ctx, id, taskId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Attachment, error)); ok {
+		return rf(ctx, id, taskId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Attachment); ok {
+		r0 = rf(ctx, id, taskId)
+	} else {
+		r0 = ret.Get(0).(model.Attachment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, taskId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachmentRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockAttachmentRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - taskId int64
+func (_e *MockAttachmentRepository_Expecter) GetByID(ctx interface{}, id interface{}, taskId interface{}) *MockAttachmentRepository_GetByID_Call {
+	return &MockAttachmentRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id, taskId)}
+}
+
+func (_c *MockAttachmentRepository_GetByID_Call) Run(run func(ctx context.Context, id int64, taskId int64)) *MockAttachmentRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentRepository_GetByID_Call) Return(_a0 model.Attachment, _a1 error) *MockAttachmentRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachmentRepository_GetByID_Call) RunAndReturn(run func(context.Context, int64, int64) (model.Attachment, error)) *MockAttachmentRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTaskID provides a mock function with given fields: ctx, taskId
+func (_m *MockAttachmentRepository) GetByTaskID(ctx context.Context, taskId int64) ([]model.Attachment, error) {
+	ret := _m.Called(ctx, taskId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTaskID")
+	}
+
+	var r0 []model.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.Attachment, error)); ok {
+		return rf(ctx, taskId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.Attachment); ok {
+		r0 = rf(ctx, taskId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, taskId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachmentRepository_GetByTaskID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTaskID'
+type MockAttachmentRepository_GetByTaskID_Call struct {
+	*mock.Call
+}
+
+// GetByTaskID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+func (_e *MockAttachmentRepository_Expecter) GetByTaskID(ctx interface{}, taskId interface{}) *MockAttachmentRepository_GetByTaskID_Call {
+	return &MockAttachmentRepository_GetByTaskID_Call{Call: _e.mock.On("GetByTaskID", ctx, taskId)}
+}
+
+func (_c *MockAttachmentRepository_GetByTaskID_Call) Run(run func(ctx context.Context, taskId int64)) *MockAttachmentRepository_GetByTaskID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentRepository_GetByTaskID_Call) Return(_a0 []model.Attachment, _a1 error) *MockAttachmentRepository_GetByTaskID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachmentRepository_GetByTaskID_Call) RunAndReturn(run func(context.Context, int64) ([]model.Attachment, error)) *MockAttachmentRepository_GetByTaskID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrphaned provides a mock function with given fields: ctx, limit
+func (_m *MockAttachmentRepository) GetOrphaned(ctx context.Context, limit int) ([]model.Attachment, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrphaned")
+	}
+
+	var r0 []model.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]model.Attachment, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []model.Attachment); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachmentRepository_GetOrphaned_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrphaned'
+type MockAttachmentRepository_GetOrphaned_Call struct {
+	*mock.Call
+}
+
+// GetOrphaned is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockAttachmentRepository_Expecter) GetOrphaned(ctx interface{}, limit interface{}) *MockAttachmentRepository_GetOrphaned_Call {
+	return &MockAttachmentRepository_GetOrphaned_Call{Call: _e.mock.On("GetOrphaned", ctx, limit)}
+}
+
+func (_c *MockAttachmentRepository_GetOrphaned_Call) Run(run func(ctx context.Context, limit int)) *MockAttachmentRepository_GetOrphaned_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentRepository_GetOrphaned_Call) Return(_a0 []model.Attachment, _a1 error) *MockAttachmentRepository_GetOrphaned_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachmentRepository_GetOrphaned_Call) RunAndReturn(run func(context.Context, int) ([]model.Attachment, error)) *MockAttachmentRepository_GetOrphaned_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAttachmentRepository creates a new instance of MockAttachmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachmentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachmentRepository {
+	mock := &MockAttachmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,119 @@
+package comment
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rzfhlv/go-task/internal/model"
+)
+
+var (
+	createCommentQuery = `INSERT INTO task_comments
+		(task_id, user_id, body)
+		VALUES ($1, $2, $3)
+		RETURNING id, task_id, user_id, body, created_at, updated_at`
+
+	getCommentByTaskIDQuery = `SELECT 
+		id, task_id, user_id, body, created_at, updated_at
+		FROM task_comments
+		WHERE task_id = $1 AND id > $2
+		ORDER BY id LIMIT $3`
+
+	countCommentByTaskIDQuery = `SELECT count(*) FROM task_comments WHERE task_id = $1`
+
+	getCommentByIDQuery = `SELECT 
+		id, task_id, user_id, body, created_at, updated_at
+		FROM task_comments
+		WHERE id = $1 AND task_id = $2`
+
+	updateCommentQuery = `UPDATE task_comments
+		SET body = $1, updated_at = $2
+		WHERE id = $3 AND task_id = $4
+		RETURNING id, task_id, user_id, body, created_at, updated_at`
+
+	deleteCommentQuery = `DELETE FROM task_comments WHERE id = $1 AND task_id = $2`
+)
+
+type CommentRepository interface {
+	Create(ctx context.Context, comment model.Comment) (model.Comment, error)
+	GetByTaskID(ctx context.Context, taskId, afterId int64, limit int) ([]model.Comment, error)
+	Count(ctx context.Context, taskId int64) (int64, error)
+	GetByID(ctx context.Context, id, taskId int64) (model.Comment, error)
+	Update(ctx context.Context, comment model.Comment) (model.Comment, error)
+	Delete(ctx context.Context, id, taskId int64) error
+}
+
+type Comment struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) CommentRepository {
+	return &Comment{
+		db: db,
+	}
+}
+
+func (c *Comment) Create(ctx context.Context, comment model.Comment) (model.Comment, error) {
+	result := model.Comment{}
+	err := c.db.Get(&result, createCommentQuery, comment.TaskID, comment.UserID, comment.Body)
+	if err != nil {
+		return model.Comment{}, err
+	}
+
+	return result, nil
+}
+
+func (c *Comment) GetByTaskID(ctx context.Context, taskId, afterId int64, limit int) ([]model.Comment, error) {
+	result := []model.Comment{}
+	err := c.db.Select(&result, getCommentByTaskIDQuery, taskId, afterId, limit)
+	if err != nil {
+		return []model.Comment{}, err
+	}
+
+	return result, nil
+}
+
+func (c *Comment) Count(ctx context.Context, taskId int64) (int64, error) {
+	var total int64
+	err := c.db.Get(&total, countCommentByTaskIDQuery, taskId)
+	return total, err
+}
+
+func (c *Comment) GetByID(ctx context.Context, id, taskId int64) (model.Comment, error) {
+	result := model.Comment{}
+	err := c.db.Get(&result, getCommentByIDQuery, id, taskId)
+	if err != nil {
+		return model.Comment{}, err
+	}
+
+	return result, nil
+}
+
+func (c *Comment) Update(ctx context.Context, comment model.Comment) (model.Comment, error) {
+	result := model.Comment{}
+	err := c.db.Get(&result, updateCommentQuery, comment.Body, comment.UpdatedAt, comment.ID, comment.TaskID)
+	if err != nil {
+		return model.Comment{}, err
+	}
+
+	return result, nil
+}
+
+func (c *Comment) Delete(ctx context.Context, id, taskId int64) error {
+	result, err := c.db.Exec(deleteCommentQuery, id, taskId)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
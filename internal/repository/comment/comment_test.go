@@ -0,0 +1,391 @@
+package comment_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/repository/comment"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	now = time.Date(2023, time.August, 15, 12, 0, 0, 0, time.UTC)
+
+	commentModel = model.Comment{
+		ID:        1,
+		TaskID:    2,
+		UserID:    int64(1),
+		Body:      "looks good to me",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	commentColumns = []string{"id", "task_id", "user_id", "body", "created_at", "updated_at"}
+)
+
+func TestCommentCreate(t *testing.T) {
+	query := `INSERT INTO task_comments
+		(task_id, user_id, body)
+		VALUES ($1, $2, $3)
+		RETURNING id, task_id, user_id, body, created_at, updated_at`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Comment
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(commentColumns).
+					AddRow(commentModel.ID, commentModel.TaskID, commentModel.UserID, commentModel.Body, now, now)
+
+				s.ExpectQuery(query).
+					WithArgs(commentModel.TaskID, commentModel.UserID, commentModel.Body).
+					WillReturnRows(rows)
+			},
+			wantResult: commentModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when create comment",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(commentModel.TaskID, commentModel.UserID, commentModel.Body).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: model.Comment{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := comment.New(db)
+			result, err := r.Create(context.Background(), commentModel)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCommentGetByTaskID(t *testing.T) {
+	query := `SELECT 
+		id, task_id, user_id, body, created_at, updated_at
+		FROM task_comments
+		WHERE task_id = $1 AND id > $2
+		ORDER BY id LIMIT $3`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.Comment
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(commentColumns).
+					AddRow(commentModel.ID, commentModel.TaskID, commentModel.UserID, commentModel.Body, now, now)
+
+				s.ExpectQuery(query).
+					WithArgs(commentModel.TaskID, int64(0), 11).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.Comment{commentModel},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get comments",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(commentModel.TaskID, int64(0), 11).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.Comment{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := comment.New(db)
+			result, err := r.GetByTaskID(context.Background(), commentModel.TaskID, 0, 11)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCommentCount(t *testing.T) {
+	query := `SELECT count(*) FROM task_comments WHERE task_id = $1`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult int64
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"count"}).AddRow(int64(3))
+
+				s.ExpectQuery(query).
+					WithArgs(commentModel.TaskID).
+					WillReturnRows(rows)
+			},
+			wantResult: 3,
+			wantErr:    nil,
+		},
+		{
+			name: "error when count comments",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(commentModel.TaskID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: 0,
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := comment.New(db)
+			result, err := r.Count(context.Background(), commentModel.TaskID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCommentGetByID(t *testing.T) {
+	query := `SELECT 
+		id, task_id, user_id, body, created_at, updated_at
+		FROM task_comments
+		WHERE id = $1 AND task_id = $2`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Comment
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(commentColumns).
+					AddRow(commentModel.ID, commentModel.TaskID, commentModel.UserID, commentModel.Body, now, now)
+
+				s.ExpectQuery(query).
+					WithArgs(commentModel.ID, commentModel.TaskID).
+					WillReturnRows(rows)
+			},
+			wantResult: commentModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when comment is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(commentModel.ID, commentModel.TaskID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantResult: model.Comment{},
+			wantErr:    sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := comment.New(db)
+			result, err := r.GetByID(context.Background(), commentModel.ID, commentModel.TaskID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCommentUpdate(t *testing.T) {
+	query := `UPDATE task_comments
+		SET body = $1, updated_at = $2
+		WHERE id = $3 AND task_id = $4
+		RETURNING id, task_id, user_id, body, created_at, updated_at`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Comment
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(commentColumns).
+					AddRow(commentModel.ID, commentModel.TaskID, commentModel.UserID, commentModel.Body, now, now)
+
+				s.ExpectQuery(query).
+					WithArgs(commentModel.Body, commentModel.UpdatedAt, commentModel.ID, commentModel.TaskID).
+					WillReturnRows(rows)
+			},
+			wantResult: commentModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when update comment",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(commentModel.Body, commentModel.UpdatedAt, commentModel.ID, commentModel.TaskID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: model.Comment{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := comment.New(db)
+			result, err := r.Update(context.Background(), commentModel)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCommentDelete(t *testing.T) {
+	query := `DELETE FROM task_comments WHERE id = $1 AND task_id = $2`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(commentModel.ID, commentModel.TaskID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when comment is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(commentModel.ID, commentModel.TaskID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name: "error when delete comment",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(commentModel.ID, commentModel.TaskID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := comment.New(db)
+			err := r.Delete(context.Background(), commentModel.ID, commentModel.TaskID)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
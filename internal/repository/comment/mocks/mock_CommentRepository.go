@@ -0,0 +1,375 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/rzfhlv/go-task/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCommentRepository is an autogenerated mock type for the CommentRepository type
+type MockCommentRepository struct {
+	mock.Mock
+}
+
+type MockCommentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCommentRepository) EXPECT() *MockCommentRepository_Expecter {
+	return &MockCommentRepository_Expecter{mock: &_m.Mock}
+}
+
+// Count provides a mock function with given fields: ctx, taskId
+func (_m *MockCommentRepository) Count(ctx context.Context, taskId int64) (int64, error) {
+	ret := _m.Called(ctx, taskId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, taskId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, taskId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, taskId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockCommentRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+func (_e *MockCommentRepository_Expecter) Count(ctx interface{}, taskId interface{}) *MockCommentRepository_Count_Call {
+	return &MockCommentRepository_Count_Call{Call: _e.mock.On("Count", ctx, taskId)}
+}
+
+func (_c *MockCommentRepository_Count_Call) Run(run func(ctx context.Context, taskId int64)) *MockCommentRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_Count_Call) Return(_a0 int64, _a1 error) *MockCommentRepository_Count_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentRepository_Count_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockCommentRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *MockCommentRepository) Create(ctx context.Context, _a1 model.Comment) (model.Comment, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Comment) (model.Comment, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Comment) model.Comment); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Comment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Comment) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockCommentRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Comment
+func (_e *MockCommentRepository_Expecter) Create(ctx interface{}, _a1 interface{}) *MockCommentRepository_Create_Call {
+	return &MockCommentRepository_Create_Call{Call: _e.mock.On("Create", ctx, _a1)}
+}
+
+func (_c *MockCommentRepository_Create_Call) Run(run func(ctx context.Context, _a1 model.Comment)) *MockCommentRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Comment))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_Create_Call) Return(_a0 model.Comment, _a1 error) *MockCommentRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentRepository_Create_Call) RunAndReturn(run func(context.Context, model.Comment) (model.Comment, error)) *MockCommentRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, taskId
+func (_m *MockCommentRepository) Delete(ctx context.Context, id int64, taskId int64) error {
+	ret := _m.Called(ctx, id, taskId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, id, taskId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockCommentRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - taskId int64
+func (_e *MockCommentRepository_Expecter) Delete(ctx interface{}, id interface{}, taskId interface{}) *MockCommentRepository_Delete_Call {
+	return &MockCommentRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, taskId)}
+}
+
+func (_c *MockCommentRepository_Delete_Call) Run(run func(ctx context.Context, id int64, taskId int64)) *MockCommentRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_Delete_Call) Return(_a0 error) *MockCommentRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommentRepository_Delete_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockCommentRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id, taskId
+func (_m *MockCommentRepository) GetByID(ctx context.Context, id int64, taskId int64) (model.Comment, error) {
+	ret := _m.Called(ctx, id, taskId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Comment, error)); ok {
+		return rf(ctx, id, taskId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Comment); ok {
+		r0 = rf(ctx, id, taskId)
+	} else {
+		r0 = ret.Get(0).(model.Comment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, taskId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockCommentRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - taskId int64
+func (_e *MockCommentRepository_Expecter) GetByID(ctx interface{}, id interface{}, taskId interface{}) *MockCommentRepository_GetByID_Call {
+	return &MockCommentRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id, taskId)}
+}
+
+func (_c *MockCommentRepository_GetByID_Call) Run(run func(ctx context.Context, id int64, taskId int64)) *MockCommentRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_GetByID_Call) Return(_a0 model.Comment, _a1 error) *MockCommentRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentRepository_GetByID_Call) RunAndReturn(run func(context.Context, int64, int64) (model.Comment, error)) *MockCommentRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTaskID provides a mock function with given fields: ctx, taskId, afterId, limit
+func (_m *MockCommentRepository) GetByTaskID(ctx context.Context, taskId int64, afterId int64, limit int) ([]model.Comment, error) {
+	ret := _m.Called(ctx, taskId, afterId, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTaskID")
+	}
+
+	var r0 []model.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int) ([]model.Comment, error)); ok {
+		return rf(ctx, taskId, afterId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int) []model.Comment); ok {
+		r0 = rf(ctx, taskId, afterId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int) error); ok {
+		r1 = rf(ctx, taskId, afterId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentRepository_GetByTaskID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTaskID'
+type MockCommentRepository_GetByTaskID_Call struct {
+	*mock.Call
+}
+
+// GetByTaskID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+//   - afterId int64
+//   - limit int
+func (_e *MockCommentRepository_Expecter) GetByTaskID(ctx interface{}, taskId interface{}, afterId interface{}, limit interface{}) *MockCommentRepository_GetByTaskID_Call {
+	return &MockCommentRepository_GetByTaskID_Call{Call: _e.mock.On("GetByTaskID", ctx, taskId, afterId, limit)}
+}
+
+func (_c *MockCommentRepository_GetByTaskID_Call) Run(run func(ctx context.Context, taskId int64, afterId int64, limit int)) *MockCommentRepository_GetByTaskID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_GetByTaskID_Call) Return(_a0 []model.Comment, _a1 error) *MockCommentRepository_GetByTaskID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentRepository_GetByTaskID_Call) RunAndReturn(run func(context.Context, int64, int64, int) ([]model.Comment, error)) *MockCommentRepository_GetByTaskID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, _a1
+func (_m *MockCommentRepository) Update(ctx context.Context, _a1 model.Comment) (model.Comment, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Comment) (model.Comment, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Comment) model.Comment); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Comment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Comment) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockCommentRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Comment
+func (_e *MockCommentRepository_Expecter) Update(ctx interface{}, _a1 interface{}) *MockCommentRepository_Update_Call {
+	return &MockCommentRepository_Update_Call{Call: _e.mock.On("Update", ctx, _a1)}
+}
+
+func (_c *MockCommentRepository_Update_Call) Run(run func(ctx context.Context, _a1 model.Comment)) *MockCommentRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Comment))
+	})
+	return _c
+}
+
+func (_c *MockCommentRepository_Update_Call) Return(_a0 model.Comment, _a1 error) *MockCommentRepository_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentRepository_Update_Call) RunAndReturn(run func(context.Context, model.Comment) (model.Comment, error)) *MockCommentRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCommentRepository creates a new instance of MockCommentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCommentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCommentRepository {
+	mock := &MockCommentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
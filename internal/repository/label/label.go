@@ -0,0 +1,128 @@
+package label
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rzfhlv/go-task/internal/model"
+)
+
+var (
+	createLabelQuery = `INSERT INTO labels
+		(name, colour, user_id)
+		VALUES ($1, $2, $3)
+		RETURNING id, name, colour, user_id, created_at, updated_at`
+
+	getLabelByUserIDQuery = `SELECT 
+		id, name, colour, user_id, created_at, updated_at
+		FROM labels
+		WHERE user_id = $1
+		ORDER BY name`
+
+	getLabelByIDQuery = `SELECT 
+		id, name, colour, user_id, created_at, updated_at
+		FROM labels
+		WHERE id = $1 AND user_id = $2`
+
+	updateLabelQuery = `UPDATE labels
+		SET name = $1, colour = $2, updated_at = $3
+		WHERE id = $4 AND user_id = $5
+		RETURNING id, name, colour, user_id, created_at, updated_at`
+
+	deleteLabelQuery = `DELETE FROM labels WHERE id = $1 AND user_id = $2`
+)
+
+const uniqueViolation = "23505"
+
+var ErrLabelExists = errors.New("label already exists")
+
+type LabelRepository interface {
+	Create(ctx context.Context, label model.Label) (model.Label, error)
+	GetByUserID(ctx context.Context, userId int64) ([]model.Label, error)
+	GetByID(ctx context.Context, id, userId int64) (model.Label, error)
+	Update(ctx context.Context, label model.Label) (model.Label, error)
+	Delete(ctx context.Context, id, userId int64) error
+}
+
+type Label struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) LabelRepository {
+	return &Label{
+		db: db,
+	}
+}
+
+func (l *Label) Create(ctx context.Context, label model.Label) (model.Label, error) {
+	result := model.Label{}
+	err := l.db.Get(&result, createLabelQuery, label.Name, label.Colour, label.UserID)
+	if isUniqueViolation(err) {
+		return model.Label{}, ErrLabelExists
+	}
+
+	if err != nil {
+		return model.Label{}, err
+	}
+
+	return result, nil
+}
+
+func (l *Label) GetByUserID(ctx context.Context, userId int64) ([]model.Label, error) {
+	result := []model.Label{}
+	err := l.db.Select(&result, getLabelByUserIDQuery, userId)
+	if err != nil {
+		return []model.Label{}, err
+	}
+
+	return result, nil
+}
+
+func (l *Label) GetByID(ctx context.Context, id, userId int64) (model.Label, error) {
+	result := model.Label{}
+	err := l.db.Get(&result, getLabelByIDQuery, id, userId)
+	if err != nil {
+		return model.Label{}, err
+	}
+
+	return result, nil
+}
+
+func (l *Label) Update(ctx context.Context, label model.Label) (model.Label, error) {
+	result := model.Label{}
+	err := l.db.Get(&result, updateLabelQuery, label.Name, label.Colour, label.UpdatedAt, label.ID, label.UserID)
+	if isUniqueViolation(err) {
+		return model.Label{}, ErrLabelExists
+	}
+
+	if err != nil {
+		return model.Label{}, err
+	}
+
+	return result, nil
+}
+
+func (l *Label) Delete(ctx context.Context, id, userId int64) error {
+	result, err := l.db.Exec(deleteLabelQuery, id, userId)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	var sqlErr interface{ SQLState() string }
+	return errors.As(err, &sqlErr) && sqlErr.SQLState() == uniqueViolation
+}
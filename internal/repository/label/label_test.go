@@ -0,0 +1,366 @@
+package label_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/repository/label"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	now = time.Date(2023, time.August, 15, 12, 0, 0, 0, time.UTC)
+
+	labelModel = model.Label{
+		ID:        1,
+		Name:      "urgent",
+		Colour:    "#ff0000",
+		UserID:    int64(1),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	labelColumns = []string{"id", "name", "colour", "user_id", "created_at", "updated_at"}
+)
+
+type pgError struct {
+	code string
+}
+
+func (e pgError) Error() string {
+	return "pq: duplicate key value violates unique constraint"
+}
+
+func (e pgError) SQLState() string {
+	return e.code
+}
+
+func TestLabelCreate(t *testing.T) {
+	query := `INSERT INTO labels
+		(name, colour, user_id)
+		VALUES ($1, $2, $3)
+		RETURNING id, name, colour, user_id, created_at, updated_at`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Label
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(labelColumns).
+					AddRow(labelModel.ID, labelModel.Name, labelModel.Colour, labelModel.UserID, now, now)
+
+				s.ExpectQuery(query).
+					WithArgs(labelModel.Name, labelModel.Colour, labelModel.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: labelModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when label already exists",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(labelModel.Name, labelModel.Colour, labelModel.UserID).
+					WillReturnError(pgError{code: "23505"})
+			},
+			wantResult: model.Label{},
+			wantErr:    label.ErrLabelExists,
+		},
+		{
+			name: "error when create label",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(labelModel.Name, labelModel.Colour, labelModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: model.Label{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := label.New(db)
+			result, err := r.Create(context.Background(), labelModel)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestLabelGetByUserID(t *testing.T) {
+	query := `SELECT 
+		id, name, colour, user_id, created_at, updated_at
+		FROM labels
+		WHERE user_id = $1
+		ORDER BY name`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.Label
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(labelColumns).
+					AddRow(labelModel.ID, labelModel.Name, labelModel.Colour, labelModel.UserID, now, now)
+
+				s.ExpectQuery(query).
+					WithArgs(labelModel.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.Label{labelModel},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get labels",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(labelModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.Label{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := label.New(db)
+			result, err := r.GetByUserID(context.Background(), labelModel.UserID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestLabelGetByID(t *testing.T) {
+	query := `SELECT 
+		id, name, colour, user_id, created_at, updated_at
+		FROM labels
+		WHERE id = $1 AND user_id = $2`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Label
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(labelColumns).
+					AddRow(labelModel.ID, labelModel.Name, labelModel.Colour, labelModel.UserID, now, now)
+
+				s.ExpectQuery(query).
+					WithArgs(labelModel.ID, labelModel.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: labelModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when label is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(labelModel.ID, labelModel.UserID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantResult: model.Label{},
+			wantErr:    sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := label.New(db)
+			result, err := r.GetByID(context.Background(), labelModel.ID, labelModel.UserID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestLabelUpdate(t *testing.T) {
+	query := `UPDATE labels
+		SET name = $1, colour = $2, updated_at = $3
+		WHERE id = $4 AND user_id = $5
+		RETURNING id, name, colour, user_id, created_at, updated_at`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Label
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(labelColumns).
+					AddRow(labelModel.ID, labelModel.Name, labelModel.Colour, labelModel.UserID, now, now)
+
+				s.ExpectQuery(query).
+					WithArgs(labelModel.Name, labelModel.Colour, labelModel.UpdatedAt, labelModel.ID, labelModel.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: labelModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when label already exists",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(labelModel.Name, labelModel.Colour, labelModel.UpdatedAt, labelModel.ID, labelModel.UserID).
+					WillReturnError(pgError{code: "23505"})
+			},
+			wantResult: model.Label{},
+			wantErr:    label.ErrLabelExists,
+		},
+		{
+			name: "error when update label",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(labelModel.Name, labelModel.Colour, labelModel.UpdatedAt, labelModel.ID, labelModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: model.Label{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := label.New(db)
+			result, err := r.Update(context.Background(), labelModel)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestLabelDelete(t *testing.T) {
+	query := `DELETE FROM labels WHERE id = $1 AND user_id = $2`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(labelModel.ID, labelModel.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when label is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(labelModel.ID, labelModel.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name: "error when delete label",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(labelModel.ID, labelModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := label.New(db)
+			err := r.Delete(context.Background(), labelModel.ID, labelModel.UserID)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,317 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/rzfhlv/go-task/internal/model"
+)
+
+// MockLabelRepository is an autogenerated mock type for the LabelRepository type
+type MockLabelRepository struct {
+	mock.Mock
+}
+
+type MockLabelRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLabelRepository) EXPECT() *MockLabelRepository_Expecter {
+	return &MockLabelRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *MockLabelRepository) Create(ctx context.Context, _a1 model.Label) (model.Label, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Label) (model.Label, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Label) model.Label); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Label)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Label) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLabelRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockLabelRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Label
+func (_e *MockLabelRepository_Expecter) Create(ctx interface{}, _a1 interface{}) *MockLabelRepository_Create_Call {
+	return &MockLabelRepository_Create_Call{Call: _e.mock.On("Create", ctx, _a1)}
+}
+
+func (_c *MockLabelRepository_Create_Call) Run(run func(ctx context.Context, _a1 model.Label)) *MockLabelRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Label))
+	})
+	return _c
+}
+
+func (_c *MockLabelRepository_Create_Call) Return(_a0 model.Label, _a1 error) *MockLabelRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLabelRepository_Create_Call) RunAndReturn(run func(context.Context, model.Label) (model.Label, error)) *MockLabelRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, userId
+func (_m *MockLabelRepository) Delete(ctx context.Context, id int64, userId int64) error {
+	ret := _m.Called(ctx, id, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, id, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLabelRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockLabelRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userId int64
+func (_e *MockLabelRepository_Expecter) Delete(ctx interface{}, id interface{}, userId interface{}) *MockLabelRepository_Delete_Call {
+	return &MockLabelRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, userId)}
+}
+
+func (_c *MockLabelRepository_Delete_Call) Run(run func(ctx context.Context, id int64, userId int64)) *MockLabelRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockLabelRepository_Delete_Call) Return(_a0 error) *MockLabelRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLabelRepository_Delete_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockLabelRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id, userId
+func (_m *MockLabelRepository) GetByID(ctx context.Context, id int64, userId int64) (model.Label, error) {
+	ret := _m.Called(ctx, id, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Label, error)); ok {
+		return rf(ctx, id, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Label); ok {
+		r0 = rf(ctx, id, userId)
+	} else {
+		r0 = ret.Get(0).(model.Label)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLabelRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockLabelRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userId int64
+func (_e *MockLabelRepository_Expecter) GetByID(ctx interface{}, id interface{}, userId interface{}) *MockLabelRepository_GetByID_Call {
+	return &MockLabelRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id, userId)}
+}
+
+func (_c *MockLabelRepository_GetByID_Call) Run(run func(ctx context.Context, id int64, userId int64)) *MockLabelRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockLabelRepository_GetByID_Call) Return(_a0 model.Label, _a1 error) *MockLabelRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLabelRepository_GetByID_Call) RunAndReturn(run func(context.Context, int64, int64) (model.Label, error)) *MockLabelRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUserID provides a mock function with given fields: ctx, userId
+func (_m *MockLabelRepository) GetByUserID(ctx context.Context, userId int64) ([]model.Label, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
+	}
+
+	var r0 []model.Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.Label, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.Label); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLabelRepository_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
+type MockLabelRepository_GetByUserID_Call struct {
+	*mock.Call
+}
+
+// GetByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+func (_e *MockLabelRepository_Expecter) GetByUserID(ctx interface{}, userId interface{}) *MockLabelRepository_GetByUserID_Call {
+	return &MockLabelRepository_GetByUserID_Call{Call: _e.mock.On("GetByUserID", ctx, userId)}
+}
+
+func (_c *MockLabelRepository_GetByUserID_Call) Run(run func(ctx context.Context, userId int64)) *MockLabelRepository_GetByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockLabelRepository_GetByUserID_Call) Return(_a0 []model.Label, _a1 error) *MockLabelRepository_GetByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLabelRepository_GetByUserID_Call) RunAndReturn(run func(context.Context, int64) ([]model.Label, error)) *MockLabelRepository_GetByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, _a1
+func (_m *MockLabelRepository) Update(ctx context.Context, _a1 model.Label) (model.Label, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Label) (model.Label, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Label) model.Label); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Label)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Label) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLabelRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockLabelRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Label
+func (_e *MockLabelRepository_Expecter) Update(ctx interface{}, _a1 interface{}) *MockLabelRepository_Update_Call {
+	return &MockLabelRepository_Update_Call{Call: _e.mock.On("Update", ctx, _a1)}
+}
+
+func (_c *MockLabelRepository_Update_Call) Run(run func(ctx context.Context, _a1 model.Label)) *MockLabelRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Label))
+	})
+	return _c
+}
+
+func (_c *MockLabelRepository_Update_Call) Return(_a0 model.Label, _a1 error) *MockLabelRepository_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLabelRepository_Update_Call) RunAndReturn(run func(context.Context, model.Label) (model.Label, error)) *MockLabelRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLabelRepository creates a new instance of MockLabelRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLabelRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLabelRepository {
+	mock := &MockLabelRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
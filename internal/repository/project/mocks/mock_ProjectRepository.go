@@ -0,0 +1,497 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/rzfhlv/go-task/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	param "github.com/rzfhlv/go-task/pkg/param"
+
+	time "time"
+)
+
+// MockProjectRepository is an autogenerated mock type for the ProjectRepository type
+type MockProjectRepository struct {
+	mock.Mock
+}
+
+type MockProjectRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectRepository) EXPECT() *MockProjectRepository_Expecter {
+	return &MockProjectRepository_Expecter{mock: &_m.Mock}
+}
+
+// Archive provides a mock function with given fields: ctx, id, userId, archivedAt
+func (_m *MockProjectRepository) Archive(ctx context.Context, id int64, userId int64, archivedAt *time.Time) (model.Project, error) {
+	ret := _m.Called(ctx, id, userId, archivedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Archive")
+	}
+
+	var r0 model.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, *time.Time) (model.Project, error)); ok {
+		return rf(ctx, id, userId, archivedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, *time.Time) model.Project); ok {
+		r0 = rf(ctx, id, userId, archivedAt)
+	} else {
+		r0 = ret.Get(0).(model.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, *time.Time) error); ok {
+		r1 = rf(ctx, id, userId, archivedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectRepository_Archive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Archive'
+type MockProjectRepository_Archive_Call struct {
+	*mock.Call
+}
+
+// Archive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userId int64
+//   - archivedAt *time.Time
+func (_e *MockProjectRepository_Expecter) Archive(ctx interface{}, id interface{}, userId interface{}, archivedAt interface{}) *MockProjectRepository_Archive_Call {
+	return &MockProjectRepository_Archive_Call{Call: _e.mock.On("Archive", ctx, id, userId, archivedAt)}
+}
+
+func (_c *MockProjectRepository_Archive_Call) Run(run func(ctx context.Context, id int64, userId int64, archivedAt *time.Time)) *MockProjectRepository_Archive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_Archive_Call) Return(_a0 model.Project, _a1 error) *MockProjectRepository_Archive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectRepository_Archive_Call) RunAndReturn(run func(context.Context, int64, int64, *time.Time) (model.Project, error)) *MockProjectRepository_Archive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Count provides a mock function with given fields: ctx, userId, _a2
+func (_m *MockProjectRepository) Count(ctx context.Context, userId int64, _a2 param.Param) (int64, error) {
+	ret := _m.Called(ctx, userId, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, param.Param) (int64, error)); ok {
+		return rf(ctx, userId, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, param.Param) int64); ok {
+		r0 = rf(ctx, userId, _a2)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, param.Param) error); ok {
+		r1 = rf(ctx, userId, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockProjectRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - _a2 param.Param
+func (_e *MockProjectRepository_Expecter) Count(ctx interface{}, userId interface{}, _a2 interface{}) *MockProjectRepository_Count_Call {
+	return &MockProjectRepository_Count_Call{Call: _e.mock.On("Count", ctx, userId, _a2)}
+}
+
+func (_c *MockProjectRepository_Count_Call) Run(run func(ctx context.Context, userId int64, _a2 param.Param)) *MockProjectRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(param.Param))
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_Count_Call) Return(_a0 int64, _a1 error) *MockProjectRepository_Count_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectRepository_Count_Call) RunAndReturn(run func(context.Context, int64, param.Param) (int64, error)) *MockProjectRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountTasks provides a mock function with given fields: ctx, ids
+func (_m *MockProjectRepository) CountTasks(ctx context.Context, ids []int64) (map[int64]map[string]int64, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountTasks")
+	}
+
+	var r0 map[int64]map[string]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) (map[int64]map[string]int64, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) map[int64]map[string]int64); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]map[string]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectRepository_CountTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountTasks'
+type MockProjectRepository_CountTasks_Call struct {
+	*mock.Call
+}
+
+// CountTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *MockProjectRepository_Expecter) CountTasks(ctx interface{}, ids interface{}) *MockProjectRepository_CountTasks_Call {
+	return &MockProjectRepository_CountTasks_Call{Call: _e.mock.On("CountTasks", ctx, ids)}
+}
+
+func (_c *MockProjectRepository_CountTasks_Call) Run(run func(ctx context.Context, ids []int64)) *MockProjectRepository_CountTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_CountTasks_Call) Return(_a0 map[int64]map[string]int64, _a1 error) *MockProjectRepository_CountTasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectRepository_CountTasks_Call) RunAndReturn(run func(context.Context, []int64) (map[int64]map[string]int64, error)) *MockProjectRepository_CountTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *MockProjectRepository) Create(ctx context.Context, _a1 model.Project) (model.Project, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Project) (model.Project, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Project) model.Project); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Project) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockProjectRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Project
+func (_e *MockProjectRepository_Expecter) Create(ctx interface{}, _a1 interface{}) *MockProjectRepository_Create_Call {
+	return &MockProjectRepository_Create_Call{Call: _e.mock.On("Create", ctx, _a1)}
+}
+
+func (_c *MockProjectRepository_Create_Call) Run(run func(ctx context.Context, _a1 model.Project)) *MockProjectRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Project))
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_Create_Call) Return(_a0 model.Project, _a1 error) *MockProjectRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectRepository_Create_Call) RunAndReturn(run func(context.Context, model.Project) (model.Project, error)) *MockProjectRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, userId
+func (_m *MockProjectRepository) Delete(ctx context.Context, id int64, userId int64) error {
+	ret := _m.Called(ctx, id, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, id, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProjectRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockProjectRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userId int64
+func (_e *MockProjectRepository_Expecter) Delete(ctx interface{}, id interface{}, userId interface{}) *MockProjectRepository_Delete_Call {
+	return &MockProjectRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, userId)}
+}
+
+func (_c *MockProjectRepository_Delete_Call) Run(run func(ctx context.Context, id int64, userId int64)) *MockProjectRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_Delete_Call) Return(_a0 error) *MockProjectRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProjectRepository_Delete_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockProjectRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id, userId
+func (_m *MockProjectRepository) GetByID(ctx context.Context, id int64, userId int64) (model.Project, error) {
+	ret := _m.Called(ctx, id, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Project, error)); ok {
+		return rf(ctx, id, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Project); ok {
+		r0 = rf(ctx, id, userId)
+	} else {
+		r0 = ret.Get(0).(model.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockProjectRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userId int64
+func (_e *MockProjectRepository_Expecter) GetByID(ctx interface{}, id interface{}, userId interface{}) *MockProjectRepository_GetByID_Call {
+	return &MockProjectRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id, userId)}
+}
+
+func (_c *MockProjectRepository_GetByID_Call) Run(run func(ctx context.Context, id int64, userId int64)) *MockProjectRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_GetByID_Call) Return(_a0 model.Project, _a1 error) *MockProjectRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectRepository_GetByID_Call) RunAndReturn(run func(context.Context, int64, int64) (model.Project, error)) *MockProjectRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUserID provides a mock function with given fields: ctx, userId, _a2
+func (_m *MockProjectRepository) GetByUserID(ctx context.Context, userId int64, _a2 param.Param) ([]model.Project, error) {
+	ret := _m.Called(ctx, userId, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
+	}
+
+	var r0 []model.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, param.Param) ([]model.Project, error)); ok {
+		return rf(ctx, userId, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, param.Param) []model.Project); ok {
+		r0 = rf(ctx, userId, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, param.Param) error); ok {
+		r1 = rf(ctx, userId, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectRepository_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
+type MockProjectRepository_GetByUserID_Call struct {
+	*mock.Call
+}
+
+// GetByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - _a2 param.Param
+func (_e *MockProjectRepository_Expecter) GetByUserID(ctx interface{}, userId interface{}, _a2 interface{}) *MockProjectRepository_GetByUserID_Call {
+	return &MockProjectRepository_GetByUserID_Call{Call: _e.mock.On("GetByUserID", ctx, userId, _a2)}
+}
+
+func (_c *MockProjectRepository_GetByUserID_Call) Run(run func(ctx context.Context, userId int64, _a2 param.Param)) *MockProjectRepository_GetByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(param.Param))
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_GetByUserID_Call) Return(_a0 []model.Project, _a1 error) *MockProjectRepository_GetByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectRepository_GetByUserID_Call) RunAndReturn(run func(context.Context, int64, param.Param) ([]model.Project, error)) *MockProjectRepository_GetByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, _a1
+func (_m *MockProjectRepository) Update(ctx context.Context, _a1 model.Project) (model.Project, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Project) (model.Project, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Project) model.Project); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Project) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockProjectRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Project
+func (_e *MockProjectRepository_Expecter) Update(ctx interface{}, _a1 interface{}) *MockProjectRepository_Update_Call {
+	return &MockProjectRepository_Update_Call{Call: _e.mock.On("Update", ctx, _a1)}
+}
+
+func (_c *MockProjectRepository_Update_Call) Run(run func(ctx context.Context, _a1 model.Project)) *MockProjectRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Project))
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_Update_Call) Return(_a0 model.Project, _a1 error) *MockProjectRepository_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectRepository_Update_Call) RunAndReturn(run func(context.Context, model.Project) (model.Project, error)) *MockProjectRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectRepository creates a new instance of MockProjectRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectRepository {
+	mock := &MockProjectRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
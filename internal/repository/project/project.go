@@ -0,0 +1,193 @@
+package project
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/pkg/param"
+)
+
+var (
+	createProjectQuery = `INSERT INTO projects
+		(name, description, user_id)
+		VALUES ($1, $2, $3)
+		RETURNING id, name, description, user_id, archived_at, created_at, updated_at`
+
+	getProjectByUserIDQuery = `SELECT 
+		id, name, description, user_id, archived_at, created_at, updated_at
+		FROM projects
+		WHERE user_id = $1 AND (archived_at IS NOT NULL) = $2
+		ORDER BY id LIMIT $3 OFFSET $4`
+
+	countProjectByUserIDQuery = `SELECT count(*) FROM projects WHERE user_id = $1 AND (archived_at IS NOT NULL) = $2`
+
+	getProjectByIDQuery = `SELECT 
+		id, name, description, user_id, archived_at, created_at, updated_at
+		FROM projects
+		WHERE id = $1 AND user_id = $2`
+
+	updateProjectQuery = `UPDATE projects
+		SET name = $1, description = $2, updated_at = $3
+		WHERE id = $4 AND user_id = $5
+		RETURNING id, name, description, user_id, archived_at, created_at, updated_at`
+
+	archiveProjectQuery = `UPDATE projects
+		SET archived_at = $1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $2 AND user_id = $3
+		RETURNING id, name, description, user_id, archived_at, created_at, updated_at`
+
+	deleteProjectQuery = `DELETE FROM projects WHERE id = $1 AND user_id = $2`
+
+	countProjectTasksQuery = `SELECT project_id, status, count(*) AS total
+		FROM tasks
+		WHERE deleted_at IS NULL AND project_id IN (%s)
+		GROUP BY project_id, status`
+)
+
+const foreignKeyViolation = "23503"
+
+var ErrProjectHasTasks = errors.New("project still has tasks")
+
+type ProjectRepository interface {
+	Create(ctx context.Context, project model.Project) (model.Project, error)
+	GetByUserID(ctx context.Context, userId int64, param param.Param) ([]model.Project, error)
+	Count(ctx context.Context, userId int64, param param.Param) (int64, error)
+	GetByID(ctx context.Context, id, userId int64) (model.Project, error)
+	Update(ctx context.Context, project model.Project) (model.Project, error)
+	Archive(ctx context.Context, id, userId int64, archivedAt *time.Time) (model.Project, error)
+	Delete(ctx context.Context, id, userId int64) error
+	CountTasks(ctx context.Context, ids []int64) (map[int64]map[string]int64, error)
+}
+
+type Project struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) ProjectRepository {
+	return &Project{
+		db: db,
+	}
+}
+
+func (p *Project) Create(ctx context.Context, project model.Project) (model.Project, error) {
+	result := model.Project{}
+	err := p.db.Get(&result, createProjectQuery, project.Name, project.Description, project.UserID)
+	if err != nil {
+		return model.Project{}, err
+	}
+
+	return result, nil
+}
+
+func (p *Project) GetByUserID(ctx context.Context, userId int64, param param.Param) ([]model.Project, error) {
+	result := []model.Project{}
+	err := p.db.Select(&result, getProjectByUserIDQuery, userId, param.Archived, param.Limit, param.CalculateOffset())
+	if err != nil {
+		return []model.Project{}, err
+	}
+
+	return result, nil
+}
+
+func (p *Project) Count(ctx context.Context, userId int64, param param.Param) (int64, error) {
+	var total int64
+	err := p.db.Get(&total, countProjectByUserIDQuery, userId, param.Archived)
+	return total, err
+}
+
+func (p *Project) GetByID(ctx context.Context, id, userId int64) (model.Project, error) {
+	result := model.Project{}
+	err := p.db.Get(&result, getProjectByIDQuery, id, userId)
+	if err != nil {
+		return model.Project{}, err
+	}
+
+	return result, nil
+}
+
+func (p *Project) Update(ctx context.Context, project model.Project) (model.Project, error) {
+	result := model.Project{}
+	err := p.db.Get(&result, updateProjectQuery, project.Name, project.Description, project.UpdatedAt, project.ID, project.UserID)
+	if err != nil {
+		return model.Project{}, err
+	}
+
+	return result, nil
+}
+
+func (p *Project) Archive(ctx context.Context, id, userId int64, archivedAt *time.Time) (model.Project, error) {
+	result := model.Project{}
+	err := p.db.Get(&result, archiveProjectQuery, archivedAt, id, userId)
+	if err != nil {
+		return model.Project{}, err
+	}
+
+	return result, nil
+}
+
+func (p *Project) Delete(ctx context.Context, id, userId int64) error {
+	result, err := p.db.Exec(deleteProjectQuery, id, userId)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return ErrProjectHasTasks
+		}
+
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (p *Project) CountTasks(ctx context.Context, ids []int64) (map[int64]map[string]int64, error) {
+	result := map[int64]map[string]int64{}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	placeholders := make([]string, 0, len(ids))
+	args := make([]any, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+	}
+
+	rows := []struct {
+		ProjectID int64  `db:"project_id"`
+		Status    string `db:"status"`
+		Total     int64  `db:"total"`
+	}{}
+	err := p.db.Select(&rows, fmt.Sprintf(countProjectTasksQuery, strings.Join(placeholders, ", ")), args...)
+	if err != nil {
+		return map[int64]map[string]int64{}, err
+	}
+
+	for _, row := range rows {
+		if result[row.ProjectID] == nil {
+			result[row.ProjectID] = map[string]int64{}
+		}
+
+		result[row.ProjectID][row.Status] = row.Total
+	}
+
+	return result, nil
+}
+
+func isForeignKeyViolation(err error) bool {
+	var sqlErr interface{ SQLState() string }
+	return errors.As(err, &sqlErr) && sqlErr.SQLState() == foreignKeyViolation
+}
@@ -0,0 +1,571 @@
+package project_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/repository/project"
+	"github.com/rzfhlv/go-task/pkg/param"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	now = time.Date(2023, time.August, 15, 12, 0, 0, 0, time.UTC)
+
+	projectModel = model.Project{
+		ID:          1,
+		Name:        "Launch",
+		Description: "q3 launch",
+		UserID:      int64(1),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	projectColumns = []string{"id", "name", "description", "user_id", "archived_at", "created_at", "updated_at"}
+)
+
+type pgError struct {
+	code string
+}
+
+func (e pgError) Error() string {
+	return "pq: update or delete on table violates foreign key constraint"
+}
+
+func (e pgError) SQLState() string {
+	return e.code
+}
+
+func projectRows() *sqlmock.Rows {
+	return sqlmock.NewRows(projectColumns).
+		AddRow(projectModel.ID, projectModel.Name, projectModel.Description, projectModel.UserID, nil, now, now)
+}
+
+func TestProjectCreate(t *testing.T) {
+	query := `INSERT INTO projects
+		(name, description, user_id)
+		VALUES ($1, $2, $3)
+		RETURNING id, name, description, user_id, archived_at, created_at, updated_at`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Project
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(projectModel.Name, projectModel.Description, projectModel.UserID).
+					WillReturnRows(projectRows())
+			},
+			wantResult: projectModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when create project",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(projectModel.Name, projectModel.Description, projectModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: model.Project{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := project.New(db)
+			result, err := r.Create(context.Background(), projectModel)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestProjectGetByUserID(t *testing.T) {
+	query := `SELECT 
+		id, name, description, user_id, archived_at, created_at, updated_at
+		FROM projects
+		WHERE user_id = $1 AND (archived_at IS NOT NULL) = $2
+		ORDER BY id LIMIT $3 OFFSET $4`
+
+	tests := []struct {
+		name       string
+		param      param.Param
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.Project
+		wantErr    error
+	}{
+		{
+			name:  "success",
+			param: param.Param{Page: 1, Limit: 10},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(projectModel.UserID, false, 10, 0).
+					WillReturnRows(projectRows())
+			},
+			wantResult: []model.Project{projectModel},
+			wantErr:    nil,
+		},
+		{
+			name:  "success with archived projects",
+			param: param.Param{Page: 2, Limit: 10, Archived: true},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(projectModel.UserID, true, 10, 10).
+					WillReturnRows(sqlmock.NewRows(projectColumns))
+			},
+			wantResult: []model.Project{},
+			wantErr:    nil,
+		},
+		{
+			name:  "error when get projects",
+			param: param.Param{Page: 1, Limit: 10},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(projectModel.UserID, false, 10, 0).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.Project{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := project.New(db)
+			result, err := r.GetByUserID(context.Background(), projectModel.UserID, tt.param)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestProjectCount(t *testing.T) {
+	query := `SELECT count(*) FROM projects WHERE user_id = $1 AND (archived_at IS NOT NULL) = $2`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult int64
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(projectModel.UserID, false).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(3)))
+			},
+			wantResult: 3,
+			wantErr:    nil,
+		},
+		{
+			name: "error when count",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(projectModel.UserID, false).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: 0,
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := project.New(db)
+			result, err := r.Count(context.Background(), projectModel.UserID, param.Param{Page: 1, Limit: 10})
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestProjectGetByID(t *testing.T) {
+	query := `SELECT 
+		id, name, description, user_id, archived_at, created_at, updated_at
+		FROM projects
+		WHERE id = $1 AND user_id = $2`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Project
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(projectModel.ID, projectModel.UserID).
+					WillReturnRows(projectRows())
+			},
+			wantResult: projectModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when project is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(projectModel.ID, projectModel.UserID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantResult: model.Project{},
+			wantErr:    sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := project.New(db)
+			result, err := r.GetByID(context.Background(), projectModel.ID, projectModel.UserID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestProjectUpdate(t *testing.T) {
+	query := `UPDATE projects
+		SET name = $1, description = $2, updated_at = $3
+		WHERE id = $4 AND user_id = $5
+		RETURNING id, name, description, user_id, archived_at, created_at, updated_at`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Project
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(projectModel.Name, projectModel.Description, projectModel.UpdatedAt, projectModel.ID, projectModel.UserID).
+					WillReturnRows(projectRows())
+			},
+			wantResult: projectModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when update project",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(projectModel.Name, projectModel.Description, projectModel.UpdatedAt, projectModel.ID, projectModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: model.Project{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := project.New(db)
+			result, err := r.Update(context.Background(), projectModel)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestProjectArchive(t *testing.T) {
+	query := `UPDATE projects
+		SET archived_at = $1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $2 AND user_id = $3
+		RETURNING id, name, description, user_id, archived_at, created_at, updated_at`
+
+	archived := projectModel
+	archived.ArchivedAt = &now
+
+	tests := []struct {
+		name       string
+		archivedAt *time.Time
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Project
+		wantErr    error
+	}{
+		{
+			name:       "success archive",
+			archivedAt: &now,
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(projectColumns).
+					AddRow(projectModel.ID, projectModel.Name, projectModel.Description, projectModel.UserID, now, now, now)
+
+				s.ExpectQuery(query).
+					WithArgs(&now, projectModel.ID, projectModel.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: archived,
+			wantErr:    nil,
+		},
+		{
+			name:       "success unarchive",
+			archivedAt: nil,
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(nil, projectModel.ID, projectModel.UserID).
+					WillReturnRows(projectRows())
+			},
+			wantResult: projectModel,
+			wantErr:    nil,
+		},
+		{
+			name:       "error when project is not found",
+			archivedAt: &now,
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(&now, projectModel.ID, projectModel.UserID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantResult: model.Project{},
+			wantErr:    sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := project.New(db)
+			result, err := r.Archive(context.Background(), projectModel.ID, projectModel.UserID, tt.archivedAt)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestProjectDelete(t *testing.T) {
+	query := `DELETE FROM projects WHERE id = $1 AND user_id = $2`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(projectModel.ID, projectModel.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when project is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(projectModel.ID, projectModel.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name: "error when project still has tasks",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(projectModel.ID, projectModel.UserID).
+					WillReturnError(pgError{code: "23503"})
+			},
+			wantErr: project.ErrProjectHasTasks,
+		},
+		{
+			name: "error when delete project",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(projectModel.ID, projectModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := project.New(db)
+			err := r.Delete(context.Background(), projectModel.ID, projectModel.UserID)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestProjectCountTasks(t *testing.T) {
+	query := `SELECT project_id, status, count(*) AS total
+		FROM tasks
+		WHERE deleted_at IS NULL AND project_id IN ($1, $2)
+		GROUP BY project_id, status`
+
+	tests := []struct {
+		name       string
+		ids        []int64
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult map[int64]map[string]int64
+		wantErr    error
+	}{
+		{
+			name: "success",
+			ids:  []int64{1, 2},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"project_id", "status", "total"}).
+					AddRow(int64(1), "todo", int64(3)).
+					AddRow(int64(1), "done", int64(2)).
+					AddRow(int64(2), "in_progress", int64(1))
+
+				s.ExpectQuery(query).
+					WithArgs(int64(1), int64(2)).
+					WillReturnRows(rows)
+			},
+			wantResult: map[int64]map[string]int64{
+				1: {"todo": 3, "done": 2},
+				2: {"in_progress": 1},
+			},
+			wantErr: nil,
+		},
+		{
+			name:       "success with empty ids",
+			ids:        []int64{},
+			wantResult: map[int64]map[string]int64{},
+			wantErr:    nil,
+		},
+		{
+			name: "error when count tasks",
+			ids:  []int64{1, 2},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(int64(1), int64(2)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: map[int64]map[string]int64{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := project.New(db)
+			result, err := r.CountTasks(context.Background(), tt.ids)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,316 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/rzfhlv/go-task/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockReminderRepository is an autogenerated mock type for the ReminderRepository type
+type MockReminderRepository struct {
+	mock.Mock
+}
+
+type MockReminderRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReminderRepository) EXPECT() *MockReminderRepository_Expecter {
+	return &MockReminderRepository_Expecter{mock: &_m.Mock}
+}
+
+// Claim provides a mock function with given fields: ctx, now, lease, maxAttempts, limit
+func (_m *MockReminderRepository) Claim(ctx context.Context, now time.Time, lease time.Duration, maxAttempts int, limit int) ([]model.DueReminder, error) {
+	ret := _m.Called(ctx, now, lease, maxAttempts, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Claim")
+	}
+
+	var r0 []model.DueReminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Duration, int, int) ([]model.DueReminder, error)); ok {
+		return rf(ctx, now, lease, maxAttempts, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Duration, int, int) []model.DueReminder); ok {
+		r0 = rf(ctx, now, lease, maxAttempts, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.DueReminder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Duration, int, int) error); ok {
+		r1 = rf(ctx, now, lease, maxAttempts, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReminderRepository_Claim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Claim'
+type MockReminderRepository_Claim_Call struct {
+	*mock.Call
+}
+
+// Claim is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - lease time.Duration
+//   - maxAttempts int
+//   - limit int
+func (_e *MockReminderRepository_Expecter) Claim(ctx interface{}, now interface{}, lease interface{}, maxAttempts interface{}, limit interface{}) *MockReminderRepository_Claim_Call {
+	return &MockReminderRepository_Claim_Call{Call: _e.mock.On("Claim", ctx, now, lease, maxAttempts, limit)}
+}
+
+func (_c *MockReminderRepository_Claim_Call) Run(run func(ctx context.Context, now time.Time, lease time.Duration, maxAttempts int, limit int)) *MockReminderRepository_Claim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Duration), args[3].(int), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *MockReminderRepository_Claim_Call) Return(_a0 []model.DueReminder, _a1 error) *MockReminderRepository_Claim_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReminderRepository_Claim_Call) RunAndReturn(run func(context.Context, time.Time, time.Duration, int, int) ([]model.DueReminder, error)) *MockReminderRepository_Claim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *MockReminderRepository) Create(ctx context.Context, _a1 model.Reminder) (model.Reminder, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Reminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Reminder) (model.Reminder, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Reminder) model.Reminder); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Reminder)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Reminder) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReminderRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockReminderRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Reminder
+func (_e *MockReminderRepository_Expecter) Create(ctx interface{}, _a1 interface{}) *MockReminderRepository_Create_Call {
+	return &MockReminderRepository_Create_Call{Call: _e.mock.On("Create", ctx, _a1)}
+}
+
+func (_c *MockReminderRepository_Create_Call) Run(run func(ctx context.Context, _a1 model.Reminder)) *MockReminderRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Reminder))
+	})
+	return _c
+}
+
+func (_c *MockReminderRepository_Create_Call) Return(_a0 model.Reminder, _a1 error) *MockReminderRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReminderRepository_Create_Call) RunAndReturn(run func(context.Context, model.Reminder) (model.Reminder, error)) *MockReminderRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, taskId, userId
+func (_m *MockReminderRepository) Delete(ctx context.Context, id int64, taskId int64, userId int64) error {
+	ret := _m.Called(ctx, id, taskId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) error); ok {
+		r0 = rf(ctx, id, taskId, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReminderRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockReminderRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - taskId int64
+//   - userId int64
+func (_e *MockReminderRepository_Expecter) Delete(ctx interface{}, id interface{}, taskId interface{}, userId interface{}) *MockReminderRepository_Delete_Call {
+	return &MockReminderRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, taskId, userId)}
+}
+
+func (_c *MockReminderRepository_Delete_Call) Run(run func(ctx context.Context, id int64, taskId int64, userId int64)) *MockReminderRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockReminderRepository_Delete_Call) Return(_a0 error) *MockReminderRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReminderRepository_Delete_Call) RunAndReturn(run func(context.Context, int64, int64, int64) error) *MockReminderRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTaskID provides a mock function with given fields: ctx, taskId, userId
+func (_m *MockReminderRepository) GetByTaskID(ctx context.Context, taskId int64, userId int64) ([]model.Reminder, error) {
+	ret := _m.Called(ctx, taskId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTaskID")
+	}
+
+	var r0 []model.Reminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]model.Reminder, error)); ok {
+		return rf(ctx, taskId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []model.Reminder); ok {
+		r0 = rf(ctx, taskId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Reminder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, taskId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReminderRepository_GetByTaskID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTaskID'
+type MockReminderRepository_GetByTaskID_Call struct {
+	*mock.Call
+}
+
+// GetByTaskID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+//   - userId int64
+func (_e *MockReminderRepository_Expecter) GetByTaskID(ctx interface{}, taskId interface{}, userId interface{}) *MockReminderRepository_GetByTaskID_Call {
+	return &MockReminderRepository_GetByTaskID_Call{Call: _e.mock.On("GetByTaskID", ctx, taskId, userId)}
+}
+
+func (_c *MockReminderRepository_GetByTaskID_Call) Run(run func(ctx context.Context, taskId int64, userId int64)) *MockReminderRepository_GetByTaskID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockReminderRepository_GetByTaskID_Call) Return(_a0 []model.Reminder, _a1 error) *MockReminderRepository_GetByTaskID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReminderRepository_GetByTaskID_Call) RunAndReturn(run func(context.Context, int64, int64) ([]model.Reminder, error)) *MockReminderRepository_GetByTaskID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSent provides a mock function with given fields: ctx, id, lockedUntil, sentAt
+func (_m *MockReminderRepository) MarkSent(ctx context.Context, id int64, lockedUntil time.Time, sentAt time.Time) error {
+	ret := _m.Called(ctx, id, lockedUntil, sentAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkSent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time) error); ok {
+		r0 = rf(ctx, id, lockedUntil, sentAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReminderRepository_MarkSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSent'
+type MockReminderRepository_MarkSent_Call struct {
+	*mock.Call
+}
+
+// MarkSent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - lockedUntil time.Time
+//   - sentAt time.Time
+func (_e *MockReminderRepository_Expecter) MarkSent(ctx interface{}, id interface{}, lockedUntil interface{}, sentAt interface{}) *MockReminderRepository_MarkSent_Call {
+	return &MockReminderRepository_MarkSent_Call{Call: _e.mock.On("MarkSent", ctx, id, lockedUntil, sentAt)}
+}
+
+func (_c *MockReminderRepository_MarkSent_Call) Run(run func(ctx context.Context, id int64, lockedUntil time.Time, sentAt time.Time)) *MockReminderRepository_MarkSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockReminderRepository_MarkSent_Call) Return(_a0 error) *MockReminderRepository_MarkSent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReminderRepository_MarkSent_Call) RunAndReturn(run func(context.Context, int64, time.Time, time.Time) error) *MockReminderRepository_MarkSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReminderRepository creates a new instance of MockReminderRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReminderRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReminderRepository {
+	mock := &MockReminderRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
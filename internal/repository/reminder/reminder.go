@@ -0,0 +1,137 @@
+package reminder
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rzfhlv/go-task/internal/model"
+)
+
+var (
+	createReminderQuery = `WITH inserted AS (
+		INSERT INTO task_reminders (task_id, user_id, remind_at, offset_minutes)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, task_id, user_id, remind_at, offset_minutes, sent_at, created_at
+	)
+	SELECT inserted.id, inserted.task_id, inserted.user_id, inserted.remind_at, inserted.offset_minutes,
+		COALESCE(inserted.remind_at, tasks.due_at - make_interval(mins => inserted.offset_minutes)) AS fire_at,
+		inserted.sent_at, inserted.created_at
+		FROM inserted JOIN tasks ON tasks.id = inserted.task_id`
+
+	getReminderByTaskIDQuery = `SELECT task_reminders.id, task_reminders.task_id, task_reminders.user_id,
+		task_reminders.remind_at, task_reminders.offset_minutes,
+		COALESCE(task_reminders.remind_at, tasks.due_at - make_interval(mins => task_reminders.offset_minutes)) AS fire_at,
+		task_reminders.sent_at, task_reminders.created_at
+		FROM task_reminders JOIN tasks ON tasks.id = task_reminders.task_id
+		WHERE task_reminders.task_id = $1 AND task_reminders.user_id = $2
+		ORDER BY fire_at NULLS LAST, task_reminders.id`
+
+	deleteReminderQuery = `DELETE FROM task_reminders WHERE id = $1 AND task_id = $2 AND user_id = $3`
+
+	claimReminderQuery = `UPDATE task_reminders
+		SET locked_until = $1, attempts = task_reminders.attempts + 1
+		FROM tasks
+		WHERE tasks.id = task_reminders.task_id AND task_reminders.id IN (
+			SELECT task_reminders.id FROM task_reminders
+			JOIN tasks ON tasks.id = task_reminders.task_id
+			WHERE task_reminders.sent_at IS NULL
+			AND (task_reminders.locked_until IS NULL OR task_reminders.locked_until < $2)
+			AND task_reminders.attempts < $3
+			AND tasks.deleted_at IS NULL AND tasks.status NOT IN ('done', 'cancelled')
+			AND COALESCE(task_reminders.remind_at, tasks.due_at - make_interval(mins => task_reminders.offset_minutes)) <= $2
+			ORDER BY task_reminders.id
+			LIMIT $4
+			FOR UPDATE OF task_reminders SKIP LOCKED
+		)
+		RETURNING task_reminders.id, task_reminders.task_id, task_reminders.user_id, tasks.title, tasks.due_at,
+		COALESCE(task_reminders.remind_at, tasks.due_at - make_interval(mins => task_reminders.offset_minutes)) AS fire_at,
+		task_reminders.attempts, task_reminders.locked_until`
+
+	markReminderSentQuery = `UPDATE task_reminders SET sent_at = $1, locked_until = NULL WHERE id = $2 AND locked_until = $3 AND sent_at IS NULL`
+)
+
+type ReminderRepository interface {
+	Create(ctx context.Context, reminder model.Reminder) (model.Reminder, error)
+	GetByTaskID(ctx context.Context, taskId, userId int64) ([]model.Reminder, error)
+	Delete(ctx context.Context, id, taskId, userId int64) error
+	Claim(ctx context.Context, now time.Time, lease time.Duration, maxAttempts, limit int) ([]model.DueReminder, error)
+	MarkSent(ctx context.Context, id int64, lockedUntil, sentAt time.Time) error
+}
+
+type Reminder struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) ReminderRepository {
+	return &Reminder{
+		db: db,
+	}
+}
+
+func (r *Reminder) Create(ctx context.Context, reminder model.Reminder) (model.Reminder, error) {
+	result := model.Reminder{}
+	err := r.db.Get(&result, createReminderQuery, reminder.TaskID, reminder.UserID, reminder.RemindAt, reminder.OffsetMinutes)
+	if err != nil {
+		return model.Reminder{}, err
+	}
+
+	return result, nil
+}
+
+func (r *Reminder) GetByTaskID(ctx context.Context, taskId, userId int64) ([]model.Reminder, error) {
+	result := []model.Reminder{}
+	err := r.db.Select(&result, getReminderByTaskIDQuery, taskId, userId)
+	if err != nil {
+		return []model.Reminder{}, err
+	}
+
+	return result, nil
+}
+
+func (r *Reminder) Delete(ctx context.Context, id, taskId, userId int64) error {
+	result, err := r.db.Exec(deleteReminderQuery, id, taskId, userId)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *Reminder) Claim(ctx context.Context, now time.Time, lease time.Duration, maxAttempts, limit int) ([]model.DueReminder, error) {
+	result := []model.DueReminder{}
+	err := r.db.Select(&result, claimReminderQuery, now.Add(lease), now, maxAttempts, limit)
+	if err != nil {
+		return []model.DueReminder{}, err
+	}
+
+	return result, nil
+}
+
+func (r *Reminder) MarkSent(ctx context.Context, id int64, lockedUntil, sentAt time.Time) error {
+	result, err := r.db.Exec(markReminderSentQuery, sentAt, id, lockedUntil)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,368 @@
+package reminder_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/repository/reminder"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	now      = time.Date(2023, time.August, 15, 12, 0, 0, 0, time.UTC)
+	remindAt = now.Add(time.Hour)
+
+	reminderModel = model.Reminder{
+		ID:        1,
+		TaskID:    2,
+		UserID:    int64(1),
+		RemindAt:  &remindAt,
+		FireAt:    &remindAt,
+		CreatedAt: now,
+	}
+
+	reminderColumns = []string{"id", "task_id", "user_id", "remind_at", "offset_minutes", "fire_at", "sent_at", "created_at"}
+
+	dueReminderModel = model.DueReminder{
+		ID:          1,
+		TaskID:      2,
+		UserID:      int64(1),
+		Title:       "write report",
+		DueAt:       &remindAt,
+		FireAt:      now,
+		Attempts:    1,
+		LockedUntil: now.Add(time.Minute),
+	}
+
+	dueReminderColumns = []string{"id", "task_id", "user_id", "title", "due_at", "fire_at", "attempts", "locked_until"}
+)
+
+func TestReminderCreate(t *testing.T) {
+	query := `WITH inserted AS (
+		INSERT INTO task_reminders (task_id, user_id, remind_at, offset_minutes)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, task_id, user_id, remind_at, offset_minutes, sent_at, created_at
+	)
+	SELECT inserted.id, inserted.task_id, inserted.user_id, inserted.remind_at, inserted.offset_minutes,
+		COALESCE(inserted.remind_at, tasks.due_at - make_interval(mins => inserted.offset_minutes)) AS fire_at,
+		inserted.sent_at, inserted.created_at
+		FROM inserted JOIN tasks ON tasks.id = inserted.task_id`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Reminder
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(reminderColumns).
+					AddRow(reminderModel.ID, reminderModel.TaskID, reminderModel.UserID, remindAt, nil, remindAt, nil, now)
+
+				s.ExpectQuery(query).
+					WithArgs(reminderModel.TaskID, reminderModel.UserID, reminderModel.RemindAt, reminderModel.OffsetMinutes).
+					WillReturnRows(rows)
+			},
+			wantResult: reminderModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when create reminder",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(reminderModel.TaskID, reminderModel.UserID, reminderModel.RemindAt, reminderModel.OffsetMinutes).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: model.Reminder{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := reminder.New(db)
+			result, err := r.Create(context.Background(), reminderModel)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestReminderGetByTaskID(t *testing.T) {
+	query := `SELECT task_reminders.id, task_reminders.task_id, task_reminders.user_id,
+		task_reminders.remind_at, task_reminders.offset_minutes,
+		COALESCE(task_reminders.remind_at, tasks.due_at - make_interval(mins => task_reminders.offset_minutes)) AS fire_at,
+		task_reminders.sent_at, task_reminders.created_at
+		FROM task_reminders JOIN tasks ON tasks.id = task_reminders.task_id
+		WHERE task_reminders.task_id = $1 AND task_reminders.user_id = $2
+		ORDER BY fire_at NULLS LAST, task_reminders.id`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.Reminder
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(reminderColumns).
+					AddRow(reminderModel.ID, reminderModel.TaskID, reminderModel.UserID, remindAt, nil, remindAt, nil, now)
+
+				s.ExpectQuery(query).
+					WithArgs(reminderModel.TaskID, reminderModel.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.Reminder{reminderModel},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get reminders",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(reminderModel.TaskID, reminderModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.Reminder{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := reminder.New(db)
+			result, err := r.GetByTaskID(context.Background(), reminderModel.TaskID, reminderModel.UserID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestReminderDelete(t *testing.T) {
+	query := `DELETE FROM task_reminders WHERE id = $1 AND task_id = $2 AND user_id = $3`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(reminderModel.ID, reminderModel.TaskID, reminderModel.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when reminder is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(reminderModel.ID, reminderModel.TaskID, reminderModel.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name: "error when delete reminder",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(reminderModel.ID, reminderModel.TaskID, reminderModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := reminder.New(db)
+			err := r.Delete(context.Background(), reminderModel.ID, reminderModel.TaskID, reminderModel.UserID)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestReminderClaim(t *testing.T) {
+	query := `UPDATE task_reminders
+		SET locked_until = $1, attempts = task_reminders.attempts + 1
+		FROM tasks
+		WHERE tasks.id = task_reminders.task_id AND task_reminders.id IN (
+			SELECT task_reminders.id FROM task_reminders
+			JOIN tasks ON tasks.id = task_reminders.task_id
+			WHERE task_reminders.sent_at IS NULL
+			AND (task_reminders.locked_until IS NULL OR task_reminders.locked_until < $2)
+			AND task_reminders.attempts < $3
+			AND tasks.deleted_at IS NULL AND tasks.status NOT IN ('done', 'cancelled')
+			AND COALESCE(task_reminders.remind_at, tasks.due_at - make_interval(mins => task_reminders.offset_minutes)) <= $2
+			ORDER BY task_reminders.id
+			LIMIT $4
+			FOR UPDATE OF task_reminders SKIP LOCKED
+		)
+		RETURNING task_reminders.id, task_reminders.task_id, task_reminders.user_id, tasks.title, tasks.due_at,
+		COALESCE(task_reminders.remind_at, tasks.due_at - make_interval(mins => task_reminders.offset_minutes)) AS fire_at,
+		task_reminders.attempts, task_reminders.locked_until`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.DueReminder
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(dueReminderColumns).
+					AddRow(dueReminderModel.ID, dueReminderModel.TaskID, dueReminderModel.UserID, dueReminderModel.Title, remindAt, now, 1, now.Add(time.Minute))
+
+				s.ExpectQuery(query).
+					WithArgs(now.Add(time.Minute), now, 5, 100).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.DueReminder{dueReminderModel},
+			wantErr:    nil,
+		},
+		{
+			name: "error when claim reminders",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(now.Add(time.Minute), now, 5, 100).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.DueReminder{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := reminder.New(db)
+			result, err := r.Claim(context.Background(), now, time.Minute, 5, 100)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestReminderMarkSent(t *testing.T) {
+	query := `UPDATE task_reminders SET sent_at = $1, locked_until = NULL WHERE id = $2 AND locked_until = $3 AND sent_at IS NULL`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(now, reminderModel.ID, dueReminderModel.LockedUntil).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when lease is lost",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(now, reminderModel.ID, dueReminderModel.LockedUntil).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name: "error when mark reminder sent",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(now, reminderModel.ID, dueReminderModel.LockedUntil).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := reminder.New(db)
+			err := r.MarkSent(context.Background(), reminderModel.ID, dueReminderModel.LockedUntil, now)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
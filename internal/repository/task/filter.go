@@ -0,0 +1,261 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/pkg/cursor"
+	"github.com/rzfhlv/go-task/pkg/param"
+)
+
+const accessCondition = "(user_id = %[1]s AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = %[1]s))"
+
+var (
+	ErrInvalidSort = errors.New("invalid sort field")
+
+	sortColumns = map[string]string{
+		"id":          "id",
+		"title":       "title",
+		"status":      "status",
+		"priority":    "CASE priority WHEN 'low' THEN 1 WHEN 'medium' THEN 2 WHEN 'high' THEN 3 WHEN 'urgent' THEN 4 END",
+		"due_at":      "COALESCE(due_at, 'infinity')",
+		"created_at":  "created_at",
+		"updated_at":  "updated_at",
+		"deleted_at":  "COALESCE(deleted_at, 'infinity')",
+		"assigned_at": "COALESCE(assigned_at, 'infinity')",
+		"position":    "position",
+	}
+)
+
+type sortField struct {
+	name   string
+	column string
+	desc   bool
+}
+
+type filter struct {
+	conditions []string
+	args       []any
+}
+
+func (f *filter) add(condition string, values ...any) {
+	placeholders := make([]any, 0, len(values))
+	for _, value := range values {
+		f.args = append(f.args, value)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(f.args)))
+	}
+
+	f.conditions = append(f.conditions, fmt.Sprintf(condition, placeholders...))
+}
+
+func (f *filter) in(column string, values []any) {
+	if len(values) == 0 {
+		return
+	}
+
+	placeholders := make([]string, 0, len(values))
+	for _, value := range values {
+		f.args = append(f.args, value)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(f.args)))
+	}
+
+	f.conditions = append(f.conditions, fmt.Sprintf("%s IN (%s)", column, strings.Join(placeholders, ", ")))
+}
+
+func (f *filter) labels(names []string, mode string) {
+	if len(names) == 0 {
+		return
+	}
+
+	names = slices.Compact(slices.Sorted(slices.Values(names)))
+	placeholders := make([]string, 0, len(names))
+	for _, name := range names {
+		f.args = append(f.args, name)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(f.args)))
+	}
+
+	// names match any label attached to the task, not only the caller's own,
+	// the same set GetLabels shows on a shared task
+	query := fmt.Sprintf(`SELECT task_labels.task_id FROM task_labels
+		JOIN labels ON labels.id = task_labels.label_id
+		WHERE labels.name IN (%s)`, strings.Join(placeholders, ", "))
+	if mode == model.LabelModeAll {
+		f.args = append(f.args, len(names))
+		query += fmt.Sprintf(" GROUP BY task_labels.task_id HAVING count(DISTINCT labels.name) = $%d", len(f.args))
+	}
+
+	f.conditions = append(f.conditions, fmt.Sprintf("id IN (%s)", query))
+}
+
+func (f *filter) keyset(fields []sortField, c *cursor.Cursor) {
+	desc := len(fields) > 0 && fields[0].desc
+	operator := ">"
+	if desc != c.Backward {
+		operator = "<"
+	}
+
+	if len(fields) == 0 || fields[0].name == "id" {
+		f.add("id "+operator+" %s", c.ID)
+		return
+	}
+
+	f.add(fmt.Sprintf("(%s, id) %s (%%s, %%s)", fields[0].column, operator), c.Value, c.ID)
+}
+
+func anys[T any](values []T) []any {
+	result := make([]any, 0, len(values))
+	for _, value := range values {
+		result = append(result, value)
+	}
+
+	return result
+}
+
+func (f *filter) where() string {
+	if len(f.conditions) == 0 {
+		return ""
+	}
+
+	return "WHERE " + strings.Join(f.conditions, " AND ")
+}
+
+func laneFilter(lane model.TaskLane) *filter {
+	f := &filter{}
+	if lane.WorkspaceID != nil {
+		f.add("workspace_id = %s", *lane.WorkspaceID)
+	} else {
+		f.add("user_id = %s AND workspace_id IS NULL", lane.UserID)
+	}
+
+	f.add("status = %s", lane.Status)
+	f.add("deleted_at IS NULL")
+
+	return f
+}
+
+func buildFilter(userId int64, param param.Param) *filter {
+	f := &filter{}
+	f.add(accessCondition, userId)
+	if param.Trashed {
+		f.add("deleted_at IS NOT NULL")
+	} else {
+		f.add("deleted_at IS NULL")
+	}
+
+	f.in("status", anys(param.Statuses()))
+	f.in("priority", anys(param.Priorities()))
+
+	f.labels(param.LabelNames(), param.LabelMode)
+
+	if param.ParentID != nil {
+		f.add("parent_id = %s", *param.ParentID)
+	}
+
+	if param.ProjectID != nil {
+		f.add("project_id = %s", *param.ProjectID)
+	} else if !param.Trashed {
+		f.add("NOT EXISTS (SELECT 1 FROM projects WHERE projects.id = tasks.project_id AND projects.archived_at IS NOT NULL)")
+	}
+
+	if param.WorkspaceID != nil {
+		f.add("workspace_id = %s", *param.WorkspaceID)
+	}
+
+	if param.AssigneeID != nil {
+		f.add("assignee_id = %s", *param.AssigneeID)
+	} else if param.Unassigned {
+		f.add("assignee_id IS NULL")
+	}
+
+	if param.Q != "" {
+		f.add("search_vector @@ websearch_to_tsquery('english', %s)", param.Q)
+	}
+
+	if param.Overdue {
+		f.add("due_at < CURRENT_TIMESTAMP AND status NOT IN ('done', 'cancelled')")
+	}
+
+	if param.DueBefore != nil {
+		f.add("due_at < %s", *param.DueBefore)
+	}
+
+	if param.DueAfter != nil {
+		f.add("due_at > %s", *param.DueAfter)
+	}
+
+	if param.CreatedBefore != nil {
+		f.add("created_at < %s", *param.CreatedBefore)
+	}
+
+	if param.CreatedAfter != nil {
+		f.add("created_at > %s", *param.CreatedAfter)
+	}
+
+	if param.UpdatedBefore != nil {
+		f.add("updated_at < %s", *param.UpdatedBefore)
+	}
+
+	if param.UpdatedAfter != nil {
+		f.add("updated_at > %s", *param.UpdatedAfter)
+	}
+
+	if param.AssignedAfter != nil {
+		f.add("assigned_at > %s", *param.AssignedAfter)
+	}
+
+	return f
+}
+
+func parseSort(param param.Param) ([]sortField, error) {
+	fields := []sortField{}
+	for _, field := range param.Sorts() {
+		name := strings.TrimPrefix(field, "-")
+		column, ok := sortColumns[name]
+		if !ok {
+			return nil, ErrInvalidSort
+		}
+
+		fields = append(fields, sortField{
+			name:   name,
+			column: column,
+			desc:   strings.HasPrefix(field, "-"),
+		})
+	}
+
+	return fields, nil
+}
+
+func buildOrder(fields []sortField, backward bool) string {
+	orders := []string{}
+	hasID := false
+	desc := false
+	for _, field := range fields {
+		desc = field.desc
+		if field.name == "id" {
+			hasID = true
+		}
+
+		orders = append(orders, fmt.Sprintf("%s %s", field.column, direction(field.desc != backward)))
+	}
+
+	if !hasID {
+		if desc != backward {
+			orders = append(orders, "id DESC")
+		} else {
+			orders = append(orders, "id")
+		}
+	}
+
+	return strings.Join(orders, ", ")
+}
+
+func direction(desc bool) string {
+	if desc {
+		return "DESC"
+	}
+
+	return "ASC"
+}
@@ -9,42 +9,2940 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	param "github.com/rzfhlv/go-task/pkg/param"
+
+	task "github.com/rzfhlv/go-task/internal/repository/task"
+
+	time "time"
 )
 
-// MockTaskRepository is an autogenerated mock type for the TaskRepository type
-type MockTaskRepository struct {
-	mock.Mock
+// MockTaskRepository is an autogenerated mock type for the TaskRepository type
+type MockTaskRepository struct {
+	mock.Mock
+}
+
+type MockTaskRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTaskRepository) EXPECT() *MockTaskRepository_Expecter {
+	return &MockTaskRepository_Expecter{mock: &_m.Mock}
+}
+
+// Ancestors provides a mock function with given fields: ctx, id, userId
+func (_m *MockTaskRepository) Ancestors(ctx context.Context, id int64, userId int64) ([]int64, error) {
+	ret := _m.Called(ctx, id, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ancestors")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]int64, error)); ok {
+		return rf(ctx, id, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []int64); ok {
+		r0 = rf(ctx, id, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_Ancestors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ancestors'
+type MockTaskRepository_Ancestors_Call struct {
+	*mock.Call
+}
+
+// Ancestors is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userId int64
+func (_e *MockTaskRepository_Expecter) Ancestors(ctx interface{}, id interface{}, userId interface{}) *MockTaskRepository_Ancestors_Call {
+	return &MockTaskRepository_Ancestors_Call{Call: _e.mock.On("Ancestors", ctx, id, userId)}
+}
+
+func (_c *MockTaskRepository_Ancestors_Call) Run(run func(ctx context.Context, id int64, userId int64)) *MockTaskRepository_Ancestors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_Ancestors_Call) Return(_a0 []int64, _a1 error) *MockTaskRepository_Ancestors_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_Ancestors_Call) RunAndReturn(run func(context.Context, int64, int64) ([]int64, error)) *MockTaskRepository_Ancestors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AttachLabel provides a mock function with given fields: ctx, id, labelId, userId
+func (_m *MockTaskRepository) AttachLabel(ctx context.Context, id int64, labelId int64, userId int64) error {
+	ret := _m.Called(ctx, id, labelId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) error); ok {
+		r0 = rf(ctx, id, labelId, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_AttachLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachLabel'
+type MockTaskRepository_AttachLabel_Call struct {
+	*mock.Call
+}
+
+// AttachLabel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - labelId int64
+//   - userId int64
+func (_e *MockTaskRepository_Expecter) AttachLabel(ctx interface{}, id interface{}, labelId interface{}, userId interface{}) *MockTaskRepository_AttachLabel_Call {
+	return &MockTaskRepository_AttachLabel_Call{Call: _e.mock.On("AttachLabel", ctx, id, labelId, userId)}
+}
+
+func (_c *MockTaskRepository_AttachLabel_Call) Run(run func(ctx context.Context, id int64, labelId int64, userId int64)) *MockTaskRepository_AttachLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_AttachLabel_Call) Return(_a0 error) *MockTaskRepository_AttachLabel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_AttachLabel_Call) RunAndReturn(run func(context.Context, int64, int64, int64) error) *MockTaskRepository_AttachLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChecklistSummary provides a mock function with given fields: ctx, ids
+func (_m *MockTaskRepository) ChecklistSummary(ctx context.Context, ids []int64) (map[int64]model.TaskChecklistSummary, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChecklistSummary")
+	}
+
+	var r0 map[int64]model.TaskChecklistSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) (map[int64]model.TaskChecklistSummary, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) map[int64]model.TaskChecklistSummary); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]model.TaskChecklistSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_ChecklistSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChecklistSummary'
+type MockTaskRepository_ChecklistSummary_Call struct {
+	*mock.Call
+}
+
+// ChecklistSummary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *MockTaskRepository_Expecter) ChecklistSummary(ctx interface{}, ids interface{}) *MockTaskRepository_ChecklistSummary_Call {
+	return &MockTaskRepository_ChecklistSummary_Call{Call: _e.mock.On("ChecklistSummary", ctx, ids)}
+}
+
+func (_c *MockTaskRepository_ChecklistSummary_Call) Run(run func(ctx context.Context, ids []int64)) *MockTaskRepository_ChecklistSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_ChecklistSummary_Call) Return(_a0 map[int64]model.TaskChecklistSummary, _a1 error) *MockTaskRepository_ChecklistSummary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_ChecklistSummary_Call) RunAndReturn(run func(context.Context, []int64) (map[int64]model.TaskChecklistSummary, error)) *MockTaskRepository_ChecklistSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Count provides a mock function with given fields: ctx, userId, _a2
+func (_m *MockTaskRepository) Count(ctx context.Context, userId int64, _a2 param.Param) (int64, error) {
+	ret := _m.Called(ctx, userId, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, param.Param) (int64, error)); ok {
+		return rf(ctx, userId, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, param.Param) int64); ok {
+		r0 = rf(ctx, userId, _a2)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, param.Param) error); ok {
+		r1 = rf(ctx, userId, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockTaskRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - _a2 param.Param
+func (_e *MockTaskRepository_Expecter) Count(ctx interface{}, userId interface{}, _a2 interface{}) *MockTaskRepository_Count_Call {
+	return &MockTaskRepository_Count_Call{Call: _e.mock.On("Count", ctx, userId, _a2)}
+}
+
+func (_c *MockTaskRepository_Count_Call) Run(run func(ctx context.Context, userId int64, _a2 param.Param)) *MockTaskRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(param.Param))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_Count_Call) Return(_a0 int64, _a1 error) *MockTaskRepository_Count_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_Count_Call) RunAndReturn(run func(context.Context, int64, param.Param) (int64, error)) *MockTaskRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountByStatus provides a mock function with given fields: ctx, userId, _a2
+func (_m *MockTaskRepository) CountByStatus(ctx context.Context, userId int64, _a2 param.Param) (map[string]int64, error) {
+	ret := _m.Called(ctx, userId, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByStatus")
+	}
+
+	var r0 map[string]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, param.Param) (map[string]int64, error)); ok {
+		return rf(ctx, userId, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, param.Param) map[string]int64); ok {
+		r0 = rf(ctx, userId, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, param.Param) error); ok {
+		r1 = rf(ctx, userId, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_CountByStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByStatus'
+type MockTaskRepository_CountByStatus_Call struct {
+	*mock.Call
+}
+
+// CountByStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - _a2 param.Param
+func (_e *MockTaskRepository_Expecter) CountByStatus(ctx interface{}, userId interface{}, _a2 interface{}) *MockTaskRepository_CountByStatus_Call {
+	return &MockTaskRepository_CountByStatus_Call{Call: _e.mock.On("CountByStatus", ctx, userId, _a2)}
+}
+
+func (_c *MockTaskRepository_CountByStatus_Call) Run(run func(ctx context.Context, userId int64, _a2 param.Param)) *MockTaskRepository_CountByStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(param.Param))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_CountByStatus_Call) Return(_a0 map[string]int64, _a1 error) *MockTaskRepository_CountByStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_CountByStatus_Call) RunAndReturn(run func(context.Context, int64, param.Param) (map[string]int64, error)) *MockTaskRepository_CountByStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountComments provides a mock function with given fields: ctx, ids
+func (_m *MockTaskRepository) CountComments(ctx context.Context, ids []int64) (map[int64]int64, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountComments")
+	}
+
+	var r0 map[int64]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) (map[int64]int64, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) map[int64]int64); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_CountComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountComments'
+type MockTaskRepository_CountComments_Call struct {
+	*mock.Call
+}
+
+// CountComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *MockTaskRepository_Expecter) CountComments(ctx interface{}, ids interface{}) *MockTaskRepository_CountComments_Call {
+	return &MockTaskRepository_CountComments_Call{Call: _e.mock.On("CountComments", ctx, ids)}
+}
+
+func (_c *MockTaskRepository_CountComments_Call) Run(run func(ctx context.Context, ids []int64)) *MockTaskRepository_CountComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_CountComments_Call) Return(_a0 map[int64]int64, _a1 error) *MockTaskRepository_CountComments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_CountComments_Call) RunAndReturn(run func(context.Context, []int64) (map[int64]int64, error)) *MockTaskRepository_CountComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountHistory provides a mock function with given fields: ctx, id
+func (_m *MockTaskRepository) CountHistory(ctx context.Context, id int64) (int64, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountHistory")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_CountHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountHistory'
+type MockTaskRepository_CountHistory_Call struct {
+	*mock.Call
+}
+
+// CountHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockTaskRepository_Expecter) CountHistory(ctx interface{}, id interface{}) *MockTaskRepository_CountHistory_Call {
+	return &MockTaskRepository_CountHistory_Call{Call: _e.mock.On("CountHistory", ctx, id)}
+}
+
+func (_c *MockTaskRepository_CountHistory_Call) Run(run func(ctx context.Context, id int64)) *MockTaskRepository_CountHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_CountHistory_Call) Return(_a0 int64, _a1 error) *MockTaskRepository_CountHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_CountHistory_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockTaskRepository_CountHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountOpenBlockers provides a mock function with given fields: ctx, id
+func (_m *MockTaskRepository) CountOpenBlockers(ctx context.Context, id int64) (int64, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountOpenBlockers")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_CountOpenBlockers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountOpenBlockers'
+type MockTaskRepository_CountOpenBlockers_Call struct {
+	*mock.Call
+}
+
+// CountOpenBlockers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockTaskRepository_Expecter) CountOpenBlockers(ctx interface{}, id interface{}) *MockTaskRepository_CountOpenBlockers_Call {
+	return &MockTaskRepository_CountOpenBlockers_Call{Call: _e.mock.On("CountOpenBlockers", ctx, id)}
+}
+
+func (_c *MockTaskRepository_CountOpenBlockers_Call) Run(run func(ctx context.Context, id int64)) *MockTaskRepository_CountOpenBlockers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_CountOpenBlockers_Call) Return(_a0 int64, _a1 error) *MockTaskRepository_CountOpenBlockers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_CountOpenBlockers_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockTaskRepository_CountOpenBlockers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *MockTaskRepository) Create(ctx context.Context, _a1 model.Task) (model.Task, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Task) (model.Task, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Task) model.Task); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Task) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockTaskRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Task
+func (_e *MockTaskRepository_Expecter) Create(ctx interface{}, _a1 interface{}) *MockTaskRepository_Create_Call {
+	return &MockTaskRepository_Create_Call{Call: _e.mock.On("Create", ctx, _a1)}
+}
+
+func (_c *MockTaskRepository_Create_Call) Run(run func(ctx context.Context, _a1 model.Task)) *MockTaskRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Task))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_Create_Call) Return(_a0 model.Task, _a1 error) *MockTaskRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_Create_Call) RunAndReturn(run func(context.Context, model.Task) (model.Task, error)) *MockTaskRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateChecklistItem provides a mock function with given fields: ctx, item
+func (_m *MockTaskRepository) CreateChecklistItem(ctx context.Context, item model.TaskChecklistItem) (model.TaskChecklistItem, error) {
+	ret := _m.Called(ctx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChecklistItem")
+	}
+
+	var r0 model.TaskChecklistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskChecklistItem) (model.TaskChecklistItem, error)); ok {
+		return rf(ctx, item)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskChecklistItem) model.TaskChecklistItem); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Get(0).(model.TaskChecklistItem)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TaskChecklistItem) error); ok {
+		r1 = rf(ctx, item)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_CreateChecklistItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateChecklistItem'
+type MockTaskRepository_CreateChecklistItem_Call struct {
+	*mock.Call
+}
+
+// CreateChecklistItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item model.TaskChecklistItem
+func (_e *MockTaskRepository_Expecter) CreateChecklistItem(ctx interface{}, item interface{}) *MockTaskRepository_CreateChecklistItem_Call {
+	return &MockTaskRepository_CreateChecklistItem_Call{Call: _e.mock.On("CreateChecklistItem", ctx, item)}
+}
+
+func (_c *MockTaskRepository_CreateChecklistItem_Call) Run(run func(ctx context.Context, item model.TaskChecklistItem)) *MockTaskRepository_CreateChecklistItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TaskChecklistItem))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_CreateChecklistItem_Call) Return(_a0 model.TaskChecklistItem, _a1 error) *MockTaskRepository_CreateChecklistItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_CreateChecklistItem_Call) RunAndReturn(run func(context.Context, model.TaskChecklistItem) (model.TaskChecklistItem, error)) *MockTaskRepository_CreateChecklistItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDependency provides a mock function with given fields: ctx, blockerId, blockedId
+func (_m *MockTaskRepository) CreateDependency(ctx context.Context, blockerId int64, blockedId int64) (model.TaskDependency, error) {
+	ret := _m.Called(ctx, blockerId, blockedId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDependency")
+	}
+
+	var r0 model.TaskDependency
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.TaskDependency, error)); ok {
+		return rf(ctx, blockerId, blockedId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.TaskDependency); ok {
+		r0 = rf(ctx, blockerId, blockedId)
+	} else {
+		r0 = ret.Get(0).(model.TaskDependency)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, blockerId, blockedId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_CreateDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDependency'
+type MockTaskRepository_CreateDependency_Call struct {
+	*mock.Call
+}
+
+// CreateDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blockerId int64
+//   - blockedId int64
+func (_e *MockTaskRepository_Expecter) CreateDependency(ctx interface{}, blockerId interface{}, blockedId interface{}) *MockTaskRepository_CreateDependency_Call {
+	return &MockTaskRepository_CreateDependency_Call{Call: _e.mock.On("CreateDependency", ctx, blockerId, blockedId)}
+}
+
+func (_c *MockTaskRepository_CreateDependency_Call) Run(run func(ctx context.Context, blockerId int64, blockedId int64)) *MockTaskRepository_CreateDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_CreateDependency_Call) Return(_a0 model.TaskDependency, _a1 error) *MockTaskRepository_CreateDependency_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_CreateDependency_Call) RunAndReturn(run func(context.Context, int64, int64) (model.TaskDependency, error)) *MockTaskRepository_CreateDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateHistory provides a mock function with given fields: ctx, history
+func (_m *MockTaskRepository) CreateHistory(ctx context.Context, history model.TaskHistory) (model.TaskHistory, error) {
+	ret := _m.Called(ctx, history)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateHistory")
+	}
+
+	var r0 model.TaskHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskHistory) (model.TaskHistory, error)); ok {
+		return rf(ctx, history)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskHistory) model.TaskHistory); ok {
+		r0 = rf(ctx, history)
+	} else {
+		r0 = ret.Get(0).(model.TaskHistory)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TaskHistory) error); ok {
+		r1 = rf(ctx, history)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_CreateHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateHistory'
+type MockTaskRepository_CreateHistory_Call struct {
+	*mock.Call
+}
+
+// CreateHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - history model.TaskHistory
+func (_e *MockTaskRepository_Expecter) CreateHistory(ctx interface{}, history interface{}) *MockTaskRepository_CreateHistory_Call {
+	return &MockTaskRepository_CreateHistory_Call{Call: _e.mock.On("CreateHistory", ctx, history)}
+}
+
+func (_c *MockTaskRepository_CreateHistory_Call) Run(run func(ctx context.Context, history model.TaskHistory)) *MockTaskRepository_CreateHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TaskHistory))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_CreateHistory_Call) Return(_a0 model.TaskHistory, _a1 error) *MockTaskRepository_CreateHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_CreateHistory_Call) RunAndReturn(run func(context.Context, model.TaskHistory) (model.TaskHistory, error)) *MockTaskRepository_CreateHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOccurrence provides a mock function with given fields: ctx, occurrence
+func (_m *MockTaskRepository) CreateOccurrence(ctx context.Context, occurrence model.TaskOccurrence) error {
+	ret := _m.Called(ctx, occurrence)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOccurrence")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskOccurrence) error); ok {
+		r0 = rf(ctx, occurrence)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_CreateOccurrence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOccurrence'
+type MockTaskRepository_CreateOccurrence_Call struct {
+	*mock.Call
+}
+
+// CreateOccurrence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - occurrence model.TaskOccurrence
+func (_e *MockTaskRepository_Expecter) CreateOccurrence(ctx interface{}, occurrence interface{}) *MockTaskRepository_CreateOccurrence_Call {
+	return &MockTaskRepository_CreateOccurrence_Call{Call: _e.mock.On("CreateOccurrence", ctx, occurrence)}
+}
+
+func (_c *MockTaskRepository_CreateOccurrence_Call) Run(run func(ctx context.Context, occurrence model.TaskOccurrence)) *MockTaskRepository_CreateOccurrence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TaskOccurrence))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_CreateOccurrence_Call) Return(_a0 error) *MockTaskRepository_CreateOccurrence_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_CreateOccurrence_Call) RunAndReturn(run func(context.Context, model.TaskOccurrence) error) *MockTaskRepository_CreateOccurrence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSeries provides a mock function with given fields: ctx, series
+func (_m *MockTaskRepository) CreateSeries(ctx context.Context, series model.TaskSeries) (model.TaskSeries, error) {
+	ret := _m.Called(ctx, series)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSeries")
+	}
+
+	var r0 model.TaskSeries
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskSeries) (model.TaskSeries, error)); ok {
+		return rf(ctx, series)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskSeries) model.TaskSeries); ok {
+		r0 = rf(ctx, series)
+	} else {
+		r0 = ret.Get(0).(model.TaskSeries)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TaskSeries) error); ok {
+		r1 = rf(ctx, series)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_CreateSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSeries'
+type MockTaskRepository_CreateSeries_Call struct {
+	*mock.Call
+}
+
+// CreateSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - series model.TaskSeries
+func (_e *MockTaskRepository_Expecter) CreateSeries(ctx interface{}, series interface{}) *MockTaskRepository_CreateSeries_Call {
+	return &MockTaskRepository_CreateSeries_Call{Call: _e.mock.On("CreateSeries", ctx, series)}
+}
+
+func (_c *MockTaskRepository_CreateSeries_Call) Run(run func(ctx context.Context, series model.TaskSeries)) *MockTaskRepository_CreateSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TaskSeries))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_CreateSeries_Call) Return(_a0 model.TaskSeries, _a1 error) *MockTaskRepository_CreateSeries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_CreateSeries_Call) RunAndReturn(run func(context.Context, model.TaskSeries) (model.TaskSeries, error)) *MockTaskRepository_CreateSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, userId, version
+func (_m *MockTaskRepository) Delete(ctx context.Context, id int64, userId int64, version int64) error {
+	ret := _m.Called(ctx, id, userId, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) error); ok {
+		r0 = rf(ctx, id, userId, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockTaskRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userId int64
+//   - version int64
+func (_e *MockTaskRepository_Expecter) Delete(ctx interface{}, id interface{}, userId interface{}, version interface{}) *MockTaskRepository_Delete_Call {
+	return &MockTaskRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, userId, version)}
+}
+
+func (_c *MockTaskRepository_Delete_Call) Run(run func(ctx context.Context, id int64, userId int64, version int64)) *MockTaskRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_Delete_Call) Return(_a0 error) *MockTaskRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_Delete_Call) RunAndReturn(run func(context.Context, int64, int64, int64) error) *MockTaskRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteChecklistItem provides a mock function with given fields: ctx, itemId, id
+func (_m *MockTaskRepository) DeleteChecklistItem(ctx context.Context, itemId int64, id int64) error {
+	ret := _m.Called(ctx, itemId, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteChecklistItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, itemId, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_DeleteChecklistItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteChecklistItem'
+type MockTaskRepository_DeleteChecklistItem_Call struct {
+	*mock.Call
+}
+
+// DeleteChecklistItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemId int64
+//   - id int64
+func (_e *MockTaskRepository_Expecter) DeleteChecklistItem(ctx interface{}, itemId interface{}, id interface{}) *MockTaskRepository_DeleteChecklistItem_Call {
+	return &MockTaskRepository_DeleteChecklistItem_Call{Call: _e.mock.On("DeleteChecklistItem", ctx, itemId, id)}
+}
+
+func (_c *MockTaskRepository_DeleteChecklistItem_Call) Run(run func(ctx context.Context, itemId int64, id int64)) *MockTaskRepository_DeleteChecklistItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_DeleteChecklistItem_Call) Return(_a0 error) *MockTaskRepository_DeleteChecklistItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_DeleteChecklistItem_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockTaskRepository_DeleteChecklistItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDependency provides a mock function with given fields: ctx, blockerId, blockedId, userId
+func (_m *MockTaskRepository) DeleteDependency(ctx context.Context, blockerId int64, blockedId int64, userId int64) error {
+	ret := _m.Called(ctx, blockerId, blockedId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDependency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) error); ok {
+		r0 = rf(ctx, blockerId, blockedId, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_DeleteDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDependency'
+type MockTaskRepository_DeleteDependency_Call struct {
+	*mock.Call
+}
+
+// DeleteDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blockerId int64
+//   - blockedId int64
+//   - userId int64
+func (_e *MockTaskRepository_Expecter) DeleteDependency(ctx interface{}, blockerId interface{}, blockedId interface{}, userId interface{}) *MockTaskRepository_DeleteDependency_Call {
+	return &MockTaskRepository_DeleteDependency_Call{Call: _e.mock.On("DeleteDependency", ctx, blockerId, blockedId, userId)}
+}
+
+func (_c *MockTaskRepository_DeleteDependency_Call) Run(run func(ctx context.Context, blockerId int64, blockedId int64, userId int64)) *MockTaskRepository_DeleteDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_DeleteDependency_Call) Return(_a0 error) *MockTaskRepository_DeleteDependency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_DeleteDependency_Call) RunAndReturn(run func(context.Context, int64, int64, int64) error) *MockTaskRepository_DeleteDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteDescendants provides a mock function with given fields: ctx, id, userId
+func (_m *MockTaskRepository) DeleteDescendants(ctx context.Context, id int64, userId int64) ([]model.Task, error) {
+	ret := _m.Called(ctx, id, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDescendants")
+	}
+
+	var r0 []model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]model.Task, error)); ok {
+		return rf(ctx, id, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []model.Task); ok {
+		r0 = rf(ctx, id, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_DeleteDescendants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDescendants'
+type MockTaskRepository_DeleteDescendants_Call struct {
+	*mock.Call
+}
+
+// DeleteDescendants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userId int64
+func (_e *MockTaskRepository_Expecter) DeleteDescendants(ctx interface{}, id interface{}, userId interface{}) *MockTaskRepository_DeleteDescendants_Call {
+	return &MockTaskRepository_DeleteDescendants_Call{Call: _e.mock.On("DeleteDescendants", ctx, id, userId)}
+}
+
+func (_c *MockTaskRepository_DeleteDescendants_Call) Run(run func(ctx context.Context, id int64, userId int64)) *MockTaskRepository_DeleteDescendants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_DeleteDescendants_Call) Return(_a0 []model.Task, _a1 error) *MockTaskRepository_DeleteDescendants_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_DeleteDescendants_Call) RunAndReturn(run func(context.Context, int64, int64) ([]model.Task, error)) *MockTaskRepository_DeleteDescendants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Destroy provides a mock function with given fields: ctx, id, userId, version
+func (_m *MockTaskRepository) Destroy(ctx context.Context, id int64, userId int64, version int64) error {
+	ret := _m.Called(ctx, id, userId, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Destroy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) error); ok {
+		r0 = rf(ctx, id, userId, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_Destroy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Destroy'
+type MockTaskRepository_Destroy_Call struct {
+	*mock.Call
+}
+
+// Destroy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userId int64
+//   - version int64
+func (_e *MockTaskRepository_Expecter) Destroy(ctx interface{}, id interface{}, userId interface{}, version interface{}) *MockTaskRepository_Destroy_Call {
+	return &MockTaskRepository_Destroy_Call{Call: _e.mock.On("Destroy", ctx, id, userId, version)}
+}
+
+func (_c *MockTaskRepository_Destroy_Call) Run(run func(ctx context.Context, id int64, userId int64, version int64)) *MockTaskRepository_Destroy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_Destroy_Call) Return(_a0 error) *MockTaskRepository_Destroy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_Destroy_Call) RunAndReturn(run func(context.Context, int64, int64, int64) error) *MockTaskRepository_Destroy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DestroyDescendants provides a mock function with given fields: ctx, id, userId
+func (_m *MockTaskRepository) DestroyDescendants(ctx context.Context, id int64, userId int64) error {
+	ret := _m.Called(ctx, id, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DestroyDescendants")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, id, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_DestroyDescendants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DestroyDescendants'
+type MockTaskRepository_DestroyDescendants_Call struct {
+	*mock.Call
+}
+
+// DestroyDescendants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userId int64
+func (_e *MockTaskRepository_Expecter) DestroyDescendants(ctx interface{}, id interface{}, userId interface{}) *MockTaskRepository_DestroyDescendants_Call {
+	return &MockTaskRepository_DestroyDescendants_Call{Call: _e.mock.On("DestroyDescendants", ctx, id, userId)}
+}
+
+func (_c *MockTaskRepository_DestroyDescendants_Call) Run(run func(ctx context.Context, id int64, userId int64)) *MockTaskRepository_DestroyDescendants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_DestroyDescendants_Call) Return(_a0 error) *MockTaskRepository_DestroyDescendants_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_DestroyDescendants_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockTaskRepository_DestroyDescendants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DetachLabel provides a mock function with given fields: ctx, id, labelId, userId
+func (_m *MockTaskRepository) DetachLabel(ctx context.Context, id int64, labelId int64, userId int64) error {
+	ret := _m.Called(ctx, id, labelId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) error); ok {
+		r0 = rf(ctx, id, labelId, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_DetachLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetachLabel'
+type MockTaskRepository_DetachLabel_Call struct {
+	*mock.Call
+}
+
+// DetachLabel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - labelId int64
+//   - userId int64
+func (_e *MockTaskRepository_Expecter) DetachLabel(ctx interface{}, id interface{}, labelId interface{}, userId interface{}) *MockTaskRepository_DetachLabel_Call {
+	return &MockTaskRepository_DetachLabel_Call{Call: _e.mock.On("DetachLabel", ctx, id, labelId, userId)}
+}
+
+func (_c *MockTaskRepository_DetachLabel_Call) Run(run func(ctx context.Context, id int64, labelId int64, userId int64)) *MockTaskRepository_DetachLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_DetachLabel_Call) Return(_a0 error) *MockTaskRepository_DetachLabel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_DetachLabel_Call) RunAndReturn(run func(context.Context, int64, int64, int64) error) *MockTaskRepository_DetachLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBoard provides a mock function with given fields: ctx, userId, _a2, limit
+func (_m *MockTaskRepository) GetBoard(ctx context.Context, userId int64, _a2 param.Param, limit int) ([]model.Task, error) {
+	ret := _m.Called(ctx, userId, _a2, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBoard")
+	}
+
+	var r0 []model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, param.Param, int) ([]model.Task, error)); ok {
+		return rf(ctx, userId, _a2, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, param.Param, int) []model.Task); ok {
+		r0 = rf(ctx, userId, _a2, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, param.Param, int) error); ok {
+		r1 = rf(ctx, userId, _a2, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetBoard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBoard'
+type MockTaskRepository_GetBoard_Call struct {
+	*mock.Call
+}
+
+// GetBoard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - _a2 param.Param
+//   - limit int
+func (_e *MockTaskRepository_Expecter) GetBoard(ctx interface{}, userId interface{}, _a2 interface{}, limit interface{}) *MockTaskRepository_GetBoard_Call {
+	return &MockTaskRepository_GetBoard_Call{Call: _e.mock.On("GetBoard", ctx, userId, _a2, limit)}
+}
+
+func (_c *MockTaskRepository_GetBoard_Call) Run(run func(ctx context.Context, userId int64, _a2 param.Param, limit int)) *MockTaskRepository_GetBoard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(param.Param), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetBoard_Call) Return(_a0 []model.Task, _a1 error) *MockTaskRepository_GetBoard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetBoard_Call) RunAndReturn(run func(context.Context, int64, param.Param, int) ([]model.Task, error)) *MockTaskRepository_GetBoard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBurndownTasks provides a mock function with given fields: ctx, userId, projectId, before
+func (_m *MockTaskRepository) GetBurndownTasks(ctx context.Context, userId int64, projectId *int64, before time.Time) ([]model.Task, error) {
+	ret := _m.Called(ctx, userId, projectId, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBurndownTasks")
+	}
+
+	var r0 []model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *int64, time.Time) ([]model.Task, error)); ok {
+		return rf(ctx, userId, projectId, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *int64, time.Time) []model.Task); ok {
+		r0 = rf(ctx, userId, projectId, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *int64, time.Time) error); ok {
+		r1 = rf(ctx, userId, projectId, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetBurndownTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBurndownTasks'
+type MockTaskRepository_GetBurndownTasks_Call struct {
+	*mock.Call
+}
+
+// GetBurndownTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - projectId *int64
+//   - before time.Time
+func (_e *MockTaskRepository_Expecter) GetBurndownTasks(ctx interface{}, userId interface{}, projectId interface{}, before interface{}) *MockTaskRepository_GetBurndownTasks_Call {
+	return &MockTaskRepository_GetBurndownTasks_Call{Call: _e.mock.On("GetBurndownTasks", ctx, userId, projectId, before)}
+}
+
+func (_c *MockTaskRepository_GetBurndownTasks_Call) Run(run func(ctx context.Context, userId int64, projectId *int64, before time.Time)) *MockTaskRepository_GetBurndownTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*int64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetBurndownTasks_Call) Return(_a0 []model.Task, _a1 error) *MockTaskRepository_GetBurndownTasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetBurndownTasks_Call) RunAndReturn(run func(context.Context, int64, *int64, time.Time) ([]model.Task, error)) *MockTaskRepository_GetBurndownTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id, userId
+func (_m *MockTaskRepository) GetByID(ctx context.Context, id int64, userId int64) (model.Task, error) {
+	ret := _m.Called(ctx, id, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Task, error)); ok {
+		return rf(ctx, id, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Task); ok {
+		r0 = rf(ctx, id, userId)
+	} else {
+		r0 = ret.Get(0).(model.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockTaskRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userId int64
+func (_e *MockTaskRepository_Expecter) GetByID(ctx interface{}, id interface{}, userId interface{}) *MockTaskRepository_GetByID_Call {
+	return &MockTaskRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id, userId)}
+}
+
+func (_c *MockTaskRepository_GetByID_Call) Run(run func(ctx context.Context, id int64, userId int64)) *MockTaskRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetByID_Call) Return(_a0 model.Task, _a1 error) *MockTaskRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetByID_Call) RunAndReturn(run func(context.Context, int64, int64) (model.Task, error)) *MockTaskRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDs provides a mock function with given fields: ctx, ids, userId
+func (_m *MockTaskRepository) GetByIDs(ctx context.Context, ids []int64, userId int64) ([]model.Task, error) {
+	ret := _m.Called(ctx, ids, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 []model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, int64) ([]model.Task, error)); ok {
+		return rf(ctx, ids, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, int64) []model.Task); ok {
+		r0 = rf(ctx, ids, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, int64) error); ok {
+		r1 = rf(ctx, ids, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDs'
+type MockTaskRepository_GetByIDs_Call struct {
+	*mock.Call
+}
+
+// GetByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+//   - userId int64
+func (_e *MockTaskRepository_Expecter) GetByIDs(ctx interface{}, ids interface{}, userId interface{}) *MockTaskRepository_GetByIDs_Call {
+	return &MockTaskRepository_GetByIDs_Call{Call: _e.mock.On("GetByIDs", ctx, ids, userId)}
+}
+
+func (_c *MockTaskRepository_GetByIDs_Call) Run(run func(ctx context.Context, ids []int64, userId int64)) *MockTaskRepository_GetByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetByIDs_Call) Return(_a0 []model.Task, _a1 error) *MockTaskRepository_GetByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetByIDs_Call) RunAndReturn(run func(context.Context, []int64, int64) ([]model.Task, error)) *MockTaskRepository_GetByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByParentID provides a mock function with given fields: ctx, parentId, userId
+func (_m *MockTaskRepository) GetByParentID(ctx context.Context, parentId int64, userId int64) ([]model.Task, error) {
+	ret := _m.Called(ctx, parentId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByParentID")
+	}
+
+	var r0 []model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]model.Task, error)); ok {
+		return rf(ctx, parentId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []model.Task); ok {
+		r0 = rf(ctx, parentId, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, parentId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetByParentID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByParentID'
+type MockTaskRepository_GetByParentID_Call struct {
+	*mock.Call
+}
+
+// GetByParentID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentId int64
+//   - userId int64
+func (_e *MockTaskRepository_Expecter) GetByParentID(ctx interface{}, parentId interface{}, userId interface{}) *MockTaskRepository_GetByParentID_Call {
+	return &MockTaskRepository_GetByParentID_Call{Call: _e.mock.On("GetByParentID", ctx, parentId, userId)}
+}
+
+func (_c *MockTaskRepository_GetByParentID_Call) Run(run func(ctx context.Context, parentId int64, userId int64)) *MockTaskRepository_GetByParentID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetByParentID_Call) Return(_a0 []model.Task, _a1 error) *MockTaskRepository_GetByParentID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetByParentID_Call) RunAndReturn(run func(context.Context, int64, int64) ([]model.Task, error)) *MockTaskRepository_GetByParentID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUserID provides a mock function with given fields: ctx, userId, _a2
+func (_m *MockTaskRepository) GetByUserID(ctx context.Context, userId int64, _a2 param.Param) ([]model.Task, error) {
+	ret := _m.Called(ctx, userId, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
+	}
+
+	var r0 []model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, param.Param) ([]model.Task, error)); ok {
+		return rf(ctx, userId, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, param.Param) []model.Task); ok {
+		r0 = rf(ctx, userId, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, param.Param) error); ok {
+		r1 = rf(ctx, userId, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
+type MockTaskRepository_GetByUserID_Call struct {
+	*mock.Call
+}
+
+// GetByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - _a2 param.Param
+func (_e *MockTaskRepository_Expecter) GetByUserID(ctx interface{}, userId interface{}, _a2 interface{}) *MockTaskRepository_GetByUserID_Call {
+	return &MockTaskRepository_GetByUserID_Call{Call: _e.mock.On("GetByUserID", ctx, userId, _a2)}
+}
+
+func (_c *MockTaskRepository_GetByUserID_Call) Run(run func(ctx context.Context, userId int64, _a2 param.Param)) *MockTaskRepository_GetByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(param.Param))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetByUserID_Call) Return(_a0 []model.Task, _a1 error) *MockTaskRepository_GetByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetByUserID_Call) RunAndReturn(run func(context.Context, int64, param.Param) ([]model.Task, error)) *MockTaskRepository_GetByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetChecklist provides a mock function with given fields: ctx, id
+func (_m *MockTaskRepository) GetChecklist(ctx context.Context, id int64) ([]model.TaskChecklistItem, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChecklist")
+	}
+
+	var r0 []model.TaskChecklistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.TaskChecklistItem, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.TaskChecklistItem); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TaskChecklistItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetChecklist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChecklist'
+type MockTaskRepository_GetChecklist_Call struct {
+	*mock.Call
+}
+
+// GetChecklist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockTaskRepository_Expecter) GetChecklist(ctx interface{}, id interface{}) *MockTaskRepository_GetChecklist_Call {
+	return &MockTaskRepository_GetChecklist_Call{Call: _e.mock.On("GetChecklist", ctx, id)}
+}
+
+func (_c *MockTaskRepository_GetChecklist_Call) Run(run func(ctx context.Context, id int64)) *MockTaskRepository_GetChecklist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetChecklist_Call) Return(_a0 []model.TaskChecklistItem, _a1 error) *MockTaskRepository_GetChecklist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetChecklist_Call) RunAndReturn(run func(context.Context, int64) ([]model.TaskChecklistItem, error)) *MockTaskRepository_GetChecklist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDependenciesByBlockedID provides a mock function with given fields: ctx, ids
+func (_m *MockTaskRepository) GetDependenciesByBlockedID(ctx context.Context, ids []int64) ([]model.TaskDependency, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDependenciesByBlockedID")
+	}
+
+	var r0 []model.TaskDependency
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) ([]model.TaskDependency, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []model.TaskDependency); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TaskDependency)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetDependenciesByBlockedID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDependenciesByBlockedID'
+type MockTaskRepository_GetDependenciesByBlockedID_Call struct {
+	*mock.Call
+}
+
+// GetDependenciesByBlockedID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *MockTaskRepository_Expecter) GetDependenciesByBlockedID(ctx interface{}, ids interface{}) *MockTaskRepository_GetDependenciesByBlockedID_Call {
+	return &MockTaskRepository_GetDependenciesByBlockedID_Call{Call: _e.mock.On("GetDependenciesByBlockedID", ctx, ids)}
+}
+
+func (_c *MockTaskRepository_GetDependenciesByBlockedID_Call) Run(run func(ctx context.Context, ids []int64)) *MockTaskRepository_GetDependenciesByBlockedID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetDependenciesByBlockedID_Call) Return(_a0 []model.TaskDependency, _a1 error) *MockTaskRepository_GetDependenciesByBlockedID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetDependenciesByBlockedID_Call) RunAndReturn(run func(context.Context, []int64) ([]model.TaskDependency, error)) *MockTaskRepository_GetDependenciesByBlockedID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDependenciesByBlockerID provides a mock function with given fields: ctx, ids
+func (_m *MockTaskRepository) GetDependenciesByBlockerID(ctx context.Context, ids []int64) ([]model.TaskDependency, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDependenciesByBlockerID")
+	}
+
+	var r0 []model.TaskDependency
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) ([]model.TaskDependency, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []model.TaskDependency); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TaskDependency)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetDependenciesByBlockerID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDependenciesByBlockerID'
+type MockTaskRepository_GetDependenciesByBlockerID_Call struct {
+	*mock.Call
+}
+
+// GetDependenciesByBlockerID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *MockTaskRepository_Expecter) GetDependenciesByBlockerID(ctx interface{}, ids interface{}) *MockTaskRepository_GetDependenciesByBlockerID_Call {
+	return &MockTaskRepository_GetDependenciesByBlockerID_Call{Call: _e.mock.On("GetDependenciesByBlockerID", ctx, ids)}
+}
+
+func (_c *MockTaskRepository_GetDependenciesByBlockerID_Call) Run(run func(ctx context.Context, ids []int64)) *MockTaskRepository_GetDependenciesByBlockerID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetDependenciesByBlockerID_Call) Return(_a0 []model.TaskDependency, _a1 error) *MockTaskRepository_GetDependenciesByBlockerID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetDependenciesByBlockerID_Call) RunAndReturn(run func(context.Context, []int64) ([]model.TaskDependency, error)) *MockTaskRepository_GetDependenciesByBlockerID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDescendants provides a mock function with given fields: ctx, id, userId
+func (_m *MockTaskRepository) GetDescendants(ctx context.Context, id int64, userId int64) ([]model.Task, error) {
+	ret := _m.Called(ctx, id, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDescendants")
+	}
+
+	var r0 []model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]model.Task, error)); ok {
+		return rf(ctx, id, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []model.Task); ok {
+		r0 = rf(ctx, id, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetDescendants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDescendants'
+type MockTaskRepository_GetDescendants_Call struct {
+	*mock.Call
+}
+
+// GetDescendants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userId int64
+func (_e *MockTaskRepository_Expecter) GetDescendants(ctx interface{}, id interface{}, userId interface{}) *MockTaskRepository_GetDescendants_Call {
+	return &MockTaskRepository_GetDescendants_Call{Call: _e.mock.On("GetDescendants", ctx, id, userId)}
+}
+
+func (_c *MockTaskRepository_GetDescendants_Call) Run(run func(ctx context.Context, id int64, userId int64)) *MockTaskRepository_GetDescendants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetDescendants_Call) Return(_a0 []model.Task, _a1 error) *MockTaskRepository_GetDescendants_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetDescendants_Call) RunAndReturn(run func(context.Context, int64, int64) ([]model.Task, error)) *MockTaskRepository_GetDescendants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFutureOccurrences provides a mock function with given fields: ctx, seriesId, occurrence, userId
+func (_m *MockTaskRepository) GetFutureOccurrences(ctx context.Context, seriesId int64, occurrence int, userId int64) ([]model.Task, error) {
+	ret := _m.Called(ctx, seriesId, occurrence, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFutureOccurrences")
+	}
+
+	var r0 []model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int64) ([]model.Task, error)); ok {
+		return rf(ctx, seriesId, occurrence, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int64) []model.Task); ok {
+		r0 = rf(ctx, seriesId, occurrence, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int, int64) error); ok {
+		r1 = rf(ctx, seriesId, occurrence, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetFutureOccurrences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFutureOccurrences'
+type MockTaskRepository_GetFutureOccurrences_Call struct {
+	*mock.Call
+}
+
+// GetFutureOccurrences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - seriesId int64
+//   - occurrence int
+//   - userId int64
+func (_e *MockTaskRepository_Expecter) GetFutureOccurrences(ctx interface{}, seriesId interface{}, occurrence interface{}, userId interface{}) *MockTaskRepository_GetFutureOccurrences_Call {
+	return &MockTaskRepository_GetFutureOccurrences_Call{Call: _e.mock.On("GetFutureOccurrences", ctx, seriesId, occurrence, userId)}
+}
+
+func (_c *MockTaskRepository_GetFutureOccurrences_Call) Run(run func(ctx context.Context, seriesId int64, occurrence int, userId int64)) *MockTaskRepository_GetFutureOccurrences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetFutureOccurrences_Call) Return(_a0 []model.Task, _a1 error) *MockTaskRepository_GetFutureOccurrences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetFutureOccurrences_Call) RunAndReturn(run func(context.Context, int64, int, int64) ([]model.Task, error)) *MockTaskRepository_GetFutureOccurrences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHistory provides a mock function with given fields: ctx, id, limit, offset
+func (_m *MockTaskRepository) GetHistory(ctx context.Context, id int64, limit int, offset int) ([]model.TaskHistory, error) {
+	ret := _m.Called(ctx, id, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistory")
+	}
+
+	var r0 []model.TaskHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int) ([]model.TaskHistory, error)); ok {
+		return rf(ctx, id, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int) []model.TaskHistory); ok {
+		r0 = rf(ctx, id, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TaskHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int, int) error); ok {
+		r1 = rf(ctx, id, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHistory'
+type MockTaskRepository_GetHistory_Call struct {
+	*mock.Call
+}
+
+// GetHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - limit int
+//   - offset int
+func (_e *MockTaskRepository_Expecter) GetHistory(ctx interface{}, id interface{}, limit interface{}, offset interface{}) *MockTaskRepository_GetHistory_Call {
+	return &MockTaskRepository_GetHistory_Call{Call: _e.mock.On("GetHistory", ctx, id, limit, offset)}
+}
+
+func (_c *MockTaskRepository_GetHistory_Call) Run(run func(ctx context.Context, id int64, limit int, offset int)) *MockTaskRepository_GetHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetHistory_Call) Return(_a0 []model.TaskHistory, _a1 error) *MockTaskRepository_GetHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetHistory_Call) RunAndReturn(run func(context.Context, int64, int, int) ([]model.TaskHistory, error)) *MockTaskRepository_GetHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHistoryByTaskIDs provides a mock function with given fields: ctx, ids, since
+func (_m *MockTaskRepository) GetHistoryByTaskIDs(ctx context.Context, ids []int64, since time.Time) ([]model.TaskHistory, error) {
+	ret := _m.Called(ctx, ids, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistoryByTaskIDs")
+	}
+
+	var r0 []model.TaskHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, time.Time) ([]model.TaskHistory, error)); ok {
+		return rf(ctx, ids, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, time.Time) []model.TaskHistory); ok {
+		r0 = rf(ctx, ids, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TaskHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, time.Time) error); ok {
+		r1 = rf(ctx, ids, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetHistoryByTaskIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHistoryByTaskIDs'
+type MockTaskRepository_GetHistoryByTaskIDs_Call struct {
+	*mock.Call
+}
+
+// GetHistoryByTaskIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+//   - since time.Time
+func (_e *MockTaskRepository_Expecter) GetHistoryByTaskIDs(ctx interface{}, ids interface{}, since interface{}) *MockTaskRepository_GetHistoryByTaskIDs_Call {
+	return &MockTaskRepository_GetHistoryByTaskIDs_Call{Call: _e.mock.On("GetHistoryByTaskIDs", ctx, ids, since)}
+}
+
+func (_c *MockTaskRepository_GetHistoryByTaskIDs_Call) Run(run func(ctx context.Context, ids []int64, since time.Time)) *MockTaskRepository_GetHistoryByTaskIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetHistoryByTaskIDs_Call) Return(_a0 []model.TaskHistory, _a1 error) *MockTaskRepository_GetHistoryByTaskIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetHistoryByTaskIDs_Call) RunAndReturn(run func(context.Context, []int64, time.Time) ([]model.TaskHistory, error)) *MockTaskRepository_GetHistoryByTaskIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHistorySince provides a mock function with given fields: ctx, id, revision
+func (_m *MockTaskRepository) GetHistorySince(ctx context.Context, id int64, revision int64) ([]model.TaskHistory, error) {
+	ret := _m.Called(ctx, id, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistorySince")
+	}
+
+	var r0 []model.TaskHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]model.TaskHistory, error)); ok {
+		return rf(ctx, id, revision)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []model.TaskHistory); ok {
+		r0 = rf(ctx, id, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TaskHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetHistorySince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHistorySince'
+type MockTaskRepository_GetHistorySince_Call struct {
+	*mock.Call
+}
+
+// GetHistorySince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - revision int64
+func (_e *MockTaskRepository_Expecter) GetHistorySince(ctx interface{}, id interface{}, revision interface{}) *MockTaskRepository_GetHistorySince_Call {
+	return &MockTaskRepository_GetHistorySince_Call{Call: _e.mock.On("GetHistorySince", ctx, id, revision)}
+}
+
+func (_c *MockTaskRepository_GetHistorySince_Call) Run(run func(ctx context.Context, id int64, revision int64)) *MockTaskRepository_GetHistorySince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetHistorySince_Call) Return(_a0 []model.TaskHistory, _a1 error) *MockTaskRepository_GetHistorySince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetHistorySince_Call) RunAndReturn(run func(context.Context, int64, int64) ([]model.TaskHistory, error)) *MockTaskRepository_GetHistorySince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLabels provides a mock function with given fields: ctx, ids
+func (_m *MockTaskRepository) GetLabels(ctx context.Context, ids []int64) (map[int64][]model.Label, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLabels")
+	}
+
+	var r0 map[int64][]model.Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) (map[int64][]model.Label, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) map[int64][]model.Label); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64][]model.Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLabels'
+type MockTaskRepository_GetLabels_Call struct {
+	*mock.Call
+}
+
+// GetLabels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *MockTaskRepository_Expecter) GetLabels(ctx interface{}, ids interface{}) *MockTaskRepository_GetLabels_Call {
+	return &MockTaskRepository_GetLabels_Call{Call: _e.mock.On("GetLabels", ctx, ids)}
+}
+
+func (_c *MockTaskRepository_GetLabels_Call) Run(run func(ctx context.Context, ids []int64)) *MockTaskRepository_GetLabels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetLabels_Call) Return(_a0 map[int64][]model.Label, _a1 error) *MockTaskRepository_GetLabels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetLabels_Call) RunAndReturn(run func(context.Context, []int64) (map[int64][]model.Label, error)) *MockTaskRepository_GetLabels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOccurrence provides a mock function with given fields: ctx, id
+func (_m *MockTaskRepository) GetOccurrence(ctx context.Context, id int64) (model.TaskOccurrence, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOccurrence")
+	}
+
+	var r0 model.TaskOccurrence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.TaskOccurrence, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.TaskOccurrence); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.TaskOccurrence)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetOccurrence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOccurrence'
+type MockTaskRepository_GetOccurrence_Call struct {
+	*mock.Call
+}
+
+// GetOccurrence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockTaskRepository_Expecter) GetOccurrence(ctx interface{}, id interface{}) *MockTaskRepository_GetOccurrence_Call {
+	return &MockTaskRepository_GetOccurrence_Call{Call: _e.mock.On("GetOccurrence", ctx, id)}
+}
+
+func (_c *MockTaskRepository_GetOccurrence_Call) Run(run func(ctx context.Context, id int64)) *MockTaskRepository_GetOccurrence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetOccurrence_Call) Return(_a0 model.TaskOccurrence, _a1 error) *MockTaskRepository_GetOccurrence_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetOccurrence_Call) RunAndReturn(run func(context.Context, int64) (model.TaskOccurrence, error)) *MockTaskRepository_GetOccurrence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOccurrences provides a mock function with given fields: ctx, ids
+func (_m *MockTaskRepository) GetOccurrences(ctx context.Context, ids []int64) (map[int64]model.TaskOccurrence, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOccurrences")
+	}
+
+	var r0 map[int64]model.TaskOccurrence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) (map[int64]model.TaskOccurrence, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) map[int64]model.TaskOccurrence); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]model.TaskOccurrence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetOccurrences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOccurrences'
+type MockTaskRepository_GetOccurrences_Call struct {
+	*mock.Call
+}
+
+// GetOccurrences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *MockTaskRepository_Expecter) GetOccurrences(ctx interface{}, ids interface{}) *MockTaskRepository_GetOccurrences_Call {
+	return &MockTaskRepository_GetOccurrences_Call{Call: _e.mock.On("GetOccurrences", ctx, ids)}
+}
+
+func (_c *MockTaskRepository_GetOccurrences_Call) Run(run func(ctx context.Context, ids []int64)) *MockTaskRepository_GetOccurrences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetOccurrences_Call) Return(_a0 map[int64]model.TaskOccurrence, _a1 error) *MockTaskRepository_GetOccurrences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetOccurrences_Call) RunAndReturn(run func(context.Context, []int64) (map[int64]model.TaskOccurrence, error)) *MockTaskRepository_GetOccurrences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProject provides a mock function with given fields: ctx, projectId, userId
+func (_m *MockTaskRepository) GetProject(ctx context.Context, projectId int64, userId int64) (model.Project, error) {
+	ret := _m.Called(ctx, projectId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProject")
+	}
+
+	var r0 model.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Project, error)); ok {
+		return rf(ctx, projectId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Project); ok {
+		r0 = rf(ctx, projectId, userId)
+	} else {
+		r0 = ret.Get(0).(model.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, projectId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProject'
+type MockTaskRepository_GetProject_Call struct {
+	*mock.Call
+}
+
+// GetProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectId int64
+//   - userId int64
+func (_e *MockTaskRepository_Expecter) GetProject(ctx interface{}, projectId interface{}, userId interface{}) *MockTaskRepository_GetProject_Call {
+	return &MockTaskRepository_GetProject_Call{Call: _e.mock.On("GetProject", ctx, projectId, userId)}
+}
+
+func (_c *MockTaskRepository_GetProject_Call) Run(run func(ctx context.Context, projectId int64, userId int64)) *MockTaskRepository_GetProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetProject_Call) Return(_a0 model.Project, _a1 error) *MockTaskRepository_GetProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetProject_Call) RunAndReturn(run func(context.Context, int64, int64) (model.Project, error)) *MockTaskRepository_GetProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPurgeable provides a mock function with given fields: ctx, before
+func (_m *MockTaskRepository) GetPurgeable(ctx context.Context, before time.Time) ([]model.Task, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPurgeable")
+	}
+
+	var r0 []model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]model.Task, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []model.Task); ok {
+		r0 = rf(ctx, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetPurgeable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPurgeable'
+type MockTaskRepository_GetPurgeable_Call struct {
+	*mock.Call
+}
+
+// GetPurgeable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockTaskRepository_Expecter) GetPurgeable(ctx interface{}, before interface{}) *MockTaskRepository_GetPurgeable_Call {
+	return &MockTaskRepository_GetPurgeable_Call{Call: _e.mock.On("GetPurgeable", ctx, before)}
+}
+
+func (_c *MockTaskRepository_GetPurgeable_Call) Run(run func(ctx context.Context, before time.Time)) *MockTaskRepository_GetPurgeable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetPurgeable_Call) Return(_a0 []model.Task, _a1 error) *MockTaskRepository_GetPurgeable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetPurgeable_Call) RunAndReturn(run func(context.Context, time.Time) ([]model.Task, error)) *MockTaskRepository_GetPurgeable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRole provides a mock function with given fields: ctx, workspaceId, userId
+func (_m *MockTaskRepository) GetRole(ctx context.Context, workspaceId int64, userId int64) (string, error) {
+	ret := _m.Called(ctx, workspaceId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRole")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (string, error)); ok {
+		return rf(ctx, workspaceId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) string); ok {
+		r0 = rf(ctx, workspaceId, userId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, workspaceId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRole'
+type MockTaskRepository_GetRole_Call struct {
+	*mock.Call
+}
+
+// GetRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceId int64
+//   - userId int64
+func (_e *MockTaskRepository_Expecter) GetRole(ctx interface{}, workspaceId interface{}, userId interface{}) *MockTaskRepository_GetRole_Call {
+	return &MockTaskRepository_GetRole_Call{Call: _e.mock.On("GetRole", ctx, workspaceId, userId)}
+}
+
+func (_c *MockTaskRepository_GetRole_Call) Run(run func(ctx context.Context, workspaceId int64, userId int64)) *MockTaskRepository_GetRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetRole_Call) Return(_a0 string, _a1 error) *MockTaskRepository_GetRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetRole_Call) RunAndReturn(run func(context.Context, int64, int64) (string, error)) *MockTaskRepository_GetRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSeries provides a mock function with given fields: ctx, id
+func (_m *MockTaskRepository) GetSeries(ctx context.Context, id int64) (model.TaskSeries, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeries")
+	}
+
+	var r0 model.TaskSeries
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.TaskSeries, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.TaskSeries); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.TaskSeries)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeries'
+type MockTaskRepository_GetSeries_Call struct {
+	*mock.Call
+}
+
+// GetSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockTaskRepository_Expecter) GetSeries(ctx interface{}, id interface{}) *MockTaskRepository_GetSeries_Call {
+	return &MockTaskRepository_GetSeries_Call{Call: _e.mock.On("GetSeries", ctx, id)}
+}
+
+func (_c *MockTaskRepository_GetSeries_Call) Run(run func(ctx context.Context, id int64)) *MockTaskRepository_GetSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetSeries_Call) Return(_a0 model.TaskSeries, _a1 error) *MockTaskRepository_GetSeries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetSeries_Call) RunAndReturn(run func(context.Context, int64) (model.TaskSeries, error)) *MockTaskRepository_GetSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrashedByID provides a mock function with given fields: ctx, id, userId
+func (_m *MockTaskRepository) GetTrashedByID(ctx context.Context, id int64, userId int64) (model.Task, error) {
+	ret := _m.Called(ctx, id, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrashedByID")
+	}
+
+	var r0 model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Task, error)); ok {
+		return rf(ctx, id, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Task); ok {
+		r0 = rf(ctx, id, userId)
+	} else {
+		r0 = ret.Get(0).(model.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_GetTrashedByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrashedByID'
+type MockTaskRepository_GetTrashedByID_Call struct {
+	*mock.Call
+}
+
+// GetTrashedByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userId int64
+func (_e *MockTaskRepository_Expecter) GetTrashedByID(ctx interface{}, id interface{}, userId interface{}) *MockTaskRepository_GetTrashedByID_Call {
+	return &MockTaskRepository_GetTrashedByID_Call{Call: _e.mock.On("GetTrashedByID", ctx, id, userId)}
+}
+
+func (_c *MockTaskRepository_GetTrashedByID_Call) Run(run func(ctx context.Context, id int64, userId int64)) *MockTaskRepository_GetTrashedByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_GetTrashedByID_Call) Return(_a0 model.Task, _a1 error) *MockTaskRepository_GetTrashedByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_GetTrashedByID_Call) RunAndReturn(run func(context.Context, int64, int64) (model.Task, error)) *MockTaskRepository_GetTrashedByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LastPosition provides a mock function with given fields: ctx, lane
+func (_m *MockTaskRepository) LastPosition(ctx context.Context, lane model.TaskLane) (string, error) {
+	ret := _m.Called(ctx, lane)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LastPosition")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskLane) (string, error)); ok {
+		return rf(ctx, lane)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskLane) string); ok {
+		r0 = rf(ctx, lane)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TaskLane) error); ok {
+		r1 = rf(ctx, lane)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_LastPosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LastPosition'
+type MockTaskRepository_LastPosition_Call struct {
+	*mock.Call
+}
+
+// LastPosition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lane model.TaskLane
+func (_e *MockTaskRepository_Expecter) LastPosition(ctx interface{}, lane interface{}) *MockTaskRepository_LastPosition_Call {
+	return &MockTaskRepository_LastPosition_Call{Call: _e.mock.On("LastPosition", ctx, lane)}
+}
+
+func (_c *MockTaskRepository_LastPosition_Call) Run(run func(ctx context.Context, lane model.TaskLane)) *MockTaskRepository_LastPosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TaskLane))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_LastPosition_Call) Return(_a0 string, _a1 error) *MockTaskRepository_LastPosition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_LastPosition_Call) RunAndReturn(run func(context.Context, model.TaskLane) (string, error)) *MockTaskRepository_LastPosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockPositions provides a mock function with given fields: ctx, lane
+func (_m *MockTaskRepository) LockPositions(ctx context.Context, lane model.TaskLane) error {
+	ret := _m.Called(ctx, lane)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockPositions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskLane) error); ok {
+		r0 = rf(ctx, lane)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_LockPositions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockPositions'
+type MockTaskRepository_LockPositions_Call struct {
+	*mock.Call
+}
+
+// LockPositions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lane model.TaskLane
+func (_e *MockTaskRepository_Expecter) LockPositions(ctx interface{}, lane interface{}) *MockTaskRepository_LockPositions_Call {
+	return &MockTaskRepository_LockPositions_Call{Call: _e.mock.On("LockPositions", ctx, lane)}
+}
+
+func (_c *MockTaskRepository_LockPositions_Call) Run(run func(ctx context.Context, lane model.TaskLane)) *MockTaskRepository_LockPositions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TaskLane))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_LockPositions_Call) Return(_a0 error) *MockTaskRepository_LockPositions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_LockPositions_Call) RunAndReturn(run func(context.Context, model.TaskLane) error) *MockTaskRepository_LockPositions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveChecklistItem provides a mock function with given fields: ctx, itemId, id, position
+func (_m *MockTaskRepository) MoveChecklistItem(ctx context.Context, itemId int64, id int64, position int) error {
+	ret := _m.Called(ctx, itemId, id, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveChecklistItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int) error); ok {
+		r0 = rf(ctx, itemId, id, position)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_MoveChecklistItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveChecklistItem'
+type MockTaskRepository_MoveChecklistItem_Call struct {
+	*mock.Call
+}
+
+// MoveChecklistItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemId int64
+//   - id int64
+//   - position int
+func (_e *MockTaskRepository_Expecter) MoveChecklistItem(ctx interface{}, itemId interface{}, id interface{}, position interface{}) *MockTaskRepository_MoveChecklistItem_Call {
+	return &MockTaskRepository_MoveChecklistItem_Call{Call: _e.mock.On("MoveChecklistItem", ctx, itemId, id, position)}
+}
+
+func (_c *MockTaskRepository_MoveChecklistItem_Call) Run(run func(ctx context.Context, itemId int64, id int64, position int)) *MockTaskRepository_MoveChecklistItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_MoveChecklistItem_Call) Return(_a0 error) *MockTaskRepository_MoveChecklistItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_MoveChecklistItem_Call) RunAndReturn(run func(context.Context, int64, int64, int) error) *MockTaskRepository_MoveChecklistItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NextPosition provides a mock function with given fields: ctx, lane, position
+func (_m *MockTaskRepository) NextPosition(ctx context.Context, lane model.TaskLane, position string) (string, error) {
+	ret := _m.Called(ctx, lane, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NextPosition")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskLane, string) (string, error)); ok {
+		return rf(ctx, lane, position)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskLane, string) string); ok {
+		r0 = rf(ctx, lane, position)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TaskLane, string) error); ok {
+		r1 = rf(ctx, lane, position)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_NextPosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NextPosition'
+type MockTaskRepository_NextPosition_Call struct {
+	*mock.Call
+}
+
+// NextPosition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lane model.TaskLane
+//   - position string
+func (_e *MockTaskRepository_Expecter) NextPosition(ctx interface{}, lane interface{}, position interface{}) *MockTaskRepository_NextPosition_Call {
+	return &MockTaskRepository_NextPosition_Call{Call: _e.mock.On("NextPosition", ctx, lane, position)}
+}
+
+func (_c *MockTaskRepository_NextPosition_Call) Run(run func(ctx context.Context, lane model.TaskLane, position string)) *MockTaskRepository_NextPosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TaskLane), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_NextPosition_Call) Return(_a0 string, _a1 error) *MockTaskRepository_NextPosition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_NextPosition_Call) RunAndReturn(run func(context.Context, model.TaskLane, string) (string, error)) *MockTaskRepository_NextPosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Patch provides a mock function with given fields: ctx, id, userId, patch
+func (_m *MockTaskRepository) Patch(ctx context.Context, id int64, userId int64, patch model.TaskPatch) (model.Task, error) {
+	ret := _m.Called(ctx, id, userId, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, model.TaskPatch) (model.Task, error)); ok {
+		return rf(ctx, id, userId, patch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, model.TaskPatch) model.Task); ok {
+		r0 = rf(ctx, id, userId, patch)
+	} else {
+		r0 = ret.Get(0).(model.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, model.TaskPatch) error); ok {
+		r1 = rf(ctx, id, userId, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type MockTaskRepository_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userId int64
+//   - patch model.TaskPatch
+func (_e *MockTaskRepository_Expecter) Patch(ctx interface{}, id interface{}, userId interface{}, patch interface{}) *MockTaskRepository_Patch_Call {
+	return &MockTaskRepository_Patch_Call{Call: _e.mock.On("Patch", ctx, id, userId, patch)}
+}
+
+func (_c *MockTaskRepository_Patch_Call) Run(run func(ctx context.Context, id int64, userId int64, patch model.TaskPatch)) *MockTaskRepository_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(model.TaskPatch))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_Patch_Call) Return(_a0 model.Task, _a1 error) *MockTaskRepository_Patch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_Patch_Call) RunAndReturn(run func(context.Context, int64, int64, model.TaskPatch) (model.Task, error)) *MockTaskRepository_Patch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PrevPosition provides a mock function with given fields: ctx, lane, position
+func (_m *MockTaskRepository) PrevPosition(ctx context.Context, lane model.TaskLane, position string) (string, error) {
+	ret := _m.Called(ctx, lane, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrevPosition")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskLane, string) (string, error)); ok {
+		return rf(ctx, lane, position)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskLane, string) string); ok {
+		r0 = rf(ctx, lane, position)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TaskLane, string) error); ok {
+		r1 = rf(ctx, lane, position)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_PrevPosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PrevPosition'
+type MockTaskRepository_PrevPosition_Call struct {
+	*mock.Call
+}
+
+// PrevPosition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lane model.TaskLane
+//   - position string
+func (_e *MockTaskRepository_Expecter) PrevPosition(ctx interface{}, lane interface{}, position interface{}) *MockTaskRepository_PrevPosition_Call {
+	return &MockTaskRepository_PrevPosition_Call{Call: _e.mock.On("PrevPosition", ctx, lane, position)}
+}
+
+func (_c *MockTaskRepository_PrevPosition_Call) Run(run func(ctx context.Context, lane model.TaskLane, position string)) *MockTaskRepository_PrevPosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TaskLane), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_PrevPosition_Call) Return(_a0 string, _a1 error) *MockTaskRepository_PrevPosition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_PrevPosition_Call) RunAndReturn(run func(context.Context, model.TaskLane, string) (string, error)) *MockTaskRepository_PrevPosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Progress provides a mock function with given fields: ctx, ids
+func (_m *MockTaskRepository) Progress(ctx context.Context, ids []int64) (map[int64]model.TaskProgress, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Progress")
+	}
+
+	var r0 map[int64]model.TaskProgress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) (map[int64]model.TaskProgress, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) map[int64]model.TaskProgress); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]model.TaskProgress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_Progress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Progress'
+type MockTaskRepository_Progress_Call struct {
+	*mock.Call
+}
+
+// Progress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *MockTaskRepository_Expecter) Progress(ctx interface{}, ids interface{}) *MockTaskRepository_Progress_Call {
+	return &MockTaskRepository_Progress_Call{Call: _e.mock.On("Progress", ctx, ids)}
+}
+
+func (_c *MockTaskRepository_Progress_Call) Run(run func(ctx context.Context, ids []int64)) *MockTaskRepository_Progress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_Progress_Call) Return(_a0 map[int64]model.TaskProgress, _a1 error) *MockTaskRepository_Progress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_Progress_Call) RunAndReturn(run func(context.Context, []int64) (map[int64]model.TaskProgress, error)) *MockTaskRepository_Progress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function with given fields: ctx, before
+func (_m *MockTaskRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockTaskRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *MockTaskRepository_Expecter) Purge(ctx interface{}, before interface{}) *MockTaskRepository_Purge_Call {
+	return &MockTaskRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, before)}
 }
 
-type MockTaskRepository_Expecter struct {
-	mock *mock.Mock
+func (_c *MockTaskRepository_Purge_Call) Run(run func(ctx context.Context, before time.Time)) *MockTaskRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
 }
 
-func (_m *MockTaskRepository) EXPECT() *MockTaskRepository_Expecter {
-	return &MockTaskRepository_Expecter{mock: &_m.Mock}
+func (_c *MockTaskRepository_Purge_Call) Return(_a0 int64, _a1 error) *MockTaskRepository_Purge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_Purge_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *MockTaskRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
 }
 
-// Count provides a mock function with given fields: ctx
-func (_m *MockTaskRepository) Count(ctx context.Context) (int64, error) {
-	ret := _m.Called(ctx)
+// Reattach provides a mock function with given fields: ctx, id, userId, parentId
+func (_m *MockTaskRepository) Reattach(ctx context.Context, id int64, userId int64, parentId *int64) ([]model.Task, error) {
+	ret := _m.Called(ctx, id, userId, parentId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Count")
+		panic("no return value specified for Reattach")
 	}
 
-	var r0 int64
+	var r0 []model.Task
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, *int64) ([]model.Task, error)); ok {
+		return rf(ctx, id, userId, parentId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, *int64) []model.Task); ok {
+		r0 = rf(ctx, id, userId, parentId)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Task)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, *int64) error); ok {
+		r1 = rf(ctx, id, userId, parentId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -52,55 +2950,60 @@ func (_m *MockTaskRepository) Count(ctx context.Context) (int64, error) {
 	return r0, r1
 }
 
-// MockTaskRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
-type MockTaskRepository_Count_Call struct {
+// MockTaskRepository_Reattach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reattach'
+type MockTaskRepository_Reattach_Call struct {
 	*mock.Call
 }
 
-// Count is a helper method to define mock.On call
+// Reattach is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockTaskRepository_Expecter) Count(ctx interface{}) *MockTaskRepository_Count_Call {
-	return &MockTaskRepository_Count_Call{Call: _e.mock.On("Count", ctx)}
+//   - id int64
+//   - userId int64
+//   - parentId *int64
+func (_e *MockTaskRepository_Expecter) Reattach(ctx interface{}, id interface{}, userId interface{}, parentId interface{}) *MockTaskRepository_Reattach_Call {
+	return &MockTaskRepository_Reattach_Call{Call: _e.mock.On("Reattach", ctx, id, userId, parentId)}
 }
 
-func (_c *MockTaskRepository_Count_Call) Run(run func(ctx context.Context)) *MockTaskRepository_Count_Call {
+func (_c *MockTaskRepository_Reattach_Call) Run(run func(ctx context.Context, id int64, userId int64, parentId *int64)) *MockTaskRepository_Reattach_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(*int64))
 	})
 	return _c
 }
 
-func (_c *MockTaskRepository_Count_Call) Return(_a0 int64, _a1 error) *MockTaskRepository_Count_Call {
+func (_c *MockTaskRepository_Reattach_Call) Return(_a0 []model.Task, _a1 error) *MockTaskRepository_Reattach_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTaskRepository_Count_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockTaskRepository_Count_Call {
+func (_c *MockTaskRepository_Reattach_Call) RunAndReturn(run func(context.Context, int64, int64, *int64) ([]model.Task, error)) *MockTaskRepository_Reattach_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Create provides a mock function with given fields: ctx, _a1
-func (_m *MockTaskRepository) Create(ctx context.Context, _a1 model.Task) (model.Task, error) {
-	ret := _m.Called(ctx, _a1)
+// Reparent provides a mock function with given fields: ctx, id, userId, parentId
+func (_m *MockTaskRepository) Reparent(ctx context.Context, id int64, userId int64, parentId *int64) ([]model.Task, error) {
+	ret := _m.Called(ctx, id, userId, parentId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Reparent")
 	}
 
-	var r0 model.Task
+	var r0 []model.Task
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.Task) (model.Task, error)); ok {
-		return rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, *int64) ([]model.Task, error)); ok {
+		return rf(ctx, id, userId, parentId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, model.Task) model.Task); ok {
-		r0 = rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, *int64) []model.Task); ok {
+		r0 = rf(ctx, id, userId, parentId)
 	} else {
-		r0 = ret.Get(0).(model.Task)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Task)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, model.Task) error); ok {
-		r1 = rf(ctx, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, *int64) error); ok {
+		r1 = rf(ctx, id, userId, parentId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -108,100 +3011,114 @@ func (_m *MockTaskRepository) Create(ctx context.Context, _a1 model.Task) (model
 	return r0, r1
 }
 
-// MockTaskRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockTaskRepository_Create_Call struct {
+// MockTaskRepository_Reparent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reparent'
+type MockTaskRepository_Reparent_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// Reparent is a helper method to define mock.On call
 //   - ctx context.Context
-//   - _a1 model.Task
-func (_e *MockTaskRepository_Expecter) Create(ctx interface{}, _a1 interface{}) *MockTaskRepository_Create_Call {
-	return &MockTaskRepository_Create_Call{Call: _e.mock.On("Create", ctx, _a1)}
+//   - id int64
+//   - userId int64
+//   - parentId *int64
+func (_e *MockTaskRepository_Expecter) Reparent(ctx interface{}, id interface{}, userId interface{}, parentId interface{}) *MockTaskRepository_Reparent_Call {
+	return &MockTaskRepository_Reparent_Call{Call: _e.mock.On("Reparent", ctx, id, userId, parentId)}
 }
 
-func (_c *MockTaskRepository_Create_Call) Run(run func(ctx context.Context, _a1 model.Task)) *MockTaskRepository_Create_Call {
+func (_c *MockTaskRepository_Reparent_Call) Run(run func(ctx context.Context, id int64, userId int64, parentId *int64)) *MockTaskRepository_Reparent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.Task))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(*int64))
 	})
 	return _c
 }
 
-func (_c *MockTaskRepository_Create_Call) Return(_a0 model.Task, _a1 error) *MockTaskRepository_Create_Call {
+func (_c *MockTaskRepository_Reparent_Call) Return(_a0 []model.Task, _a1 error) *MockTaskRepository_Reparent_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTaskRepository_Create_Call) RunAndReturn(run func(context.Context, model.Task) (model.Task, error)) *MockTaskRepository_Create_Call {
+func (_c *MockTaskRepository_Reparent_Call) RunAndReturn(run func(context.Context, int64, int64, *int64) ([]model.Task, error)) *MockTaskRepository_Reparent_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, id, userId
-func (_m *MockTaskRepository) Delete(ctx context.Context, id int64, userId int64) error {
+// Restore provides a mock function with given fields: ctx, id, userId
+func (_m *MockTaskRepository) Restore(ctx context.Context, id int64, userId int64) (model.Task, error) {
 	ret := _m.Called(ctx, id, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for Restore")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+	var r0 model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Task, error)); ok {
+		return rf(ctx, id, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Task); ok {
 		r0 = rf(ctx, id, userId)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(model.Task)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockTaskRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockTaskRepository_Delete_Call struct {
+// MockTaskRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockTaskRepository_Restore_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// Restore is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int64
 //   - userId int64
-func (_e *MockTaskRepository_Expecter) Delete(ctx interface{}, id interface{}, userId interface{}) *MockTaskRepository_Delete_Call {
-	return &MockTaskRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, userId)}
+func (_e *MockTaskRepository_Expecter) Restore(ctx interface{}, id interface{}, userId interface{}) *MockTaskRepository_Restore_Call {
+	return &MockTaskRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id, userId)}
 }
 
-func (_c *MockTaskRepository_Delete_Call) Run(run func(ctx context.Context, id int64, userId int64)) *MockTaskRepository_Delete_Call {
+func (_c *MockTaskRepository_Restore_Call) Run(run func(ctx context.Context, id int64, userId int64)) *MockTaskRepository_Restore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
 	})
 	return _c
 }
 
-func (_c *MockTaskRepository_Delete_Call) Return(_a0 error) *MockTaskRepository_Delete_Call {
-	_c.Call.Return(_a0)
+func (_c *MockTaskRepository_Restore_Call) Return(_a0 model.Task, _a1 error) *MockTaskRepository_Restore_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTaskRepository_Delete_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockTaskRepository_Delete_Call {
+func (_c *MockTaskRepository_Restore_Call) RunAndReturn(run func(context.Context, int64, int64) (model.Task, error)) *MockTaskRepository_Restore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function with given fields: ctx, id, userId
-func (_m *MockTaskRepository) GetByID(ctx context.Context, id int64, userId int64) (model.Task, error) {
+// RestoreDescendants provides a mock function with given fields: ctx, id, userId
+func (_m *MockTaskRepository) RestoreDescendants(ctx context.Context, id int64, userId int64) ([]model.Task, error) {
 	ret := _m.Called(ctx, id, userId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for RestoreDescendants")
 	}
 
-	var r0 model.Task
+	var r0 []model.Task
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Task, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]model.Task, error)); ok {
 		return rf(ctx, id, userId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Task); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []model.Task); ok {
 		r0 = rf(ctx, id, userId)
 	} else {
-		r0 = ret.Get(0).(model.Task)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Task)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
@@ -213,59 +3130,117 @@ func (_m *MockTaskRepository) GetByID(ctx context.Context, id int64, userId int6
 	return r0, r1
 }
 
-// MockTaskRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockTaskRepository_GetByID_Call struct {
+// MockTaskRepository_RestoreDescendants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreDescendants'
+type MockTaskRepository_RestoreDescendants_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// RestoreDescendants is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int64
 //   - userId int64
-func (_e *MockTaskRepository_Expecter) GetByID(ctx interface{}, id interface{}, userId interface{}) *MockTaskRepository_GetByID_Call {
-	return &MockTaskRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id, userId)}
+func (_e *MockTaskRepository_Expecter) RestoreDescendants(ctx interface{}, id interface{}, userId interface{}) *MockTaskRepository_RestoreDescendants_Call {
+	return &MockTaskRepository_RestoreDescendants_Call{Call: _e.mock.On("RestoreDescendants", ctx, id, userId)}
 }
 
-func (_c *MockTaskRepository_GetByID_Call) Run(run func(ctx context.Context, id int64, userId int64)) *MockTaskRepository_GetByID_Call {
+func (_c *MockTaskRepository_RestoreDescendants_Call) Run(run func(ctx context.Context, id int64, userId int64)) *MockTaskRepository_RestoreDescendants_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
 	})
 	return _c
 }
 
-func (_c *MockTaskRepository_GetByID_Call) Return(_a0 model.Task, _a1 error) *MockTaskRepository_GetByID_Call {
+func (_c *MockTaskRepository_RestoreDescendants_Call) Return(_a0 []model.Task, _a1 error) *MockTaskRepository_RestoreDescendants_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTaskRepository_GetByID_Call) RunAndReturn(run func(context.Context, int64, int64) (model.Task, error)) *MockTaskRepository_GetByID_Call {
+func (_c *MockTaskRepository_RestoreDescendants_Call) RunAndReturn(run func(context.Context, int64, int64) ([]model.Task, error)) *MockTaskRepository_RestoreDescendants_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByUserID provides a mock function with given fields: ctx, userId, _a2
-func (_m *MockTaskRepository) GetByUserID(ctx context.Context, userId int64, _a2 param.Param) ([]model.Task, error) {
-	ret := _m.Called(ctx, userId, _a2)
+// ToggleChecklistItem provides a mock function with given fields: ctx, itemId, id
+func (_m *MockTaskRepository) ToggleChecklistItem(ctx context.Context, itemId int64, id int64) (model.TaskChecklistItem, error) {
+	ret := _m.Called(ctx, itemId, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByUserID")
+		panic("no return value specified for ToggleChecklistItem")
 	}
 
-	var r0 []model.Task
+	var r0 model.TaskChecklistItem
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, param.Param) ([]model.Task, error)); ok {
-		return rf(ctx, userId, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.TaskChecklistItem, error)); ok {
+		return rf(ctx, itemId, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, param.Param) []model.Task); ok {
-		r0 = rf(ctx, userId, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.TaskChecklistItem); ok {
+		r0 = rf(ctx, itemId, id)
+	} else {
+		r0 = ret.Get(0).(model.TaskChecklistItem)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, itemId, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_ToggleChecklistItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToggleChecklistItem'
+type MockTaskRepository_ToggleChecklistItem_Call struct {
+	*mock.Call
+}
+
+// ToggleChecklistItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemId int64
+//   - id int64
+func (_e *MockTaskRepository_Expecter) ToggleChecklistItem(ctx interface{}, itemId interface{}, id interface{}) *MockTaskRepository_ToggleChecklistItem_Call {
+	return &MockTaskRepository_ToggleChecklistItem_Call{Call: _e.mock.On("ToggleChecklistItem", ctx, itemId, id)}
+}
+
+func (_c *MockTaskRepository_ToggleChecklistItem_Call) Run(run func(ctx context.Context, itemId int64, id int64)) *MockTaskRepository_ToggleChecklistItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_ToggleChecklistItem_Call) Return(_a0 model.TaskChecklistItem, _a1 error) *MockTaskRepository_ToggleChecklistItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_ToggleChecklistItem_Call) RunAndReturn(run func(context.Context, int64, int64) (model.TaskChecklistItem, error)) *MockTaskRepository_ToggleChecklistItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TrackedTime provides a mock function with given fields: ctx, ids
+func (_m *MockTaskRepository) TrackedTime(ctx context.Context, ids []int64) (map[int64]int64, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrackedTime")
+	}
+
+	var r0 map[int64]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) (map[int64]int64, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) map[int64]int64); ok {
+		r0 = rf(ctx, ids)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Task)
+			r0 = ret.Get(0).(map[int64]int64)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, param.Param) error); ok {
-		r1 = rf(ctx, userId, _a2)
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -273,32 +3248,31 @@ func (_m *MockTaskRepository) GetByUserID(ctx context.Context, userId int64, _a2
 	return r0, r1
 }
 
-// MockTaskRepository_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
-type MockTaskRepository_GetByUserID_Call struct {
+// MockTaskRepository_TrackedTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrackedTime'
+type MockTaskRepository_TrackedTime_Call struct {
 	*mock.Call
 }
 
-// GetByUserID is a helper method to define mock.On call
+// TrackedTime is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userId int64
-//   - _a2 param.Param
-func (_e *MockTaskRepository_Expecter) GetByUserID(ctx interface{}, userId interface{}, _a2 interface{}) *MockTaskRepository_GetByUserID_Call {
-	return &MockTaskRepository_GetByUserID_Call{Call: _e.mock.On("GetByUserID", ctx, userId, _a2)}
+//   - ids []int64
+func (_e *MockTaskRepository_Expecter) TrackedTime(ctx interface{}, ids interface{}) *MockTaskRepository_TrackedTime_Call {
+	return &MockTaskRepository_TrackedTime_Call{Call: _e.mock.On("TrackedTime", ctx, ids)}
 }
 
-func (_c *MockTaskRepository_GetByUserID_Call) Run(run func(ctx context.Context, userId int64, _a2 param.Param)) *MockTaskRepository_GetByUserID_Call {
+func (_c *MockTaskRepository_TrackedTime_Call) Run(run func(ctx context.Context, ids []int64)) *MockTaskRepository_TrackedTime_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(param.Param))
+		run(args[0].(context.Context), args[1].([]int64))
 	})
 	return _c
 }
 
-func (_c *MockTaskRepository_GetByUserID_Call) Return(_a0 []model.Task, _a1 error) *MockTaskRepository_GetByUserID_Call {
+func (_c *MockTaskRepository_TrackedTime_Call) Return(_a0 map[int64]int64, _a1 error) *MockTaskRepository_TrackedTime_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTaskRepository_GetByUserID_Call) RunAndReturn(run func(context.Context, int64, param.Param) ([]model.Task, error)) *MockTaskRepository_GetByUserID_Call {
+func (_c *MockTaskRepository_TrackedTime_Call) RunAndReturn(run func(context.Context, []int64) (map[int64]int64, error)) *MockTaskRepository_TrackedTime_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -361,6 +3335,147 @@ func (_c *MockTaskRepository_Update_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// UpdateOccurrence provides a mock function with given fields: ctx, occurrence
+func (_m *MockTaskRepository) UpdateOccurrence(ctx context.Context, occurrence model.TaskOccurrence) error {
+	ret := _m.Called(ctx, occurrence)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateOccurrence")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskOccurrence) error); ok {
+		r0 = rf(ctx, occurrence)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_UpdateOccurrence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateOccurrence'
+type MockTaskRepository_UpdateOccurrence_Call struct {
+	*mock.Call
+}
+
+// UpdateOccurrence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - occurrence model.TaskOccurrence
+func (_e *MockTaskRepository_Expecter) UpdateOccurrence(ctx interface{}, occurrence interface{}) *MockTaskRepository_UpdateOccurrence_Call {
+	return &MockTaskRepository_UpdateOccurrence_Call{Call: _e.mock.On("UpdateOccurrence", ctx, occurrence)}
+}
+
+func (_c *MockTaskRepository_UpdateOccurrence_Call) Run(run func(ctx context.Context, occurrence model.TaskOccurrence)) *MockTaskRepository_UpdateOccurrence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TaskOccurrence))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_UpdateOccurrence_Call) Return(_a0 error) *MockTaskRepository_UpdateOccurrence_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_UpdateOccurrence_Call) RunAndReturn(run func(context.Context, model.TaskOccurrence) error) *MockTaskRepository_UpdateOccurrence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSeries provides a mock function with given fields: ctx, series
+func (_m *MockTaskRepository) UpdateSeries(ctx context.Context, series model.TaskSeries) error {
+	ret := _m.Called(ctx, series)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSeries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskSeries) error); ok {
+		r0 = rf(ctx, series)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_UpdateSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSeries'
+type MockTaskRepository_UpdateSeries_Call struct {
+	*mock.Call
+}
+
+// UpdateSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - series model.TaskSeries
+func (_e *MockTaskRepository_Expecter) UpdateSeries(ctx interface{}, series interface{}) *MockTaskRepository_UpdateSeries_Call {
+	return &MockTaskRepository_UpdateSeries_Call{Call: _e.mock.On("UpdateSeries", ctx, series)}
+}
+
+func (_c *MockTaskRepository_UpdateSeries_Call) Run(run func(ctx context.Context, series model.TaskSeries)) *MockTaskRepository_UpdateSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TaskSeries))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_UpdateSeries_Call) Return(_a0 error) *MockTaskRepository_UpdateSeries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_UpdateSeries_Call) RunAndReturn(run func(context.Context, model.TaskSeries) error) *MockTaskRepository_UpdateSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithTransaction provides a mock function with given fields: ctx, fn
+func (_m *MockTaskRepository) WithTransaction(ctx context.Context, fn func(task.TaskRepository) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(task.TaskRepository) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_WithTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTransaction'
+type MockTaskRepository_WithTransaction_Call struct {
+	*mock.Call
+}
+
+// WithTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(task.TaskRepository) error
+func (_e *MockTaskRepository_Expecter) WithTransaction(ctx interface{}, fn interface{}) *MockTaskRepository_WithTransaction_Call {
+	return &MockTaskRepository_WithTransaction_Call{Call: _e.mock.On("WithTransaction", ctx, fn)}
+}
+
+func (_c *MockTaskRepository_WithTransaction_Call) Run(run func(ctx context.Context, fn func(task.TaskRepository) error)) *MockTaskRepository_WithTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(task.TaskRepository) error))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_WithTransaction_Call) Return(_a0 error) *MockTaskRepository_WithTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_WithTransaction_Call) RunAndReturn(run func(context.Context, func(task.TaskRepository) error) error) *MockTaskRepository_WithTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockTaskRepository creates a new instance of MockTaskRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockTaskRepository(t interface {
@@ -2,6 +2,11 @@ package task
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/rzfhlv/go-task/internal/model"
@@ -10,49 +15,402 @@ import (
 
 var (
 	createTaskQuery = `INSERT INTO tasks
-		(title, description, status, user_id)
-		VALUES ($1, $2, $3, $4) RETURNING *`
+		(title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`
 
 	getTaskByUserIDQuery = `SELECT 
-		id, title, description, status, created_at, updated_at
+		id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
 		FROM tasks
-		WHERE user_id = $1
-		ORDER BY id LIMIT $2 OFFSET $3`
+		%s
+		ORDER BY %s LIMIT $%d OFFSET $%d`
 
 	getTaskByIDQuery = `SELECT 
-		id, title, description, status, created_at, updated_at
+		id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version
 		FROM tasks
-		WHERE id = $1 AND user_id = $2`
+		WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NULL`
+
+	getTrashedTaskByIDQuery = `SELECT 
+		id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+		FROM tasks
+		WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NOT NULL`
 
 	updateTaskQuery = `UPDATE tasks
-		SET title = $1, description = $2, status = $3, updated_at = $4
-		WHERE id = $5 AND user_id = $6`
+		SET title = $1, description = $2, status = $3, priority = $4, due_at = $5, parent_id = $6, project_id = $7, assignee_id = $8, assigned_at = $9, estimate_minutes = $10, story_points = $11, position = $12, updated_at = $13, version = version + 1
+		WHERE id = $14 AND (user_id = $15 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $15)) AND version = $16`
+
+	patchTaskQuery = `UPDATE tasks
+		SET %s
+		WHERE id = $%[2]d AND (user_id = $%[3]d AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $%[3]d)) AND version = $%[4]d
+		RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`
+
+	taskExistsQuery = `SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NULL)`
+
+	anyTaskExistsQuery = `SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)))`
+
+	deleteTaskQuery = `UPDATE tasks
+		SET deleted_at = CURRENT_TIMESTAMP, deleted_batch = id, version = version + 1
+		WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND version = $3 AND deleted_at IS NULL`
+
+	restoreTaskQuery = `UPDATE tasks
+		SET deleted_at = NULL, deleted_batch = NULL, version = version + 1
+		WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NOT NULL
+		RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`
+
+	destroyTaskQuery = `DELETE FROM tasks WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND version = $3`
+
+	purgeTaskQuery = `DELETE FROM tasks WHERE deleted_at < $1`
+
+	getTaskPurgeableQuery = `SELECT 
+		id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version
+		FROM tasks
+		WHERE deleted_at < $1
+		ORDER BY id
+		FOR UPDATE`
+
+	countTaskByUserIDQuery = `SELECT count(*) FROM tasks %s`
+
+	getTaskByParentIDQuery = `SELECT 
+		id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version
+		FROM tasks
+		WHERE parent_id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NULL
+		ORDER BY id`
+
+	getTaskAncestorsQuery = `WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id FROM tasks WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NULL
+			UNION
+			SELECT tasks.id, tasks.parent_id FROM tasks JOIN ancestors ON tasks.id = ancestors.parent_id
+		)
+		SELECT id FROM ancestors`
+
+	progressTaskQuery = `SELECT parent_id, count(*) FILTER (WHERE status = 'done') AS done, count(*) AS total
+		FROM tasks
+		%s
+		GROUP BY parent_id`
+
+	reparentTaskQuery = `UPDATE tasks
+		SET parent_id = $1, detached_from = $2, version = version + 1
+		WHERE parent_id = $2 AND (user_id = $3 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $3))
+		RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`
+
+	reattachTaskChildrenQuery = `UPDATE tasks
+		SET parent_id = $1, detached_from = NULL, version = version + 1
+		WHERE detached_from = $1 AND parent_id IS NOT DISTINCT FROM $2 AND (user_id = $3 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $3))
+		RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`
+
+	deleteTaskDescendantsQuery = `WITH RECURSIVE descendants AS (
+			SELECT id FROM tasks WHERE parent_id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NULL
+			UNION
+			SELECT tasks.id FROM tasks JOIN descendants ON tasks.parent_id = descendants.id WHERE tasks.deleted_at IS NULL
+		)
+		UPDATE tasks
+		SET deleted_at = CURRENT_TIMESTAMP, deleted_batch = $1, version = version + 1
+		WHERE id IN (SELECT id FROM descendants)
+		RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`
+
+	restoreTaskDescendantsQuery = `WITH RECURSIVE descendants AS (
+			SELECT id FROM tasks WHERE parent_id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_batch = (SELECT deleted_batch FROM tasks WHERE id = $1)
+			UNION
+			SELECT tasks.id FROM tasks JOIN descendants ON tasks.parent_id = descendants.id WHERE tasks.deleted_batch = (SELECT deleted_batch FROM tasks WHERE id = $1)
+		)
+		UPDATE tasks
+		SET deleted_at = NULL, deleted_batch = NULL, version = version + 1
+		WHERE id IN (SELECT id FROM descendants)
+		RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`
+
+	getTaskDescendantsQuery = `WITH RECURSIVE descendants AS (
+			SELECT id FROM tasks WHERE parent_id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2))
+			UNION
+			SELECT tasks.id FROM tasks JOIN descendants ON tasks.parent_id = descendants.id
+		)
+		SELECT id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version
+		FROM tasks
+		WHERE id IN (SELECT id FROM descendants)
+		ORDER BY id
+		FOR UPDATE`
+
+	destroyTaskDescendantsQuery = `WITH RECURSIVE descendants AS (
+			SELECT id FROM tasks WHERE parent_id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2))
+			UNION
+			SELECT tasks.id FROM tasks JOIN descendants ON tasks.parent_id = descendants.id
+		)
+		DELETE FROM tasks WHERE id IN (SELECT id FROM descendants)`
+
+	getTaskByIDsQuery = `SELECT 
+		id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version
+		FROM tasks
+		%s
+		ORDER BY id`
+
+	createTaskDependencyQuery = `INSERT INTO task_dependencies
+		(blocker_id, blocked_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+		RETURNING blocker_id, blocked_id, created_at`
+
+	deleteTaskDependencyQuery = `DELETE FROM task_dependencies
+		WHERE blocker_id = $1 AND blocked_id = $2 AND blocked_id IN (SELECT id FROM tasks WHERE (user_id = $3 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $3)))`
+
+	getTaskDependenciesQuery = `SELECT 
+		task_dependencies.blocker_id, task_dependencies.blocked_id, task_dependencies.created_at
+		FROM task_dependencies
+		JOIN tasks blocker ON blocker.id = task_dependencies.blocker_id AND blocker.deleted_at IS NULL
+		JOIN tasks blocked ON blocked.id = task_dependencies.blocked_id AND blocked.deleted_at IS NULL
+		%s
+		ORDER BY task_dependencies.blocker_id, task_dependencies.blocked_id`
+
+	getTaskWorkspaceRoleQuery = `SELECT role FROM workspace_members WHERE workspace_id = $1 AND user_id = $2`
+
+	getTaskProjectQuery = `SELECT 
+		id, name, description, user_id, archived_at, created_at, updated_at
+		FROM projects
+		WHERE id = $1 AND user_id = $2`
+
+	countOpenBlockersQuery = `SELECT count(*)
+		FROM task_dependencies
+		JOIN tasks ON tasks.id = task_dependencies.blocker_id
+		WHERE task_dependencies.blocked_id = $1 AND tasks.deleted_at IS NULL AND tasks.status NOT IN ('done', 'cancelled')`
+
+	attachTaskLabelQuery = `INSERT INTO task_labels
+		(task_id, label_id)
+		SELECT $1, id FROM labels WHERE id = $2 AND user_id = $3
+		ON CONFLICT (task_id, label_id) DO UPDATE SET task_id = EXCLUDED.task_id
+		RETURNING label_id`
+
+	detachTaskLabelQuery = `DELETE FROM task_labels
+		WHERE task_id = $1 AND label_id = $2 AND task_id IN (SELECT id FROM tasks WHERE (user_id = $3 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $3)))`
+
+	getTaskLabelsQuery = `SELECT 
+		task_labels.task_id, labels.id, labels.name, labels.colour, labels.user_id, labels.created_at, labels.updated_at
+		FROM task_labels
+		JOIN labels ON labels.id = task_labels.label_id
+		%s
+		ORDER BY labels.name`
+
+	countTaskCommentsQuery = `SELECT task_id, count(*) AS total
+		FROM task_comments
+		%s
+		GROUP BY task_id`
+
+	sumTaskTrackedTimeQuery = `SELECT task_id, COALESCE(sum(EXTRACT(EPOCH FROM ended_at - started_at)), 0)::BIGINT AS total
+		FROM time_entries
+		%s
+		GROUP BY task_id`
+
+	createTaskChecklistItemQuery = `INSERT INTO task_checklist_items
+		(task_id, text, position)
+		SELECT $1, $2, COALESCE(MAX(position), 0) + 1 FROM task_checklist_items WHERE task_id = $1
+		RETURNING id, task_id, text, checked, position, created_at, updated_at`
+
+	getTaskChecklistQuery = `SELECT 
+		id, task_id, text, checked, position, created_at, updated_at
+		FROM task_checklist_items
+		WHERE task_id = $1
+		ORDER BY position, id`
+
+	toggleTaskChecklistItemQuery = `UPDATE task_checklist_items
+		SET checked = NOT checked, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND task_id = $2
+		RETURNING id, task_id, text, checked, position, created_at, updated_at`
+
+	moveTaskChecklistItemQuery = `UPDATE task_checklist_items
+		SET position = $1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $2 AND task_id = $3`
+
+	deleteTaskChecklistItemQuery = `DELETE FROM task_checklist_items WHERE id = $1 AND task_id = $2`
+
+	summaryTaskChecklistQuery = `SELECT task_id, count(*) FILTER (WHERE checked) AS done, count(*) AS total
+		FROM task_checklist_items
+		%s
+		GROUP BY task_id`
+
+	createTaskSeriesQuery = `INSERT INTO task_series
+		(rule, dtstart, title, description, priority, project_id, workspace_id, assignee_id, user_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, rule, dtstart, title, description, priority, project_id, workspace_id, assignee_id, occurrence_offset, user_id, created_at, updated_at`
+
+	getTaskSeriesQuery = `SELECT 
+		id, rule, dtstart, title, description, priority, project_id, workspace_id, assignee_id, occurrence_offset, user_id, created_at, updated_at
+		FROM task_series
+		WHERE id = $1`
+
+	updateTaskSeriesQuery = `UPDATE task_series
+		SET rule = $1, dtstart = $2, title = $3, description = $4, priority = $5, project_id = $6, assignee_id = $7, occurrence_offset = $8, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $9`
+
+	createTaskOccurrenceQuery = `INSERT INTO task_occurrences
+		(task_id, series_id, occurrence, scheduled_at)
+		VALUES ($1, $2, $3, $4)`
+
+	updateTaskOccurrenceQuery = `UPDATE task_occurrences SET scheduled_at = $1 WHERE task_id = $2`
+
+	getTaskFutureOccurrencesQuery = `SELECT 
+		id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version
+		FROM tasks
+		JOIN task_occurrences ON task_occurrences.task_id = tasks.id
+		WHERE task_occurrences.series_id = $1 AND task_occurrences.occurrence > $2 AND (user_id = $3 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $3)) AND deleted_at IS NULL
+		ORDER BY task_occurrences.occurrence`
+
+	getTaskOccurrenceQuery = `SELECT 
+		task_occurrences.task_id, task_occurrences.series_id, task_occurrences.occurrence, task_occurrences.scheduled_at, task_series.rule,
+		(SELECT max(latest.occurrence) FROM task_occurrences latest WHERE latest.series_id = task_occurrences.series_id) AS latest
+		FROM task_occurrences
+		JOIN task_series ON task_series.id = task_occurrences.series_id
+		WHERE task_occurrences.task_id = $1`
+
+	getTaskOccurrencesQuery = `SELECT 
+		task_occurrences.task_id, task_occurrences.series_id, task_occurrences.occurrence, task_occurrences.scheduled_at, task_series.rule
+		FROM task_occurrences
+		JOIN task_series ON task_series.id = task_occurrences.series_id
+		%s`
+
+	createTaskHistoryQuery = `INSERT INTO task_history
+		(task_id, revision, action, actor_id, changes)
+		VALUES ($1, (SELECT COALESCE(max(revision), 0) + 1 FROM task_history WHERE task_id = $1), $2, $3, $4)
+		RETURNING id, task_id, revision, action, actor_id, changes, created_at`
+
+	getTaskHistoryQuery = `SELECT 
+		id, task_id, revision, action, actor_id, changes, created_at
+		FROM task_history
+		WHERE task_id = $1
+		ORDER BY revision DESC LIMIT $2 OFFSET $3`
+
+	countTaskHistoryQuery = `SELECT count(*) FROM task_history WHERE task_id = $1`
+
+	getTaskHistorySinceQuery = `SELECT 
+		id, task_id, revision, action, actor_id, changes, created_at
+		FROM task_history
+		WHERE task_id = $1 AND revision >= $2
+		ORDER BY revision DESC`
+
+	getTaskHistoryByTaskIDsQuery = `SELECT 
+		id, task_id, revision, action, actor_id, changes, created_at
+		FROM task_history
+		%s
+		ORDER BY created_at DESC, revision DESC`
+
+	getTaskBurndownQuery = `SELECT 
+		id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+		FROM tasks
+		%s
+		ORDER BY id`
+
+	lockTaskPositionQuery = `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`
+
+	lastTaskPositionQuery = `SELECT COALESCE(max(position), '') FROM tasks %s`
+
+	prevTaskPositionQuery = `SELECT COALESCE(max(position), '') FROM tasks %s`
 
-	deleteTaskQuery = `DELETE FROM tasks WHERE id = $1 AND user_id = $2`
+	nextTaskPositionQuery = `SELECT COALESCE(min(position), '') FROM tasks %s`
+
+	getTaskBoardQuery = `SELECT 
+		id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+		FROM (
+			SELECT *, row_number() OVER (PARTITION BY status ORDER BY position, id) AS rank
+			FROM tasks
+			%s
+		) board
+		WHERE rank <= $%d
+		ORDER BY status, position, id`
+
+	countTaskByStatusQuery = `SELECT status, count(*) AS total
+		FROM tasks
+		%s
+		GROUP BY status`
+)
+
+var (
+	ErrVersionConflict  = errors.New("version conflict")
+	ErrDependencyExists = errors.New("dependency already exists")
 )
 
 type TaskRepository interface {
 	Create(ctx context.Context, task model.Task) (model.Task, error)
 	GetByUserID(ctx context.Context, userId int64, param param.Param) ([]model.Task, error)
 	GetByID(ctx context.Context, id, userId int64) (model.Task, error)
+	GetTrashedByID(ctx context.Context, id, userId int64) (model.Task, error)
 	Update(ctx context.Context, task model.Task, userId int64) (model.Task, error)
-	Delete(ctx context.Context, id, userId int64) error
-	Count(ctx context.Context) (int64, error)
+	Patch(ctx context.Context, id, userId int64, patch model.TaskPatch) (model.Task, error)
+	Delete(ctx context.Context, id, userId, version int64) error
+	Restore(ctx context.Context, id, userId int64) (model.Task, error)
+	Destroy(ctx context.Context, id, userId, version int64) error
+	GetPurgeable(ctx context.Context, before time.Time) ([]model.Task, error)
+	Purge(ctx context.Context, before time.Time) (int64, error)
+	Count(ctx context.Context, userId int64, param param.Param) (int64, error)
+	GetByParentID(ctx context.Context, parentId, userId int64) ([]model.Task, error)
+	Ancestors(ctx context.Context, id, userId int64) ([]int64, error)
+	Progress(ctx context.Context, ids []int64) (map[int64]model.TaskProgress, error)
+	Reparent(ctx context.Context, id, userId int64, parentId *int64) ([]model.Task, error)
+	Reattach(ctx context.Context, id, userId int64, parentId *int64) ([]model.Task, error)
+	GetDescendants(ctx context.Context, id, userId int64) ([]model.Task, error)
+	DeleteDescendants(ctx context.Context, id, userId int64) ([]model.Task, error)
+	DestroyDescendants(ctx context.Context, id, userId int64) error
+	RestoreDescendants(ctx context.Context, id, userId int64) ([]model.Task, error)
+	GetByIDs(ctx context.Context, ids []int64, userId int64) ([]model.Task, error)
+	CreateDependency(ctx context.Context, blockerId, blockedId int64) (model.TaskDependency, error)
+	DeleteDependency(ctx context.Context, blockerId, blockedId, userId int64) error
+	GetDependenciesByBlockerID(ctx context.Context, ids []int64) ([]model.TaskDependency, error)
+	GetDependenciesByBlockedID(ctx context.Context, ids []int64) ([]model.TaskDependency, error)
+	CountOpenBlockers(ctx context.Context, id int64) (int64, error)
+	AttachLabel(ctx context.Context, id, labelId, userId int64) error
+	DetachLabel(ctx context.Context, id, labelId, userId int64) error
+	GetLabels(ctx context.Context, ids []int64) (map[int64][]model.Label, error)
+	CountComments(ctx context.Context, ids []int64) (map[int64]int64, error)
+	CreateChecklistItem(ctx context.Context, item model.TaskChecklistItem) (model.TaskChecklistItem, error)
+	GetChecklist(ctx context.Context, id int64) ([]model.TaskChecklistItem, error)
+	ToggleChecklistItem(ctx context.Context, itemId, id int64) (model.TaskChecklistItem, error)
+	MoveChecklistItem(ctx context.Context, itemId, id int64, position int) error
+	DeleteChecklistItem(ctx context.Context, itemId, id int64) error
+	ChecklistSummary(ctx context.Context, ids []int64) (map[int64]model.TaskChecklistSummary, error)
+	TrackedTime(ctx context.Context, ids []int64) (map[int64]int64, error)
+	CreateSeries(ctx context.Context, series model.TaskSeries) (model.TaskSeries, error)
+	GetSeries(ctx context.Context, id int64) (model.TaskSeries, error)
+	UpdateSeries(ctx context.Context, series model.TaskSeries) error
+	CreateOccurrence(ctx context.Context, occurrence model.TaskOccurrence) error
+	UpdateOccurrence(ctx context.Context, occurrence model.TaskOccurrence) error
+	GetFutureOccurrences(ctx context.Context, seriesId int64, occurrence int, userId int64) ([]model.Task, error)
+	GetOccurrence(ctx context.Context, id int64) (model.TaskOccurrence, error)
+	GetOccurrences(ctx context.Context, ids []int64) (map[int64]model.TaskOccurrence, error)
+	CreateHistory(ctx context.Context, history model.TaskHistory) (model.TaskHistory, error)
+	GetHistory(ctx context.Context, id int64, limit, offset int) ([]model.TaskHistory, error)
+	CountHistory(ctx context.Context, id int64) (int64, error)
+	GetHistorySince(ctx context.Context, id, revision int64) ([]model.TaskHistory, error)
+	GetHistoryByTaskIDs(ctx context.Context, ids []int64, since time.Time) ([]model.TaskHistory, error)
+	GetBurndownTasks(ctx context.Context, userId int64, projectId *int64, before time.Time) ([]model.Task, error)
+	LockPositions(ctx context.Context, lane model.TaskLane) error
+	LastPosition(ctx context.Context, lane model.TaskLane) (string, error)
+	PrevPosition(ctx context.Context, lane model.TaskLane, position string) (string, error)
+	NextPosition(ctx context.Context, lane model.TaskLane, position string) (string, error)
+	GetBoard(ctx context.Context, userId int64, param param.Param, limit int) ([]model.Task, error)
+	CountByStatus(ctx context.Context, userId int64, param param.Param) (map[string]int64, error)
+	GetProject(ctx context.Context, projectId, userId int64) (model.Project, error)
+	GetRole(ctx context.Context, workspaceId, userId int64) (string, error)
+	WithTransaction(ctx context.Context, fn func(repository TaskRepository) error) error
+}
+
+type querier interface {
+	Get(dest any, query string, args ...any) error
+	Select(dest any, query string, args ...any) error
+	Exec(query string, args ...any) (sql.Result, error)
 }
 
 type Task struct {
-	db *sqlx.DB
+	db    querier
+	conn  *sqlx.DB
+	tx    *sqlx.Tx
+	depth int
 }
 
 func New(db *sqlx.DB) TaskRepository {
 	return &Task{
-		db: db,
+		db:   db,
+		conn: db,
 	}
 }
 
 func (t *Task) Create(ctx context.Context, task model.Task) (model.Task, error) {
 	result := model.Task{}
-	err := t.db.Get(&result, createTaskQuery, task.Title, task.Description, task.Status, task.UserID)
+	err := t.db.Get(&result, createTaskQuery, task.Title, task.Description, task.Status, task.Priority, task.DueAt, task.ParentID, task.ProjectID, task.WorkspaceID, task.AssigneeID, task.AssignedAt, task.EstimateMinutes, task.StoryPoints, task.Position, task.UserID)
 	if err != nil {
 		return model.Task{}, err
 	}
@@ -63,7 +421,26 @@ func (t *Task) Create(ctx context.Context, task model.Task) (model.Task, error)
 func (t *Task) GetByUserID(ctx context.Context, userId int64, param param.Param) ([]model.Task, error) {
 	result := []model.Task{}
 
-	err := t.db.Select(&result, getTaskByUserIDQuery, userId, param.Limit, param.CalculateOffset())
+	fields, err := parseSort(param)
+	if err != nil {
+		return []model.Task{}, err
+	}
+
+	filter := buildFilter(userId, param)
+	limit, offset, backward := param.Limit, param.CalculateOffset(), false
+	if param.Keyset != nil {
+		if len(fields) > 1 {
+			return []model.Task{}, ErrInvalidSort
+		}
+
+		filter.keyset(fields, param.Keyset)
+		limit, offset, backward = param.Limit+1, 0, param.Keyset.Backward
+	}
+
+	args := append(filter.args, limit, offset)
+	query := fmt.Sprintf(getTaskByUserIDQuery, filter.where(), buildOrder(fields, backward), len(filter.args)+1, len(filter.args)+2)
+
+	err = t.db.Select(&result, query, args...)
 	if err != nil {
 		return []model.Task{}, err
 	}
@@ -82,36 +459,861 @@ func (t *Task) GetByID(ctx context.Context, id, userId int64) (model.Task, error
 	return result, nil
 }
 
+func (t *Task) GetTrashedByID(ctx context.Context, id, userId int64) (model.Task, error) {
+	result := model.Task{}
+
+	err := t.db.Get(&result, getTrashedTaskByIDQuery, id, userId)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	return result, nil
+}
+
 func (t *Task) Update(ctx context.Context, task model.Task, userId int64) (model.Task, error) {
-	result, err := t.db.Exec(updateTaskQuery, task.Title, task.Description, task.Status, task.UpdatedAt, task.ID, userId)
+	result, err := t.db.Exec(updateTaskQuery, task.Title, task.Description, task.Status, task.Priority, task.DueAt, task.ParentID, task.ProjectID, task.AssigneeID, task.AssignedAt, task.EstimateMinutes, task.StoryPoints, task.Position, task.UpdatedAt, task.ID, userId, task.Version)
 	if err != nil {
 		return model.Task{}, err
 	}
 
-	_, err = result.RowsAffected()
+	affected, err := result.RowsAffected()
 	if err != nil {
 		return model.Task{}, err
 	}
 
+	if affected == 0 {
+		return model.Task{}, t.conflict(taskExistsQuery, task.ID, userId)
+	}
+
+	task.Version++
 	return task, nil
 }
 
-func (t *Task) Delete(ctx context.Context, id, userId int64) error {
-	result, err := t.db.Exec(deleteTaskQuery, id, userId)
+func (t *Task) Patch(ctx context.Context, id, userId int64, patch model.TaskPatch) (model.Task, error) {
+	sets := []string{}
+	args := []any{}
+	set := func(column string, value any) {
+		args = append(args, value)
+		sets = append(sets, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+
+	if patch.Has("title") {
+		set("title", patch.Title)
+	}
+
+	if patch.Has("description") {
+		set("description", patch.Description)
+	}
+
+	if patch.Has("status") {
+		set("status", patch.Status)
+	}
+
+	if patch.Has("priority") {
+		set("priority", patch.Priority)
+	}
+
+	if patch.Has("due_at") {
+		set("due_at", patch.DueAt)
+	}
+
+	if patch.Has("parent_id") {
+		set("parent_id", patch.ParentID)
+	}
+
+	if patch.Has("project_id") {
+		set("project_id", patch.ProjectID)
+	}
+
+	if patch.Has("assignee_id") {
+		set("assignee_id", patch.AssigneeID)
+		set("assigned_at", patch.AssignedAt)
+	}
+
+	if patch.Has("estimate_minutes") {
+		set("estimate_minutes", patch.EstimateMinutes)
+	}
+
+	if patch.Has("story_points") {
+		set("story_points", patch.StoryPoints)
+	}
+
+	if patch.Has("position") {
+		set("position", patch.Position)
+	}
+
+	set("updated_at", patch.UpdatedAt)
+	sets = append(sets, "version = version + 1")
+	args = append(args, id, userId, patch.Version)
+	query := fmt.Sprintf(patchTaskQuery, strings.Join(sets, ", "), len(args)-2, len(args)-1, len(args))
+
+	result := model.Task{}
+	err := t.db.Get(&result, query, args...)
+	if err == sql.ErrNoRows {
+		return model.Task{}, t.conflict(taskExistsQuery, id, userId)
+	}
+
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) Delete(ctx context.Context, id, userId, version int64) error {
+	result, err := t.db.Exec(deleteTaskQuery, id, userId, version)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return t.conflict(taskExistsQuery, id, userId)
+	}
+
+	return nil
+}
+
+func (t *Task) Restore(ctx context.Context, id, userId int64) (model.Task, error) {
+	result := model.Task{}
+
+	err := t.db.Get(&result, restoreTaskQuery, id, userId)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) Destroy(ctx context.Context, id, userId, version int64) error {
+	result, err := t.db.Exec(destroyTaskQuery, id, userId, version)
 	if err != nil {
 		return err
 	}
 
-	_, err = result.RowsAffected()
+	affected, err := result.RowsAffected()
 	if err != nil {
 		return err
 	}
 
+	if affected == 0 {
+		return t.conflict(anyTaskExistsQuery, id, userId)
+	}
+
 	return nil
 }
 
-func (t *Task) Count(ctx context.Context) (int64, error) {
+func (t *Task) conflict(query string, id, userId int64) error {
+	var exists bool
+
+	err := t.db.Get(&exists, query, id, userId)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return sql.ErrNoRows
+	}
+
+	return ErrVersionConflict
+}
+
+func (t *Task) GetPurgeable(ctx context.Context, before time.Time) ([]model.Task, error) {
+	result := []model.Task{}
+
+	err := t.db.Select(&result, getTaskPurgeableQuery, before)
+	if err != nil {
+		return []model.Task{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) Purge(ctx context.Context, before time.Time) (int64, error) {
+	result, err := t.db.Exec(purgeTaskQuery, before)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func (t *Task) Count(ctx context.Context, userId int64, param param.Param) (int64, error) {
 	var total int64
-	err := t.db.Get(&total, `SELECT count(*) FROM tasks;`)
+	filter := buildFilter(userId, param)
+	err := t.db.Get(&total, fmt.Sprintf(countTaskByUserIDQuery, filter.where()), filter.args...)
 	return total, err
 }
+
+func (t *Task) GetByParentID(ctx context.Context, parentId, userId int64) ([]model.Task, error) {
+	result := []model.Task{}
+
+	err := t.db.Select(&result, getTaskByParentIDQuery, parentId, userId)
+	if err != nil {
+		return []model.Task{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) Ancestors(ctx context.Context, id, userId int64) ([]int64, error) {
+	result := []int64{}
+
+	err := t.db.Select(&result, getTaskAncestorsQuery, id, userId)
+	if err != nil {
+		return []int64{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) Progress(ctx context.Context, ids []int64) (map[int64]model.TaskProgress, error) {
+	result := map[int64]model.TaskProgress{}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	filter := &filter{}
+	filter.in("parent_id", anys(ids))
+	filter.add("deleted_at IS NULL")
+
+	rows := []struct {
+		ParentID int64 `db:"parent_id"`
+		model.TaskProgress
+	}{}
+	err := t.db.Select(&rows, fmt.Sprintf(progressTaskQuery, filter.where()), filter.args...)
+	if err != nil {
+		return map[int64]model.TaskProgress{}, err
+	}
+
+	for _, row := range rows {
+		result[row.ParentID] = row.TaskProgress
+	}
+
+	return result, nil
+}
+
+func (t *Task) Reparent(ctx context.Context, id, userId int64, parentId *int64) ([]model.Task, error) {
+	result := []model.Task{}
+
+	err := t.db.Select(&result, reparentTaskQuery, parentId, id, userId)
+	if err != nil {
+		return []model.Task{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) Reattach(ctx context.Context, id, userId int64, parentId *int64) ([]model.Task, error) {
+	result := []model.Task{}
+
+	err := t.db.Select(&result, reattachTaskChildrenQuery, id, parentId, userId)
+	if err != nil {
+		return []model.Task{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) GetDescendants(ctx context.Context, id, userId int64) ([]model.Task, error) {
+	result := []model.Task{}
+
+	err := t.db.Select(&result, getTaskDescendantsQuery, id, userId)
+	if err != nil {
+		return []model.Task{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) DeleteDescendants(ctx context.Context, id, userId int64) ([]model.Task, error) {
+	result := []model.Task{}
+
+	err := t.db.Select(&result, deleteTaskDescendantsQuery, id, userId)
+	if err != nil {
+		return []model.Task{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) DestroyDescendants(ctx context.Context, id, userId int64) error {
+	_, err := t.db.Exec(destroyTaskDescendantsQuery, id, userId)
+	return err
+}
+
+func (t *Task) RestoreDescendants(ctx context.Context, id, userId int64) ([]model.Task, error) {
+	result := []model.Task{}
+
+	err := t.db.Select(&result, restoreTaskDescendantsQuery, id, userId)
+	if err != nil {
+		return []model.Task{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) GetByIDs(ctx context.Context, ids []int64, userId int64) ([]model.Task, error) {
+	result := []model.Task{}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	filter := &filter{}
+	filter.add(accessCondition, userId)
+	filter.add("deleted_at IS NULL")
+	filter.in("id", anys(ids))
+
+	err := t.db.Select(&result, fmt.Sprintf(getTaskByIDsQuery, filter.where()), filter.args...)
+	if err != nil {
+		return []model.Task{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) CreateDependency(ctx context.Context, blockerId, blockedId int64) (model.TaskDependency, error) {
+	result := model.TaskDependency{}
+
+	err := t.db.Get(&result, createTaskDependencyQuery, blockerId, blockedId)
+	if err == sql.ErrNoRows {
+		return model.TaskDependency{}, ErrDependencyExists
+	}
+
+	if err != nil {
+		return model.TaskDependency{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) DeleteDependency(ctx context.Context, blockerId, blockedId, userId int64) error {
+	result, err := t.db.Exec(deleteTaskDependencyQuery, blockerId, blockedId, userId)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (t *Task) GetDependenciesByBlockerID(ctx context.Context, ids []int64) ([]model.TaskDependency, error) {
+	return t.dependencies("task_dependencies.blocker_id", ids)
+}
+
+func (t *Task) GetDependenciesByBlockedID(ctx context.Context, ids []int64) ([]model.TaskDependency, error) {
+	return t.dependencies("task_dependencies.blocked_id", ids)
+}
+
+func (t *Task) dependencies(column string, ids []int64) ([]model.TaskDependency, error) {
+	result := []model.TaskDependency{}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	filter := &filter{}
+	filter.in(column, anys(ids))
+
+	err := t.db.Select(&result, fmt.Sprintf(getTaskDependenciesQuery, filter.where()), filter.args...)
+	if err != nil {
+		return []model.TaskDependency{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) CountOpenBlockers(ctx context.Context, id int64) (int64, error) {
+	var result int64
+
+	err := t.db.Get(&result, countOpenBlockersQuery, id)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) AttachLabel(ctx context.Context, id, labelId, userId int64) error {
+	var result int64
+	return t.db.Get(&result, attachTaskLabelQuery, id, labelId, userId)
+}
+
+func (t *Task) DetachLabel(ctx context.Context, id, labelId, userId int64) error {
+	result, err := t.db.Exec(detachTaskLabelQuery, id, labelId, userId)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (t *Task) GetLabels(ctx context.Context, ids []int64) (map[int64][]model.Label, error) {
+	result := map[int64][]model.Label{}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	filter := &filter{}
+	filter.in("task_labels.task_id", anys(ids))
+
+	rows := []struct {
+		TaskID int64 `db:"task_id"`
+		model.Label
+	}{}
+	err := t.db.Select(&rows, fmt.Sprintf(getTaskLabelsQuery, filter.where()), filter.args...)
+	if err != nil {
+		return map[int64][]model.Label{}, err
+	}
+
+	for _, row := range rows {
+		result[row.TaskID] = append(result[row.TaskID], row.Label)
+	}
+
+	return result, nil
+}
+
+func (t *Task) CountComments(ctx context.Context, ids []int64) (map[int64]int64, error) {
+	result := map[int64]int64{}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	filter := &filter{}
+	filter.in("task_id", anys(ids))
+
+	rows := []struct {
+		TaskID int64 `db:"task_id"`
+		Total  int64 `db:"total"`
+	}{}
+	err := t.db.Select(&rows, fmt.Sprintf(countTaskCommentsQuery, filter.where()), filter.args...)
+	if err != nil {
+		return map[int64]int64{}, err
+	}
+
+	for _, row := range rows {
+		result[row.TaskID] = row.Total
+	}
+
+	return result, nil
+}
+
+func (t *Task) CreateChecklistItem(ctx context.Context, item model.TaskChecklistItem) (model.TaskChecklistItem, error) {
+	result := model.TaskChecklistItem{}
+	err := t.db.Get(&result, createTaskChecklistItemQuery, item.TaskID, item.Text)
+	if err != nil {
+		return model.TaskChecklistItem{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) GetChecklist(ctx context.Context, id int64) ([]model.TaskChecklistItem, error) {
+	result := []model.TaskChecklistItem{}
+	err := t.db.Select(&result, getTaskChecklistQuery, id)
+	if err != nil {
+		return []model.TaskChecklistItem{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) ToggleChecklistItem(ctx context.Context, itemId, id int64) (model.TaskChecklistItem, error) {
+	result := model.TaskChecklistItem{}
+	err := t.db.Get(&result, toggleTaskChecklistItemQuery, itemId, id)
+	if err != nil {
+		return model.TaskChecklistItem{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) MoveChecklistItem(ctx context.Context, itemId, id int64, position int) error {
+	_, err := t.db.Exec(moveTaskChecklistItemQuery, position, itemId, id)
+	return err
+}
+
+func (t *Task) DeleteChecklistItem(ctx context.Context, itemId, id int64) error {
+	result, err := t.db.Exec(deleteTaskChecklistItemQuery, itemId, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (t *Task) ChecklistSummary(ctx context.Context, ids []int64) (map[int64]model.TaskChecklistSummary, error) {
+	result := map[int64]model.TaskChecklistSummary{}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	filter := &filter{}
+	filter.in("task_id", anys(ids))
+
+	rows := []struct {
+		TaskID int64 `db:"task_id"`
+		model.TaskChecklistSummary
+	}{}
+	err := t.db.Select(&rows, fmt.Sprintf(summaryTaskChecklistQuery, filter.where()), filter.args...)
+	if err != nil {
+		return map[int64]model.TaskChecklistSummary{}, err
+	}
+
+	for _, row := range rows {
+		result[row.TaskID] = row.TaskChecklistSummary
+	}
+
+	return result, nil
+}
+
+func (t *Task) TrackedTime(ctx context.Context, ids []int64) (map[int64]int64, error) {
+	result := map[int64]int64{}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	filter := &filter{}
+	filter.in("task_id", anys(ids))
+	filter.add("ended_at IS NOT NULL")
+
+	rows := []struct {
+		TaskID int64 `db:"task_id"`
+		Total  int64 `db:"total"`
+	}{}
+	err := t.db.Select(&rows, fmt.Sprintf(sumTaskTrackedTimeQuery, filter.where()), filter.args...)
+	if err != nil {
+		return map[int64]int64{}, err
+	}
+
+	for _, row := range rows {
+		result[row.TaskID] = row.Total
+	}
+
+	return result, nil
+}
+
+func (t *Task) CreateSeries(ctx context.Context, series model.TaskSeries) (model.TaskSeries, error) {
+	result := model.TaskSeries{}
+	err := t.db.Get(&result, createTaskSeriesQuery, series.Rule, series.DTStart, series.Title, series.Description, series.Priority, series.ProjectID, series.WorkspaceID, series.AssigneeID, series.UserID)
+	if err != nil {
+		return model.TaskSeries{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) GetSeries(ctx context.Context, id int64) (model.TaskSeries, error) {
+	result := model.TaskSeries{}
+	err := t.db.Get(&result, getTaskSeriesQuery, id)
+	if err != nil {
+		return model.TaskSeries{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) UpdateSeries(ctx context.Context, series model.TaskSeries) error {
+	_, err := t.db.Exec(updateTaskSeriesQuery, series.Rule, series.DTStart, series.Title, series.Description, series.Priority, series.ProjectID, series.AssigneeID, series.Offset, series.ID)
+	return err
+}
+
+func (t *Task) CreateOccurrence(ctx context.Context, occurrence model.TaskOccurrence) error {
+	_, err := t.db.Exec(createTaskOccurrenceQuery, occurrence.TaskID, occurrence.SeriesID, occurrence.Occurrence, occurrence.ScheduledAt)
+	return err
+}
+
+func (t *Task) UpdateOccurrence(ctx context.Context, occurrence model.TaskOccurrence) error {
+	_, err := t.db.Exec(updateTaskOccurrenceQuery, occurrence.ScheduledAt, occurrence.TaskID)
+	return err
+}
+
+func (t *Task) GetFutureOccurrences(ctx context.Context, seriesId int64, occurrence int, userId int64) ([]model.Task, error) {
+	result := []model.Task{}
+	err := t.db.Select(&result, getTaskFutureOccurrencesQuery, seriesId, occurrence, userId)
+	if err != nil {
+		return []model.Task{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) GetOccurrence(ctx context.Context, id int64) (model.TaskOccurrence, error) {
+	result := model.TaskOccurrence{}
+	err := t.db.Get(&result, getTaskOccurrenceQuery, id)
+	if err != nil {
+		return model.TaskOccurrence{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) GetOccurrences(ctx context.Context, ids []int64) (map[int64]model.TaskOccurrence, error) {
+	result := map[int64]model.TaskOccurrence{}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	filter := &filter{}
+	filter.in("task_occurrences.task_id", anys(ids))
+
+	rows := []model.TaskOccurrence{}
+	err := t.db.Select(&rows, fmt.Sprintf(getTaskOccurrencesQuery, filter.where()), filter.args...)
+	if err != nil {
+		return map[int64]model.TaskOccurrence{}, err
+	}
+
+	for _, row := range rows {
+		result[row.TaskID] = row
+	}
+
+	return result, nil
+}
+
+func (t *Task) CreateHistory(ctx context.Context, history model.TaskHistory) (model.TaskHistory, error) {
+	result := model.TaskHistory{}
+	err := t.db.Get(&result, createTaskHistoryQuery, history.TaskID, history.Action, history.ActorID, history.Changes)
+	if err != nil {
+		return model.TaskHistory{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) GetHistory(ctx context.Context, id int64, limit, offset int) ([]model.TaskHistory, error) {
+	result := []model.TaskHistory{}
+	err := t.db.Select(&result, getTaskHistoryQuery, id, limit, offset)
+	if err != nil {
+		return []model.TaskHistory{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) CountHistory(ctx context.Context, id int64) (int64, error) {
+	var total int64
+	err := t.db.Get(&total, countTaskHistoryQuery, id)
+	return total, err
+}
+
+func (t *Task) GetHistorySince(ctx context.Context, id, revision int64) ([]model.TaskHistory, error) {
+	result := []model.TaskHistory{}
+	err := t.db.Select(&result, getTaskHistorySinceQuery, id, revision)
+	if err != nil {
+		return []model.TaskHistory{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) GetHistoryByTaskIDs(ctx context.Context, ids []int64, since time.Time) ([]model.TaskHistory, error) {
+	result := []model.TaskHistory{}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	filter := &filter{}
+	filter.in("task_id", anys(ids))
+	filter.add("created_at >= %s", since)
+
+	err := t.db.Select(&result, fmt.Sprintf(getTaskHistoryByTaskIDsQuery, filter.where()), filter.args...)
+	if err != nil {
+		return []model.TaskHistory{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) GetBurndownTasks(ctx context.Context, userId int64, projectId *int64, before time.Time) ([]model.Task, error) {
+	result := []model.Task{}
+
+	filter := &filter{}
+	filter.add(accessCondition, userId)
+	filter.add("created_at < %s", before)
+	if projectId != nil {
+		filter.add("(project_id = %[1]s OR id IN (SELECT task_id FROM task_history WHERE changes @> %[2]s::JSONB))", *projectId, fmt.Sprintf(`[{"field":"project_id","old":%d}]`, *projectId))
+	}
+
+	err := t.db.Select(&result, fmt.Sprintf(getTaskBurndownQuery, filter.where()), filter.args...)
+	if err != nil {
+		return []model.Task{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) LockPositions(ctx context.Context, lane model.TaskLane) error {
+	key := fmt.Sprintf("tasks:position:user:%d:%s", lane.UserID, lane.Status)
+	if lane.WorkspaceID != nil {
+		key = fmt.Sprintf("tasks:position:workspace:%d:%s", *lane.WorkspaceID, lane.Status)
+	}
+
+	_, err := t.db.Exec(lockTaskPositionQuery, key)
+	return err
+}
+
+func (t *Task) LastPosition(ctx context.Context, lane model.TaskLane) (string, error) {
+	var result string
+
+	filter := laneFilter(lane)
+	err := t.db.Get(&result, fmt.Sprintf(lastTaskPositionQuery, filter.where()), filter.args...)
+	if err != nil {
+		return "", err
+	}
+
+	return result, nil
+}
+
+func (t *Task) PrevPosition(ctx context.Context, lane model.TaskLane, position string) (string, error) {
+	var result string
+
+	filter := laneFilter(lane)
+	filter.add("position < %s", position)
+	err := t.db.Get(&result, fmt.Sprintf(prevTaskPositionQuery, filter.where()), filter.args...)
+	if err != nil {
+		return "", err
+	}
+
+	return result, nil
+}
+
+func (t *Task) NextPosition(ctx context.Context, lane model.TaskLane, position string) (string, error) {
+	var result string
+
+	filter := laneFilter(lane)
+	filter.add("position > %s", position)
+	err := t.db.Get(&result, fmt.Sprintf(nextTaskPositionQuery, filter.where()), filter.args...)
+	if err != nil {
+		return "", err
+	}
+
+	return result, nil
+}
+
+func (t *Task) GetBoard(ctx context.Context, userId int64, param param.Param, limit int) ([]model.Task, error) {
+	result := []model.Task{}
+
+	filter := buildFilter(userId, param)
+	args := append(filter.args, limit)
+	query := fmt.Sprintf(getTaskBoardQuery, filter.where(), len(args))
+
+	err := t.db.Select(&result, query, args...)
+	if err != nil {
+		return []model.Task{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) CountByStatus(ctx context.Context, userId int64, param param.Param) (map[string]int64, error) {
+	result := map[string]int64{}
+
+	filter := buildFilter(userId, param)
+	rows := []struct {
+		Status string `db:"status"`
+		Total  int64  `db:"total"`
+	}{}
+	err := t.db.Select(&rows, fmt.Sprintf(countTaskByStatusQuery, filter.where()), filter.args...)
+	if err != nil {
+		return map[string]int64{}, err
+	}
+
+	for _, row := range rows {
+		result[row.Status] = row.Total
+	}
+
+	return result, nil
+}
+
+func (t *Task) GetProject(ctx context.Context, projectId, userId int64) (model.Project, error) {
+	result := model.Project{}
+
+	err := t.db.Get(&result, getTaskProjectQuery, projectId, userId)
+	if err != nil {
+		return model.Project{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) GetRole(ctx context.Context, workspaceId, userId int64) (string, error) {
+	var result string
+
+	err := t.db.Get(&result, getTaskWorkspaceRoleQuery, workspaceId, userId)
+	if err != nil {
+		return "", err
+	}
+
+	return result, nil
+}
+
+func (t *Task) WithTransaction(ctx context.Context, fn func(repository TaskRepository) error) error {
+	if t.tx != nil {
+		return t.savepoint(ctx, fn)
+	}
+
+	tx, err := t.conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = fn(&Task{db: tx, conn: t.conn, tx: tx})
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return errors.Join(err, rollbackErr)
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (t *Task) savepoint(ctx context.Context, fn func(repository TaskRepository) error) error {
+	name := fmt.Sprintf("task_savepoint_%d", t.depth+1)
+	_, err := t.tx.ExecContext(ctx, "SAVEPOINT "+name)
+	if err != nil {
+		return err
+	}
+
+	err = fn(&Task{db: t.tx, conn: t.conn, tx: t.tx, depth: t.depth + 1})
+	if err != nil {
+		if _, rollbackErr := t.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rollbackErr != nil {
+			return errors.Join(err, rollbackErr)
+		}
+
+		return err
+	}
+
+	_, err = t.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	return err
+}
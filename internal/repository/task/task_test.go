@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -11,21 +12,32 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/rzfhlv/go-task/internal/model"
 	"github.com/rzfhlv/go-task/internal/repository/task"
+	"github.com/rzfhlv/go-task/pkg/cursor"
 	"github.com/rzfhlv/go-task/pkg/param"
 	"github.com/stretchr/testify/assert"
 )
 
 var (
 	now = time.Date(2023, time.August, 15, 12, 0, 0, 0, time.UTC)
+	due = time.Date(2023, time.August, 20, 12, 0, 0, 0, time.UTC)
+
+	projectId = int64(7)
+
+	workspaceId = int64(3)
+
+	assigneeId = int64(2)
 
 	taskModel = model.Task{
 		ID:          1,
 		Title:       "Todo 1",
 		Description: "urgent task",
 		Status:      "todo",
+		Priority:    "medium",
+		DueAt:       &due,
 		UserID:      int64(1),
 		CreatedAt:   now,
 		UpdatedAt:   now,
+		Version:     1,
 	}
 
 	taskModelArr = []model.Task{
@@ -34,9 +46,12 @@ var (
 			Title:       "Todo 1",
 			Description: "urgent task",
 			Status:      "todo",
+			Priority:    "medium",
+			DueAt:       &due,
 			UserID:      int64(1),
 			CreatedAt:   now,
 			UpdatedAt:   now,
+			Version:     1,
 		},
 	}
 
@@ -58,13 +73,14 @@ func TestTaskCreate(t *testing.T) {
 		{
 			name: "success",
 			beforeTest: func(s sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "user_id", "created_at", "updated_at"}).
-					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt)
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
 
 				s.ExpectQuery(`INSERT INTO tasks 
-				(title, description, status, user_id)
-				VALUES ($1, $2, $3, $4) RETURNING *`).
-					WithArgs(taskModel.Title, taskModel.Description, taskModel.Status, taskModel.UserID).
+				(title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+				RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`).
+					WithArgs(taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.ParentID, taskModel.ProjectID, taskModel.WorkspaceID, taskModel.AssigneeID, taskModel.AssignedAt, taskModel.EstimateMinutes, taskModel.StoryPoints, taskModel.Position, taskModel.UserID).
 					WillReturnRows(rows)
 			},
 			wantResult: taskModel,
@@ -74,9 +90,10 @@ func TestTaskCreate(t *testing.T) {
 			name: "error when create task",
 			beforeTest: func(s sqlmock.Sqlmock) {
 				s.ExpectQuery(`INSERT INTO tasks 
-				(title, description, status, user_id)
-				VALUES ($1, $2, $3, $4) RETURNING *`).
-					WithArgs(taskModel.Title, taskModel.Description, taskModel.Status, taskModel.UserID).
+				(title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+				RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`).
+					WithArgs(taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.ParentID, taskModel.ProjectID, taskModel.WorkspaceID, taskModel.AssigneeID, taskModel.AssignedAt, taskModel.EstimateMinutes, taskModel.StoryPoints, taskModel.Position, taskModel.UserID).
 					WillReturnError(sql.ErrConnDone)
 			},
 			wantResult: model.Task{},
@@ -111,20 +128,23 @@ func TestTaskCreate(t *testing.T) {
 func TestTaskGetByUserID(t *testing.T) {
 	tests := []struct {
 		name       string
+		param      param.Param
 		beforeTest func(s sqlmock.Sqlmock)
 		wantResult []model.Task
 		wantErr    error
 	}{
 		{
-			name: "success",
+			name:  "success",
+			param: paramPkg,
 			beforeTest: func(s sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "user_id", "created_at", "updated_at"}).
-					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt)
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
 
 				s.ExpectQuery(`SELECT 
-					id, title, description, status, created_at, updated_at
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
 					FROM tasks
-					WHERE user_id = $1
+					WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL
+					AND NOT EXISTS (SELECT 1 FROM projects WHERE projects.id = tasks.project_id AND projects.archived_at IS NOT NULL)
 					ORDER BY id LIMIT $2 OFFSET $3`).
 					WithArgs(taskModel.UserID, 10, 0).
 					WillReturnRows(rows)
@@ -133,12 +153,14 @@ func TestTaskGetByUserID(t *testing.T) {
 			wantErr:    nil,
 		},
 		{
-			name: "error when get by id",
+			name:  "error when get by id",
+			param: paramPkg,
 			beforeTest: func(s sqlmock.Sqlmock) {
 				s.ExpectQuery(`SELECT 
-					id, title, description, status, created_at, updated_at
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
 					FROM tasks
-					WHERE user_id = $1
+					WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL
+					AND NOT EXISTS (SELECT 1 FROM projects WHERE projects.id = tasks.project_id AND projects.archived_at IS NOT NULL)
 					ORDER BY id LIMIT $2 OFFSET $3`).
 					WithArgs(taskModel.UserID, paramPkg.Limit, paramPkg.Offset).
 					WillReturnError(sql.ErrConnDone)
@@ -146,6 +168,317 @@ func TestTaskGetByUserID(t *testing.T) {
 			wantResult: []model.Task{},
 			wantErr:    sql.ErrConnDone,
 		},
+		{
+			name: "success with priority and due date filter",
+			param: param.Param{
+				Page:      1,
+				Limit:     10,
+				Priority:  "high,urgent",
+				Overdue:   true,
+				DueBefore: &due,
+				DueAfter:  &now,
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
+
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+					FROM tasks
+					WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL AND priority IN ($2, $3)
+					AND NOT EXISTS (SELECT 1 FROM projects WHERE projects.id = tasks.project_id AND projects.archived_at IS NOT NULL)
+					AND due_at < CURRENT_TIMESTAMP AND status NOT IN ('done', 'cancelled')
+					AND due_at < $4 AND due_at > $5
+					ORDER BY id LIMIT $6 OFFSET $7`).
+					WithArgs(taskModel.UserID, "high", "urgent", due, now, 10, 0).
+					WillReturnRows(rows)
+			},
+			wantResult: taskModelArr,
+			wantErr:    nil,
+		},
+		{
+			name: "success with project filter",
+			param: param.Param{
+				Page:      1,
+				Limit:     10,
+				ProjectID: &projectId,
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
+
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+					FROM tasks
+					WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL AND project_id = $2
+					ORDER BY id LIMIT $3 OFFSET $4`).
+					WithArgs(taskModel.UserID, projectId, 10, 0).
+					WillReturnRows(rows)
+			},
+			wantResult: taskModelArr,
+			wantErr:    nil,
+		},
+		{
+			name: "success with workspace filter",
+			param: param.Param{
+				Page:        1,
+				Limit:       10,
+				WorkspaceID: &workspaceId,
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "workspace_id", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, nil, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
+
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+					FROM tasks
+					WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL AND NOT EXISTS (SELECT 1 FROM projects WHERE projects.id = tasks.project_id AND projects.archived_at IS NOT NULL) AND workspace_id = $2
+					ORDER BY id LIMIT $3 OFFSET $4`).
+					WithArgs(taskModel.UserID, workspaceId, 10, 0).
+					WillReturnRows(rows)
+			},
+			wantResult: taskModelArr,
+			wantErr:    nil,
+		},
+		{
+			name: "success with assignee filter",
+			param: param.Param{
+				Page:          1,
+				Limit:         10,
+				Sort:          "-assigned_at",
+				AssigneeID:    &assigneeId,
+				AssignedAfter: &now,
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
+
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+					FROM tasks
+					WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL AND NOT EXISTS (SELECT 1 FROM projects WHERE projects.id = tasks.project_id AND projects.archived_at IS NOT NULL) AND assignee_id = $2 AND assigned_at > $3
+					ORDER BY COALESCE(assigned_at, 'infinity') DESC, id DESC LIMIT $4 OFFSET $5`).
+					WithArgs(taskModel.UserID, assigneeId, now, 10, 0).
+					WillReturnRows(rows)
+			},
+			wantResult: taskModelArr,
+			wantErr:    nil,
+		},
+		{
+			name: "success with unassigned filter",
+			param: param.Param{
+				Page:       1,
+				Limit:      10,
+				Unassigned: true,
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
+
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+					FROM tasks
+					WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL AND NOT EXISTS (SELECT 1 FROM projects WHERE projects.id = tasks.project_id AND projects.archived_at IS NOT NULL) AND assignee_id IS NULL
+					ORDER BY id LIMIT $2 OFFSET $3`).
+					WithArgs(taskModel.UserID, 10, 0).
+					WillReturnRows(rows)
+			},
+			wantResult: taskModelArr,
+			wantErr:    nil,
+		},
+		{
+			name: "success with any label filter",
+			param: param.Param{
+				Page:   1,
+				Limit:  10,
+				Labels: "urgent,backend,urgent",
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
+
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+					FROM tasks
+					WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL
+					AND id IN (SELECT task_labels.task_id FROM task_labels JOIN labels ON labels.id = task_labels.label_id WHERE labels.name IN ($2, $3))
+					AND NOT EXISTS (SELECT 1 FROM projects WHERE projects.id = tasks.project_id AND projects.archived_at IS NOT NULL)
+					ORDER BY id LIMIT $4 OFFSET $5`).
+					WithArgs(taskModel.UserID, "backend", "urgent", 10, 0).
+					WillReturnRows(rows)
+			},
+			wantResult: taskModelArr,
+			wantErr:    nil,
+		},
+		{
+			name: "success with all label filter",
+			param: param.Param{
+				Page:      1,
+				Limit:     10,
+				Labels:    "urgent,backend",
+				LabelMode: model.LabelModeAll,
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
+
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+					FROM tasks
+					WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL
+					AND id IN (SELECT task_labels.task_id FROM task_labels JOIN labels ON labels.id = task_labels.label_id WHERE labels.name IN ($2, $3)
+					GROUP BY task_labels.task_id HAVING count(DISTINCT labels.name) = $4)
+					AND NOT EXISTS (SELECT 1 FROM projects WHERE projects.id = tasks.project_id AND projects.archived_at IS NOT NULL)
+					ORDER BY id LIMIT $5 OFFSET $6`).
+					WithArgs(taskModel.UserID, "backend", "urgent", 2, 10, 0).
+					WillReturnRows(rows)
+			},
+			wantResult: taskModelArr,
+			wantErr:    nil,
+		},
+		{
+			name: "success with all label filter when only duplicate names match",
+			param: param.Param{
+				Page:      1,
+				Limit:     10,
+				Labels:    "bug,ui",
+				LabelMode: model.LabelModeAll,
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version"})
+
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+					FROM tasks
+					WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL
+					AND id IN (SELECT task_labels.task_id FROM task_labels JOIN labels ON labels.id = task_labels.label_id WHERE labels.name IN ($2, $3)
+					GROUP BY task_labels.task_id HAVING count(DISTINCT labels.name) = $4)
+					AND NOT EXISTS (SELECT 1 FROM projects WHERE projects.id = tasks.project_id AND projects.archived_at IS NOT NULL)
+					ORDER BY id LIMIT $5 OFFSET $6`).
+					WithArgs(taskModel.UserID, "bug", "ui", 2, 10, 0).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.Task{},
+			wantErr:    nil,
+		},
+		{
+			name: "success with search, status and sort",
+			param: param.Param{
+				Page:         2,
+				Limit:        10,
+				Sort:         "-updated_at,priority",
+				Q:            "release notes",
+				Status:       "todo,in_progress",
+				CreatedAfter: &now,
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
+
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+					FROM tasks
+					WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL AND status IN ($2, $3)
+					AND NOT EXISTS (SELECT 1 FROM projects WHERE projects.id = tasks.project_id AND projects.archived_at IS NOT NULL)
+					AND search_vector @@ websearch_to_tsquery('english', $4)
+					AND created_at > $5
+					ORDER BY updated_at DESC, CASE priority WHEN 'low' THEN 1 WHEN 'medium' THEN 2 WHEN 'high' THEN 3 WHEN 'urgent' THEN 4 END ASC, id
+					LIMIT $6 OFFSET $7`).
+					WithArgs(taskModel.UserID, "todo", "in_progress", "release notes", now, 10, 10).
+					WillReturnRows(rows)
+			},
+			wantResult: taskModelArr,
+			wantErr:    nil,
+		},
+		{
+			name: "success with keyset forward",
+			param: param.Param{
+				Page:   1,
+				Limit:  10,
+				Sort:   "-updated_at",
+				Keyset: &cursor.Cursor{Sort: "-updated_at", Value: "2023-08-15T12:00:00Z", ID: 5},
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
+
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+					FROM tasks
+					WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL AND NOT EXISTS (SELECT 1 FROM projects WHERE projects.id = tasks.project_id AND projects.archived_at IS NOT NULL) AND (updated_at, id) < ($2, $3)
+					ORDER BY updated_at DESC, id DESC LIMIT $4 OFFSET $5`).
+					WithArgs(taskModel.UserID, "2023-08-15T12:00:00Z", int64(5), 11, 0).
+					WillReturnRows(rows)
+			},
+			wantResult: taskModelArr,
+			wantErr:    nil,
+		},
+		{
+			name: "success with keyset backward by id",
+			param: param.Param{
+				Page:   1,
+				Limit:  10,
+				Keyset: &cursor.Cursor{Value: "5", ID: 5, Backward: true},
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
+
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+					FROM tasks
+					WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL AND NOT EXISTS (SELECT 1 FROM projects WHERE projects.id = tasks.project_id AND projects.archived_at IS NOT NULL) AND id < $2
+					ORDER BY id DESC LIMIT $3 OFFSET $4`).
+					WithArgs(taskModel.UserID, int64(5), 11, 0).
+					WillReturnRows(rows)
+			},
+			wantResult: taskModelArr,
+			wantErr:    nil,
+		},
+		{
+			name: "success with trashed tasks",
+			param: param.Param{
+				Page:    1,
+				Limit:   10,
+				Sort:    "-deleted_at",
+				Trashed: true,
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
+
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+					FROM tasks
+					WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NOT NULL
+					ORDER BY COALESCE(deleted_at, 'infinity') DESC, id DESC LIMIT $2 OFFSET $3`).
+					WithArgs(taskModel.UserID, 10, 0).
+					WillReturnRows(rows)
+			},
+			wantResult: taskModelArr,
+			wantErr:    nil,
+		},
+		{
+			name: "error when keyset with multiple sort fields",
+			param: param.Param{
+				Page:   1,
+				Limit:  10,
+				Sort:   "-updated_at,title",
+				Keyset: &cursor.Cursor{Sort: "-updated_at,title", Value: "x", ID: 5},
+			},
+			wantResult: []model.Task{},
+			wantErr:    task.ErrInvalidSort,
+		},
+		{
+			name: "error when sort field is not allowed",
+			param: param.Param{
+				Page:  1,
+				Limit: 10,
+				Sort:  "password",
+			},
+			wantResult: []model.Task{},
+			wantErr:    task.ErrInvalidSort,
+		},
 	}
 
 	for _, tt := range tests {
@@ -160,7 +493,7 @@ func TestTaskGetByUserID(t *testing.T) {
 			}
 
 			r := task.New(db)
-			result, err := r.GetByUserID(context.Background(), taskModel.UserID, paramPkg)
+			result, err := r.GetByUserID(context.Background(), taskModel.UserID, tt.param)
 
 			assert.Equal(t, tt.wantResult, result)
 			assert.Equal(t, tt.wantErr, err)
@@ -182,13 +515,13 @@ func TestTaskGetByID(t *testing.T) {
 		{
 			name: "success",
 			beforeTest: func(s sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "user_id", "created_at", "updated_at"}).
-					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt)
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
 
 				s.ExpectQuery(`SELECT 
-					id, title, description, status, created_at, updated_at
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version
 					FROM tasks
-					WHERE id = $1 AND user_id = $2`).
+					WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NULL`).
 					WithArgs(taskModel.ID, taskModel.UserID).
 					WillReturnRows(rows)
 			},
@@ -199,9 +532,9 @@ func TestTaskGetByID(t *testing.T) {
 			name: "error when get by id",
 			beforeTest: func(s sqlmock.Sqlmock) {
 				s.ExpectQuery(`SELECT 
-					id, title, description, status, created_at, updated_at
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version
 					FROM tasks
-					WHERE id = $1 AND user_id = $2`).
+					WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NULL`).
 					WithArgs(taskModel.ID, taskModel.UserID).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -235,6 +568,9 @@ func TestTaskGetByID(t *testing.T) {
 }
 
 func TestTaskUpdate(t *testing.T) {
+	updatedTaskModel := taskModel
+	updatedTaskModel.Version = 2
+
 	tests := []struct {
 		name       string
 		beforeTest func(s sqlmock.Sqlmock)
@@ -245,21 +581,53 @@ func TestTaskUpdate(t *testing.T) {
 			name: "success",
 			beforeTest: func(s sqlmock.Sqlmock) {
 				s.ExpectExec(`UPDATE tasks
-					SET title = $1, description = $2, status = $3, updated_at = $4
-					WHERE id = $5 AND user_id = $6`).
-					WithArgs(taskModel.Title, taskModel.Description, taskModel.Status, taskModel.UpdatedAt, taskModel.ID, taskModel.UserID).
+					SET title = $1, description = $2, status = $3, priority = $4, due_at = $5, parent_id = $6, project_id = $7, assignee_id = $8, assigned_at = $9, estimate_minutes = $10, story_points = $11, position = $12, updated_at = $13, version = version + 1
+					WHERE id = $14 AND (user_id = $15 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $15)) AND version = $16`).
+					WithArgs(taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.ParentID, taskModel.ProjectID, taskModel.AssigneeID, taskModel.AssignedAt, taskModel.EstimateMinutes, taskModel.StoryPoints, taskModel.Position, taskModel.UpdatedAt, taskModel.ID, taskModel.UserID, taskModel.Version).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
-			wantResult: taskModel,
+			wantResult: updatedTaskModel,
 			wantErr:    nil,
 		},
+		{
+			name: "error when version is stale",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(`UPDATE tasks
+					SET title = $1, description = $2, status = $3, priority = $4, due_at = $5, parent_id = $6, project_id = $7, assignee_id = $8, assigned_at = $9, estimate_minutes = $10, story_points = $11, position = $12, updated_at = $13, version = version + 1
+					WHERE id = $14 AND (user_id = $15 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $15)) AND version = $16`).
+					WithArgs(taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.ParentID, taskModel.ProjectID, taskModel.AssigneeID, taskModel.AssignedAt, taskModel.EstimateMinutes, taskModel.StoryPoints, taskModel.Position, taskModel.UpdatedAt, taskModel.ID, taskModel.UserID, taskModel.Version).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				s.ExpectQuery(`SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NULL)`).
+					WithArgs(taskModel.ID, taskModel.UserID).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+			},
+			wantResult: model.Task{},
+			wantErr:    task.ErrVersionConflict,
+		},
+		{
+			name: "error when task is removed",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(`UPDATE tasks
+					SET title = $1, description = $2, status = $3, priority = $4, due_at = $5, parent_id = $6, project_id = $7, assignee_id = $8, assigned_at = $9, estimate_minutes = $10, story_points = $11, position = $12, updated_at = $13, version = version + 1
+					WHERE id = $14 AND (user_id = $15 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $15)) AND version = $16`).
+					WithArgs(taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.ParentID, taskModel.ProjectID, taskModel.AssigneeID, taskModel.AssignedAt, taskModel.EstimateMinutes, taskModel.StoryPoints, taskModel.Position, taskModel.UpdatedAt, taskModel.ID, taskModel.UserID, taskModel.Version).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				s.ExpectQuery(`SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NULL)`).
+					WithArgs(taskModel.ID, taskModel.UserID).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+			},
+			wantResult: model.Task{},
+			wantErr:    sql.ErrNoRows,
+		},
 		{
 			name: "error when no rows affected",
 			beforeTest: func(s sqlmock.Sqlmock) {
 				s.ExpectExec(`UPDATE tasks
-					SET title = $1, description = $2, status = $3, updated_at = $4
-					WHERE id = $5 AND user_id = $6`).
-					WithArgs(taskModel.Title, taskModel.Description, taskModel.Status, taskModel.UpdatedAt, taskModel.ID, taskModel.UserID).
+					SET title = $1, description = $2, status = $3, priority = $4, due_at = $5, parent_id = $6, project_id = $7, assignee_id = $8, assigned_at = $9, estimate_minutes = $10, story_points = $11, position = $12, updated_at = $13, version = version + 1
+					WHERE id = $14 AND (user_id = $15 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $15)) AND version = $16`).
+					WithArgs(taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.ParentID, taskModel.ProjectID, taskModel.AssigneeID, taskModel.AssignedAt, taskModel.EstimateMinutes, taskModel.StoryPoints, taskModel.Position, taskModel.UpdatedAt, taskModel.ID, taskModel.UserID, taskModel.Version).
 					WillReturnResult(sqlmock.NewErrorResult(errors.New("rows affected error")))
 			},
 			wantResult: model.Task{},
@@ -269,9 +637,9 @@ func TestTaskUpdate(t *testing.T) {
 			name: "error when update task",
 			beforeTest: func(s sqlmock.Sqlmock) {
 				s.ExpectExec(`UPDATE tasks
-					SET title = $1, description = $2, status = $3, updated_at = $4
-					WHERE id = $5 AND user_id = $6`).
-					WithArgs(taskModel.Title, taskModel.Description, taskModel.Status, taskModel.UpdatedAt, taskModel.ID, taskModel.UserID).
+					SET title = $1, description = $2, status = $3, priority = $4, due_at = $5, parent_id = $6, project_id = $7, assignee_id = $8, assigned_at = $9, estimate_minutes = $10, story_points = $11, position = $12, updated_at = $13, version = version + 1
+					WHERE id = $14 AND (user_id = $15 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $15)) AND version = $16`).
+					WithArgs(taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.ParentID, taskModel.ProjectID, taskModel.AssigneeID, taskModel.AssignedAt, taskModel.EstimateMinutes, taskModel.StoryPoints, taskModel.Position, taskModel.UpdatedAt, taskModel.ID, taskModel.UserID, taskModel.Version).
 					WillReturnError(sql.ErrConnDone)
 			},
 			wantResult: model.Task{},
@@ -303,7 +671,16 @@ func TestTaskUpdate(t *testing.T) {
 	}
 }
 
-func TestTaskDelete(t *testing.T) {
+func TestTaskPatch(t *testing.T) {
+	title := "Todo 2"
+	patch := model.TaskPatch{
+		Title:     &title,
+		DueAt:     nil,
+		Fields:    []string{"due_at", "title"},
+		UpdatedAt: now,
+		Version:   1,
+	}
+
 	tests := []struct {
 		name       string
 		beforeTest func(s sqlmock.Sqlmock)
@@ -313,32 +690,65 @@ func TestTaskDelete(t *testing.T) {
 		{
 			name: "success",
 			beforeTest: func(s sqlmock.Sqlmock) {
-				s.ExpectExec(`DELETE FROM tasks WHERE id = $1 AND user_id = $2`).
-					WithArgs(taskModel.ID, taskModel.UserID).
-					WillReturnResult(sqlmock.NewResult(0, 1))
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
+
+				s.ExpectQuery(`UPDATE tasks
+					SET title = $1, due_at = $2, updated_at = $3, version = version + 1
+					WHERE id = $4 AND (user_id = $5 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $5)) AND version = $6
+					RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`).
+					WithArgs(title, nil, now, taskModel.ID, taskModel.UserID, int64(1)).
+					WillReturnRows(rows)
 			},
 			wantResult: taskModel,
 			wantErr:    nil,
 		},
 		{
-			name: "error when no rows affected",
+			name: "error when patch task",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(`UPDATE tasks
+					SET title = $1, due_at = $2, updated_at = $3, version = version + 1
+					WHERE id = $4 AND (user_id = $5 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $5)) AND version = $6
+					RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`).
+					WithArgs(title, nil, now, taskModel.ID, taskModel.UserID, int64(1)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: model.Task{},
+			wantErr:    sql.ErrConnDone,
+		},
+		{
+			name: "error when version is stale",
 			beforeTest: func(s sqlmock.Sqlmock) {
-				s.ExpectExec(`DELETE FROM tasks WHERE id = $1 AND user_id = $2`).
+				s.ExpectQuery(`UPDATE tasks
+					SET title = $1, due_at = $2, updated_at = $3, version = version + 1
+					WHERE id = $4 AND (user_id = $5 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $5)) AND version = $6
+					RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`).
+					WithArgs(title, nil, now, taskModel.ID, taskModel.UserID, int64(1)).
+					WillReturnError(sql.ErrNoRows)
+
+				s.ExpectQuery(`SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NULL)`).
 					WithArgs(taskModel.ID, taskModel.UserID).
-					WillReturnResult(sqlmock.NewErrorResult(errors.New("rows affected error")))
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
 			},
 			wantResult: model.Task{},
-			wantErr:    errors.New("rows affected error"),
+			wantErr:    task.ErrVersionConflict,
 		},
 		{
-			name: "error when update task",
+			name: "error when task is removed",
 			beforeTest: func(s sqlmock.Sqlmock) {
-				s.ExpectExec(`DELETE FROM tasks WHERE id = $1 AND user_id = $2`).
+				s.ExpectQuery(`UPDATE tasks
+					SET title = $1, due_at = $2, updated_at = $3, version = version + 1
+					WHERE id = $4 AND (user_id = $5 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $5)) AND version = $6
+					RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`).
+					WithArgs(title, nil, now, taskModel.ID, taskModel.UserID, int64(1)).
+					WillReturnError(sql.ErrNoRows)
+
+				s.ExpectQuery(`SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NULL)`).
 					WithArgs(taskModel.ID, taskModel.UserID).
-					WillReturnError(sql.ErrConnDone)
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 			},
 			wantResult: model.Task{},
-			wantErr:    sql.ErrConnDone,
+			wantErr:    sql.ErrNoRows,
 		},
 	}
 
@@ -354,8 +764,9 @@ func TestTaskDelete(t *testing.T) {
 			}
 
 			r := task.New(db)
-			err := r.Delete(context.Background(), taskModel.ID, taskModel.UserID)
+			result, err := r.Patch(context.Background(), taskModel.ID, taskModel.UserID, patch)
 
+			assert.Equal(t, tt.wantResult, result)
 			assert.Equal(t, tt.wantErr, err)
 
 			if err := mockSQL.ExpectationsWereMet(); err != nil {
@@ -365,33 +776,3731 @@ func TestTaskDelete(t *testing.T) {
 	}
 }
 
-func TestTaskCount(t *testing.T) {
-	expectedCount := int64(10)
+func TestTaskPatchAssignee(t *testing.T) {
+	patch := model.TaskPatch{
+		AssigneeID: &assigneeId,
+		AssignedAt: &now,
+		Fields:     []string{"assignee_id"},
+		UpdatedAt:  now,
+		Version:    1,
+	}
+
 	tests := []struct {
 		name       string
 		beforeTest func(s sqlmock.Sqlmock)
-		wantResult int64
+		wantResult model.Task
 		wantErr    error
 	}{
 		{
 			name: "success",
 			beforeTest: func(s sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"count"}).
-					AddRow(expectedCount)
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "assignee_id", "assigned_at", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, assigneeId, now, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
 
-				s.ExpectQuery("SELECT count(*) FROM tasks;").
+				s.ExpectQuery(`UPDATE tasks
+					SET assignee_id = $1, assigned_at = $2, updated_at = $3, version = version + 1
+					WHERE id = $4 AND (user_id = $5 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $5)) AND version = $6
+					RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`).
+					WithArgs(&assigneeId, &now, now, taskModel.ID, taskModel.UserID, int64(1)).
 					WillReturnRows(rows)
 			},
-			wantResult: 10,
-			wantErr:    nil,
+			wantResult: model.Task{
+				ID:          taskModel.ID,
+				Title:       taskModel.Title,
+				Description: taskModel.Description,
+				Status:      taskModel.Status,
+				Priority:    taskModel.Priority,
+				DueAt:       taskModel.DueAt,
+				AssigneeID:  &assigneeId,
+				AssignedAt:  &now,
+				UserID:      taskModel.UserID,
+				CreatedAt:   taskModel.CreatedAt,
+				UpdatedAt:   taskModel.UpdatedAt,
+				Version:     taskModel.Version,
+			},
+			wantErr: nil,
 		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.Patch(context.Background(), taskModel.ID, taskModel.UserID, patch)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskPatchEstimate(t *testing.T) {
+	estimate, points := 90, 5
+	patch := model.TaskPatch{
+		EstimateMinutes: &estimate,
+		StoryPoints:     &points,
+		Fields:          []string{"estimate_minutes", "story_points"},
+		UpdatedAt:       now,
+		Version:         1,
+	}
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Task
+		wantErr    error
+	}{
 		{
-			name: "error when count",
+			name: "success",
 			beforeTest: func(s sqlmock.Sqlmock) {
-				s.ExpectQuery("SELECT count(*) FROM tasks;").
-					WillReturnError(sql.ErrConnDone)
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "estimate_minutes", "story_points", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, estimate, points, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
+
+				s.ExpectQuery(`UPDATE tasks
+					SET estimate_minutes = $1, story_points = $2, updated_at = $3, version = version + 1
+					WHERE id = $4 AND (user_id = $5 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $5)) AND version = $6
+					RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`).
+					WithArgs(&estimate, &points, now, taskModel.ID, taskModel.UserID, int64(1)).
+					WillReturnRows(rows)
 			},
-			wantResult: 0,
+			wantResult: model.Task{
+				ID:              taskModel.ID,
+				Title:           taskModel.Title,
+				Description:     taskModel.Description,
+				Status:          taskModel.Status,
+				Priority:        taskModel.Priority,
+				DueAt:           taskModel.DueAt,
+				EstimateMinutes: &estimate,
+				StoryPoints:     &points,
+				UserID:          taskModel.UserID,
+				CreatedAt:       taskModel.CreatedAt,
+				UpdatedAt:       taskModel.UpdatedAt,
+				Version:         taskModel.Version,
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.Patch(context.Background(), taskModel.ID, taskModel.UserID, patch)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskDelete(t *testing.T) {
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(`UPDATE tasks
+					SET deleted_at = CURRENT_TIMESTAMP, deleted_batch = id, version = version + 1
+					WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND version = $3 AND deleted_at IS NULL`).
+					WithArgs(taskModel.ID, taskModel.UserID, taskModel.Version).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantResult: taskModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when version is stale",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(`UPDATE tasks
+					SET deleted_at = CURRENT_TIMESTAMP, deleted_batch = id, version = version + 1
+					WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND version = $3 AND deleted_at IS NULL`).
+					WithArgs(taskModel.ID, taskModel.UserID, taskModel.Version).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				s.ExpectQuery(`SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NULL)`).
+					WithArgs(taskModel.ID, taskModel.UserID).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+			},
+			wantResult: model.Task{},
+			wantErr:    task.ErrVersionConflict,
+		},
+		{
+			name: "error when task is removed",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(`UPDATE tasks
+					SET deleted_at = CURRENT_TIMESTAMP, deleted_batch = id, version = version + 1
+					WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND version = $3 AND deleted_at IS NULL`).
+					WithArgs(taskModel.ID, taskModel.UserID, taskModel.Version).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				s.ExpectQuery(`SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NULL)`).
+					WithArgs(taskModel.ID, taskModel.UserID).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+			},
+			wantResult: model.Task{},
+			wantErr:    sql.ErrNoRows,
+		},
+		{
+			name: "error when no rows affected",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(`UPDATE tasks
+					SET deleted_at = CURRENT_TIMESTAMP, deleted_batch = id, version = version + 1
+					WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND version = $3 AND deleted_at IS NULL`).
+					WithArgs(taskModel.ID, taskModel.UserID, taskModel.Version).
+					WillReturnResult(sqlmock.NewErrorResult(errors.New("rows affected error")))
+			},
+			wantResult: model.Task{},
+			wantErr:    errors.New("rows affected error"),
+		},
+		{
+			name: "error when update task",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(`UPDATE tasks
+					SET deleted_at = CURRENT_TIMESTAMP, deleted_batch = id, version = version + 1
+					WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND version = $3 AND deleted_at IS NULL`).
+					WithArgs(taskModel.ID, taskModel.UserID, taskModel.Version).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: model.Task{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			err := r.Delete(context.Background(), taskModel.ID, taskModel.UserID, taskModel.Version)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskCount(t *testing.T) {
+	expectedCount := int64(10)
+	tests := []struct {
+		name       string
+		param      param.Param
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult int64
+		wantErr    error
+	}{
+		{
+			name:  "success",
+			param: paramPkg,
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"count"}).
+					AddRow(expectedCount)
+
+				s.ExpectQuery(`SELECT count(*) FROM tasks WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL
+					AND NOT EXISTS (SELECT 1 FROM projects WHERE projects.id = tasks.project_id AND projects.archived_at IS NOT NULL)`).
+					WithArgs(taskModel.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: 10,
+			wantErr:    nil,
+		},
+		{
+			name:  "error when count",
+			param: paramPkg,
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(`SELECT count(*) FROM tasks WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL
+					AND NOT EXISTS (SELECT 1 FROM projects WHERE projects.id = tasks.project_id AND projects.archived_at IS NOT NULL)`).
+					WithArgs(taskModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: 0,
+			wantErr:    sql.ErrConnDone,
+		},
+		{
+			name: "success with same filters as listing",
+			param: param.Param{
+				Page:   2,
+				Limit:  10,
+				Status: "done",
+				Q:      "release",
+				Keyset: &cursor.Cursor{Value: "5", ID: 5},
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"count"}).
+					AddRow(int64(3))
+
+				s.ExpectQuery(`SELECT count(*) FROM tasks
+					WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL AND status IN ($2)
+					AND NOT EXISTS (SELECT 1 FROM projects WHERE projects.id = tasks.project_id AND projects.archived_at IS NOT NULL)
+					AND search_vector @@ websearch_to_tsquery('english', $3)`).
+					WithArgs(taskModel.UserID, "done", "release").
+					WillReturnRows(rows)
+			},
+			wantResult: 3,
+			wantErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.Count(context.Background(), taskModel.UserID, tt.param)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskGetTrashedByID(t *testing.T) {
+	trashedTaskModel := taskModel
+	trashedTaskModel.DeletedAt = &now
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version", "deleted_at"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version, now)
+
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+					FROM tasks
+					WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NOT NULL`).
+					WithArgs(taskModel.ID, taskModel.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: trashedTaskModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when get trashed by id",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+					FROM tasks
+					WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NOT NULL`).
+					WithArgs(taskModel.ID, taskModel.UserID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantResult: model.Task{},
+			wantErr:    sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.GetTrashedByID(context.Background(), taskModel.ID, taskModel.UserID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskRestore(t *testing.T) {
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
+
+				s.ExpectQuery(`UPDATE tasks
+					SET deleted_at = NULL, deleted_batch = NULL, version = version + 1
+					WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NOT NULL
+					RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`).
+					WithArgs(taskModel.ID, taskModel.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: taskModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when task is not in trash",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(`UPDATE tasks
+					SET deleted_at = NULL, deleted_batch = NULL, version = version + 1
+					WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NOT NULL
+					RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`).
+					WithArgs(taskModel.ID, taskModel.UserID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantResult: model.Task{},
+			wantErr:    sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.Restore(context.Background(), taskModel.ID, taskModel.UserID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskDestroy(t *testing.T) {
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(`DELETE FROM tasks WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND version = $3`).
+					WithArgs(taskModel.ID, taskModel.UserID, taskModel.Version).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when version is stale",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(`DELETE FROM tasks WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND version = $3`).
+					WithArgs(taskModel.ID, taskModel.UserID, taskModel.Version).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				s.ExpectQuery(`SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)))`).
+					WithArgs(taskModel.ID, taskModel.UserID).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+			},
+			wantErr: task.ErrVersionConflict,
+		},
+		{
+			name: "error when task is removed",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(`DELETE FROM tasks WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND version = $3`).
+					WithArgs(taskModel.ID, taskModel.UserID, taskModel.Version).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				s.ExpectQuery(`SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)))`).
+					WithArgs(taskModel.ID, taskModel.UserID).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+			},
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name: "error when destroy task",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(`DELETE FROM tasks WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND version = $3`).
+					WithArgs(taskModel.ID, taskModel.UserID, taskModel.Version).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			err := r.Destroy(context.Background(), taskModel.ID, taskModel.UserID, taskModel.Version)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskGetPurgeable(t *testing.T) {
+	query := `SELECT 
+		id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version
+		FROM tasks
+		WHERE deleted_at < $1
+		ORDER BY id
+		FOR UPDATE`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "user_id", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.UserID, taskModel.Version)
+
+				s.ExpectQuery(query).
+					WithArgs(now).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.Task{{ID: taskModel.ID, Title: taskModel.Title, UserID: taskModel.UserID, Version: taskModel.Version}},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get purgeable tasks",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(now).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.Task{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.GetPurgeable(context.Background(), now)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskPurge(t *testing.T) {
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult int64
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(`DELETE FROM tasks WHERE deleted_at < $1`).
+					WithArgs(now).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+			},
+			wantResult: 3,
+			wantErr:    nil,
+		},
+		{
+			name: "error when purge tasks",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(`DELETE FROM tasks WHERE deleted_at < $1`).
+					WithArgs(now).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: 0,
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.Purge(context.Background(), now)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskWithTransaction(t *testing.T) {
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		fn         func(ctx context.Context, r task.TaskRepository) error
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectBegin()
+				s.ExpectExec(`DELETE FROM tasks WHERE deleted_at < $1`).
+					WithArgs(now).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				s.ExpectCommit()
+			},
+			fn: func(ctx context.Context, r task.TaskRepository) error {
+				_, err := r.Purge(ctx, now)
+				return err
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when callback fails",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectBegin()
+				s.ExpectRollback()
+			},
+			fn: func(ctx context.Context, r task.TaskRepository) error {
+				return sql.ErrConnDone
+			},
+			wantErr: sql.ErrConnDone,
+		},
+		{
+			name: "error when begin transaction",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectBegin().WillReturnError(sql.ErrConnDone)
+			},
+			fn: func(ctx context.Context, r task.TaskRepository) error {
+				return nil
+			},
+			wantErr: sql.ErrConnDone,
+		},
+		{
+			name: "success with nested savepoint",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectBegin()
+				s.ExpectExec(`SAVEPOINT task_savepoint_1`).WillReturnResult(sqlmock.NewResult(0, 0))
+				s.ExpectExec(`RELEASE SAVEPOINT task_savepoint_1`).WillReturnResult(sqlmock.NewResult(0, 0))
+				s.ExpectExec(`SAVEPOINT task_savepoint_1`).WillReturnResult(sqlmock.NewResult(0, 0))
+				s.ExpectExec(`ROLLBACK TO SAVEPOINT task_savepoint_1`).WillReturnResult(sqlmock.NewResult(0, 0))
+				s.ExpectCommit()
+			},
+			fn: func(ctx context.Context, r task.TaskRepository) error {
+				err := r.WithTransaction(ctx, func(item task.TaskRepository) error {
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+
+				err = r.WithTransaction(ctx, func(item task.TaskRepository) error {
+					return sql.ErrNoRows
+				})
+				if err != sql.ErrNoRows {
+					return errors.New("unexpected savepoint result")
+				}
+
+				return nil
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			ctx := context.Background()
+			r := task.New(db)
+			err := r.WithTransaction(ctx, func(repository task.TaskRepository) error {
+				return tt.fn(ctx, repository)
+			})
+
+			assert.Equal(t, tt.wantErr, err)
+			assert.NoError(t, mockSQL.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTaskGetByParentID(t *testing.T) {
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
+
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version
+					FROM tasks
+					WHERE parent_id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NULL
+					ORDER BY id`).
+					WithArgs(int64(2), taskModel.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: taskModelArr,
+			wantErr:    nil,
+		},
+		{
+			name: "error when get by parent id",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version
+					FROM tasks
+					WHERE parent_id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NULL
+					ORDER BY id`).
+					WithArgs(int64(2), taskModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.Task{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.GetByParentID(context.Background(), int64(2), taskModel.UserID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskAncestors(t *testing.T) {
+	query := `WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id FROM tasks WHERE id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NULL
+			UNION
+			SELECT tasks.id, tasks.parent_id FROM tasks JOIN ancestors ON tasks.id = ancestors.parent_id
+		)
+		SELECT id FROM ancestors`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []int64
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id"}).AddRow(int64(2)).AddRow(int64(1))
+
+				s.ExpectQuery(query).
+					WithArgs(int64(2), taskModel.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: []int64{2, 1},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get ancestors",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(int64(2), taskModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []int64{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.Ancestors(context.Background(), int64(2), taskModel.UserID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskProgress(t *testing.T) {
+	query := `SELECT parent_id, count(*) FILTER (WHERE status = 'done') AS done, count(*) AS total
+		FROM tasks
+		WHERE parent_id IN ($1, $2) AND deleted_at IS NULL
+		GROUP BY parent_id`
+
+	tests := []struct {
+		name       string
+		ids        []int64
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult map[int64]model.TaskProgress
+		wantErr    error
+	}{
+		{
+			name: "success",
+			ids:  []int64{1, 2},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"parent_id", "done", "total"}).AddRow(int64(1), int64(1), int64(3))
+
+				s.ExpectQuery(query).
+					WithArgs(int64(1), int64(2)).
+					WillReturnRows(rows)
+			},
+			wantResult: map[int64]model.TaskProgress{1: {Done: 1, Total: 3}},
+			wantErr:    nil,
+		},
+		{
+			name:       "success without ids",
+			ids:        []int64{},
+			wantResult: map[int64]model.TaskProgress{},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get progress",
+			ids:  []int64{1, 2},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(int64(1), int64(2)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: map[int64]model.TaskProgress{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.Progress(context.Background(), tt.ids)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskReparent(t *testing.T) {
+	parentId := int64(5)
+	query := `UPDATE tasks
+		SET parent_id = $1, detached_from = $2, version = version + 1
+		WHERE parent_id = $2 AND (user_id = $3 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $3))
+		RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "parent_id", "user_id", "version"}).
+					AddRow(int64(8), "Child", parentId, taskModel.UserID, int64(2))
+
+				s.ExpectQuery(query).
+					WithArgs(&parentId, taskModel.ID, taskModel.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.Task{{ID: 8, Title: "Child", ParentID: &parentId, UserID: taskModel.UserID, Version: 2}},
+			wantErr:    nil,
+		},
+		{
+			name: "error when reparent tasks",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(&parentId, taskModel.ID, taskModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.Task{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.Reparent(context.Background(), taskModel.ID, taskModel.UserID, &parentId)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskReattach(t *testing.T) {
+	parentId := int64(5)
+	query := `UPDATE tasks
+		SET parent_id = $1, detached_from = NULL, version = version + 1
+		WHERE detached_from = $1 AND parent_id IS NOT DISTINCT FROM $2 AND (user_id = $3 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $3))
+		RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "parent_id", "user_id", "version"}).
+					AddRow(int64(7), "Child", taskModel.ID, taskModel.UserID, int64(4))
+
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID, &parentId, taskModel.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.Task{{ID: 7, Title: "Child", ParentID: &taskModel.ID, UserID: taskModel.UserID, Version: 4}},
+			wantErr:    nil,
+		},
+		{
+			name: "error when reattach tasks",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID, &parentId, taskModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.Task{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.Reattach(context.Background(), taskModel.ID, taskModel.UserID, &parentId)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskGetDescendants(t *testing.T) {
+	query := `WITH RECURSIVE descendants AS (
+			SELECT id FROM tasks WHERE parent_id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2))
+			UNION
+			SELECT tasks.id FROM tasks JOIN descendants ON tasks.parent_id = descendants.id
+		)
+		SELECT id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version
+		FROM tasks
+		WHERE id IN (SELECT id FROM descendants)
+		ORDER BY id
+		FOR UPDATE`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "parent_id", "user_id", "version"}).
+					AddRow(int64(5), "Child", taskModel.ID, taskModel.UserID, int64(3))
+
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID, taskModel.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.Task{{ID: 5, Title: "Child", ParentID: &taskModel.ID, UserID: taskModel.UserID, Version: 3}},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get descendants",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID, taskModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.Task{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.GetDescendants(context.Background(), taskModel.ID, taskModel.UserID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskDeleteDescendants(t *testing.T) {
+	query := `WITH RECURSIVE descendants AS (
+			SELECT id FROM tasks WHERE parent_id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_at IS NULL
+			UNION
+			SELECT tasks.id FROM tasks JOIN descendants ON tasks.parent_id = descendants.id WHERE tasks.deleted_at IS NULL
+		)
+		UPDATE tasks
+		SET deleted_at = CURRENT_TIMESTAMP, deleted_batch = $1, version = version + 1
+		WHERE id IN (SELECT id FROM descendants)
+		RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "parent_id", "user_id", "version"}).
+					AddRow(int64(5), "Child", taskModel.ID, taskModel.UserID, int64(3))
+
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID, taskModel.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.Task{{ID: 5, Title: "Child", ParentID: &taskModel.ID, UserID: taskModel.UserID, Version: 3}},
+			wantErr:    nil,
+		},
+		{
+			name: "error when delete descendants",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID, taskModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.Task{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.DeleteDescendants(context.Background(), taskModel.ID, taskModel.UserID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskDestroyDescendants(t *testing.T) {
+	query := `WITH RECURSIVE descendants AS (
+			SELECT id FROM tasks WHERE parent_id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2))
+			UNION
+			SELECT tasks.id FROM tasks JOIN descendants ON tasks.parent_id = descendants.id
+		)
+		DELETE FROM tasks WHERE id IN (SELECT id FROM descendants)`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(taskModel.ID, taskModel.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when destroy descendants",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(taskModel.ID, taskModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			err := r.DestroyDescendants(context.Background(), taskModel.ID, taskModel.UserID)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskRestoreDescendants(t *testing.T) {
+	query := `WITH RECURSIVE descendants AS (
+			SELECT id FROM tasks WHERE parent_id = $1 AND (user_id = $2 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $2)) AND deleted_batch = (SELECT deleted_batch FROM tasks WHERE id = $1)
+			UNION
+			SELECT tasks.id FROM tasks JOIN descendants ON tasks.parent_id = descendants.id WHERE tasks.deleted_batch = (SELECT deleted_batch FROM tasks WHERE id = $1)
+		)
+		UPDATE tasks
+		SET deleted_at = NULL, deleted_batch = NULL, version = version + 1
+		WHERE id IN (SELECT id FROM descendants)
+		RETURNING id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "parent_id", "user_id", "version"}).
+					AddRow(int64(5), "Child", taskModel.ID, taskModel.UserID, int64(3))
+
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID, taskModel.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.Task{{ID: 5, Title: "Child", ParentID: &taskModel.ID, UserID: taskModel.UserID, Version: 3}},
+			wantErr:    nil,
+		},
+		{
+			name: "error when restore descendants",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID, taskModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.Task{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.RestoreDescendants(context.Background(), taskModel.ID, taskModel.UserID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskGetByIDs(t *testing.T) {
+	query := `SELECT 
+		id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version
+		FROM tasks
+		WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL AND id IN ($2, $3)
+		ORDER BY id`
+
+	tests := []struct {
+		name       string
+		ids        []int64
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			ids:  []int64{1, 2},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "user_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, taskModel.UserID, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
+
+				s.ExpectQuery(query).
+					WithArgs(taskModel.UserID, int64(1), int64(2)).
+					WillReturnRows(rows)
+			},
+			wantResult: taskModelArr,
+			wantErr:    nil,
+		},
+		{
+			name:       "success without ids",
+			ids:        []int64{},
+			wantResult: []model.Task{},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get by ids",
+			ids:  []int64{1, 2},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(taskModel.UserID, int64(1), int64(2)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.Task{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.GetByIDs(context.Background(), tt.ids, taskModel.UserID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskCreateDependency(t *testing.T) {
+	query := `INSERT INTO task_dependencies
+		(blocker_id, blocked_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+		RETURNING blocker_id, blocked_id, created_at`
+
+	dependency := model.TaskDependency{BlockerID: 2, BlockedID: 1, CreatedAt: now}
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.TaskDependency
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"blocker_id", "blocked_id", "created_at"}).
+					AddRow(dependency.BlockerID, dependency.BlockedID, dependency.CreatedAt)
+
+				s.ExpectQuery(query).
+					WithArgs(dependency.BlockerID, dependency.BlockedID).
+					WillReturnRows(rows)
+			},
+			wantResult: dependency,
+			wantErr:    nil,
+		},
+		{
+			name: "error when dependency already exists",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(dependency.BlockerID, dependency.BlockedID).
+					WillReturnRows(sqlmock.NewRows([]string{"blocker_id", "blocked_id", "created_at"}))
+			},
+			wantResult: model.TaskDependency{},
+			wantErr:    task.ErrDependencyExists,
+		},
+		{
+			name: "error when create dependency",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(dependency.BlockerID, dependency.BlockedID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: model.TaskDependency{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.CreateDependency(context.Background(), dependency.BlockerID, dependency.BlockedID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskDeleteDependency(t *testing.T) {
+	query := `DELETE FROM task_dependencies
+		WHERE blocker_id = $1 AND blocked_id = $2 AND blocked_id IN (SELECT id FROM tasks WHERE (user_id = $3 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $3)))`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(int64(2), taskModel.ID, taskModel.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when dependency is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(int64(2), taskModel.ID, taskModel.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name: "error when delete dependency",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(int64(2), taskModel.ID, taskModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			err := r.DeleteDependency(context.Background(), int64(2), taskModel.ID, taskModel.UserID)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskGetDependencies(t *testing.T) {
+	query := `SELECT 
+		task_dependencies.blocker_id, task_dependencies.blocked_id, task_dependencies.created_at
+		FROM task_dependencies
+		JOIN tasks blocker ON blocker.id = task_dependencies.blocker_id AND blocker.deleted_at IS NULL
+		JOIN tasks blocked ON blocked.id = task_dependencies.blocked_id AND blocked.deleted_at IS NULL
+		WHERE task_dependencies.%s IN ($1, $2)
+		ORDER BY task_dependencies.blocker_id, task_dependencies.blocked_id`
+
+	dependencies := []model.TaskDependency{{BlockerID: 1, BlockedID: 3, CreatedAt: now}}
+
+	tests := []struct {
+		name       string
+		blocked    bool
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.TaskDependency
+		wantErr    error
+	}{
+		{
+			name: "success by blocker id",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"blocker_id", "blocked_id", "created_at"}).AddRow(int64(1), int64(3), now)
+
+				s.ExpectQuery(fmt.Sprintf(query, "blocker_id")).
+					WithArgs(int64(1), int64(2)).
+					WillReturnRows(rows)
+			},
+			wantResult: dependencies,
+			wantErr:    nil,
+		},
+		{
+			name:    "success by blocked id",
+			blocked: true,
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"blocker_id", "blocked_id", "created_at"}).AddRow(int64(1), int64(3), now)
+
+				s.ExpectQuery(fmt.Sprintf(query, "blocked_id")).
+					WithArgs(int64(1), int64(2)).
+					WillReturnRows(rows)
+			},
+			wantResult: dependencies,
+			wantErr:    nil,
+		},
+		{
+			name: "error when get dependencies",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(fmt.Sprintf(query, "blocker_id")).
+					WithArgs(int64(1), int64(2)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.TaskDependency{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			getDependencies := r.GetDependenciesByBlockerID
+			if tt.blocked {
+				getDependencies = r.GetDependenciesByBlockedID
+			}
+			result, err := getDependencies(context.Background(), []int64{1, 2})
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskCountOpenBlockers(t *testing.T) {
+	query := `SELECT count(*)
+		FROM task_dependencies
+		JOIN tasks ON tasks.id = task_dependencies.blocker_id
+		WHERE task_dependencies.blocked_id = $1 AND tasks.deleted_at IS NULL AND tasks.status NOT IN ('done', 'cancelled')`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult int64
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(2)))
+			},
+			wantResult: 2,
+			wantErr:    nil,
+		},
+		{
+			name: "error when count open blockers",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: 0,
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.CountOpenBlockers(context.Background(), taskModel.ID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskAttachLabel(t *testing.T) {
+	query := `INSERT INTO task_labels
+		(task_id, label_id)
+		SELECT $1, id FROM labels WHERE id = $2 AND user_id = $3
+		ON CONFLICT (task_id, label_id) DO UPDATE SET task_id = EXCLUDED.task_id
+		RETURNING label_id`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID, int64(3), taskModel.UserID).
+					WillReturnRows(sqlmock.NewRows([]string{"label_id"}).AddRow(int64(3)))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when label is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID, int64(3), taskModel.UserID).
+					WillReturnRows(sqlmock.NewRows([]string{"label_id"}))
+			},
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name: "error when attach label",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID, int64(3), taskModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			err := r.AttachLabel(context.Background(), taskModel.ID, int64(3), taskModel.UserID)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskDetachLabel(t *testing.T) {
+	query := `DELETE FROM task_labels
+		WHERE task_id = $1 AND label_id = $2 AND task_id IN (SELECT id FROM tasks WHERE (user_id = $3 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $3)))`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(taskModel.ID, int64(3), taskModel.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when label is not attached",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(taskModel.ID, int64(3), taskModel.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name: "error when detach label",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(taskModel.ID, int64(3), taskModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			err := r.DetachLabel(context.Background(), taskModel.ID, int64(3), taskModel.UserID)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskGetLabels(t *testing.T) {
+	query := `SELECT 
+		task_labels.task_id, labels.id, labels.name, labels.colour, labels.user_id, labels.created_at, labels.updated_at
+		FROM task_labels
+		JOIN labels ON labels.id = task_labels.label_id
+		WHERE task_labels.task_id IN ($1, $2)
+		ORDER BY labels.name`
+
+	backend := model.Label{ID: 4, Name: "backend", Colour: "#0000ff", UserID: 1, CreatedAt: now, UpdatedAt: now}
+	urgent := model.Label{ID: 3, Name: "urgent", Colour: "#ff0000", UserID: 1, CreatedAt: now, UpdatedAt: now}
+
+	tests := []struct {
+		name       string
+		ids        []int64
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult map[int64][]model.Label
+		wantErr    error
+	}{
+		{
+			name: "success",
+			ids:  []int64{1, 2},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"task_id", "id", "name", "colour", "user_id", "created_at", "updated_at"}).
+					AddRow(int64(1), backend.ID, backend.Name, backend.Colour, backend.UserID, now, now).
+					AddRow(int64(1), urgent.ID, urgent.Name, urgent.Colour, urgent.UserID, now, now).
+					AddRow(int64(2), urgent.ID, urgent.Name, urgent.Colour, urgent.UserID, now, now)
+
+				s.ExpectQuery(query).
+					WithArgs(int64(1), int64(2)).
+					WillReturnRows(rows)
+			},
+			wantResult: map[int64][]model.Label{1: {backend, urgent}, 2: {urgent}},
+			wantErr:    nil,
+		},
+		{
+			name:       "success with empty ids",
+			ids:        []int64{},
+			wantResult: map[int64][]model.Label{},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get labels",
+			ids:  []int64{1, 2},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(int64(1), int64(2)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: map[int64][]model.Label{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.GetLabels(context.Background(), tt.ids)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskCountComments(t *testing.T) {
+	query := `SELECT task_id, count(*) AS total
+		FROM task_comments
+		WHERE task_id IN ($1, $2)
+		GROUP BY task_id`
+
+	tests := []struct {
+		name       string
+		ids        []int64
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult map[int64]int64
+		wantErr    error
+	}{
+		{
+			name: "success",
+			ids:  []int64{1, 2},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"task_id", "total"}).
+					AddRow(int64(1), int64(3))
+
+				s.ExpectQuery(query).
+					WithArgs(int64(1), int64(2)).
+					WillReturnRows(rows)
+			},
+			wantResult: map[int64]int64{1: 3},
+			wantErr:    nil,
+		},
+		{
+			name:       "success with empty ids",
+			ids:        []int64{},
+			wantResult: map[int64]int64{},
+			wantErr:    nil,
+		},
+		{
+			name: "error when count comments",
+			ids:  []int64{1, 2},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(int64(1), int64(2)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: map[int64]int64{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.CountComments(context.Background(), tt.ids)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskCreateChecklistItem(t *testing.T) {
+	query := `INSERT INTO task_checklist_items
+		(task_id, text, position)
+		SELECT $1, $2, COALESCE(MAX(position), 0) + 1 FROM task_checklist_items WHERE task_id = $1
+		RETURNING id, task_id, text, checked, position, created_at, updated_at`
+
+	item := model.TaskChecklistItem{ID: 1, TaskID: taskModel.ID, Text: "write tests", Position: 1, CreatedAt: now, UpdatedAt: now}
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.TaskChecklistItem
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "task_id", "text", "checked", "position", "created_at", "updated_at"}).
+					AddRow(item.ID, item.TaskID, item.Text, item.Checked, item.Position, now, now)
+
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID, item.Text).
+					WillReturnRows(rows)
+			},
+			wantResult: item,
+			wantErr:    nil,
+		},
+		{
+			name: "error when create checklist item",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID, item.Text).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: model.TaskChecklistItem{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.CreateChecklistItem(context.Background(), model.TaskChecklistItem{TaskID: taskModel.ID, Text: item.Text})
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskGetChecklist(t *testing.T) {
+	query := `SELECT 
+		id, task_id, text, checked, position, created_at, updated_at
+		FROM task_checklist_items
+		WHERE task_id = $1
+		ORDER BY position, id`
+
+	checklist := []model.TaskChecklistItem{
+		{ID: 1, TaskID: taskModel.ID, Text: "write tests", Checked: true, Position: 1, CreatedAt: now, UpdatedAt: now},
+		{ID: 2, TaskID: taskModel.ID, Text: "review", Position: 2, CreatedAt: now, UpdatedAt: now},
+	}
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.TaskChecklistItem
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "task_id", "text", "checked", "position", "created_at", "updated_at"})
+				for _, item := range checklist {
+					rows.AddRow(item.ID, item.TaskID, item.Text, item.Checked, item.Position, now, now)
+				}
+
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID).
+					WillReturnRows(rows)
+			},
+			wantResult: checklist,
+			wantErr:    nil,
+		},
+		{
+			name: "error when get checklist",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.TaskChecklistItem{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.GetChecklist(context.Background(), taskModel.ID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskToggleChecklistItem(t *testing.T) {
+	query := `UPDATE task_checklist_items
+		SET checked = NOT checked, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND task_id = $2
+		RETURNING id, task_id, text, checked, position, created_at, updated_at`
+
+	item := model.TaskChecklistItem{ID: 2, TaskID: taskModel.ID, Text: "review", Checked: true, Position: 2, CreatedAt: now, UpdatedAt: now}
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.TaskChecklistItem
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "task_id", "text", "checked", "position", "created_at", "updated_at"}).
+					AddRow(item.ID, item.TaskID, item.Text, item.Checked, item.Position, now, now)
+
+				s.ExpectQuery(query).
+					WithArgs(item.ID, taskModel.ID).
+					WillReturnRows(rows)
+			},
+			wantResult: item,
+			wantErr:    nil,
+		},
+		{
+			name: "error when checklist item is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(item.ID, taskModel.ID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantResult: model.TaskChecklistItem{},
+			wantErr:    sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.ToggleChecklistItem(context.Background(), item.ID, taskModel.ID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskMoveChecklistItem(t *testing.T) {
+	query := `UPDATE task_checklist_items
+		SET position = $1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $2 AND task_id = $3`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(3, int64(2), taskModel.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when move checklist item",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(3, int64(2), taskModel.ID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			err := r.MoveChecklistItem(context.Background(), int64(2), taskModel.ID, 3)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskDeleteChecklistItem(t *testing.T) {
+	query := `DELETE FROM task_checklist_items WHERE id = $1 AND task_id = $2`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(int64(2), taskModel.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when checklist item is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(int64(2), taskModel.ID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name: "error when delete checklist item",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(int64(2), taskModel.ID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			err := r.DeleteChecklistItem(context.Background(), int64(2), taskModel.ID)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskChecklistSummary(t *testing.T) {
+	query := `SELECT task_id, count(*) FILTER (WHERE checked) AS done, count(*) AS total
+		FROM task_checklist_items
+		WHERE task_id IN ($1, $2)
+		GROUP BY task_id`
+
+	tests := []struct {
+		name       string
+		ids        []int64
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult map[int64]model.TaskChecklistSummary
+		wantErr    error
+	}{
+		{
+			name: "success",
+			ids:  []int64{1, 2},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"task_id", "done", "total"}).
+					AddRow(int64(1), int64(1), int64(3))
+
+				s.ExpectQuery(query).
+					WithArgs(int64(1), int64(2)).
+					WillReturnRows(rows)
+			},
+			wantResult: map[int64]model.TaskChecklistSummary{1: {Done: 1, Total: 3}},
+			wantErr:    nil,
+		},
+		{
+			name:       "success with empty ids",
+			ids:        []int64{},
+			wantResult: map[int64]model.TaskChecklistSummary{},
+			wantErr:    nil,
+		},
+		{
+			name: "error when summarize checklist",
+			ids:  []int64{1, 2},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(int64(1), int64(2)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: map[int64]model.TaskChecklistSummary{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.ChecklistSummary(context.Background(), tt.ids)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskTrackedTime(t *testing.T) {
+	query := `SELECT task_id, COALESCE(sum(EXTRACT(EPOCH FROM ended_at - started_at)), 0)::BIGINT AS total
+		FROM time_entries
+		WHERE task_id IN ($1, $2) AND ended_at IS NOT NULL
+		GROUP BY task_id`
+
+	tests := []struct {
+		name       string
+		ids        []int64
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult map[int64]int64
+		wantErr    error
+	}{
+		{
+			name: "success",
+			ids:  []int64{1, 2},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"task_id", "total"}).
+					AddRow(int64(2), int64(5400))
+
+				s.ExpectQuery(query).
+					WithArgs(int64(1), int64(2)).
+					WillReturnRows(rows)
+			},
+			wantResult: map[int64]int64{2: 5400},
+			wantErr:    nil,
+		},
+		{
+			name:       "success with empty ids",
+			ids:        []int64{},
+			wantResult: map[int64]int64{},
+			wantErr:    nil,
+		},
+		{
+			name: "error when sum tracked time",
+			ids:  []int64{1, 2},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(int64(1), int64(2)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: map[int64]int64{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.TrackedTime(context.Background(), tt.ids)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskCreateSeries(t *testing.T) {
+	query := `INSERT INTO task_series
+		(rule, dtstart, title, description, priority, project_id, workspace_id, assignee_id, user_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, rule, dtstart, title, description, priority, project_id, workspace_id, assignee_id, occurrence_offset, user_id, created_at, updated_at`
+
+	series := model.TaskSeries{ID: 5, Rule: "FREQ=WEEKLY", DTStart: due, Title: taskModel.Title, Description: taskModel.Description, Priority: taskModel.Priority, UserID: taskModel.UserID, CreatedAt: now, UpdatedAt: now}
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.TaskSeries
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "rule", "dtstart", "title", "description", "priority", "project_id", "workspace_id", "assignee_id", "occurrence_offset", "user_id", "created_at", "updated_at"}).
+					AddRow(series.ID, series.Rule, due, series.Title, series.Description, series.Priority, nil, nil, nil, 0, series.UserID, now, now)
+
+				s.ExpectQuery(query).
+					WithArgs(series.Rule, due, series.Title, series.Description, series.Priority, nil, nil, nil, series.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: series,
+			wantErr:    nil,
+		},
+		{
+			name: "error when create series",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(series.Rule, due, series.Title, series.Description, series.Priority, nil, nil, nil, series.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: model.TaskSeries{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.CreateSeries(context.Background(), model.TaskSeries{Rule: series.Rule, DTStart: due, Title: series.Title, Description: series.Description, Priority: series.Priority, UserID: series.UserID})
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskGetSeries(t *testing.T) {
+	query := `SELECT 
+		id, rule, dtstart, title, description, priority, project_id, workspace_id, assignee_id, occurrence_offset, user_id, created_at, updated_at
+		FROM task_series
+		WHERE id = $1`
+
+	series := model.TaskSeries{ID: 5, Rule: "FREQ=DAILY;COUNT=3", DTStart: due, Title: taskModel.Title, Description: taskModel.Description, Priority: taskModel.Priority, WorkspaceID: &workspaceId, Offset: 1, UserID: taskModel.UserID, CreatedAt: now, UpdatedAt: now}
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.TaskSeries
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "rule", "dtstart", "title", "description", "priority", "project_id", "workspace_id", "assignee_id", "occurrence_offset", "user_id", "created_at", "updated_at"}).
+					AddRow(series.ID, series.Rule, due, series.Title, series.Description, series.Priority, nil, workspaceId, nil, series.Offset, series.UserID, now, now)
+
+				s.ExpectQuery(query).
+					WithArgs(series.ID).
+					WillReturnRows(rows)
+			},
+			wantResult: series,
+			wantErr:    nil,
+		},
+		{
+			name: "error when series is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(series.ID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantResult: model.TaskSeries{},
+			wantErr:    sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.GetSeries(context.Background(), series.ID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskUpdateSeries(t *testing.T) {
+	query := `UPDATE task_series
+		SET rule = $1, dtstart = $2, title = $3, description = $4, priority = $5, project_id = $6, assignee_id = $7, occurrence_offset = $8, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $9`
+
+	series := model.TaskSeries{ID: 5, Rule: "FREQ=WEEKLY;BYDAY=MO,TH", DTStart: due, Title: taskModel.Title, Description: taskModel.Description, Priority: taskModel.Priority, ProjectID: &projectId, Offset: 2}
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(series.Rule, due, series.Title, series.Description, series.Priority, projectId, nil, series.Offset, series.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when update series",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(series.Rule, due, series.Title, series.Description, series.Priority, projectId, nil, series.Offset, series.ID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			err := r.UpdateSeries(context.Background(), series)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskCreateOccurrence(t *testing.T) {
+	query := `INSERT INTO task_occurrences
+		(task_id, series_id, occurrence, scheduled_at)
+		VALUES ($1, $2, $3, $4)`
+
+	occurrence := model.TaskOccurrence{TaskID: taskModel.ID, SeriesID: 5, Occurrence: 2, ScheduledAt: due}
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(occurrence.TaskID, occurrence.SeriesID, occurrence.Occurrence, due).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when create occurrence",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(occurrence.TaskID, occurrence.SeriesID, occurrence.Occurrence, due).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			err := r.CreateOccurrence(context.Background(), occurrence)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskUpdateOccurrence(t *testing.T) {
+	query := `UPDATE task_occurrences SET scheduled_at = $1 WHERE task_id = $2`
+
+	occurrence := model.TaskOccurrence{TaskID: taskModel.ID, SeriesID: 5, ScheduledAt: due}
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(due, occurrence.TaskID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when update occurrence",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(due, occurrence.TaskID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			err := r.UpdateOccurrence(context.Background(), occurrence)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskGetFutureOccurrences(t *testing.T) {
+	query := `SELECT 
+		id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, user_id, created_at, updated_at, version
+		FROM tasks
+		JOIN task_occurrences ON task_occurrences.task_id = tasks.id
+		WHERE task_occurrences.series_id = $1 AND task_occurrences.occurrence > $2 AND (user_id = $3 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $3)) AND deleted_at IS NULL
+		ORDER BY task_occurrences.occurrence`
+
+	seriesId := int64(5)
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "due_at", "user_id", "version"}).
+					AddRow(int64(9), "Water plants", due, taskModel.UserID, int64(1))
+
+				s.ExpectQuery(query).
+					WithArgs(seriesId, 2, taskModel.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.Task{{ID: 9, Title: "Water plants", DueAt: &due, UserID: taskModel.UserID, Version: 1}},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get future occurrences",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(seriesId, 2, taskModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.Task{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.GetFutureOccurrences(context.Background(), seriesId, 2, taskModel.UserID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskGetOccurrence(t *testing.T) {
+	query := `SELECT 
+		task_occurrences.task_id, task_occurrences.series_id, task_occurrences.occurrence, task_occurrences.scheduled_at, task_series.rule,
+		(SELECT max(latest.occurrence) FROM task_occurrences latest WHERE latest.series_id = task_occurrences.series_id) AS latest
+		FROM task_occurrences
+		JOIN task_series ON task_series.id = task_occurrences.series_id
+		WHERE task_occurrences.task_id = $1`
+
+	occurrence := model.TaskOccurrence{TaskID: taskModel.ID, SeriesID: 5, Occurrence: 2, ScheduledAt: due, Rule: "FREQ=WEEKLY", Latest: 3}
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.TaskOccurrence
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"task_id", "series_id", "occurrence", "scheduled_at", "rule", "latest"}).
+					AddRow(occurrence.TaskID, occurrence.SeriesID, occurrence.Occurrence, due, occurrence.Rule, occurrence.Latest)
+
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID).
+					WillReturnRows(rows)
+			},
+			wantResult: occurrence,
+			wantErr:    nil,
+		},
+		{
+			name: "error when task is not recurring",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantResult: model.TaskOccurrence{},
+			wantErr:    sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.GetOccurrence(context.Background(), taskModel.ID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskGetOccurrences(t *testing.T) {
+	query := `SELECT 
+		task_occurrences.task_id, task_occurrences.series_id, task_occurrences.occurrence, task_occurrences.scheduled_at, task_series.rule
+		FROM task_occurrences
+		JOIN task_series ON task_series.id = task_occurrences.series_id
+		WHERE task_occurrences.task_id IN ($1, $2)`
+
+	tests := []struct {
+		name       string
+		ids        []int64
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult map[int64]model.TaskOccurrence
+		wantErr    error
+	}{
+		{
+			name: "success",
+			ids:  []int64{1, 2},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"task_id", "series_id", "occurrence", "scheduled_at", "rule"}).
+					AddRow(int64(2), int64(5), 3, due, "FREQ=DAILY")
+
+				s.ExpectQuery(query).
+					WithArgs(int64(1), int64(2)).
+					WillReturnRows(rows)
+			},
+			wantResult: map[int64]model.TaskOccurrence{2: {TaskID: 2, SeriesID: 5, Occurrence: 3, ScheduledAt: due, Rule: "FREQ=DAILY"}},
+			wantErr:    nil,
+		},
+		{
+			name:       "success with empty ids",
+			ids:        []int64{},
+			wantResult: map[int64]model.TaskOccurrence{},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get occurrences",
+			ids:  []int64{1, 2},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(int64(1), int64(2)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: map[int64]model.TaskOccurrence{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.GetOccurrences(context.Background(), tt.ids)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskCreateHistory(t *testing.T) {
+	query := `INSERT INTO task_history
+		(task_id, revision, action, actor_id, changes)
+		VALUES ($1, (SELECT COALESCE(max(revision), 0) + 1 FROM task_history WHERE task_id = $1), $2, $3, $4)
+		RETURNING id, task_id, revision, action, actor_id, changes, created_at`
+
+	changes := model.TaskChanges{{Field: "status", Old: []byte(`"todo"`), New: []byte(`"done"`)}}
+	history := model.TaskHistory{TaskID: taskModel.ID, Action: model.TaskActionTransition, ActorID: 1, Changes: changes}
+	encoded := []byte(`[{"field":"status","old":"todo","new":"done"}]`)
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.TaskHistory
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "task_id", "revision", "action", "actor_id", "changes", "created_at"}).
+					AddRow(3, history.TaskID, 2, history.Action, history.ActorID, encoded, now)
+
+				s.ExpectQuery(query).
+					WithArgs(history.TaskID, history.Action, history.ActorID, encoded).
+					WillReturnRows(rows)
+			},
+			wantResult: model.TaskHistory{ID: 3, TaskID: history.TaskID, Revision: 2, Action: history.Action, ActorID: history.ActorID, Changes: changes, CreatedAt: now},
+			wantErr:    nil,
+		},
+		{
+			name: "error when create history",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(history.TaskID, history.Action, history.ActorID, encoded).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: model.TaskHistory{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.CreateHistory(context.Background(), history)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskGetHistory(t *testing.T) {
+	query := `SELECT 
+		id, task_id, revision, action, actor_id, changes, created_at
+		FROM task_history
+		WHERE task_id = $1
+		ORDER BY revision DESC LIMIT $2 OFFSET $3`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.TaskHistory
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "task_id", "revision", "action", "actor_id", "changes", "created_at"}).
+					AddRow(2, taskModel.ID, 2, model.TaskActionDelete, 1, []byte(`[]`), now).
+					AddRow(1, taskModel.ID, 1, model.TaskActionCreate, 1, []byte(`[{"field":"title","old":null,"new":"Todo 1"}]`), now)
+
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID, 10, 0).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.TaskHistory{
+				{ID: 2, TaskID: taskModel.ID, Revision: 2, Action: model.TaskActionDelete, ActorID: 1, Changes: model.TaskChanges{}, CreatedAt: now},
+				{ID: 1, TaskID: taskModel.ID, Revision: 1, Action: model.TaskActionCreate, ActorID: 1, Changes: model.TaskChanges{{Field: "title", Old: []byte(`null`), New: []byte(`"Todo 1"`)}}, CreatedAt: now},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when get history",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID, 10, 0).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.TaskHistory{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.GetHistory(context.Background(), taskModel.ID, 10, 0)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskCountHistory(t *testing.T) {
+	query := `SELECT count(*) FROM task_history WHERE task_id = $1`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult int64
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
+
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID).
+					WillReturnRows(rows)
+			},
+			wantResult: 2,
+			wantErr:    nil,
+		},
+		{
+			name: "error when count history",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: 0,
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.CountHistory(context.Background(), taskModel.ID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskGetHistorySince(t *testing.T) {
+	query := `SELECT 
+		id, task_id, revision, action, actor_id, changes, created_at
+		FROM task_history
+		WHERE task_id = $1 AND revision >= $2
+		ORDER BY revision DESC`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.TaskHistory
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "task_id", "revision", "action", "actor_id", "changes", "created_at"}).
+					AddRow(3, taskModel.ID, 3, model.TaskActionUpdate, 1, []byte(`[{"field":"title","old":"Todo 1","new":"Todo 2"}]`), now).
+					AddRow(2, taskModel.ID, 2, model.TaskActionTransition, 1, []byte(`[{"field":"status","old":"todo","new":"done"}]`), now)
+
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID, int64(2)).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.TaskHistory{
+				{ID: 3, TaskID: taskModel.ID, Revision: 3, Action: model.TaskActionUpdate, ActorID: 1, Changes: model.TaskChanges{{Field: "title", Old: []byte(`"Todo 1"`), New: []byte(`"Todo 2"`)}}, CreatedAt: now},
+				{ID: 2, TaskID: taskModel.ID, Revision: 2, Action: model.TaskActionTransition, ActorID: 1, Changes: model.TaskChanges{{Field: "status", Old: []byte(`"todo"`), New: []byte(`"done"`)}}, CreatedAt: now},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when get history since revision",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(taskModel.ID, int64(2)).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.TaskHistory{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.GetHistorySince(context.Background(), taskModel.ID, 2)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskGetHistoryByTaskIDs(t *testing.T) {
+	query := `SELECT 
+		id, task_id, revision, action, actor_id, changes, created_at
+		FROM task_history
+		WHERE task_id IN ($1, $2) AND created_at >= $3
+		ORDER BY created_at DESC, revision DESC`
+
+	tests := []struct {
+		name       string
+		ids        []int64
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.TaskHistory
+		wantErr    error
+	}{
+		{
+			name: "success",
+			ids:  []int64{1, 2},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "task_id", "revision", "action", "actor_id", "changes", "created_at"}).
+					AddRow(4, int64(2), 2, model.TaskActionUpdate, 1, []byte(`[{"field":"story_points","old":3,"new":5}]`), now)
+
+				s.ExpectQuery(query).
+					WithArgs(int64(1), int64(2), now).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.TaskHistory{
+				{ID: 4, TaskID: 2, Revision: 2, Action: model.TaskActionUpdate, ActorID: 1, Changes: model.TaskChanges{{Field: "story_points", Old: []byte(`3`), New: []byte(`5`)}}, CreatedAt: now},
+			},
+			wantErr: nil,
+		},
+		{
+			name:       "success with empty ids",
+			ids:        []int64{},
+			wantResult: []model.TaskHistory{},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get history by task ids",
+			ids:  []int64{1, 2},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(int64(1), int64(2), now).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.TaskHistory{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.GetHistoryByTaskIDs(context.Background(), tt.ids, now)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskGetBurndownTasks(t *testing.T) {
+	points := 3
+
+	tests := []struct {
+		name       string
+		projectId  *int64
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "story_points", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, points, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
+
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+					FROM tasks
+					WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND created_at < $2
+					ORDER BY id`).
+					WithArgs(taskModel.UserID, due).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.Task{
+				{
+					ID:          taskModel.ID,
+					Title:       taskModel.Title,
+					Description: taskModel.Description,
+					Status:      taskModel.Status,
+					Priority:    taskModel.Priority,
+					DueAt:       taskModel.DueAt,
+					StoryPoints: &points,
+					CreatedAt:   taskModel.CreatedAt,
+					UpdatedAt:   taskModel.UpdatedAt,
+					Version:     taskModel.Version,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:      "success with project",
+			projectId: &projectId,
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "description", "status", "priority", "due_at", "project_id", "created_at", "updated_at", "version"}).
+					AddRow(taskModel.ID, taskModel.Title, taskModel.Description, taskModel.Status, taskModel.Priority, taskModel.DueAt, projectId, taskModel.CreatedAt, taskModel.UpdatedAt, taskModel.Version)
+
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+					FROM tasks
+					WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND created_at < $2 AND (project_id = $3 OR id IN (SELECT task_id FROM task_history WHERE changes @> $4::JSONB))
+					ORDER BY id`).
+					WithArgs(taskModel.UserID, due, projectId, `[{"field":"project_id","old":7}]`).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.Task{
+				{
+					ID:          taskModel.ID,
+					Title:       taskModel.Title,
+					Description: taskModel.Description,
+					Status:      taskModel.Status,
+					Priority:    taskModel.Priority,
+					DueAt:       taskModel.DueAt,
+					ProjectID:   &projectId,
+					CreatedAt:   taskModel.CreatedAt,
+					UpdatedAt:   taskModel.UpdatedAt,
+					Version:     taskModel.Version,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when get burndown tasks",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(`SELECT 
+					id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+					FROM tasks
+					WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND created_at < $2
+					ORDER BY id`).
+					WithArgs(taskModel.UserID, due).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.Task{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.GetBurndownTasks(context.Background(), taskModel.UserID, tt.projectId, due)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskGetProject(t *testing.T) {
+	query := `SELECT 
+		id, name, description, user_id, archived_at, created_at, updated_at
+		FROM projects
+		WHERE id = $1 AND user_id = $2`
+
+	project := model.Project{ID: projectId, Name: "Launch", Description: "q3 launch", UserID: 1, ArchivedAt: &now, CreatedAt: now, UpdatedAt: now}
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Project
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "name", "description", "user_id", "archived_at", "created_at", "updated_at"}).
+					AddRow(project.ID, project.Name, project.Description, project.UserID, now, now, now)
+
+				s.ExpectQuery(query).
+					WithArgs(projectId, taskModel.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: project,
+			wantErr:    nil,
+		},
+		{
+			name: "error when project is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(projectId, taskModel.UserID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantResult: model.Project{},
+			wantErr:    sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.GetProject(context.Background(), projectId, taskModel.UserID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskGetRole(t *testing.T) {
+	query := `SELECT role FROM workspace_members WHERE workspace_id = $1 AND user_id = $2`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult string
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(workspaceId, taskModel.UserID).
+					WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow(model.WorkspaceRoleEditor))
+			},
+			wantResult: model.WorkspaceRoleEditor,
+			wantErr:    nil,
+		},
+		{
+			name: "error when user is not a member",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(workspaceId, taskModel.UserID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantResult: "",
+			wantErr:    sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.GetRole(context.Background(), workspaceId, taskModel.UserID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskLockPositions(t *testing.T) {
+	workspaceId := int64(2)
+
+	tests := []struct {
+		name       string
+		lane       model.TaskLane
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success lock personal positions",
+			lane: model.TaskLane{UserID: 1, Status: model.TaskStatusTodo},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(`SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`).
+					WithArgs("tasks:position:user:1:todo").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success lock workspace board positions",
+			lane: model.TaskLane{WorkspaceID: &workspaceId, UserID: 1, Status: model.TaskStatusTodo},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(`SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`).
+					WithArgs("tasks:position:workspace:2:todo").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when lock positions",
+			lane: model.TaskLane{UserID: 1, Status: model.TaskStatusTodo},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(`SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`).
+					WithArgs("tasks:position:user:1:todo").
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			err := r.LockPositions(context.Background(), tt.lane)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskPositions(t *testing.T) {
+	workspaceId := int64(2)
+	personal := model.TaskLane{UserID: 1, Status: model.TaskStatusTodo}
+	board := model.TaskLane{WorkspaceID: &workspaceId, UserID: 1, Status: model.TaskStatusTodo}
+
+	tests := []struct {
+		name       string
+		call       func(r task.TaskRepository) (string, error)
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult string
+		wantErr    error
+	}{
+		{
+			name: "success get last position",
+			call: func(r task.TaskRepository) (string, error) {
+				return r.LastPosition(context.Background(), personal)
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(`SELECT COALESCE(max(position), '') FROM tasks WHERE user_id = $1 AND workspace_id IS NULL AND status = $2 AND deleted_at IS NULL`).
+					WithArgs(int64(1), model.TaskStatusTodo).
+					WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow("V00002"))
+			},
+			wantResult: "V00002",
+			wantErr:    nil,
+		},
+		{
+			name: "success get previous position",
+			call: func(r task.TaskRepository) (string, error) {
+				return r.PrevPosition(context.Background(), personal, "V00002")
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(`SELECT COALESCE(max(position), '') FROM tasks WHERE user_id = $1 AND workspace_id IS NULL AND status = $2 AND deleted_at IS NULL AND position < $3`).
+					WithArgs(int64(1), model.TaskStatusTodo, "V00002").
+					WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow("V00001"))
+			},
+			wantResult: "V00001",
+			wantErr:    nil,
+		},
+		{
+			name: "success get next position",
+			call: func(r task.TaskRepository) (string, error) {
+				return r.NextPosition(context.Background(), personal, "V00001")
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(`SELECT COALESCE(min(position), '') FROM tasks WHERE user_id = $1 AND workspace_id IS NULL AND status = $2 AND deleted_at IS NULL AND position > $3`).
+					WithArgs(int64(1), model.TaskStatusTodo, "V00001").
+					WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(""))
+			},
+			wantResult: "",
+			wantErr:    nil,
+		},
+		{
+			name: "success get last position on workspace board",
+			call: func(r task.TaskRepository) (string, error) {
+				return r.LastPosition(context.Background(), board)
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(`SELECT COALESCE(max(position), '') FROM tasks WHERE workspace_id = $1 AND status = $2 AND deleted_at IS NULL`).
+					WithArgs(workspaceId, model.TaskStatusTodo).
+					WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow("V00003"))
+			},
+			wantResult: "V00003",
+			wantErr:    nil,
+		},
+		{
+			name: "error when get last position",
+			call: func(r task.TaskRepository) (string, error) {
+				return r.LastPosition(context.Background(), personal)
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(`SELECT COALESCE(max(position), '') FROM tasks WHERE user_id = $1 AND workspace_id IS NULL AND status = $2 AND deleted_at IS NULL`).
+					WithArgs(int64(1), model.TaskStatusTodo).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: "",
+			wantErr:    sql.ErrConnDone,
+		},
+		{
+			name: "error when get previous position",
+			call: func(r task.TaskRepository) (string, error) {
+				return r.PrevPosition(context.Background(), personal, "V00002")
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(`SELECT COALESCE(max(position), '') FROM tasks WHERE user_id = $1 AND workspace_id IS NULL AND status = $2 AND deleted_at IS NULL AND position < $3`).
+					WithArgs(int64(1), model.TaskStatusTodo, "V00002").
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: "",
+			wantErr:    sql.ErrConnDone,
+		},
+		{
+			name: "error when get next position",
+			call: func(r task.TaskRepository) (string, error) {
+				return r.NextPosition(context.Background(), personal, "V00001")
+			},
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(`SELECT COALESCE(min(position), '') FROM tasks WHERE user_id = $1 AND workspace_id IS NULL AND status = $2 AND deleted_at IS NULL AND position > $3`).
+					WithArgs(int64(1), model.TaskStatusTodo, "V00001").
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: "",
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := tt.call(r)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskGetBoard(t *testing.T) {
+	query := `SELECT 
+		id, title, description, status, priority, due_at, parent_id, project_id, workspace_id, assignee_id, assigned_at, estimate_minutes, story_points, position, created_at, updated_at, version, deleted_at
+		FROM (
+			SELECT *, row_number() OVER (PARTITION BY status ORDER BY position, id) AS rank
+			FROM tasks
+			WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL AND project_id = $2
+		) board
+		WHERE rank <= $3
+		ORDER BY status, position, id`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "title", "status", "position"}).
+					AddRow(int64(1), "Todo 1", model.TaskStatusTodo, "V").
+					AddRow(int64(2), "Todo 2", model.TaskStatusTodo, "W")
+
+				s.ExpectQuery(query).
+					WithArgs(taskModel.UserID, projectId, 2).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.Task{
+				{ID: 1, Title: "Todo 1", Status: model.TaskStatusTodo, Position: "V"},
+				{ID: 2, Title: "Todo 2", Status: model.TaskStatusTodo, Position: "W"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when get board",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(taskModel.UserID, projectId, 2).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.Task{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := task.New(db)
+			result, err := r.GetBoard(context.Background(), taskModel.UserID, param.Param{ProjectID: &projectId}, 2)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTaskCountByStatus(t *testing.T) {
+	query := `SELECT status, count(*) AS total
+		FROM tasks
+		WHERE (user_id = $1 AND workspace_id IS NULL OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE workspace_members.user_id = $1)) AND deleted_at IS NULL AND project_id = $2
+		GROUP BY status`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult map[string]int64
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"status", "total"}).
+					AddRow(model.TaskStatusTodo, int64(3)).
+					AddRow(model.TaskStatusDone, int64(1))
+
+				s.ExpectQuery(query).
+					WithArgs(taskModel.UserID, projectId).
+					WillReturnRows(rows)
+			},
+			wantResult: map[string]int64{model.TaskStatusTodo: 3, model.TaskStatusDone: 1},
+			wantErr:    nil,
+		},
+		{
+			name: "error when count by status",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(taskModel.UserID, projectId).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: map[string]int64{},
 			wantErr:    sql.ErrConnDone,
 		},
 	}
@@ -408,7 +4517,7 @@ func TestTaskCount(t *testing.T) {
 			}
 
 			r := task.New(db)
-			result, err := r.Count(context.Background())
+			result, err := r.CountByStatus(context.Background(), taskModel.UserID, param.Param{ProjectID: &projectId})
 
 			assert.Equal(t, tt.wantResult, result)
 			assert.Equal(t, tt.wantErr, err)
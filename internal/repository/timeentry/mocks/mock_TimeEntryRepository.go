@@ -0,0 +1,441 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/rzfhlv/go-task/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockTimeEntryRepository is an autogenerated mock type for the TimeEntryRepository type
+type MockTimeEntryRepository struct {
+	mock.Mock
+}
+
+type MockTimeEntryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTimeEntryRepository) EXPECT() *MockTimeEntryRepository_Expecter {
+	return &MockTimeEntryRepository_Expecter{mock: &_m.Mock}
+}
+
+// Count provides a mock function with given fields: ctx, taskId
+func (_m *MockTimeEntryRepository) Count(ctx context.Context, taskId int64) (int64, error) {
+	ret := _m.Called(ctx, taskId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (int64, error)); ok {
+		return rf(ctx, taskId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, taskId)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, taskId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeEntryRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockTimeEntryRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+func (_e *MockTimeEntryRepository_Expecter) Count(ctx interface{}, taskId interface{}) *MockTimeEntryRepository_Count_Call {
+	return &MockTimeEntryRepository_Count_Call{Call: _e.mock.On("Count", ctx, taskId)}
+}
+
+func (_c *MockTimeEntryRepository_Count_Call) Run(run func(ctx context.Context, taskId int64)) *MockTimeEntryRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_Count_Call) Return(_a0 int64, _a1 error) *MockTimeEntryRepository_Count_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_Count_Call) RunAndReturn(run func(context.Context, int64) (int64, error)) *MockTimeEntryRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, entry
+func (_m *MockTimeEntryRepository) Create(ctx context.Context, entry model.TimeEntry) (model.TimeEntry, error) {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TimeEntry) (model.TimeEntry, error)); ok {
+		return rf(ctx, entry)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TimeEntry) model.TimeEntry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Get(0).(model.TimeEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TimeEntry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeEntryRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockTimeEntryRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry model.TimeEntry
+func (_e *MockTimeEntryRepository_Expecter) Create(ctx interface{}, entry interface{}) *MockTimeEntryRepository_Create_Call {
+	return &MockTimeEntryRepository_Create_Call{Call: _e.mock.On("Create", ctx, entry)}
+}
+
+func (_c *MockTimeEntryRepository_Create_Call) Run(run func(ctx context.Context, entry model.TimeEntry)) *MockTimeEntryRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TimeEntry))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_Create_Call) Return(_a0 model.TimeEntry, _a1 error) *MockTimeEntryRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_Create_Call) RunAndReturn(run func(context.Context, model.TimeEntry) (model.TimeEntry, error)) *MockTimeEntryRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, taskId, userId
+func (_m *MockTimeEntryRepository) Delete(ctx context.Context, id int64, taskId int64, userId int64) error {
+	ret := _m.Called(ctx, id, taskId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) error); ok {
+		r0 = rf(ctx, id, taskId, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTimeEntryRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockTimeEntryRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - taskId int64
+//   - userId int64
+func (_e *MockTimeEntryRepository_Expecter) Delete(ctx interface{}, id interface{}, taskId interface{}, userId interface{}) *MockTimeEntryRepository_Delete_Call {
+	return &MockTimeEntryRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, taskId, userId)}
+}
+
+func (_c *MockTimeEntryRepository_Delete_Call) Run(run func(ctx context.Context, id int64, taskId int64, userId int64)) *MockTimeEntryRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_Delete_Call) Return(_a0 error) *MockTimeEntryRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_Delete_Call) RunAndReturn(run func(context.Context, int64, int64, int64) error) *MockTimeEntryRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTaskID provides a mock function with given fields: ctx, taskId, limit, offset
+func (_m *MockTimeEntryRepository) GetByTaskID(ctx context.Context, taskId int64, limit int, offset int) ([]model.TimeEntry, error) {
+	ret := _m.Called(ctx, taskId, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTaskID")
+	}
+
+	var r0 []model.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int) ([]model.TimeEntry, error)); ok {
+		return rf(ctx, taskId, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, int) []model.TimeEntry); ok {
+		r0 = rf(ctx, taskId, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TimeEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int, int) error); ok {
+		r1 = rf(ctx, taskId, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeEntryRepository_GetByTaskID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTaskID'
+type MockTimeEntryRepository_GetByTaskID_Call struct {
+	*mock.Call
+}
+
+// GetByTaskID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+//   - limit int
+//   - offset int
+func (_e *MockTimeEntryRepository_Expecter) GetByTaskID(ctx interface{}, taskId interface{}, limit interface{}, offset interface{}) *MockTimeEntryRepository_GetByTaskID_Call {
+	return &MockTimeEntryRepository_GetByTaskID_Call{Call: _e.mock.On("GetByTaskID", ctx, taskId, limit, offset)}
+}
+
+func (_c *MockTimeEntryRepository_GetByTaskID_Call) Run(run func(ctx context.Context, taskId int64, limit int, offset int)) *MockTimeEntryRepository_GetByTaskID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_GetByTaskID_Call) Return(_a0 []model.TimeEntry, _a1 error) *MockTimeEntryRepository_GetByTaskID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_GetByTaskID_Call) RunAndReturn(run func(context.Context, int64, int, int) ([]model.TimeEntry, error)) *MockTimeEntryRepository_GetByTaskID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRunning provides a mock function with given fields: ctx, userId
+func (_m *MockTimeEntryRepository) GetRunning(ctx context.Context, userId int64) (model.TimeEntry, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRunning")
+	}
+
+	var r0 model.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.TimeEntry, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.TimeEntry); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(model.TimeEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeEntryRepository_GetRunning_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRunning'
+type MockTimeEntryRepository_GetRunning_Call struct {
+	*mock.Call
+}
+
+// GetRunning is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+func (_e *MockTimeEntryRepository_Expecter) GetRunning(ctx interface{}, userId interface{}) *MockTimeEntryRepository_GetRunning_Call {
+	return &MockTimeEntryRepository_GetRunning_Call{Call: _e.mock.On("GetRunning", ctx, userId)}
+}
+
+func (_c *MockTimeEntryRepository_GetRunning_Call) Run(run func(ctx context.Context, userId int64)) *MockTimeEntryRepository_GetRunning_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_GetRunning_Call) Return(_a0 model.TimeEntry, _a1 error) *MockTimeEntryRepository_GetRunning_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_GetRunning_Call) RunAndReturn(run func(context.Context, int64) (model.TimeEntry, error)) *MockTimeEntryRepository_GetRunning_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Report provides a mock function with given fields: ctx, userId, from, to, groupBy
+func (_m *MockTimeEntryRepository) Report(ctx context.Context, userId int64, from time.Time, to time.Time, groupBy string) ([]model.TimeReportItem, error) {
+	ret := _m.Called(ctx, userId, from, to, groupBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Report")
+	}
+
+	var r0 []model.TimeReportItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, string) ([]model.TimeReportItem, error)); ok {
+		return rf(ctx, userId, from, to, groupBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, string) []model.TimeReportItem); ok {
+		r0 = rf(ctx, userId, from, to, groupBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TimeReportItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time, string) error); ok {
+		r1 = rf(ctx, userId, from, to, groupBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeEntryRepository_Report_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Report'
+type MockTimeEntryRepository_Report_Call struct {
+	*mock.Call
+}
+
+// Report is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - from time.Time
+//   - to time.Time
+//   - groupBy string
+func (_e *MockTimeEntryRepository_Expecter) Report(ctx interface{}, userId interface{}, from interface{}, to interface{}, groupBy interface{}) *MockTimeEntryRepository_Report_Call {
+	return &MockTimeEntryRepository_Report_Call{Call: _e.mock.On("Report", ctx, userId, from, to, groupBy)}
+}
+
+func (_c *MockTimeEntryRepository_Report_Call) Run(run func(ctx context.Context, userId int64, from time.Time, to time.Time, groupBy string)) *MockTimeEntryRepository_Report_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time), args[3].(time.Time), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_Report_Call) Return(_a0 []model.TimeReportItem, _a1 error) *MockTimeEntryRepository_Report_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_Report_Call) RunAndReturn(run func(context.Context, int64, time.Time, time.Time, string) ([]model.TimeReportItem, error)) *MockTimeEntryRepository_Report_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with given fields: ctx, id, userId, endedAt
+func (_m *MockTimeEntryRepository) Stop(ctx context.Context, id int64, userId int64, endedAt time.Time) (model.TimeEntry, error) {
+	ret := _m.Called(ctx, id, userId, endedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 model.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, time.Time) (model.TimeEntry, error)); ok {
+		return rf(ctx, id, userId, endedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, time.Time) model.TimeEntry); ok {
+		r0 = rf(ctx, id, userId, endedAt)
+	} else {
+		r0 = ret.Get(0).(model.TimeEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, time.Time) error); ok {
+		r1 = rf(ctx, id, userId, endedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeEntryRepository_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type MockTimeEntryRepository_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userId int64
+//   - endedAt time.Time
+func (_e *MockTimeEntryRepository_Expecter) Stop(ctx interface{}, id interface{}, userId interface{}, endedAt interface{}) *MockTimeEntryRepository_Stop_Call {
+	return &MockTimeEntryRepository_Stop_Call{Call: _e.mock.On("Stop", ctx, id, userId, endedAt)}
+}
+
+func (_c *MockTimeEntryRepository_Stop_Call) Run(run func(ctx context.Context, id int64, userId int64, endedAt time.Time)) *MockTimeEntryRepository_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_Stop_Call) Return(_a0 model.TimeEntry, _a1 error) *MockTimeEntryRepository_Stop_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeEntryRepository_Stop_Call) RunAndReturn(run func(context.Context, int64, int64, time.Time) (model.TimeEntry, error)) *MockTimeEntryRepository_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTimeEntryRepository creates a new instance of MockTimeEntryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTimeEntryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTimeEntryRepository {
+	mock := &MockTimeEntryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
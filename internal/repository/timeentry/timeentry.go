@@ -0,0 +1,177 @@
+package timeentry
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rzfhlv/go-task/internal/model"
+)
+
+var (
+	createTimeEntryQuery = `INSERT INTO time_entries
+		(task_id, user_id, started_at, ended_at, note, source)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, task_id, user_id, started_at, ended_at,
+		EXTRACT(EPOCH FROM COALESCE(ended_at, CURRENT_TIMESTAMP) - started_at)::BIGINT AS duration_seconds,
+		note, source, created_at`
+
+	getRunningTimeEntryQuery = `SELECT 
+		id, task_id, user_id, started_at, ended_at,
+		EXTRACT(EPOCH FROM CURRENT_TIMESTAMP - started_at)::BIGINT AS duration_seconds,
+		note, source, created_at
+		FROM time_entries
+		WHERE user_id = $1 AND ended_at IS NULL`
+
+	stopTimeEntryQuery = `UPDATE time_entries
+		SET ended_at = $1
+		WHERE id = $2 AND user_id = $3 AND ended_at IS NULL
+		RETURNING id, task_id, user_id, started_at, ended_at,
+		EXTRACT(EPOCH FROM ended_at - started_at)::BIGINT AS duration_seconds,
+		note, source, created_at`
+
+	getTimeEntryByTaskIDQuery = `SELECT 
+		id, task_id, user_id, started_at, ended_at,
+		EXTRACT(EPOCH FROM COALESCE(ended_at, CURRENT_TIMESTAMP) - started_at)::BIGINT AS duration_seconds,
+		note, source, created_at
+		FROM time_entries
+		WHERE task_id = $1
+		ORDER BY started_at DESC, id DESC LIMIT $2 OFFSET $3`
+
+	countTimeEntryByTaskIDQuery = `SELECT count(*) FROM time_entries WHERE task_id = $1`
+
+	deleteTimeEntryQuery = `DELETE FROM time_entries WHERE id = $1 AND task_id = $2 AND user_id = $3`
+
+	reportTimeEntryQuery = `SELECT 
+		%s AS key, %s AS name,
+		COALESCE(sum(EXTRACT(EPOCH FROM time_entries.ended_at - time_entries.started_at)), 0)::BIGINT AS total_seconds,
+		count(*) AS entries
+		FROM time_entries
+		JOIN tasks ON tasks.id = time_entries.task_id
+		LEFT JOIN projects ON projects.id = tasks.project_id
+		WHERE time_entries.user_id = $1 AND time_entries.ended_at IS NOT NULL
+		AND time_entries.started_at >= $2 AND time_entries.started_at < $3
+		GROUP BY 1, 2
+		ORDER BY 1`
+)
+
+var reportGroups = map[string][2]string{
+	model.TimeReportGroupTask:    {"time_entries.task_id::TEXT", "tasks.title"},
+	model.TimeReportGroupProject: {"COALESCE(projects.id::TEXT, '')", "COALESCE(projects.name, '')"},
+	model.TimeReportGroupDay:     {"to_char(time_entries.started_at AT TIME ZONE 'UTC', 'YYYY-MM-DD')", "to_char(time_entries.started_at AT TIME ZONE 'UTC', 'YYYY-MM-DD')"},
+}
+
+const uniqueViolation = "23505"
+
+var ErrTimerRunning = errors.New("timer already running")
+
+type TimeEntryRepository interface {
+	Create(ctx context.Context, entry model.TimeEntry) (model.TimeEntry, error)
+	GetRunning(ctx context.Context, userId int64) (model.TimeEntry, error)
+	Stop(ctx context.Context, id, userId int64, endedAt time.Time) (model.TimeEntry, error)
+	GetByTaskID(ctx context.Context, taskId int64, limit, offset int) ([]model.TimeEntry, error)
+	Count(ctx context.Context, taskId int64) (int64, error)
+	Delete(ctx context.Context, id, taskId, userId int64) error
+	Report(ctx context.Context, userId int64, from, to time.Time, groupBy string) ([]model.TimeReportItem, error)
+}
+
+type TimeEntry struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) TimeEntryRepository {
+	return &TimeEntry{
+		db: db,
+	}
+}
+
+func (t *TimeEntry) Create(ctx context.Context, entry model.TimeEntry) (model.TimeEntry, error) {
+	result := model.TimeEntry{}
+	err := t.db.Get(&result, createTimeEntryQuery, entry.TaskID, entry.UserID, entry.StartedAt, entry.EndedAt, entry.Note, entry.Source)
+	if isUniqueViolation(err) {
+		return model.TimeEntry{}, ErrTimerRunning
+	}
+
+	if err != nil {
+		return model.TimeEntry{}, err
+	}
+
+	return result, nil
+}
+
+func (t *TimeEntry) GetRunning(ctx context.Context, userId int64) (model.TimeEntry, error) {
+	result := model.TimeEntry{}
+	err := t.db.Get(&result, getRunningTimeEntryQuery, userId)
+	if err != nil {
+		return model.TimeEntry{}, err
+	}
+
+	return result, nil
+}
+
+func (t *TimeEntry) Stop(ctx context.Context, id, userId int64, endedAt time.Time) (model.TimeEntry, error) {
+	result := model.TimeEntry{}
+	err := t.db.Get(&result, stopTimeEntryQuery, endedAt, id, userId)
+	if err != nil {
+		return model.TimeEntry{}, err
+	}
+
+	return result, nil
+}
+
+func (t *TimeEntry) GetByTaskID(ctx context.Context, taskId int64, limit, offset int) ([]model.TimeEntry, error) {
+	result := []model.TimeEntry{}
+	err := t.db.Select(&result, getTimeEntryByTaskIDQuery, taskId, limit, offset)
+	if err != nil {
+		return []model.TimeEntry{}, err
+	}
+
+	return result, nil
+}
+
+func (t *TimeEntry) Count(ctx context.Context, taskId int64) (int64, error) {
+	var total int64
+	err := t.db.Get(&total, countTimeEntryByTaskIDQuery, taskId)
+	return total, err
+}
+
+func (t *TimeEntry) Delete(ctx context.Context, id, taskId, userId int64) error {
+	result, err := t.db.Exec(deleteTimeEntryQuery, id, taskId, userId)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (t *TimeEntry) Report(ctx context.Context, userId int64, from, to time.Time, groupBy string) ([]model.TimeReportItem, error) {
+	group, ok := reportGroups[groupBy]
+	if !ok {
+		group = reportGroups[model.TimeReportGroupTask]
+	}
+
+	result := []model.TimeReportItem{}
+	err := t.db.Select(&result, fmt.Sprintf(reportTimeEntryQuery, group[0], group[1]), userId, from, to)
+	if err != nil {
+		return []model.TimeReportItem{}, err
+	}
+
+	return result, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var sqlErr interface{ SQLState() string }
+	return errors.As(err, &sqlErr) && sqlErr.SQLState() == uniqueViolation
+}
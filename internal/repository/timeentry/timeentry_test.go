@@ -0,0 +1,544 @@
+package timeentry_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/repository/timeentry"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	now     = time.Date(2023, time.August, 15, 12, 0, 0, 0, time.UTC)
+	endedAt = now.Add(90 * time.Minute)
+
+	timeEntryModel = model.TimeEntry{
+		ID:              1,
+		TaskID:          2,
+		UserID:          int64(1),
+		StartedAt:       now,
+		EndedAt:         &endedAt,
+		DurationSeconds: 5400,
+		Note:            "pairing session",
+		Source:          model.TimeEntrySourceManual,
+		CreatedAt:       now,
+	}
+
+	timeEntryColumns = []string{"id", "task_id", "user_id", "started_at", "ended_at", "duration_seconds", "note", "source", "created_at"}
+)
+
+type pgError struct {
+	code string
+}
+
+func (e pgError) Error() string {
+	return "pq: duplicate key value violates unique constraint"
+}
+
+func (e pgError) SQLState() string {
+	return e.code
+}
+
+func TestTimeEntryCreate(t *testing.T) {
+	query := `INSERT INTO time_entries
+		(task_id, user_id, started_at, ended_at, note, source)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, task_id, user_id, started_at, ended_at,
+		EXTRACT(EPOCH FROM COALESCE(ended_at, CURRENT_TIMESTAMP) - started_at)::BIGINT AS duration_seconds,
+		note, source, created_at`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.TimeEntry
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(timeEntryColumns).
+					AddRow(timeEntryModel.ID, timeEntryModel.TaskID, timeEntryModel.UserID, now, endedAt, 5400, timeEntryModel.Note, timeEntryModel.Source, now)
+
+				s.ExpectQuery(query).
+					WithArgs(timeEntryModel.TaskID, timeEntryModel.UserID, now, &endedAt, timeEntryModel.Note, timeEntryModel.Source).
+					WillReturnRows(rows)
+			},
+			wantResult: timeEntryModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when another timer is running",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(timeEntryModel.TaskID, timeEntryModel.UserID, now, &endedAt, timeEntryModel.Note, timeEntryModel.Source).
+					WillReturnError(pgError{code: "23505"})
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    timeentry.ErrTimerRunning,
+		},
+		{
+			name: "error when create time entry",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(timeEntryModel.TaskID, timeEntryModel.UserID, now, &endedAt, timeEntryModel.Note, timeEntryModel.Source).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := timeentry.New(db)
+			result, err := r.Create(context.Background(), timeEntryModel)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTimeEntryGetRunning(t *testing.T) {
+	query := `SELECT 
+		id, task_id, user_id, started_at, ended_at,
+		EXTRACT(EPOCH FROM CURRENT_TIMESTAMP - started_at)::BIGINT AS duration_seconds,
+		note, source, created_at
+		FROM time_entries
+		WHERE user_id = $1 AND ended_at IS NULL`
+
+	running := model.TimeEntry{ID: 3, TaskID: 2, UserID: 1, StartedAt: now, DurationSeconds: 60, Source: model.TimeEntrySourceTimer, CreatedAt: now}
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.TimeEntry
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(timeEntryColumns).
+					AddRow(running.ID, running.TaskID, running.UserID, now, nil, 60, "", running.Source, now)
+
+				s.ExpectQuery(query).
+					WithArgs(running.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: running,
+			wantErr:    nil,
+		},
+		{
+			name: "error when no timer is running",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(running.UserID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := timeentry.New(db)
+			result, err := r.GetRunning(context.Background(), running.UserID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTimeEntryStop(t *testing.T) {
+	query := `UPDATE time_entries
+		SET ended_at = $1
+		WHERE id = $2 AND user_id = $3 AND ended_at IS NULL
+		RETURNING id, task_id, user_id, started_at, ended_at,
+		EXTRACT(EPOCH FROM ended_at - started_at)::BIGINT AS duration_seconds,
+		note, source, created_at`
+
+	stopped := timeEntryModel
+	stopped.Source = model.TimeEntrySourceTimer
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.TimeEntry
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(timeEntryColumns).
+					AddRow(stopped.ID, stopped.TaskID, stopped.UserID, now, endedAt, 5400, stopped.Note, stopped.Source, now)
+
+				s.ExpectQuery(query).
+					WithArgs(endedAt, stopped.ID, stopped.UserID).
+					WillReturnRows(rows)
+			},
+			wantResult: stopped,
+			wantErr:    nil,
+		},
+		{
+			name: "error when timer is already stopped",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(endedAt, stopped.ID, stopped.UserID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := timeentry.New(db)
+			result, err := r.Stop(context.Background(), stopped.ID, stopped.UserID, endedAt)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTimeEntryGetByTaskID(t *testing.T) {
+	query := `SELECT 
+		id, task_id, user_id, started_at, ended_at,
+		EXTRACT(EPOCH FROM COALESCE(ended_at, CURRENT_TIMESTAMP) - started_at)::BIGINT AS duration_seconds,
+		note, source, created_at
+		FROM time_entries
+		WHERE task_id = $1
+		ORDER BY started_at DESC, id DESC LIMIT $2 OFFSET $3`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.TimeEntry
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(timeEntryColumns).
+					AddRow(timeEntryModel.ID, timeEntryModel.TaskID, timeEntryModel.UserID, now, endedAt, 5400, timeEntryModel.Note, timeEntryModel.Source, now)
+
+				s.ExpectQuery(query).
+					WithArgs(timeEntryModel.TaskID, 10, 0).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.TimeEntry{timeEntryModel},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get time entries",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(timeEntryModel.TaskID, 10, 0).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.TimeEntry{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := timeentry.New(db)
+			result, err := r.GetByTaskID(context.Background(), timeEntryModel.TaskID, 10, 0)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTimeEntryCount(t *testing.T) {
+	query := `SELECT count(*) FROM time_entries WHERE task_id = $1`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult int64
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"count"}).AddRow(4)
+
+				s.ExpectQuery(query).
+					WithArgs(timeEntryModel.TaskID).
+					WillReturnRows(rows)
+			},
+			wantResult: 4,
+			wantErr:    nil,
+		},
+		{
+			name: "error when count time entries",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(timeEntryModel.TaskID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: 0,
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := timeentry.New(db)
+			result, err := r.Count(context.Background(), timeEntryModel.TaskID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTimeEntryDelete(t *testing.T) {
+	query := `DELETE FROM time_entries WHERE id = $1 AND task_id = $2 AND user_id = $3`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(timeEntryModel.ID, timeEntryModel.TaskID, timeEntryModel.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when time entry is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(timeEntryModel.ID, timeEntryModel.TaskID, timeEntryModel.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name: "error when delete time entry",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(timeEntryModel.ID, timeEntryModel.TaskID, timeEntryModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := timeentry.New(db)
+			err := r.Delete(context.Background(), timeEntryModel.ID, timeEntryModel.TaskID, timeEntryModel.UserID)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTimeEntryReport(t *testing.T) {
+	query := `SELECT 
+		%s AS key, %s AS name,
+		COALESCE(sum(EXTRACT(EPOCH FROM time_entries.ended_at - time_entries.started_at)), 0)::BIGINT AS total_seconds,
+		count(*) AS entries
+		FROM time_entries
+		JOIN tasks ON tasks.id = time_entries.task_id
+		LEFT JOIN projects ON projects.id = tasks.project_id
+		WHERE time_entries.user_id = $1 AND time_entries.ended_at IS NOT NULL
+		AND time_entries.started_at >= $2 AND time_entries.started_at < $3
+		GROUP BY 1, 2
+		ORDER BY 1`
+
+	from := now
+	to := now.AddDate(0, 0, 7)
+	day := "to_char(time_entries.started_at AT TIME ZONE 'UTC', 'YYYY-MM-DD')"
+	reportColumns := []string{"key", "name", "total_seconds", "entries"}
+
+	tests := []struct {
+		name       string
+		groupBy    string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.TimeReportItem
+		wantErr    error
+	}{
+		{
+			name:    "success group by task",
+			groupBy: model.TimeReportGroupTask,
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(reportColumns).AddRow("2", "Unit Test", 5400, 2)
+
+				s.ExpectQuery(fmt.Sprintf(query, "time_entries.task_id::TEXT", "tasks.title")).
+					WithArgs(timeEntryModel.UserID, from, to).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.TimeReportItem{{Key: "2", Name: "Unit Test", TotalSeconds: 5400, Entries: 2}},
+			wantErr:    nil,
+		},
+		{
+			name:    "success group by project",
+			groupBy: model.TimeReportGroupProject,
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(reportColumns).AddRow("", "", 600, 1).AddRow("3", "Website", 4800, 1)
+
+				s.ExpectQuery(fmt.Sprintf(query, "COALESCE(projects.id::TEXT, '')", "COALESCE(projects.name, '')")).
+					WithArgs(timeEntryModel.UserID, from, to).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.TimeReportItem{{TotalSeconds: 600, Entries: 1}, {Key: "3", Name: "Website", TotalSeconds: 4800, Entries: 1}},
+			wantErr:    nil,
+		},
+		{
+			name:    "success group by day",
+			groupBy: model.TimeReportGroupDay,
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(reportColumns).AddRow("2023-08-15", "2023-08-15", 5400, 2)
+
+				s.ExpectQuery(fmt.Sprintf(query, day, day)).
+					WithArgs(timeEntryModel.UserID, from, to).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.TimeReportItem{{Key: "2023-08-15", Name: "2023-08-15", TotalSeconds: 5400, Entries: 2}},
+			wantErr:    nil,
+		},
+		{
+			name: "success fallback to group by task",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(reportColumns)
+
+				s.ExpectQuery(fmt.Sprintf(query, "time_entries.task_id::TEXT", "tasks.title")).
+					WithArgs(timeEntryModel.UserID, from, to).
+					WillReturnRows(rows)
+			},
+			wantResult: []model.TimeReportItem{},
+			wantErr:    nil,
+		},
+		{
+			name:    "error when report time entries",
+			groupBy: model.TimeReportGroupTask,
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(fmt.Sprintf(query, "time_entries.task_id::TEXT", "tasks.title")).
+					WithArgs(timeEntryModel.UserID, from, to).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.TimeReportItem{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := timeentry.New(db)
+			result, err := r.Report(context.Background(), timeEntryModel.UserID, from, to, tt.groupBy)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,540 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/rzfhlv/go-task/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWorkspaceRepository is an autogenerated mock type for the WorkspaceRepository type
+type MockWorkspaceRepository struct {
+	mock.Mock
+}
+
+type MockWorkspaceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWorkspaceRepository) EXPECT() *MockWorkspaceRepository_Expecter {
+	return &MockWorkspaceRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddMember provides a mock function with given fields: ctx, id, email, role
+func (_m *MockWorkspaceRepository) AddMember(ctx context.Context, id int64, email string, role string) (model.WorkspaceMember, error) {
+	ret := _m.Called(ctx, id, email, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMember")
+	}
+
+	var r0 model.WorkspaceMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) (model.WorkspaceMember, error)); ok {
+		return rf(ctx, id, email, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, string) model.WorkspaceMember); ok {
+		r0 = rf(ctx, id, email, role)
+	} else {
+		r0 = ret.Get(0).(model.WorkspaceMember)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, string) error); ok {
+		r1 = rf(ctx, id, email, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceRepository_AddMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMember'
+type MockWorkspaceRepository_AddMember_Call struct {
+	*mock.Call
+}
+
+// AddMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - email string
+//   - role string
+func (_e *MockWorkspaceRepository_Expecter) AddMember(ctx interface{}, id interface{}, email interface{}, role interface{}) *MockWorkspaceRepository_AddMember_Call {
+	return &MockWorkspaceRepository_AddMember_Call{Call: _e.mock.On("AddMember", ctx, id, email, role)}
+}
+
+func (_c *MockWorkspaceRepository_AddMember_Call) Run(run func(ctx context.Context, id int64, email string, role string)) *MockWorkspaceRepository_AddMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceRepository_AddMember_Call) Return(_a0 model.WorkspaceMember, _a1 error) *MockWorkspaceRepository_AddMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceRepository_AddMember_Call) RunAndReturn(run func(context.Context, int64, string, string) (model.WorkspaceMember, error)) *MockWorkspaceRepository_AddMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *MockWorkspaceRepository) Create(ctx context.Context, _a1 model.Workspace) (model.Workspace, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Workspace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Workspace) (model.Workspace, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Workspace) model.Workspace); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Workspace)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Workspace) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockWorkspaceRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Workspace
+func (_e *MockWorkspaceRepository_Expecter) Create(ctx interface{}, _a1 interface{}) *MockWorkspaceRepository_Create_Call {
+	return &MockWorkspaceRepository_Create_Call{Call: _e.mock.On("Create", ctx, _a1)}
+}
+
+func (_c *MockWorkspaceRepository_Create_Call) Run(run func(ctx context.Context, _a1 model.Workspace)) *MockWorkspaceRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Workspace))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceRepository_Create_Call) Return(_a0 model.Workspace, _a1 error) *MockWorkspaceRepository_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceRepository_Create_Call) RunAndReturn(run func(context.Context, model.Workspace) (model.Workspace, error)) *MockWorkspaceRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockWorkspaceRepository) Delete(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkspaceRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockWorkspaceRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockWorkspaceRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockWorkspaceRepository_Delete_Call {
+	return &MockWorkspaceRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockWorkspaceRepository_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockWorkspaceRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceRepository_Delete_Call) Return(_a0 error) *MockWorkspaceRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkspaceRepository_Delete_Call) RunAndReturn(run func(context.Context, int64) error) *MockWorkspaceRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id, userId
+func (_m *MockWorkspaceRepository) GetByID(ctx context.Context, id int64, userId int64) (model.Workspace, error) {
+	ret := _m.Called(ctx, id, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Workspace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Workspace, error)); ok {
+		return rf(ctx, id, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Workspace); ok {
+		r0 = rf(ctx, id, userId)
+	} else {
+		r0 = ret.Get(0).(model.Workspace)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockWorkspaceRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userId int64
+func (_e *MockWorkspaceRepository_Expecter) GetByID(ctx interface{}, id interface{}, userId interface{}) *MockWorkspaceRepository_GetByID_Call {
+	return &MockWorkspaceRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id, userId)}
+}
+
+func (_c *MockWorkspaceRepository_GetByID_Call) Run(run func(ctx context.Context, id int64, userId int64)) *MockWorkspaceRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceRepository_GetByID_Call) Return(_a0 model.Workspace, _a1 error) *MockWorkspaceRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceRepository_GetByID_Call) RunAndReturn(run func(context.Context, int64, int64) (model.Workspace, error)) *MockWorkspaceRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUserID provides a mock function with given fields: ctx, userId
+func (_m *MockWorkspaceRepository) GetByUserID(ctx context.Context, userId int64) ([]model.Workspace, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
+	}
+
+	var r0 []model.Workspace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.Workspace, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.Workspace); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Workspace)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceRepository_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
+type MockWorkspaceRepository_GetByUserID_Call struct {
+	*mock.Call
+}
+
+// GetByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+func (_e *MockWorkspaceRepository_Expecter) GetByUserID(ctx interface{}, userId interface{}) *MockWorkspaceRepository_GetByUserID_Call {
+	return &MockWorkspaceRepository_GetByUserID_Call{Call: _e.mock.On("GetByUserID", ctx, userId)}
+}
+
+func (_c *MockWorkspaceRepository_GetByUserID_Call) Run(run func(ctx context.Context, userId int64)) *MockWorkspaceRepository_GetByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceRepository_GetByUserID_Call) Return(_a0 []model.Workspace, _a1 error) *MockWorkspaceRepository_GetByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceRepository_GetByUserID_Call) RunAndReturn(run func(context.Context, int64) ([]model.Workspace, error)) *MockWorkspaceRepository_GetByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMembers provides a mock function with given fields: ctx, id
+func (_m *MockWorkspaceRepository) GetMembers(ctx context.Context, id int64) ([]model.WorkspaceMember, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMembers")
+	}
+
+	var r0 []model.WorkspaceMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.WorkspaceMember, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.WorkspaceMember); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WorkspaceMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceRepository_GetMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMembers'
+type MockWorkspaceRepository_GetMembers_Call struct {
+	*mock.Call
+}
+
+// GetMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockWorkspaceRepository_Expecter) GetMembers(ctx interface{}, id interface{}) *MockWorkspaceRepository_GetMembers_Call {
+	return &MockWorkspaceRepository_GetMembers_Call{Call: _e.mock.On("GetMembers", ctx, id)}
+}
+
+func (_c *MockWorkspaceRepository_GetMembers_Call) Run(run func(ctx context.Context, id int64)) *MockWorkspaceRepository_GetMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceRepository_GetMembers_Call) Return(_a0 []model.WorkspaceMember, _a1 error) *MockWorkspaceRepository_GetMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceRepository_GetMembers_Call) RunAndReturn(run func(context.Context, int64) ([]model.WorkspaceMember, error)) *MockWorkspaceRepository_GetMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: ctx, id, userId
+func (_m *MockWorkspaceRepository) RemoveMember(ctx context.Context, id int64, userId int64) error {
+	ret := _m.Called(ctx, id, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, id, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkspaceRepository_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type MockWorkspaceRepository_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userId int64
+func (_e *MockWorkspaceRepository_Expecter) RemoveMember(ctx interface{}, id interface{}, userId interface{}) *MockWorkspaceRepository_RemoveMember_Call {
+	return &MockWorkspaceRepository_RemoveMember_Call{Call: _e.mock.On("RemoveMember", ctx, id, userId)}
+}
+
+func (_c *MockWorkspaceRepository_RemoveMember_Call) Run(run func(ctx context.Context, id int64, userId int64)) *MockWorkspaceRepository_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceRepository_RemoveMember_Call) Return(_a0 error) *MockWorkspaceRepository_RemoveMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkspaceRepository_RemoveMember_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockWorkspaceRepository_RemoveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, _a1
+func (_m *MockWorkspaceRepository) Update(ctx context.Context, _a1 model.Workspace) (model.Workspace, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.Workspace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Workspace) (model.Workspace, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Workspace) model.Workspace); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Workspace)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Workspace) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockWorkspaceRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Workspace
+func (_e *MockWorkspaceRepository_Expecter) Update(ctx interface{}, _a1 interface{}) *MockWorkspaceRepository_Update_Call {
+	return &MockWorkspaceRepository_Update_Call{Call: _e.mock.On("Update", ctx, _a1)}
+}
+
+func (_c *MockWorkspaceRepository_Update_Call) Run(run func(ctx context.Context, _a1 model.Workspace)) *MockWorkspaceRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Workspace))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceRepository_Update_Call) Return(_a0 model.Workspace, _a1 error) *MockWorkspaceRepository_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceRepository_Update_Call) RunAndReturn(run func(context.Context, model.Workspace) (model.Workspace, error)) *MockWorkspaceRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMember provides a mock function with given fields: ctx, id, userId, role
+func (_m *MockWorkspaceRepository) UpdateMember(ctx context.Context, id int64, userId int64, role string) (model.WorkspaceMember, error) {
+	ret := _m.Called(ctx, id, userId, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMember")
+	}
+
+	var r0 model.WorkspaceMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string) (model.WorkspaceMember, error)); ok {
+		return rf(ctx, id, userId, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string) model.WorkspaceMember); ok {
+		r0 = rf(ctx, id, userId, role)
+	} else {
+		r0 = ret.Get(0).(model.WorkspaceMember)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, string) error); ok {
+		r1 = rf(ctx, id, userId, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceRepository_UpdateMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMember'
+type MockWorkspaceRepository_UpdateMember_Call struct {
+	*mock.Call
+}
+
+// UpdateMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userId int64
+//   - role string
+func (_e *MockWorkspaceRepository_Expecter) UpdateMember(ctx interface{}, id interface{}, userId interface{}, role interface{}) *MockWorkspaceRepository_UpdateMember_Call {
+	return &MockWorkspaceRepository_UpdateMember_Call{Call: _e.mock.On("UpdateMember", ctx, id, userId, role)}
+}
+
+func (_c *MockWorkspaceRepository_UpdateMember_Call) Run(run func(ctx context.Context, id int64, userId int64, role string)) *MockWorkspaceRepository_UpdateMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceRepository_UpdateMember_Call) Return(_a0 model.WorkspaceMember, _a1 error) *MockWorkspaceRepository_UpdateMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceRepository_UpdateMember_Call) RunAndReturn(run func(context.Context, int64, int64, string) (model.WorkspaceMember, error)) *MockWorkspaceRepository_UpdateMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWorkspaceRepository creates a new instance of MockWorkspaceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWorkspaceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWorkspaceRepository {
+	mock := &MockWorkspaceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,226 @@
+package workspace
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rzfhlv/go-task/internal/model"
+)
+
+var (
+	createWorkspaceQuery = `WITH workspace AS (
+			INSERT INTO workspaces (name, owner_id)
+			VALUES ($1, $2)
+			RETURNING id, name, owner_id, created_at, updated_at
+		), owner AS (
+			INSERT INTO workspace_members (workspace_id, user_id, role)
+			SELECT id, owner_id, 'owner' FROM workspace
+		)
+		SELECT id, name, owner_id, 'owner' AS role, created_at, updated_at FROM workspace`
+
+	getWorkspaceByUserIDQuery = `SELECT 
+		workspaces.id, workspaces.name, workspaces.owner_id, workspace_members.role, workspaces.created_at, workspaces.updated_at
+		FROM workspaces
+		JOIN workspace_members ON workspace_members.workspace_id = workspaces.id
+		WHERE workspace_members.user_id = $1
+		ORDER BY workspaces.id`
+
+	getWorkspaceByIDQuery = `SELECT 
+		workspaces.id, workspaces.name, workspaces.owner_id, workspace_members.role, workspaces.created_at, workspaces.updated_at
+		FROM workspaces
+		JOIN workspace_members ON workspace_members.workspace_id = workspaces.id
+		WHERE workspaces.id = $1 AND workspace_members.user_id = $2`
+
+	updateWorkspaceQuery = `UPDATE workspaces
+		SET name = $1, updated_at = $2
+		WHERE id = $3
+		RETURNING id, name, owner_id, created_at, updated_at`
+
+	deleteWorkspaceQuery = `DELETE FROM workspaces WHERE id = $1`
+
+	getWorkspaceMembersQuery = `SELECT 
+		workspace_members.workspace_id, workspace_members.user_id, users.name, users.email, workspace_members.role, workspace_members.created_at
+		FROM workspace_members
+		JOIN users ON users.id = workspace_members.user_id
+		WHERE workspace_members.workspace_id = $1
+		ORDER BY workspace_members.created_at, workspace_members.user_id`
+
+	addWorkspaceMemberQuery = `WITH member AS (
+			INSERT INTO workspace_members (workspace_id, user_id, role)
+			SELECT $1, id, $3 FROM users WHERE email = $2
+			RETURNING workspace_id, user_id, role, created_at
+		)
+		SELECT member.workspace_id, member.user_id, users.name, users.email, member.role, member.created_at
+		FROM member
+		JOIN users ON users.id = member.user_id`
+
+	updateWorkspaceMemberQuery = `WITH member AS (
+			UPDATE workspace_members
+			SET role = $1
+			WHERE workspace_id = $2 AND user_id = $3
+			RETURNING workspace_id, user_id, role, created_at
+		)
+		SELECT member.workspace_id, member.user_id, users.name, users.email, member.role, member.created_at
+		FROM member
+		JOIN users ON users.id = member.user_id`
+
+	removeWorkspaceMemberQuery = `DELETE FROM workspace_members WHERE workspace_id = $1 AND user_id = $2`
+)
+
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+)
+
+var (
+	ErrMemberExists      = errors.New("workspace member already exists")
+	ErrWorkspaceHasTasks = errors.New("workspace still has tasks")
+)
+
+type WorkspaceRepository interface {
+	Create(ctx context.Context, workspace model.Workspace) (model.Workspace, error)
+	GetByUserID(ctx context.Context, userId int64) ([]model.Workspace, error)
+	GetByID(ctx context.Context, id, userId int64) (model.Workspace, error)
+	Update(ctx context.Context, workspace model.Workspace) (model.Workspace, error)
+	Delete(ctx context.Context, id int64) error
+	GetMembers(ctx context.Context, id int64) ([]model.WorkspaceMember, error)
+	AddMember(ctx context.Context, id int64, email, role string) (model.WorkspaceMember, error)
+	UpdateMember(ctx context.Context, id, userId int64, role string) (model.WorkspaceMember, error)
+	RemoveMember(ctx context.Context, id, userId int64) error
+}
+
+type Workspace struct {
+	db *sqlx.DB
+}
+
+func New(db *sqlx.DB) WorkspaceRepository {
+	return &Workspace{
+		db: db,
+	}
+}
+
+func (w *Workspace) Create(ctx context.Context, workspace model.Workspace) (model.Workspace, error) {
+	result := model.Workspace{}
+	err := w.db.Get(&result, createWorkspaceQuery, workspace.Name, workspace.OwnerID)
+	if err != nil {
+		return model.Workspace{}, err
+	}
+
+	return result, nil
+}
+
+func (w *Workspace) GetByUserID(ctx context.Context, userId int64) ([]model.Workspace, error) {
+	result := []model.Workspace{}
+	err := w.db.Select(&result, getWorkspaceByUserIDQuery, userId)
+	if err != nil {
+		return []model.Workspace{}, err
+	}
+
+	return result, nil
+}
+
+func (w *Workspace) GetByID(ctx context.Context, id, userId int64) (model.Workspace, error) {
+	result := model.Workspace{}
+	err := w.db.Get(&result, getWorkspaceByIDQuery, id, userId)
+	if err != nil {
+		return model.Workspace{}, err
+	}
+
+	return result, nil
+}
+
+func (w *Workspace) Update(ctx context.Context, workspace model.Workspace) (model.Workspace, error) {
+	result := model.Workspace{}
+	err := w.db.Get(&result, updateWorkspaceQuery, workspace.Name, workspace.UpdatedAt, workspace.ID)
+	if err != nil {
+		return model.Workspace{}, err
+	}
+
+	return result, nil
+}
+
+func (w *Workspace) Delete(ctx context.Context, id int64) error {
+	result, err := w.db.Exec(deleteWorkspaceQuery, id)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return ErrWorkspaceHasTasks
+		}
+
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (w *Workspace) GetMembers(ctx context.Context, id int64) ([]model.WorkspaceMember, error) {
+	result := []model.WorkspaceMember{}
+	err := w.db.Select(&result, getWorkspaceMembersQuery, id)
+	if err != nil {
+		return []model.WorkspaceMember{}, err
+	}
+
+	return result, nil
+}
+
+func (w *Workspace) AddMember(ctx context.Context, id int64, email, role string) (model.WorkspaceMember, error) {
+	result := model.WorkspaceMember{}
+	err := w.db.Get(&result, addWorkspaceMemberQuery, id, email, role)
+	if isUniqueViolation(err) {
+		return model.WorkspaceMember{}, ErrMemberExists
+	}
+
+	if err != nil {
+		return model.WorkspaceMember{}, err
+	}
+
+	return result, nil
+}
+
+func (w *Workspace) UpdateMember(ctx context.Context, id, userId int64, role string) (model.WorkspaceMember, error) {
+	result := model.WorkspaceMember{}
+	err := w.db.Get(&result, updateWorkspaceMemberQuery, role, id, userId)
+	if err != nil {
+		return model.WorkspaceMember{}, err
+	}
+
+	return result, nil
+}
+
+func (w *Workspace) RemoveMember(ctx context.Context, id, userId int64) error {
+	result, err := w.db.Exec(removeWorkspaceMemberQuery, id, userId)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	var sqlErr interface{ SQLState() string }
+	return errors.As(err, &sqlErr) && sqlErr.SQLState() == uniqueViolation
+}
+
+func isForeignKeyViolation(err error) bool {
+	var sqlErr interface{ SQLState() string }
+	return errors.As(err, &sqlErr) && sqlErr.SQLState() == foreignKeyViolation
+}
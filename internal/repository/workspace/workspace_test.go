@@ -0,0 +1,631 @@
+package workspace_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/repository/workspace"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	now = time.Date(2023, time.August, 15, 12, 0, 0, 0, time.UTC)
+
+	workspaceModel = model.Workspace{
+		ID:        1,
+		Name:      "Platform",
+		OwnerID:   1,
+		Role:      model.WorkspaceRoleOwner,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	memberModel = model.WorkspaceMember{
+		WorkspaceID: 1,
+		UserID:      2,
+		Name:        "Jane",
+		Email:       "jane@example.com",
+		Role:        model.WorkspaceRoleEditor,
+		CreatedAt:   now,
+	}
+
+	workspaceColumns = []string{"id", "name", "owner_id", "role", "created_at", "updated_at"}
+	memberColumns    = []string{"workspace_id", "user_id", "name", "email", "role", "created_at"}
+)
+
+type pgError struct {
+	code string
+}
+
+func (e pgError) Error() string {
+	return "pq: duplicate key value violates unique constraint"
+}
+
+func (e pgError) SQLState() string {
+	return e.code
+}
+
+func workspaceRows() *sqlmock.Rows {
+	return sqlmock.NewRows(workspaceColumns).
+		AddRow(workspaceModel.ID, workspaceModel.Name, workspaceModel.OwnerID, workspaceModel.Role, now, now)
+}
+
+func memberRows() *sqlmock.Rows {
+	return sqlmock.NewRows(memberColumns).
+		AddRow(memberModel.WorkspaceID, memberModel.UserID, memberModel.Name, memberModel.Email, memberModel.Role, now)
+}
+
+func TestWorkspaceCreate(t *testing.T) {
+	query := `WITH workspace AS (
+			INSERT INTO workspaces (name, owner_id)
+			VALUES ($1, $2)
+			RETURNING id, name, owner_id, created_at, updated_at
+		), owner AS (
+			INSERT INTO workspace_members (workspace_id, user_id, role)
+			SELECT id, owner_id, 'owner' FROM workspace
+		)
+		SELECT id, name, owner_id, 'owner' AS role, created_at, updated_at FROM workspace`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Workspace
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(workspaceModel.Name, workspaceModel.OwnerID).
+					WillReturnRows(workspaceRows())
+			},
+			wantResult: workspaceModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when create workspace",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(workspaceModel.Name, workspaceModel.OwnerID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: model.Workspace{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := workspace.New(db)
+			result, err := r.Create(context.Background(), model.Workspace{Name: workspaceModel.Name, OwnerID: workspaceModel.OwnerID})
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestWorkspaceGetByUserID(t *testing.T) {
+	query := `SELECT 
+		workspaces.id, workspaces.name, workspaces.owner_id, workspace_members.role, workspaces.created_at, workspaces.updated_at
+		FROM workspaces
+		JOIN workspace_members ON workspace_members.workspace_id = workspaces.id
+		WHERE workspace_members.user_id = $1
+		ORDER BY workspaces.id`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.Workspace
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(workspaceModel.OwnerID).
+					WillReturnRows(workspaceRows())
+			},
+			wantResult: []model.Workspace{workspaceModel},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get workspaces",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(workspaceModel.OwnerID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.Workspace{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := workspace.New(db)
+			result, err := r.GetByUserID(context.Background(), workspaceModel.OwnerID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestWorkspaceGetByID(t *testing.T) {
+	query := `SELECT 
+		workspaces.id, workspaces.name, workspaces.owner_id, workspace_members.role, workspaces.created_at, workspaces.updated_at
+		FROM workspaces
+		JOIN workspace_members ON workspace_members.workspace_id = workspaces.id
+		WHERE workspaces.id = $1 AND workspace_members.user_id = $2`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Workspace
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(workspaceModel.ID, workspaceModel.OwnerID).
+					WillReturnRows(workspaceRows())
+			},
+			wantResult: workspaceModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when user is not a member",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(workspaceModel.ID, workspaceModel.OwnerID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantResult: model.Workspace{},
+			wantErr:    sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := workspace.New(db)
+			result, err := r.GetByID(context.Background(), workspaceModel.ID, workspaceModel.OwnerID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestWorkspaceUpdate(t *testing.T) {
+	query := `UPDATE workspaces
+		SET name = $1, updated_at = $2
+		WHERE id = $3
+		RETURNING id, name, owner_id, created_at, updated_at`
+
+	updated := workspaceModel
+	updated.Role = ""
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.Workspace
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "name", "owner_id", "created_at", "updated_at"}).
+					AddRow(workspaceModel.ID, workspaceModel.Name, workspaceModel.OwnerID, now, now)
+
+				s.ExpectQuery(query).
+					WithArgs(workspaceModel.Name, workspaceModel.UpdatedAt, workspaceModel.ID).
+					WillReturnRows(rows)
+			},
+			wantResult: updated,
+			wantErr:    nil,
+		},
+		{
+			name: "error when update workspace",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(workspaceModel.Name, workspaceModel.UpdatedAt, workspaceModel.ID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: model.Workspace{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := workspace.New(db)
+			result, err := r.Update(context.Background(), workspaceModel)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestWorkspaceDelete(t *testing.T) {
+	query := `DELETE FROM workspaces WHERE id = $1`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(workspaceModel.ID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when workspace is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(workspaceModel.ID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name: "error when workspace still has tasks",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(workspaceModel.ID).
+					WillReturnError(pgError{code: "23503"})
+			},
+			wantErr: workspace.ErrWorkspaceHasTasks,
+		},
+		{
+			name: "error when delete workspace",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(workspaceModel.ID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := workspace.New(db)
+			err := r.Delete(context.Background(), workspaceModel.ID)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestWorkspaceGetMembers(t *testing.T) {
+	query := `SELECT 
+		workspace_members.workspace_id, workspace_members.user_id, users.name, users.email, workspace_members.role, workspace_members.created_at
+		FROM workspace_members
+		JOIN users ON users.id = workspace_members.user_id
+		WHERE workspace_members.workspace_id = $1
+		ORDER BY workspace_members.created_at, workspace_members.user_id`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult []model.WorkspaceMember
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(workspaceModel.ID).
+					WillReturnRows(memberRows())
+			},
+			wantResult: []model.WorkspaceMember{memberModel},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get members",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(workspaceModel.ID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantResult: []model.WorkspaceMember{},
+			wantErr:    sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := workspace.New(db)
+			result, err := r.GetMembers(context.Background(), workspaceModel.ID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestWorkspaceAddMember(t *testing.T) {
+	query := `WITH member AS (
+			INSERT INTO workspace_members (workspace_id, user_id, role)
+			SELECT $1, id, $3 FROM users WHERE email = $2
+			RETURNING workspace_id, user_id, role, created_at
+		)
+		SELECT member.workspace_id, member.user_id, users.name, users.email, member.role, member.created_at
+		FROM member
+		JOIN users ON users.id = member.user_id`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.WorkspaceMember
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(workspaceModel.ID, memberModel.Email, memberModel.Role).
+					WillReturnRows(memberRows())
+			},
+			wantResult: memberModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when user is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(workspaceModel.ID, memberModel.Email, memberModel.Role).
+					WillReturnRows(sqlmock.NewRows(memberColumns))
+			},
+			wantResult: model.WorkspaceMember{},
+			wantErr:    sql.ErrNoRows,
+		},
+		{
+			name: "error when user is already a member",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(workspaceModel.ID, memberModel.Email, memberModel.Role).
+					WillReturnError(pgError{code: "23505"})
+			},
+			wantResult: model.WorkspaceMember{},
+			wantErr:    workspace.ErrMemberExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := workspace.New(db)
+			result, err := r.AddMember(context.Background(), workspaceModel.ID, memberModel.Email, memberModel.Role)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestWorkspaceUpdateMember(t *testing.T) {
+	query := `WITH member AS (
+			UPDATE workspace_members
+			SET role = $1
+			WHERE workspace_id = $2 AND user_id = $3
+			RETURNING workspace_id, user_id, role, created_at
+		)
+		SELECT member.workspace_id, member.user_id, users.name, users.email, member.role, member.created_at
+		FROM member
+		JOIN users ON users.id = member.user_id`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantResult model.WorkspaceMember
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(memberModel.Role, workspaceModel.ID, memberModel.UserID).
+					WillReturnRows(memberRows())
+			},
+			wantResult: memberModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when member is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectQuery(query).
+					WithArgs(memberModel.Role, workspaceModel.ID, memberModel.UserID).
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantResult: model.WorkspaceMember{},
+			wantErr:    sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := workspace.New(db)
+			result, err := r.UpdateMember(context.Background(), workspaceModel.ID, memberModel.UserID, memberModel.Role)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestWorkspaceRemoveMember(t *testing.T) {
+	query := `DELETE FROM workspace_members WHERE workspace_id = $1 AND user_id = $2`
+
+	tests := []struct {
+		name       string
+		beforeTest func(s sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(workspaceModel.ID, memberModel.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when member is not found",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(workspaceModel.ID, memberModel.UserID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name: "error when remove member",
+			beforeTest: func(s sqlmock.Sqlmock) {
+				s.ExpectExec(query).
+					WithArgs(workspaceModel.ID, memberModel.UserID).
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mockSQL, _ := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+			defer mockDB.Close()
+
+			db := sqlx.NewDb(mockDB, "sqlmock")
+
+			if tt.beforeTest != nil {
+				tt.beforeTest(mockSQL)
+			}
+
+			r := workspace.New(db)
+			err := r.RemoveMember(context.Background(), workspaceModel.ID, memberModel.UserID)
+
+			assert.Equal(t, tt.wantErr, err)
+
+			if err := mockSQL.ExpectationsWereMet(); err != nil {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
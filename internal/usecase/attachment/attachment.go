@@ -0,0 +1,309 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/rzfhlv/go-task/config"
+	"github.com/rzfhlv/go-task/internal/infrastructure/blobstore"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/repository/attachment"
+	"github.com/rzfhlv/go-task/internal/usecase/task"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+)
+
+const cleanupBatch = 100
+
+type AttachmentUsecase interface {
+	Create(ctx context.Context, taskId int64, upload model.AttachmentUpload) (model.Attachment, error)
+	GetByTaskID(ctx context.Context, taskId int64) ([]model.Attachment, error)
+	GetByID(ctx context.Context, taskId, id int64) (model.Attachment, error)
+	Open(ctx context.Context, attachment model.Attachment, offset, length int64) (io.ReadCloser, error)
+	Delete(ctx context.Context, taskId, id int64) error
+	Cleanup(ctx context.Context) (int64, error)
+}
+
+type Attachment struct {
+	attachmentRepository attachment.AttachmentRepository
+	taskUsecase          task.TaskUsecase
+	blobStore            blobstore.BlobStore
+	maxSize              int64
+	allowedTypes         []string
+}
+
+func New(attachmentRepository attachment.AttachmentRepository, taskUsecase task.TaskUsecase, blobStore blobstore.BlobStore, cfg *config.Configuration) AttachmentUsecase {
+	maxSize := cfg.Attachment.MaxSize
+	if maxSize <= 0 {
+		maxSize = model.AttachmentMaxSize
+	}
+
+	allowedTypes := cfg.Attachment.AllowedTypes
+	if len(allowedTypes) == 0 {
+		allowedTypes = model.AttachmentAllowedTypes
+	}
+
+	return &Attachment{
+		attachmentRepository: attachmentRepository,
+		taskUsecase:          taskUsecase,
+		blobStore:            blobStore,
+		maxSize:              maxSize,
+		allowedTypes:         allowedTypes,
+	}
+}
+
+func (a *Attachment) Create(ctx context.Context, taskId int64, upload model.AttachmentUpload) (model.Attachment, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Attachment] error when get user id from context")
+		return model.Attachment{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	_, err := a.taskUsecase.Authorize(ctx, taskId, model.WorkspaceRoleEditor)
+	if err != nil {
+		return model.Attachment{}, err
+	}
+
+	if upload.Size > a.maxSize {
+		return model.Attachment{}, errs.NewErrs(http.StatusRequestEntityTooLarge, "attachment exceeds the maximum size")
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(upload.Body, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		slog.ErrorContext(ctx, "[Usecase.Attachment] error when read upload", slog.String("error", err.Error()))
+		return model.Attachment{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	head = head[:n]
+	contentType := http.DetectContentType(head)
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if !slices.Contains(a.allowedTypes, mediaType) {
+		slog.ErrorContext(ctx, "[Usecase.Attachment] error attachment type is not allowed", slog.String("content_type", contentType))
+		return model.Attachment{}, errs.NewErrs(http.StatusUnsupportedMediaType, "attachment type is not allowed")
+	}
+
+	key, err := storageKey(taskId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Attachment] error when generate storage key", slog.String("error", err.Error()))
+		return model.Attachment{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	hash := sha256.New()
+	counter := &counter{}
+	body := io.TeeReader(io.MultiReader(bytes.NewReader(head), io.LimitReader(upload.Body, a.maxSize+1-int64(n))), io.MultiWriter(hash, counter))
+
+	err = a.blobStore.Put(ctx, key, body, upload.Size, contentType)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Attachment] error when call blobStore.Put", slog.String("error", err.Error()))
+		return model.Attachment{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	if counter.total > a.maxSize {
+		a.discard(ctx, key)
+		return model.Attachment{}, errs.NewErrs(http.StatusRequestEntityTooLarge, "attachment exceeds the maximum size")
+	}
+
+	result, err := a.attachmentRepository.Create(ctx, model.Attachment{
+		TaskID:      taskId,
+		UserID:      userId,
+		Filename:    filename(upload.Filename),
+		ContentType: contentType,
+		Size:        counter.total,
+		Checksum:    hex.EncodeToString(hash.Sum(nil)),
+		StorageKey:  key,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Attachment] error when call attachmentRepository.Create", slog.String("error", err.Error()))
+		a.discard(ctx, key)
+		return model.Attachment{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (a *Attachment) GetByTaskID(ctx context.Context, taskId int64) ([]model.Attachment, error) {
+	_, err := a.taskUsecase.GetByID(ctx, taskId)
+	if err != nil {
+		return []model.Attachment{}, err
+	}
+
+	result, err := a.attachmentRepository.GetByTaskID(ctx, taskId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Attachment] error when call attachmentRepository.GetByTaskID", slog.String("error", err.Error()))
+		return []model.Attachment{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (a *Attachment) GetByID(ctx context.Context, taskId, id int64) (model.Attachment, error) {
+	_, err := a.taskUsecase.GetByID(ctx, taskId)
+	if err != nil {
+		return model.Attachment{}, err
+	}
+
+	result, err := a.attachmentRepository.GetByID(ctx, id, taskId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Attachment] error when call attachmentRepository.GetByID", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Attachment{}, errs.NewErrs(http.StatusNotFound, "attachment not found")
+		}
+
+		return model.Attachment{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (a *Attachment) Open(ctx context.Context, attachment model.Attachment, offset, length int64) (io.ReadCloser, error) {
+	result, err := a.blobStore.Get(ctx, attachment.StorageKey, offset, length)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Attachment] error when call blobStore.Get", slog.String("error", err.Error()))
+		if errors.Is(err, blobstore.ErrNotFound) {
+			return nil, errs.NewErrs(http.StatusNotFound, "attachment not found")
+		}
+
+		return nil, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (a *Attachment) Delete(ctx context.Context, taskId, id int64) error {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Attachment] error when get user id from context")
+		return errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	task, err := a.taskUsecase.Authorize(ctx, taskId, model.WorkspaceRoleEditor)
+	if err != nil {
+		return err
+	}
+
+	check, err := a.attachmentRepository.GetByID(ctx, id, taskId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Attachment] error when call attachmentRepository.GetByID", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return errs.NewErrs(http.StatusNotFound, "attachment not found")
+		}
+
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	if check.UserID != userId && task.UserID != userId {
+		_, err = a.taskUsecase.Authorize(ctx, taskId, model.WorkspaceRoleOwner)
+		if httpErr, ok := err.(*errs.HttpError); ok && httpErr.StatusCode == http.StatusForbidden {
+			slog.ErrorContext(ctx, "[Usecase.Attachment] error user cannot delete attachment", slog.Int64("id", id))
+			return errs.NewErrs(http.StatusForbidden, "only the uploader, task owner or workspace owner can delete this attachment")
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	err = a.attachmentRepository.Delete(ctx, id, taskId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Attachment] error when call attachmentRepository.Delete", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return errs.NewErrs(http.StatusNotFound, "attachment not found")
+		}
+
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	err = a.remove(ctx, check)
+	if err != nil {
+		slog.WarnContext(ctx, "[Usecase.Attachment] attachment blob left for cleanup", slog.Int64("id", id), slog.String("error", err.Error()))
+	}
+
+	return nil
+}
+
+func (a *Attachment) Cleanup(ctx context.Context) (int64, error) {
+	total := int64(0)
+	for {
+		orphaned, err := a.attachmentRepository.GetOrphaned(ctx, cleanupBatch)
+		if err != nil {
+			slog.ErrorContext(ctx, "[Usecase.Attachment] error when call attachmentRepository.GetOrphaned", slog.String("error", err.Error()))
+			return total, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+		}
+
+		for _, attachment := range orphaned {
+			err = a.remove(ctx, attachment)
+			if err != nil {
+				slog.ErrorContext(ctx, "[Usecase.Attachment] error when remove orphaned attachment", slog.Int64("id", attachment.ID), slog.String("error", err.Error()))
+				return total, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+			}
+
+			total++
+		}
+
+		if len(orphaned) < cleanupBatch {
+			return total, nil
+		}
+	}
+}
+
+func (a *Attachment) remove(ctx context.Context, attachment model.Attachment) error {
+	err := a.blobStore.Delete(ctx, attachment.StorageKey)
+	if err != nil {
+		return err
+	}
+
+	return a.attachmentRepository.Destroy(ctx, attachment.ID)
+}
+
+func (a *Attachment) discard(ctx context.Context, key string) {
+	err := a.blobStore.Delete(ctx, key)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Attachment] error when call blobStore.Delete", slog.String("key", key), slog.String("error", err.Error()))
+	}
+}
+
+type counter struct {
+	total int64
+}
+
+func (c *counter) Write(p []byte) (int, error) {
+	c.total += int64(len(p))
+	return len(p), nil
+}
+
+func storageKey(taskId int64) (string, error) {
+	random := make([]byte, 16)
+	_, err := rand.Read(random)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("tasks/%d/%s", taskId, hex.EncodeToString(random)), nil
+}
+
+func filename(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	if name == "." || name == "/" {
+		return "attachment"
+	}
+
+	if len(name) > 255 {
+		name = name[len(name)-255:]
+	}
+
+	return name
+}
@@ -0,0 +1,540 @@
+package attachment_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rzfhlv/go-task/config"
+	"github.com/rzfhlv/go-task/internal/infrastructure/blobstore"
+	blobmocks "github.com/rzfhlv/go-task/internal/infrastructure/blobstore/mocks"
+	"github.com/rzfhlv/go-task/internal/model"
+	attachmentmocks "github.com/rzfhlv/go-task/internal/repository/attachment/mocks"
+	"github.com/rzfhlv/go-task/internal/usecase/attachment"
+	taskmocks "github.com/rzfhlv/go-task/internal/usecase/task/mocks"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	userId = int64(1)
+	taskId = int64(2)
+
+	cfg = &config.Configuration{Attachment: config.AttachmentConfiguration{MaxSize: 64}}
+
+	png = "\x89PNG\r\n\x1a\n" + strings.Repeat("x", 24)
+
+	attachmentModel = model.Attachment{
+		ID:          1,
+		TaskID:      taskId,
+		UserID:      userId,
+		Filename:    "screenshot.png",
+		ContentType: "image/png",
+		Size:        int64(len(png)),
+		StorageKey:  "tasks/2/0123456789abcdef",
+	}
+)
+
+func checksum(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func consume(args mock.Arguments) {
+	io.ReadAll(args.Get(2).(io.Reader))
+}
+
+func TestAttachmentCreate(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		upload     func() model.AttachmentUpload
+		mockDeps   func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore)
+		wantResult model.Attachment
+		wantErr    error
+	}{
+		{
+			name: "success",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			upload: func() model.AttachmentUpload {
+				return model.AttachmentUpload{Filename: "../screenshot.png", Size: int64(len(png)), Body: strings.NewReader(png)}
+			},
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore) {
+				taskUsecase.On("Authorize", mock.Anything, taskId, model.WorkspaceRoleEditor).Return(model.Task{ID: taskId}, nil)
+				blobStore.On("Put", mock.Anything, mock.MatchedBy(func(key string) bool {
+					return strings.HasPrefix(key, "tasks/2/")
+				}), mock.Anything, int64(len(png)), "image/png").Run(consume).Return(nil)
+				attachmentRepository.On("Create", mock.Anything, mock.MatchedBy(func(request model.Attachment) bool {
+					return request.TaskID == taskId && request.UserID == userId && request.Filename == "screenshot.png" &&
+						request.ContentType == "image/png" && request.Size == int64(len(png)) && request.Checksum == checksum(png)
+				})).Return(attachmentModel, nil)
+			},
+			wantResult: attachmentModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when attachment exceeds the declared maximum size",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			upload: func() model.AttachmentUpload {
+				return model.AttachmentUpload{Filename: "big.png", Size: 65, Body: strings.NewReader(png)}
+			},
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore) {
+				taskUsecase.On("Authorize", mock.Anything, taskId, model.WorkspaceRoleEditor).Return(model.Task{ID: taskId}, nil)
+				blobStore.AssertNotCalled(t, "Put")
+			},
+			wantResult: model.Attachment{},
+			wantErr:    errs.NewErrs(http.StatusRequestEntityTooLarge, "attachment exceeds the maximum size"),
+		},
+		{
+			name: "error when attachment body exceeds the maximum size",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			upload: func() model.AttachmentUpload {
+				return model.AttachmentUpload{Filename: "big.png", Size: 10, Body: strings.NewReader(png + png + png)}
+			},
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore) {
+				taskUsecase.On("Authorize", mock.Anything, taskId, model.WorkspaceRoleEditor).Return(model.Task{ID: taskId}, nil)
+				blobStore.On("Put", mock.Anything, mock.Anything, mock.Anything, int64(10), "image/png").Run(consume).Return(nil)
+				blobStore.On("Delete", mock.Anything, mock.Anything).Return(nil)
+				attachmentRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: model.Attachment{},
+			wantErr:    errs.NewErrs(http.StatusRequestEntityTooLarge, "attachment exceeds the maximum size"),
+		},
+		{
+			name: "error when attachment type is not allowed",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			upload: func() model.AttachmentUpload {
+				return model.AttachmentUpload{Filename: "setup.exe", Size: 4, Body: strings.NewReader("MZ\x90\x00")}
+			},
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore) {
+				taskUsecase.On("Authorize", mock.Anything, taskId, model.WorkspaceRoleEditor).Return(model.Task{ID: taskId}, nil)
+				blobStore.AssertNotCalled(t, "Put")
+			},
+			wantResult: model.Attachment{},
+			wantErr:    errs.NewErrs(http.StatusUnsupportedMediaType, "attachment type is not allowed"),
+		},
+		{
+			name: "error when call blobstore put",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			upload: func() model.AttachmentUpload {
+				return model.AttachmentUpload{Filename: "screenshot.png", Size: int64(len(png)), Body: strings.NewReader(png)}
+			},
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore) {
+				taskUsecase.On("Authorize", mock.Anything, taskId, model.WorkspaceRoleEditor).Return(model.Task{ID: taskId}, nil)
+				blobStore.On("Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("some error"))
+				attachmentRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: model.Attachment{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when create attachment removes the blob",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			upload: func() model.AttachmentUpload {
+				return model.AttachmentUpload{Filename: "screenshot.png", Size: int64(len(png)), Body: strings.NewReader(png)}
+			},
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore) {
+				taskUsecase.On("Authorize", mock.Anything, taskId, model.WorkspaceRoleEditor).Return(model.Task{ID: taskId}, nil)
+				blobStore.On("Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(consume).Return(nil)
+				attachmentRepository.On("Create", mock.Anything, mock.Anything).Return(model.Attachment{}, errors.New("some error"))
+				blobStore.On("Delete", mock.Anything, mock.MatchedBy(func(key string) bool {
+					return strings.HasPrefix(key, "tasks/2/")
+				})).Return(nil)
+			},
+			wantResult: model.Attachment{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when task is not accessible",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			upload: func() model.AttachmentUpload {
+				return model.AttachmentUpload{Filename: "screenshot.png", Size: int64(len(png)), Body: strings.NewReader(png)}
+			},
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore) {
+				taskUsecase.On("Authorize", mock.Anything, taskId, model.WorkspaceRoleEditor).Return(model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+				blobStore.AssertNotCalled(t, "Put")
+			},
+			wantResult: model.Attachment{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name: "error when user id is missing",
+			ctx:  context.Background(),
+			upload: func() model.AttachmentUpload {
+				return model.AttachmentUpload{Filename: "screenshot.png", Size: int64(len(png)), Body: strings.NewReader(png)}
+			},
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore) {
+				taskUsecase.AssertNotCalled(t, "Authorize")
+			},
+			wantResult: model.Attachment{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attachmentRepository := attachmentmocks.MockAttachmentRepository{}
+			taskUsecase := taskmocks.MockTaskUsecase{}
+			blobStore := blobmocks.MockBlobStore{}
+
+			tt.mockDeps(&attachmentRepository, &taskUsecase, &blobStore)
+
+			usecase := attachment.New(&attachmentRepository, &taskUsecase, &blobStore, cfg)
+			result, err := usecase.Create(tt.ctx, taskId, tt.upload())
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+			blobStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAttachmentGetByTaskID(t *testing.T) {
+	tests := []struct {
+		name       string
+		mockDeps   func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase)
+		wantResult []model.Attachment
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				attachmentRepository.On("GetByTaskID", mock.Anything, taskId).Return([]model.Attachment{attachmentModel}, nil)
+			},
+			wantResult: []model.Attachment{attachmentModel},
+			wantErr:    nil,
+		},
+		{
+			name: "error when task is not accessible",
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+				attachmentRepository.AssertNotCalled(t, "GetByTaskID")
+			},
+			wantResult: []model.Attachment{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name: "error when get attachments",
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				attachmentRepository.On("GetByTaskID", mock.Anything, taskId).Return([]model.Attachment{}, errors.New("some error"))
+			},
+			wantResult: []model.Attachment{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attachmentRepository := attachmentmocks.MockAttachmentRepository{}
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&attachmentRepository, &taskUsecase)
+
+			usecase := attachment.New(&attachmentRepository, &taskUsecase, &blobmocks.MockBlobStore{}, cfg)
+			result, err := usecase.GetByTaskID(context.Background(), taskId)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestAttachmentGetByID(t *testing.T) {
+	tests := []struct {
+		name       string
+		mockDeps   func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase)
+		wantResult model.Attachment
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				attachmentRepository.On("GetByID", mock.Anything, attachmentModel.ID, taskId).Return(attachmentModel, nil)
+			},
+			wantResult: attachmentModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when attachment is not found",
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				attachmentRepository.On("GetByID", mock.Anything, attachmentModel.ID, taskId).Return(model.Attachment{}, sql.ErrNoRows)
+			},
+			wantResult: model.Attachment{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "attachment not found"),
+		},
+		{
+			name: "error when get attachment",
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				attachmentRepository.On("GetByID", mock.Anything, attachmentModel.ID, taskId).Return(model.Attachment{}, errors.New("some error"))
+			},
+			wantResult: model.Attachment{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when task is not accessible",
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+				attachmentRepository.AssertNotCalled(t, "GetByID")
+			},
+			wantResult: model.Attachment{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attachmentRepository := attachmentmocks.MockAttachmentRepository{}
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&attachmentRepository, &taskUsecase)
+
+			usecase := attachment.New(&attachmentRepository, &taskUsecase, &blobmocks.MockBlobStore{}, cfg)
+			result, err := usecase.GetByID(context.Background(), taskId, attachmentModel.ID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestAttachmentOpen(t *testing.T) {
+	tests := []struct {
+		name     string
+		mockDeps func(blobStore *blobmocks.MockBlobStore)
+		wantErr  error
+	}{
+		{
+			name: "success",
+			mockDeps: func(blobStore *blobmocks.MockBlobStore) {
+				blobStore.On("Get", mock.Anything, attachmentModel.StorageKey, int64(8), int64(4)).Return(io.NopCloser(strings.NewReader("xxxx")), nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when blob is not found",
+			mockDeps: func(blobStore *blobmocks.MockBlobStore) {
+				blobStore.On("Get", mock.Anything, attachmentModel.StorageKey, int64(8), int64(4)).Return(nil, blobstore.ErrNotFound)
+			},
+			wantErr: errs.NewErrs(http.StatusNotFound, "attachment not found"),
+		},
+		{
+			name: "error when call blobstore get",
+			mockDeps: func(blobStore *blobmocks.MockBlobStore) {
+				blobStore.On("Get", mock.Anything, attachmentModel.StorageKey, int64(8), int64(4)).Return(nil, errors.New("some error"))
+			},
+			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blobStore := blobmocks.MockBlobStore{}
+
+			tt.mockDeps(&blobStore)
+
+			usecase := attachment.New(&attachmentmocks.MockAttachmentRepository{}, &taskmocks.MockTaskUsecase{}, &blobStore, cfg)
+			result, err := usecase.Open(context.Background(), attachmentModel, 8, 4)
+
+			assert.Equal(t, tt.wantErr, err)
+			if err == nil {
+				content, _ := io.ReadAll(result)
+				assert.Equal(t, "xxxx", string(content))
+			}
+		})
+	}
+}
+
+func TestAttachmentDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		mockDeps func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore)
+		wantErr  error
+	}{
+		{
+			name: "success",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore) {
+				taskUsecase.On("Authorize", mock.Anything, taskId, model.WorkspaceRoleEditor).Return(model.Task{ID: taskId, UserID: 9}, nil)
+				attachmentRepository.On("GetByID", mock.Anything, attachmentModel.ID, taskId).Return(attachmentModel, nil)
+				attachmentRepository.On("Delete", mock.Anything, attachmentModel.ID, taskId).Return(nil)
+				blobStore.On("Delete", mock.Anything, attachmentModel.StorageKey).Return(nil)
+				attachmentRepository.On("Destroy", mock.Anything, attachmentModel.ID).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success when blob removal is left for cleanup",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore) {
+				taskUsecase.On("Authorize", mock.Anything, taskId, model.WorkspaceRoleEditor).Return(model.Task{ID: taskId, UserID: 9}, nil)
+				attachmentRepository.On("GetByID", mock.Anything, attachmentModel.ID, taskId).Return(attachmentModel, nil)
+				attachmentRepository.On("Delete", mock.Anything, attachmentModel.ID, taskId).Return(nil)
+				blobStore.On("Delete", mock.Anything, attachmentModel.StorageKey).Return(errors.New("some error"))
+				attachmentRepository.AssertNotCalled(t, "Destroy")
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success when user is the task owner",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, int64(3)),
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore) {
+				taskUsecase.On("Authorize", mock.Anything, taskId, model.WorkspaceRoleEditor).Return(model.Task{ID: taskId, UserID: 3}, nil)
+				taskUsecase.AssertNotCalled(t, "Authorize", mock.Anything, taskId, model.WorkspaceRoleOwner)
+				attachmentRepository.On("GetByID", mock.Anything, attachmentModel.ID, taskId).Return(attachmentModel, nil)
+				attachmentRepository.On("Delete", mock.Anything, attachmentModel.ID, taskId).Return(nil)
+				blobStore.On("Delete", mock.Anything, attachmentModel.StorageKey).Return(nil)
+				attachmentRepository.On("Destroy", mock.Anything, attachmentModel.ID).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success when user is a workspace owner",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, int64(3)),
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore) {
+				taskUsecase.On("Authorize", mock.Anything, taskId, model.WorkspaceRoleEditor).Return(model.Task{ID: taskId, UserID: 9}, nil)
+				taskUsecase.On("Authorize", mock.Anything, taskId, model.WorkspaceRoleOwner).Return(model.Task{ID: taskId, UserID: 9}, nil)
+				attachmentRepository.On("GetByID", mock.Anything, attachmentModel.ID, taskId).Return(attachmentModel, nil)
+				attachmentRepository.On("Delete", mock.Anything, attachmentModel.ID, taskId).Return(nil)
+				blobStore.On("Delete", mock.Anything, attachmentModel.StorageKey).Return(nil)
+				attachmentRepository.On("Destroy", mock.Anything, attachmentModel.ID).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when user is not the uploader, task owner or workspace owner",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, int64(3)),
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore) {
+				taskUsecase.On("Authorize", mock.Anything, taskId, model.WorkspaceRoleEditor).Return(model.Task{ID: taskId, UserID: 9}, nil)
+				taskUsecase.On("Authorize", mock.Anything, taskId, model.WorkspaceRoleOwner).Return(model.Task{}, errs.NewErrs(http.StatusForbidden, "insufficient workspace role"))
+				attachmentRepository.On("GetByID", mock.Anything, attachmentModel.ID, taskId).Return(attachmentModel, nil)
+				attachmentRepository.AssertNotCalled(t, "Delete")
+			},
+			wantErr: errs.NewErrs(http.StatusForbidden, "only the uploader, task owner or workspace owner can delete this attachment"),
+		},
+		{
+			name: "error when user is not an editor",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore) {
+				taskUsecase.On("Authorize", mock.Anything, taskId, model.WorkspaceRoleEditor).Return(model.Task{}, errs.NewErrs(http.StatusForbidden, "insufficient workspace role"))
+				attachmentRepository.AssertNotCalled(t, "GetByID")
+			},
+			wantErr: errs.NewErrs(http.StatusForbidden, "insufficient workspace role"),
+		},
+		{
+			name: "error when attachment is already deleted",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore) {
+				taskUsecase.On("Authorize", mock.Anything, taskId, model.WorkspaceRoleEditor).Return(model.Task{ID: taskId, UserID: 9}, nil)
+				attachmentRepository.On("GetByID", mock.Anything, attachmentModel.ID, taskId).Return(attachmentModel, nil)
+				attachmentRepository.On("Delete", mock.Anything, attachmentModel.ID, taskId).Return(sql.ErrNoRows)
+				blobStore.AssertNotCalled(t, "Delete")
+			},
+			wantErr: errs.NewErrs(http.StatusNotFound, "attachment not found"),
+		},
+		{
+			name: "error when delete attachment",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore) {
+				taskUsecase.On("Authorize", mock.Anything, taskId, model.WorkspaceRoleEditor).Return(model.Task{ID: taskId, UserID: 9}, nil)
+				attachmentRepository.On("GetByID", mock.Anything, attachmentModel.ID, taskId).Return(attachmentModel, nil)
+				attachmentRepository.On("Delete", mock.Anything, attachmentModel.ID, taskId).Return(errors.New("some error"))
+			},
+			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when user id is missing",
+			ctx:  context.Background(),
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, taskUsecase *taskmocks.MockTaskUsecase, blobStore *blobmocks.MockBlobStore) {
+				taskUsecase.AssertNotCalled(t, "Authorize")
+			},
+			wantErr: errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attachmentRepository := attachmentmocks.MockAttachmentRepository{}
+			taskUsecase := taskmocks.MockTaskUsecase{}
+			blobStore := blobmocks.MockBlobStore{}
+
+			tt.mockDeps(&attachmentRepository, &taskUsecase, &blobStore)
+
+			usecase := attachment.New(&attachmentRepository, &taskUsecase, &blobStore, cfg)
+			err := usecase.Delete(tt.ctx, taskId, attachmentModel.ID)
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestAttachmentCleanup(t *testing.T) {
+	orphaned := []model.Attachment{{ID: 1, StorageKey: "tasks/2/a"}, {ID: 2, StorageKey: "tasks/2/b"}}
+
+	tests := []struct {
+		name       string
+		mockDeps   func(attachmentRepository *attachmentmocks.MockAttachmentRepository, blobStore *blobmocks.MockBlobStore)
+		wantResult int64
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, blobStore *blobmocks.MockBlobStore) {
+				attachmentRepository.On("GetOrphaned", mock.Anything, 100).Return(orphaned, nil)
+				blobStore.On("Delete", mock.Anything, "tasks/2/a").Return(nil)
+				blobStore.On("Delete", mock.Anything, "tasks/2/b").Return(nil)
+				attachmentRepository.On("Destroy", mock.Anything, int64(1)).Return(nil)
+				attachmentRepository.On("Destroy", mock.Anything, int64(2)).Return(nil)
+			},
+			wantResult: 2,
+			wantErr:    nil,
+		},
+		{
+			name: "error when remove blob",
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, blobStore *blobmocks.MockBlobStore) {
+				attachmentRepository.On("GetOrphaned", mock.Anything, 100).Return(orphaned, nil)
+				blobStore.On("Delete", mock.Anything, "tasks/2/a").Return(nil)
+				blobStore.On("Delete", mock.Anything, "tasks/2/b").Return(errors.New("some error"))
+				attachmentRepository.On("Destroy", mock.Anything, int64(1)).Return(nil)
+			},
+			wantResult: 1,
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when get orphaned attachments",
+			mockDeps: func(attachmentRepository *attachmentmocks.MockAttachmentRepository, blobStore *blobmocks.MockBlobStore) {
+				attachmentRepository.On("GetOrphaned", mock.Anything, 100).Return([]model.Attachment{}, errors.New("some error"))
+			},
+			wantResult: 0,
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attachmentRepository := attachmentmocks.MockAttachmentRepository{}
+			blobStore := blobmocks.MockBlobStore{}
+
+			tt.mockDeps(&attachmentRepository, &blobStore)
+
+			usecase := attachment.New(&attachmentRepository, &taskmocks.MockTaskUsecase{}, &blobStore, cfg)
+			result, err := usecase.Cleanup(context.Background())
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,379 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/rzfhlv/go-task/internal/model"
+)
+
+// MockAttachmentUsecase is an autogenerated mock type for the AttachmentUsecase type
+type MockAttachmentUsecase struct {
+	mock.Mock
+}
+
+type MockAttachmentUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachmentUsecase) EXPECT() *MockAttachmentUsecase_Expecter {
+	return &MockAttachmentUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Cleanup provides a mock function with given fields: ctx
+func (_m *MockAttachmentUsecase) Cleanup(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cleanup")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachmentUsecase_Cleanup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cleanup'
+type MockAttachmentUsecase_Cleanup_Call struct {
+	*mock.Call
+}
+
+// Cleanup is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAttachmentUsecase_Expecter) Cleanup(ctx interface{}) *MockAttachmentUsecase_Cleanup_Call {
+	return &MockAttachmentUsecase_Cleanup_Call{Call: _e.mock.On("Cleanup", ctx)}
+}
+
+func (_c *MockAttachmentUsecase_Cleanup_Call) Run(run func(ctx context.Context)) *MockAttachmentUsecase_Cleanup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_Cleanup_Call) Return(_a0 int64, _a1 error) *MockAttachmentUsecase_Cleanup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_Cleanup_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockAttachmentUsecase_Cleanup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, taskId, upload
+func (_m *MockAttachmentUsecase) Create(ctx context.Context, taskId int64, upload model.AttachmentUpload) (model.Attachment, error) {
+	ret := _m.Called(ctx, taskId, upload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.AttachmentUpload) (model.Attachment, error)); ok {
+		return rf(ctx, taskId, upload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.AttachmentUpload) model.Attachment); ok {
+		r0 = rf(ctx, taskId, upload)
+	} else {
+		r0 = ret.Get(0).(model.Attachment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.AttachmentUpload) error); ok {
+		r1 = rf(ctx, taskId, upload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachmentUsecase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockAttachmentUsecase_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+//   - upload model.AttachmentUpload
+func (_e *MockAttachmentUsecase_Expecter) Create(ctx interface{}, taskId interface{}, upload interface{}) *MockAttachmentUsecase_Create_Call {
+	return &MockAttachmentUsecase_Create_Call{Call: _e.mock.On("Create", ctx, taskId, upload)}
+}
+
+func (_c *MockAttachmentUsecase_Create_Call) Run(run func(ctx context.Context, taskId int64, upload model.AttachmentUpload)) *MockAttachmentUsecase_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.AttachmentUpload))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_Create_Call) Return(_a0 model.Attachment, _a1 error) *MockAttachmentUsecase_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_Create_Call) RunAndReturn(run func(context.Context, int64, model.AttachmentUpload) (model.Attachment, error)) *MockAttachmentUsecase_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, taskId, id
+func (_m *MockAttachmentUsecase) Delete(ctx context.Context, taskId int64, id int64) error {
+	ret := _m.Called(ctx, taskId, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, taskId, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAttachmentUsecase_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockAttachmentUsecase_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+//   - id int64
+func (_e *MockAttachmentUsecase_Expecter) Delete(ctx interface{}, taskId interface{}, id interface{}) *MockAttachmentUsecase_Delete_Call {
+	return &MockAttachmentUsecase_Delete_Call{Call: _e.mock.On("Delete", ctx, taskId, id)}
+}
+
+func (_c *MockAttachmentUsecase_Delete_Call) Run(run func(ctx context.Context, taskId int64, id int64)) *MockAttachmentUsecase_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_Delete_Call) Return(_a0 error) *MockAttachmentUsecase_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_Delete_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockAttachmentUsecase_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, taskId, id
+func (_m *MockAttachmentUsecase) GetByID(ctx context.Context, taskId int64, id int64) (model.Attachment, error) {
+	ret := _m.Called(ctx, taskId, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Attachment, error)); ok {
+		return rf(ctx, taskId, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Attachment); ok {
+		r0 = rf(ctx, taskId, id)
+	} else {
+		r0 = ret.Get(0).(model.Attachment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, taskId, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachmentUsecase_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockAttachmentUsecase_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+//   - id int64
+func (_e *MockAttachmentUsecase_Expecter) GetByID(ctx interface{}, taskId interface{}, id interface{}) *MockAttachmentUsecase_GetByID_Call {
+	return &MockAttachmentUsecase_GetByID_Call{Call: _e.mock.On("GetByID", ctx, taskId, id)}
+}
+
+func (_c *MockAttachmentUsecase_GetByID_Call) Run(run func(ctx context.Context, taskId int64, id int64)) *MockAttachmentUsecase_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_GetByID_Call) Return(_a0 model.Attachment, _a1 error) *MockAttachmentUsecase_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_GetByID_Call) RunAndReturn(run func(context.Context, int64, int64) (model.Attachment, error)) *MockAttachmentUsecase_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTaskID provides a mock function with given fields: ctx, taskId
+func (_m *MockAttachmentUsecase) GetByTaskID(ctx context.Context, taskId int64) ([]model.Attachment, error) {
+	ret := _m.Called(ctx, taskId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTaskID")
+	}
+
+	var r0 []model.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.Attachment, error)); ok {
+		return rf(ctx, taskId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.Attachment); ok {
+		r0 = rf(ctx, taskId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, taskId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachmentUsecase_GetByTaskID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTaskID'
+type MockAttachmentUsecase_GetByTaskID_Call struct {
+	*mock.Call
+}
+
+// GetByTaskID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+func (_e *MockAttachmentUsecase_Expecter) GetByTaskID(ctx interface{}, taskId interface{}) *MockAttachmentUsecase_GetByTaskID_Call {
+	return &MockAttachmentUsecase_GetByTaskID_Call{Call: _e.mock.On("GetByTaskID", ctx, taskId)}
+}
+
+func (_c *MockAttachmentUsecase_GetByTaskID_Call) Run(run func(ctx context.Context, taskId int64)) *MockAttachmentUsecase_GetByTaskID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_GetByTaskID_Call) Return(_a0 []model.Attachment, _a1 error) *MockAttachmentUsecase_GetByTaskID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_GetByTaskID_Call) RunAndReturn(run func(context.Context, int64) ([]model.Attachment, error)) *MockAttachmentUsecase_GetByTaskID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Open provides a mock function with given fields: ctx, _a1, offset, length
+func (_m *MockAttachmentUsecase) Open(ctx context.Context, _a1 model.Attachment, offset int64, length int64) (io.ReadCloser, error) {
+	ret := _m.Called(ctx, _a1, offset, length)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Attachment, int64, int64) (io.ReadCloser, error)); ok {
+		return rf(ctx, _a1, offset, length)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Attachment, int64, int64) io.ReadCloser); ok {
+		r0 = rf(ctx, _a1, offset, length)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Attachment, int64, int64) error); ok {
+		r1 = rf(ctx, _a1, offset, length)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachmentUsecase_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type MockAttachmentUsecase_Open_Call struct {
+	*mock.Call
+}
+
+// Open is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Attachment
+//   - offset int64
+//   - length int64
+func (_e *MockAttachmentUsecase_Expecter) Open(ctx interface{}, _a1 interface{}, offset interface{}, length interface{}) *MockAttachmentUsecase_Open_Call {
+	return &MockAttachmentUsecase_Open_Call{Call: _e.mock.On("Open", ctx, _a1, offset, length)}
+}
+
+func (_c *MockAttachmentUsecase_Open_Call) Run(run func(ctx context.Context, _a1 model.Attachment, offset int64, length int64)) *MockAttachmentUsecase_Open_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Attachment), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_Open_Call) Return(_a0 io.ReadCloser, _a1 error) *MockAttachmentUsecase_Open_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachmentUsecase_Open_Call) RunAndReturn(run func(context.Context, model.Attachment, int64, int64) (io.ReadCloser, error)) *MockAttachmentUsecase_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAttachmentUsecase creates a new instance of MockAttachmentUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachmentUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachmentUsecase {
+	mock := &MockAttachmentUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
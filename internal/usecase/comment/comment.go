@@ -0,0 +1,170 @@
+package comment
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/rzfhlv/go-task/config"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/repository/comment"
+	"github.com/rzfhlv/go-task/internal/usecase/task"
+	"github.com/rzfhlv/go-task/pkg/cursor"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+	"github.com/rzfhlv/go-task/pkg/param"
+)
+
+type CommentUsecase interface {
+	Create(ctx context.Context, taskId int64, comment model.Comment) (model.Comment, error)
+	GetByTaskID(ctx context.Context, taskId int64, param *param.Param) ([]model.Comment, error)
+	Update(ctx context.Context, taskId int64, comment model.Comment) (model.Comment, error)
+	Delete(ctx context.Context, taskId, id int64) error
+}
+
+type Comment struct {
+	commentRepository comment.CommentRepository
+	taskUsecase       task.TaskUsecase
+	cursorSecret      string
+}
+
+func New(commentRepository comment.CommentRepository, taskUsecase task.TaskUsecase, cfg *config.Configuration) CommentUsecase {
+	return &Comment{
+		commentRepository: commentRepository,
+		taskUsecase:       taskUsecase,
+		cursorSecret:      cfg.App.CursorSecret,
+	}
+}
+
+func (c *Comment) Create(ctx context.Context, taskId int64, request model.Comment) (model.Comment, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Comment] error when get user id from context")
+		return model.Comment{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	_, err := c.taskUsecase.GetByID(ctx, taskId)
+	if err != nil {
+		return model.Comment{}, err
+	}
+
+	request.TaskID = taskId
+	request.UserID = userId
+	result, err := c.commentRepository.Create(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Comment] error when call commentRepository.Create", slog.String("error", err.Error()))
+		return model.Comment{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (c *Comment) GetByTaskID(ctx context.Context, taskId int64, param *param.Param) ([]model.Comment, error) {
+	_, err := c.taskUsecase.GetByID(ctx, taskId)
+	if err != nil {
+		return []model.Comment{}, err
+	}
+
+	afterId := int64(0)
+	if param.Cursor != "" {
+		keyset, err := cursor.Decode(param.Cursor, c.cursorSecret)
+		if err != nil {
+			slog.ErrorContext(ctx, "[Usecase.Comment] error when decode cursor", slog.String("error", err.Error()))
+			return []model.Comment{}, errs.NewErrs(http.StatusBadRequest, "invalid cursor")
+		}
+
+		afterId = keyset.ID
+	}
+
+	result, err := c.commentRepository.GetByTaskID(ctx, taskId, afterId, param.Limit+1)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Comment] error when call commentRepository.GetByTaskID", slog.String("error", err.Error()))
+		return []model.Comment{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	total, err := c.commentRepository.Count(ctx, taskId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Comment] error when call commentRepository.Count", slog.String("error", err.Error()))
+		return []model.Comment{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	param.Total = total
+	if len(result) > param.Limit {
+		result = result[:param.Limit]
+		param.NextCursor = cursor.Encode(cursor.Cursor{ID: result[len(result)-1].ID}, c.cursorSecret)
+	}
+
+	return result, nil
+}
+
+func (c *Comment) Update(ctx context.Context, taskId int64, request model.Comment) (model.Comment, error) {
+	_, err := c.find(ctx, taskId, request.ID)
+	if err != nil {
+		return model.Comment{}, err
+	}
+
+	request.TaskID = taskId
+	request.UpdatedAt = time.Now()
+	result, err := c.commentRepository.Update(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Comment] error when call commentRepository.Update", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Comment{}, errs.NewErrs(http.StatusNotFound, "comment not found")
+		}
+
+		return model.Comment{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (c *Comment) Delete(ctx context.Context, taskId, id int64) error {
+	_, err := c.find(ctx, taskId, id)
+	if err != nil {
+		return err
+	}
+
+	err = c.commentRepository.Delete(ctx, id, taskId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Comment] error when call commentRepository.Delete", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return errs.NewErrs(http.StatusNotFound, "comment not found")
+		}
+
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return nil
+}
+
+func (c *Comment) find(ctx context.Context, taskId, id int64) (model.Comment, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Comment] error when get user id from context")
+		return model.Comment{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	_, err := c.taskUsecase.GetByID(ctx, taskId)
+	if err != nil {
+		return model.Comment{}, err
+	}
+
+	result, err := c.commentRepository.GetByID(ctx, id, taskId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Comment] error when call commentRepository.GetByID", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Comment{}, errs.NewErrs(http.StatusNotFound, "comment not found")
+		}
+
+		return model.Comment{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	if result.UserID != userId {
+		slog.ErrorContext(ctx, "[Usecase.Comment] error user is not the comment author", slog.Int64("id", id))
+		return model.Comment{}, errs.NewErrs(http.StatusForbidden, "only the author can modify this comment")
+	}
+
+	return result, nil
+}
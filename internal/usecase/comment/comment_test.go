@@ -0,0 +1,371 @@
+package comment_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/rzfhlv/go-task/config"
+	"github.com/rzfhlv/go-task/internal/model"
+	commentmocks "github.com/rzfhlv/go-task/internal/repository/comment/mocks"
+	"github.com/rzfhlv/go-task/internal/usecase/comment"
+	taskmocks "github.com/rzfhlv/go-task/internal/usecase/task/mocks"
+	"github.com/rzfhlv/go-task/pkg/cursor"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+	"github.com/rzfhlv/go-task/pkg/param"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	userId = int64(1)
+	taskId = int64(2)
+	secret = "secret"
+
+	cfg = &config.Configuration{App: config.AppConfiguration{CursorSecret: secret}}
+
+	commentModel = model.Comment{
+		ID:     1,
+		TaskID: taskId,
+		UserID: userId,
+		Body:   "looks good to me",
+	}
+)
+
+func TestCommentCreate(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockDeps   func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase)
+		wantResult model.Comment
+		wantErr    error
+	}{
+		{
+			name: "success",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				commentRepository.On("Create", mock.Anything, model.Comment{TaskID: taskId, UserID: userId, Body: commentModel.Body}).Return(commentModel, nil)
+			},
+			wantResult: commentModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when task is not accessible",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+				commentRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: model.Comment{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name: "error when create comment",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				commentRepository.On("Create", mock.Anything, mock.Anything).Return(model.Comment{}, errors.New("some error"))
+			},
+			wantResult: model.Comment{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when user id is missing",
+			ctx:  context.Background(),
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetByID")
+				commentRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: model.Comment{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commentRepository := commentmocks.MockCommentRepository{}
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&commentRepository, &taskUsecase)
+
+			usecase := comment.New(&commentRepository, &taskUsecase, cfg)
+			result, err := usecase.Create(tt.ctx, taskId, model.Comment{Body: commentModel.Body})
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestCommentGetByTaskID(t *testing.T) {
+	next := model.Comment{ID: 2, TaskID: taskId, UserID: userId, Body: "second"}
+
+	tests := []struct {
+		name           string
+		param          param.Param
+		mockDeps       func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase)
+		wantResult     []model.Comment
+		wantNextCursor string
+		wantErr        error
+	}{
+		{
+			name:  "success",
+			param: param.Param{Limit: 10},
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				commentRepository.On("GetByTaskID", mock.Anything, taskId, int64(0), 11).Return([]model.Comment{commentModel, next}, nil)
+				commentRepository.On("Count", mock.Anything, taskId).Return(int64(2), nil)
+			},
+			wantResult: []model.Comment{commentModel, next},
+			wantErr:    nil,
+		},
+		{
+			name:  "success with next cursor",
+			param: param.Param{Limit: 1},
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				commentRepository.On("GetByTaskID", mock.Anything, taskId, int64(0), 2).Return([]model.Comment{commentModel, next}, nil)
+				commentRepository.On("Count", mock.Anything, taskId).Return(int64(2), nil)
+			},
+			wantResult:     []model.Comment{commentModel},
+			wantNextCursor: cursor.Encode(cursor.Cursor{ID: commentModel.ID}, secret),
+			wantErr:        nil,
+		},
+		{
+			name:  "success with cursor",
+			param: param.Param{Limit: 1, Cursor: cursor.Encode(cursor.Cursor{ID: commentModel.ID}, secret)},
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				commentRepository.On("GetByTaskID", mock.Anything, taskId, commentModel.ID, 2).Return([]model.Comment{next}, nil)
+				commentRepository.On("Count", mock.Anything, taskId).Return(int64(2), nil)
+			},
+			wantResult: []model.Comment{next},
+			wantErr:    nil,
+		},
+		{
+			name:  "error when cursor is invalid",
+			param: param.Param{Limit: 10, Cursor: "invalid"},
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				commentRepository.AssertNotCalled(t, "GetByTaskID")
+			},
+			wantResult: []model.Comment{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "invalid cursor"),
+		},
+		{
+			name:  "error when task is not accessible",
+			param: param.Param{Limit: 10},
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+				commentRepository.AssertNotCalled(t, "GetByTaskID")
+			},
+			wantResult: []model.Comment{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name:  "error when get comments",
+			param: param.Param{Limit: 10},
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				commentRepository.On("GetByTaskID", mock.Anything, taskId, int64(0), 11).Return([]model.Comment{}, errors.New("some error"))
+			},
+			wantResult: []model.Comment{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:  "error when count comments",
+			param: param.Param{Limit: 10},
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				commentRepository.On("GetByTaskID", mock.Anything, taskId, int64(0), 11).Return([]model.Comment{commentModel}, nil)
+				commentRepository.On("Count", mock.Anything, taskId).Return(int64(0), errors.New("some error"))
+			},
+			wantResult: []model.Comment{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commentRepository := commentmocks.MockCommentRepository{}
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&commentRepository, &taskUsecase)
+
+			usecase := comment.New(&commentRepository, &taskUsecase, cfg)
+			result, err := usecase.GetByTaskID(context.Background(), taskId, &tt.param)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantNextCursor, tt.param.NextCursor)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestCommentUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockDeps   func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase)
+		wantResult model.Comment
+		wantErr    error
+	}{
+		{
+			name: "success",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				commentRepository.On("GetByID", mock.Anything, commentModel.ID, taskId).Return(commentModel, nil)
+				commentRepository.On("Update", mock.Anything, mock.MatchedBy(func(request model.Comment) bool {
+					return request.ID == commentModel.ID && request.TaskID == taskId && request.Body == "edited" && !request.UpdatedAt.IsZero()
+				})).Return(model.Comment{ID: commentModel.ID, TaskID: taskId, UserID: userId, Body: "edited"}, nil)
+			},
+			wantResult: model.Comment{ID: commentModel.ID, TaskID: taskId, UserID: userId, Body: "edited"},
+			wantErr:    nil,
+		},
+		{
+			name: "error when user is not the author",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, int64(3)),
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				commentRepository.On("GetByID", mock.Anything, commentModel.ID, taskId).Return(commentModel, nil)
+				commentRepository.AssertNotCalled(t, "Update")
+			},
+			wantResult: model.Comment{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "only the author can modify this comment"),
+		},
+		{
+			name: "error when comment is not found",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				commentRepository.On("GetByID", mock.Anything, commentModel.ID, taskId).Return(model.Comment{}, sql.ErrNoRows)
+			},
+			wantResult: model.Comment{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "comment not found"),
+		},
+		{
+			name: "error when get comment",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				commentRepository.On("GetByID", mock.Anything, commentModel.ID, taskId).Return(model.Comment{}, errors.New("some error"))
+			},
+			wantResult: model.Comment{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when task is not accessible",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+				commentRepository.AssertNotCalled(t, "GetByID")
+			},
+			wantResult: model.Comment{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name: "error when update comment",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				commentRepository.On("GetByID", mock.Anything, commentModel.ID, taskId).Return(commentModel, nil)
+				commentRepository.On("Update", mock.Anything, mock.Anything).Return(model.Comment{}, errors.New("some error"))
+			},
+			wantResult: model.Comment{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when user id is missing",
+			ctx:  context.Background(),
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetByID")
+			},
+			wantResult: model.Comment{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commentRepository := commentmocks.MockCommentRepository{}
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&commentRepository, &taskUsecase)
+
+			usecase := comment.New(&commentRepository, &taskUsecase, cfg)
+			result, err := usecase.Update(tt.ctx, taskId, model.Comment{ID: commentModel.ID, Body: "edited"})
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestCommentDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		mockDeps func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase)
+		wantErr  error
+	}{
+		{
+			name: "success",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				commentRepository.On("GetByID", mock.Anything, commentModel.ID, taskId).Return(commentModel, nil)
+				commentRepository.On("Delete", mock.Anything, commentModel.ID, taskId).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when user is not the author",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, int64(3)),
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				commentRepository.On("GetByID", mock.Anything, commentModel.ID, taskId).Return(commentModel, nil)
+				commentRepository.AssertNotCalled(t, "Delete")
+			},
+			wantErr: errs.NewErrs(http.StatusForbidden, "only the author can modify this comment"),
+		},
+		{
+			name: "error when comment is already deleted",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				commentRepository.On("GetByID", mock.Anything, commentModel.ID, taskId).Return(commentModel, nil)
+				commentRepository.On("Delete", mock.Anything, commentModel.ID, taskId).Return(sql.ErrNoRows)
+			},
+			wantErr: errs.NewErrs(http.StatusNotFound, "comment not found"),
+		},
+		{
+			name: "error when delete comment",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(commentRepository *commentmocks.MockCommentRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				commentRepository.On("GetByID", mock.Anything, commentModel.ID, taskId).Return(commentModel, nil)
+				commentRepository.On("Delete", mock.Anything, commentModel.ID, taskId).Return(errors.New("some error"))
+			},
+			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commentRepository := commentmocks.MockCommentRepository{}
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&commentRepository, &taskUsecase)
+
+			usecase := comment.New(&commentRepository, &taskUsecase, cfg)
+			err := usecase.Delete(tt.ctx, taskId, commentModel.ID)
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,263 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/rzfhlv/go-task/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	param "github.com/rzfhlv/go-task/pkg/param"
+)
+
+// MockCommentUsecase is an autogenerated mock type for the CommentUsecase type
+type MockCommentUsecase struct {
+	mock.Mock
+}
+
+type MockCommentUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCommentUsecase) EXPECT() *MockCommentUsecase_Expecter {
+	return &MockCommentUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, taskId, _a2
+func (_m *MockCommentUsecase) Create(ctx context.Context, taskId int64, _a2 model.Comment) (model.Comment, error) {
+	ret := _m.Called(ctx, taskId, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.Comment) (model.Comment, error)); ok {
+		return rf(ctx, taskId, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.Comment) model.Comment); ok {
+		r0 = rf(ctx, taskId, _a2)
+	} else {
+		r0 = ret.Get(0).(model.Comment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.Comment) error); ok {
+		r1 = rf(ctx, taskId, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUsecase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockCommentUsecase_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+//   - _a2 model.Comment
+func (_e *MockCommentUsecase_Expecter) Create(ctx interface{}, taskId interface{}, _a2 interface{}) *MockCommentUsecase_Create_Call {
+	return &MockCommentUsecase_Create_Call{Call: _e.mock.On("Create", ctx, taskId, _a2)}
+}
+
+func (_c *MockCommentUsecase_Create_Call) Run(run func(ctx context.Context, taskId int64, _a2 model.Comment)) *MockCommentUsecase_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.Comment))
+	})
+	return _c
+}
+
+func (_c *MockCommentUsecase_Create_Call) Return(_a0 model.Comment, _a1 error) *MockCommentUsecase_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUsecase_Create_Call) RunAndReturn(run func(context.Context, int64, model.Comment) (model.Comment, error)) *MockCommentUsecase_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, taskId, id
+func (_m *MockCommentUsecase) Delete(ctx context.Context, taskId int64, id int64) error {
+	ret := _m.Called(ctx, taskId, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, taskId, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommentUsecase_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockCommentUsecase_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+//   - id int64
+func (_e *MockCommentUsecase_Expecter) Delete(ctx interface{}, taskId interface{}, id interface{}) *MockCommentUsecase_Delete_Call {
+	return &MockCommentUsecase_Delete_Call{Call: _e.mock.On("Delete", ctx, taskId, id)}
+}
+
+func (_c *MockCommentUsecase_Delete_Call) Run(run func(ctx context.Context, taskId int64, id int64)) *MockCommentUsecase_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCommentUsecase_Delete_Call) Return(_a0 error) *MockCommentUsecase_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommentUsecase_Delete_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockCommentUsecase_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTaskID provides a mock function with given fields: ctx, taskId, _a2
+func (_m *MockCommentUsecase) GetByTaskID(ctx context.Context, taskId int64, _a2 *param.Param) ([]model.Comment, error) {
+	ret := _m.Called(ctx, taskId, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTaskID")
+	}
+
+	var r0 []model.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) ([]model.Comment, error)); ok {
+		return rf(ctx, taskId, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) []model.Comment); ok {
+		r0 = rf(ctx, taskId, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *param.Param) error); ok {
+		r1 = rf(ctx, taskId, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUsecase_GetByTaskID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTaskID'
+type MockCommentUsecase_GetByTaskID_Call struct {
+	*mock.Call
+}
+
+// GetByTaskID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+//   - _a2 *param.Param
+func (_e *MockCommentUsecase_Expecter) GetByTaskID(ctx interface{}, taskId interface{}, _a2 interface{}) *MockCommentUsecase_GetByTaskID_Call {
+	return &MockCommentUsecase_GetByTaskID_Call{Call: _e.mock.On("GetByTaskID", ctx, taskId, _a2)}
+}
+
+func (_c *MockCommentUsecase_GetByTaskID_Call) Run(run func(ctx context.Context, taskId int64, _a2 *param.Param)) *MockCommentUsecase_GetByTaskID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*param.Param))
+	})
+	return _c
+}
+
+func (_c *MockCommentUsecase_GetByTaskID_Call) Return(_a0 []model.Comment, _a1 error) *MockCommentUsecase_GetByTaskID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUsecase_GetByTaskID_Call) RunAndReturn(run func(context.Context, int64, *param.Param) ([]model.Comment, error)) *MockCommentUsecase_GetByTaskID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, taskId, _a2
+func (_m *MockCommentUsecase) Update(ctx context.Context, taskId int64, _a2 model.Comment) (model.Comment, error) {
+	ret := _m.Called(ctx, taskId, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.Comment) (model.Comment, error)); ok {
+		return rf(ctx, taskId, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.Comment) model.Comment); ok {
+		r0 = rf(ctx, taskId, _a2)
+	} else {
+		r0 = ret.Get(0).(model.Comment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.Comment) error); ok {
+		r1 = rf(ctx, taskId, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCommentUsecase_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockCommentUsecase_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+//   - _a2 model.Comment
+func (_e *MockCommentUsecase_Expecter) Update(ctx interface{}, taskId interface{}, _a2 interface{}) *MockCommentUsecase_Update_Call {
+	return &MockCommentUsecase_Update_Call{Call: _e.mock.On("Update", ctx, taskId, _a2)}
+}
+
+func (_c *MockCommentUsecase_Update_Call) Run(run func(ctx context.Context, taskId int64, _a2 model.Comment)) *MockCommentUsecase_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.Comment))
+	})
+	return _c
+}
+
+func (_c *MockCommentUsecase_Update_Call) Return(_a0 model.Comment, _a1 error) *MockCommentUsecase_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCommentUsecase_Update_Call) RunAndReturn(run func(context.Context, int64, model.Comment) (model.Comment, error)) *MockCommentUsecase_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCommentUsecase creates a new instance of MockCommentUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCommentUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCommentUsecase {
+	mock := &MockCommentUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
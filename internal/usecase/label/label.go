@@ -0,0 +1,136 @@
+package label
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/repository/label"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+)
+
+type LabelUsecase interface {
+	Create(ctx context.Context, label model.Label) (model.Label, error)
+	GetByUserID(ctx context.Context, userId int64) ([]model.Label, error)
+	GetByID(ctx context.Context, id int64) (model.Label, error)
+	Update(ctx context.Context, label model.Label) (model.Label, error)
+	Delete(ctx context.Context, id int64) error
+}
+
+type Label struct {
+	labelRepository label.LabelRepository
+}
+
+func New(labelRepository label.LabelRepository) LabelUsecase {
+	return &Label{
+		labelRepository: labelRepository,
+	}
+}
+
+func (l *Label) Create(ctx context.Context, request model.Label) (model.Label, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Label] error when get user id from context")
+		return model.Label{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	if request.Colour == "" {
+		request.Colour = model.LabelDefaultColour
+	}
+
+	request.UserID = userId
+	result, err := l.labelRepository.Create(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Label] error when call labelRepository.Create", slog.String("error", err.Error()))
+		if err == label.ErrLabelExists {
+			return model.Label{}, errs.NewErrs(http.StatusConflict, "label already exists")
+		}
+
+		return model.Label{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (l *Label) GetByUserID(ctx context.Context, userId int64) ([]model.Label, error) {
+	result, err := l.labelRepository.GetByUserID(ctx, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Label] error when call labelRepository.GetByUserID", slog.String("error", err.Error()))
+		return []model.Label{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (l *Label) GetByID(ctx context.Context, id int64) (model.Label, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Label] error when get user id from context")
+		return model.Label{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	result, err := l.labelRepository.GetByID(ctx, id, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Label] error when call labelRepository.GetByID", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Label{}, errs.NewErrs(http.StatusNotFound, "label not found")
+		}
+
+		return model.Label{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (l *Label) Update(ctx context.Context, request model.Label) (model.Label, error) {
+	check, err := l.GetByID(ctx, request.ID)
+	if err != nil {
+		return model.Label{}, err
+	}
+
+	if request.Colour == "" {
+		request.Colour = check.Colour
+	}
+
+	request.UserID = check.UserID
+	request.UpdatedAt = time.Now()
+	result, err := l.labelRepository.Update(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Label] error when call labelRepository.Update", slog.String("error", err.Error()))
+		if err == label.ErrLabelExists {
+			return model.Label{}, errs.NewErrs(http.StatusConflict, "label already exists")
+		}
+
+		if err == sql.ErrNoRows {
+			return model.Label{}, errs.NewErrs(http.StatusNotFound, "label not found")
+		}
+
+		return model.Label{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (l *Label) Delete(ctx context.Context, id int64) error {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Label] error when get user id from context")
+		return errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	err := l.labelRepository.Delete(ctx, id, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Label] error when call labelRepository.Delete", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return errs.NewErrs(http.StatusNotFound, "label not found")
+		}
+
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return nil
+}
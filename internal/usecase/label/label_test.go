@@ -0,0 +1,307 @@
+package label_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/rzfhlv/go-task/internal/model"
+	labelrepository "github.com/rzfhlv/go-task/internal/repository/label"
+	labelmocks "github.com/rzfhlv/go-task/internal/repository/label/mocks"
+	"github.com/rzfhlv/go-task/internal/usecase/label"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	userId = int64(1)
+
+	labelModel = model.Label{
+		ID:     1,
+		Name:   "urgent",
+		Colour: "#ff0000",
+		UserID: userId,
+	}
+)
+
+func TestLabelCreate(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		request    model.Label
+		mockDeps   func(labelRepository *labelmocks.MockLabelRepository)
+		wantResult model.Label
+		wantErr    error
+	}{
+		{
+			name:    "success",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.Label{Name: "urgent", Colour: "#ff0000"},
+			mockDeps: func(labelRepository *labelmocks.MockLabelRepository) {
+				labelRepository.On("Create", mock.Anything, model.Label{Name: "urgent", Colour: "#ff0000", UserID: userId}).Return(labelModel, nil)
+			},
+			wantResult: labelModel,
+			wantErr:    nil,
+		},
+		{
+			name:    "success with default colour",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.Label{Name: "backlog"},
+			mockDeps: func(labelRepository *labelmocks.MockLabelRepository) {
+				labelRepository.On("Create", mock.Anything, model.Label{Name: "backlog", Colour: model.LabelDefaultColour, UserID: userId}).
+					Return(model.Label{ID: 2, Name: "backlog", Colour: model.LabelDefaultColour, UserID: userId}, nil)
+			},
+			wantResult: model.Label{ID: 2, Name: "backlog", Colour: model.LabelDefaultColour, UserID: userId},
+			wantErr:    nil,
+		},
+		{
+			name:    "error when label already exists",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.Label{Name: "urgent", Colour: "#ff0000"},
+			mockDeps: func(labelRepository *labelmocks.MockLabelRepository) {
+				labelRepository.On("Create", mock.Anything, mock.Anything).Return(model.Label{}, labelrepository.ErrLabelExists)
+			},
+			wantResult: model.Label{},
+			wantErr:    errs.NewErrs(http.StatusConflict, "label already exists"),
+		},
+		{
+			name:    "error when create label",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.Label{Name: "urgent", Colour: "#ff0000"},
+			mockDeps: func(labelRepository *labelmocks.MockLabelRepository) {
+				labelRepository.On("Create", mock.Anything, mock.Anything).Return(model.Label{}, errors.New("some error"))
+			},
+			wantResult: model.Label{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:    "error when user id is missing",
+			ctx:     context.Background(),
+			request: model.Label{Name: "urgent"},
+			mockDeps: func(labelRepository *labelmocks.MockLabelRepository) {
+				labelRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: model.Label{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labelRepository := labelmocks.MockLabelRepository{}
+
+			tt.mockDeps(&labelRepository)
+
+			usecase := label.New(&labelRepository)
+			result, err := usecase.Create(tt.ctx, tt.request)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestLabelGetByUserID(t *testing.T) {
+	tests := []struct {
+		name       string
+		mockDeps   func(labelRepository *labelmocks.MockLabelRepository)
+		wantResult []model.Label
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(labelRepository *labelmocks.MockLabelRepository) {
+				labelRepository.On("GetByUserID", mock.Anything, userId).Return([]model.Label{labelModel}, nil)
+			},
+			wantResult: []model.Label{labelModel},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get labels",
+			mockDeps: func(labelRepository *labelmocks.MockLabelRepository) {
+				labelRepository.On("GetByUserID", mock.Anything, userId).Return([]model.Label{}, errors.New("some error"))
+			},
+			wantResult: []model.Label{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labelRepository := labelmocks.MockLabelRepository{}
+
+			tt.mockDeps(&labelRepository)
+
+			usecase := label.New(&labelRepository)
+			result, err := usecase.GetByUserID(context.Background(), userId)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestLabelGetByID(t *testing.T) {
+	tests := []struct {
+		name       string
+		mockDeps   func(labelRepository *labelmocks.MockLabelRepository)
+		wantResult model.Label
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(labelRepository *labelmocks.MockLabelRepository) {
+				labelRepository.On("GetByID", mock.Anything, labelModel.ID, userId).Return(labelModel, nil)
+			},
+			wantResult: labelModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when label is not found",
+			mockDeps: func(labelRepository *labelmocks.MockLabelRepository) {
+				labelRepository.On("GetByID", mock.Anything, labelModel.ID, userId).Return(model.Label{}, sql.ErrNoRows)
+			},
+			wantResult: model.Label{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "label not found"),
+		},
+		{
+			name: "error when get label",
+			mockDeps: func(labelRepository *labelmocks.MockLabelRepository) {
+				labelRepository.On("GetByID", mock.Anything, labelModel.ID, userId).Return(model.Label{}, errors.New("some error"))
+			},
+			wantResult: model.Label{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labelRepository := labelmocks.MockLabelRepository{}
+
+			tt.mockDeps(&labelRepository)
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+			usecase := label.New(&labelRepository)
+			result, err := usecase.GetByID(ctx, labelModel.ID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestLabelUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		request    model.Label
+		mockDeps   func(labelRepository *labelmocks.MockLabelRepository)
+		wantResult model.Label
+		wantErr    error
+	}{
+		{
+			name:    "success keeps existing colour",
+			request: model.Label{ID: labelModel.ID, Name: "critical"},
+			mockDeps: func(labelRepository *labelmocks.MockLabelRepository) {
+				labelRepository.On("GetByID", mock.Anything, labelModel.ID, userId).Return(labelModel, nil)
+				labelRepository.On("Update", mock.Anything, mock.MatchedBy(func(l model.Label) bool {
+					return l.Name == "critical" && l.Colour == labelModel.Colour && l.UserID == userId && !l.UpdatedAt.IsZero()
+				})).Return(model.Label{ID: labelModel.ID, Name: "critical", Colour: labelModel.Colour, UserID: userId}, nil)
+			},
+			wantResult: model.Label{ID: labelModel.ID, Name: "critical", Colour: labelModel.Colour, UserID: userId},
+			wantErr:    nil,
+		},
+		{
+			name:    "error when label is not found",
+			request: model.Label{ID: labelModel.ID, Name: "critical"},
+			mockDeps: func(labelRepository *labelmocks.MockLabelRepository) {
+				labelRepository.On("GetByID", mock.Anything, labelModel.ID, userId).Return(model.Label{}, sql.ErrNoRows)
+				labelRepository.AssertNotCalled(t, "Update")
+			},
+			wantResult: model.Label{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "label not found"),
+		},
+		{
+			name:    "error when label name already exists",
+			request: model.Label{ID: labelModel.ID, Name: "backlog"},
+			mockDeps: func(labelRepository *labelmocks.MockLabelRepository) {
+				labelRepository.On("GetByID", mock.Anything, labelModel.ID, userId).Return(labelModel, nil)
+				labelRepository.On("Update", mock.Anything, mock.Anything).Return(model.Label{}, labelrepository.ErrLabelExists)
+			},
+			wantResult: model.Label{},
+			wantErr:    errs.NewErrs(http.StatusConflict, "label already exists"),
+		},
+		{
+			name:    "error when update label",
+			request: model.Label{ID: labelModel.ID, Name: "critical"},
+			mockDeps: func(labelRepository *labelmocks.MockLabelRepository) {
+				labelRepository.On("GetByID", mock.Anything, labelModel.ID, userId).Return(labelModel, nil)
+				labelRepository.On("Update", mock.Anything, mock.Anything).Return(model.Label{}, errors.New("some error"))
+			},
+			wantResult: model.Label{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labelRepository := labelmocks.MockLabelRepository{}
+
+			tt.mockDeps(&labelRepository)
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+			usecase := label.New(&labelRepository)
+			result, err := usecase.Update(ctx, tt.request)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestLabelDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		mockDeps func(labelRepository *labelmocks.MockLabelRepository)
+		wantErr  error
+	}{
+		{
+			name: "success",
+			mockDeps: func(labelRepository *labelmocks.MockLabelRepository) {
+				labelRepository.On("Delete", mock.Anything, labelModel.ID, userId).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when label is not found",
+			mockDeps: func(labelRepository *labelmocks.MockLabelRepository) {
+				labelRepository.On("Delete", mock.Anything, labelModel.ID, userId).Return(sql.ErrNoRows)
+			},
+			wantErr: errs.NewErrs(http.StatusNotFound, "label not found"),
+		},
+		{
+			name: "error when delete label",
+			mockDeps: func(labelRepository *labelmocks.MockLabelRepository) {
+				labelRepository.On("Delete", mock.Anything, labelModel.ID, userId).Return(errors.New("some error"))
+			},
+			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labelRepository := labelmocks.MockLabelRepository{}
+
+			tt.mockDeps(&labelRepository)
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+			usecase := label.New(&labelRepository)
+			err := usecase.Delete(ctx, labelModel.ID)
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
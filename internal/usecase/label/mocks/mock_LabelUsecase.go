@@ -0,0 +1,315 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/rzfhlv/go-task/internal/model"
+)
+
+// MockLabelUsecase is an autogenerated mock type for the LabelUsecase type
+type MockLabelUsecase struct {
+	mock.Mock
+}
+
+type MockLabelUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLabelUsecase) EXPECT() *MockLabelUsecase_Expecter {
+	return &MockLabelUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *MockLabelUsecase) Create(ctx context.Context, _a1 model.Label) (model.Label, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Label) (model.Label, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Label) model.Label); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Label)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Label) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLabelUsecase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockLabelUsecase_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Label
+func (_e *MockLabelUsecase_Expecter) Create(ctx interface{}, _a1 interface{}) *MockLabelUsecase_Create_Call {
+	return &MockLabelUsecase_Create_Call{Call: _e.mock.On("Create", ctx, _a1)}
+}
+
+func (_c *MockLabelUsecase_Create_Call) Run(run func(ctx context.Context, _a1 model.Label)) *MockLabelUsecase_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Label))
+	})
+	return _c
+}
+
+func (_c *MockLabelUsecase_Create_Call) Return(_a0 model.Label, _a1 error) *MockLabelUsecase_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLabelUsecase_Create_Call) RunAndReturn(run func(context.Context, model.Label) (model.Label, error)) *MockLabelUsecase_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockLabelUsecase) Delete(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLabelUsecase_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockLabelUsecase_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockLabelUsecase_Expecter) Delete(ctx interface{}, id interface{}) *MockLabelUsecase_Delete_Call {
+	return &MockLabelUsecase_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockLabelUsecase_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockLabelUsecase_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockLabelUsecase_Delete_Call) Return(_a0 error) *MockLabelUsecase_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLabelUsecase_Delete_Call) RunAndReturn(run func(context.Context, int64) error) *MockLabelUsecase_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockLabelUsecase) GetByID(ctx context.Context, id int64) (model.Label, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.Label, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.Label); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Label)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLabelUsecase_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockLabelUsecase_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockLabelUsecase_Expecter) GetByID(ctx interface{}, id interface{}) *MockLabelUsecase_GetByID_Call {
+	return &MockLabelUsecase_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockLabelUsecase_GetByID_Call) Run(run func(ctx context.Context, id int64)) *MockLabelUsecase_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockLabelUsecase_GetByID_Call) Return(_a0 model.Label, _a1 error) *MockLabelUsecase_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLabelUsecase_GetByID_Call) RunAndReturn(run func(context.Context, int64) (model.Label, error)) *MockLabelUsecase_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUserID provides a mock function with given fields: ctx, userId
+func (_m *MockLabelUsecase) GetByUserID(ctx context.Context, userId int64) ([]model.Label, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
+	}
+
+	var r0 []model.Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.Label, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.Label); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Label)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLabelUsecase_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
+type MockLabelUsecase_GetByUserID_Call struct {
+	*mock.Call
+}
+
+// GetByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+func (_e *MockLabelUsecase_Expecter) GetByUserID(ctx interface{}, userId interface{}) *MockLabelUsecase_GetByUserID_Call {
+	return &MockLabelUsecase_GetByUserID_Call{Call: _e.mock.On("GetByUserID", ctx, userId)}
+}
+
+func (_c *MockLabelUsecase_GetByUserID_Call) Run(run func(ctx context.Context, userId int64)) *MockLabelUsecase_GetByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockLabelUsecase_GetByUserID_Call) Return(_a0 []model.Label, _a1 error) *MockLabelUsecase_GetByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLabelUsecase_GetByUserID_Call) RunAndReturn(run func(context.Context, int64) ([]model.Label, error)) *MockLabelUsecase_GetByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, _a1
+func (_m *MockLabelUsecase) Update(ctx context.Context, _a1 model.Label) (model.Label, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.Label
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Label) (model.Label, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Label) model.Label); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Label)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Label) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLabelUsecase_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockLabelUsecase_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Label
+func (_e *MockLabelUsecase_Expecter) Update(ctx interface{}, _a1 interface{}) *MockLabelUsecase_Update_Call {
+	return &MockLabelUsecase_Update_Call{Call: _e.mock.On("Update", ctx, _a1)}
+}
+
+func (_c *MockLabelUsecase_Update_Call) Run(run func(ctx context.Context, _a1 model.Label)) *MockLabelUsecase_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Label))
+	})
+	return _c
+}
+
+func (_c *MockLabelUsecase_Update_Call) Return(_a0 model.Label, _a1 error) *MockLabelUsecase_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLabelUsecase_Update_Call) RunAndReturn(run func(context.Context, model.Label) (model.Label, error)) *MockLabelUsecase_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLabelUsecase creates a new instance of MockLabelUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLabelUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLabelUsecase {
+	mock := &MockLabelUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
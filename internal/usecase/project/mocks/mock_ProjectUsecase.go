@@ -0,0 +1,431 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/rzfhlv/go-task/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	param "github.com/rzfhlv/go-task/pkg/param"
+)
+
+// MockProjectUsecase is an autogenerated mock type for the ProjectUsecase type
+type MockProjectUsecase struct {
+	mock.Mock
+}
+
+type MockProjectUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProjectUsecase) EXPECT() *MockProjectUsecase_Expecter {
+	return &MockProjectUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Archive provides a mock function with given fields: ctx, id
+func (_m *MockProjectUsecase) Archive(ctx context.Context, id int64) (model.Project, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Archive")
+	}
+
+	var r0 model.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.Project, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.Project); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectUsecase_Archive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Archive'
+type MockProjectUsecase_Archive_Call struct {
+	*mock.Call
+}
+
+// Archive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockProjectUsecase_Expecter) Archive(ctx interface{}, id interface{}) *MockProjectUsecase_Archive_Call {
+	return &MockProjectUsecase_Archive_Call{Call: _e.mock.On("Archive", ctx, id)}
+}
+
+func (_c *MockProjectUsecase_Archive_Call) Run(run func(ctx context.Context, id int64)) *MockProjectUsecase_Archive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockProjectUsecase_Archive_Call) Return(_a0 model.Project, _a1 error) *MockProjectUsecase_Archive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectUsecase_Archive_Call) RunAndReturn(run func(context.Context, int64) (model.Project, error)) *MockProjectUsecase_Archive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *MockProjectUsecase) Create(ctx context.Context, _a1 model.Project) (model.Project, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Project) (model.Project, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Project) model.Project); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Project) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectUsecase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockProjectUsecase_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Project
+func (_e *MockProjectUsecase_Expecter) Create(ctx interface{}, _a1 interface{}) *MockProjectUsecase_Create_Call {
+	return &MockProjectUsecase_Create_Call{Call: _e.mock.On("Create", ctx, _a1)}
+}
+
+func (_c *MockProjectUsecase_Create_Call) Run(run func(ctx context.Context, _a1 model.Project)) *MockProjectUsecase_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Project))
+	})
+	return _c
+}
+
+func (_c *MockProjectUsecase_Create_Call) Return(_a0 model.Project, _a1 error) *MockProjectUsecase_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectUsecase_Create_Call) RunAndReturn(run func(context.Context, model.Project) (model.Project, error)) *MockProjectUsecase_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockProjectUsecase) Delete(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProjectUsecase_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockProjectUsecase_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockProjectUsecase_Expecter) Delete(ctx interface{}, id interface{}) *MockProjectUsecase_Delete_Call {
+	return &MockProjectUsecase_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockProjectUsecase_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockProjectUsecase_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockProjectUsecase_Delete_Call) Return(_a0 error) *MockProjectUsecase_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProjectUsecase_Delete_Call) RunAndReturn(run func(context.Context, int64) error) *MockProjectUsecase_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockProjectUsecase) GetByID(ctx context.Context, id int64) (model.Project, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.Project, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.Project); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectUsecase_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockProjectUsecase_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockProjectUsecase_Expecter) GetByID(ctx interface{}, id interface{}) *MockProjectUsecase_GetByID_Call {
+	return &MockProjectUsecase_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockProjectUsecase_GetByID_Call) Run(run func(ctx context.Context, id int64)) *MockProjectUsecase_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockProjectUsecase_GetByID_Call) Return(_a0 model.Project, _a1 error) *MockProjectUsecase_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectUsecase_GetByID_Call) RunAndReturn(run func(context.Context, int64) (model.Project, error)) *MockProjectUsecase_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUserID provides a mock function with given fields: ctx, userId, _a2
+func (_m *MockProjectUsecase) GetByUserID(ctx context.Context, userId int64, _a2 *param.Param) ([]model.Project, error) {
+	ret := _m.Called(ctx, userId, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
+	}
+
+	var r0 []model.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) ([]model.Project, error)); ok {
+		return rf(ctx, userId, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) []model.Project); ok {
+		r0 = rf(ctx, userId, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *param.Param) error); ok {
+		r1 = rf(ctx, userId, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectUsecase_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
+type MockProjectUsecase_GetByUserID_Call struct {
+	*mock.Call
+}
+
+// GetByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - _a2 *param.Param
+func (_e *MockProjectUsecase_Expecter) GetByUserID(ctx interface{}, userId interface{}, _a2 interface{}) *MockProjectUsecase_GetByUserID_Call {
+	return &MockProjectUsecase_GetByUserID_Call{Call: _e.mock.On("GetByUserID", ctx, userId, _a2)}
+}
+
+func (_c *MockProjectUsecase_GetByUserID_Call) Run(run func(ctx context.Context, userId int64, _a2 *param.Param)) *MockProjectUsecase_GetByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*param.Param))
+	})
+	return _c
+}
+
+func (_c *MockProjectUsecase_GetByUserID_Call) Return(_a0 []model.Project, _a1 error) *MockProjectUsecase_GetByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectUsecase_GetByUserID_Call) RunAndReturn(run func(context.Context, int64, *param.Param) ([]model.Project, error)) *MockProjectUsecase_GetByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unarchive provides a mock function with given fields: ctx, id
+func (_m *MockProjectUsecase) Unarchive(ctx context.Context, id int64) (model.Project, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unarchive")
+	}
+
+	var r0 model.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.Project, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.Project); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectUsecase_Unarchive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unarchive'
+type MockProjectUsecase_Unarchive_Call struct {
+	*mock.Call
+}
+
+// Unarchive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockProjectUsecase_Expecter) Unarchive(ctx interface{}, id interface{}) *MockProjectUsecase_Unarchive_Call {
+	return &MockProjectUsecase_Unarchive_Call{Call: _e.mock.On("Unarchive", ctx, id)}
+}
+
+func (_c *MockProjectUsecase_Unarchive_Call) Run(run func(ctx context.Context, id int64)) *MockProjectUsecase_Unarchive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockProjectUsecase_Unarchive_Call) Return(_a0 model.Project, _a1 error) *MockProjectUsecase_Unarchive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectUsecase_Unarchive_Call) RunAndReturn(run func(context.Context, int64) (model.Project, error)) *MockProjectUsecase_Unarchive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, _a1
+func (_m *MockProjectUsecase) Update(ctx context.Context, _a1 model.Project) (model.Project, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Project) (model.Project, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Project) model.Project); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Project) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProjectUsecase_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockProjectUsecase_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Project
+func (_e *MockProjectUsecase_Expecter) Update(ctx interface{}, _a1 interface{}) *MockProjectUsecase_Update_Call {
+	return &MockProjectUsecase_Update_Call{Call: _e.mock.On("Update", ctx, _a1)}
+}
+
+func (_c *MockProjectUsecase_Update_Call) Run(run func(ctx context.Context, _a1 model.Project)) *MockProjectUsecase_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Project))
+	})
+	return _c
+}
+
+func (_c *MockProjectUsecase_Update_Call) Return(_a0 model.Project, _a1 error) *MockProjectUsecase_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProjectUsecase_Update_Call) RunAndReturn(run func(context.Context, model.Project) (model.Project, error)) *MockProjectUsecase_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProjectUsecase creates a new instance of MockProjectUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProjectUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProjectUsecase {
+	mock := &MockProjectUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,238 @@
+package project
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/repository/project"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+	"github.com/rzfhlv/go-task/pkg/param"
+)
+
+type ProjectUsecase interface {
+	Create(ctx context.Context, project model.Project) (model.Project, error)
+	GetByUserID(ctx context.Context, userId int64, param *param.Param) ([]model.Project, error)
+	GetByID(ctx context.Context, id int64) (model.Project, error)
+	Update(ctx context.Context, project model.Project) (model.Project, error)
+	Archive(ctx context.Context, id int64) (model.Project, error)
+	Unarchive(ctx context.Context, id int64) (model.Project, error)
+	Delete(ctx context.Context, id int64) error
+}
+
+type Project struct {
+	projectRepository project.ProjectRepository
+}
+
+func New(projectRepository project.ProjectRepository) ProjectUsecase {
+	return &Project{
+		projectRepository: projectRepository,
+	}
+}
+
+func (p *Project) Create(ctx context.Context, request model.Project) (model.Project, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Project] error when get user id from context")
+		return model.Project{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	request.UserID = userId
+	result, err := p.projectRepository.Create(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Project] error when call projectRepository.Create", slog.String("error", err.Error()))
+		return model.Project{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	result.TaskCounts = map[string]int64{}
+
+	return result, nil
+}
+
+func (p *Project) GetByUserID(ctx context.Context, userId int64, param *param.Param) ([]model.Project, error) {
+	result, err := p.projectRepository.GetByUserID(ctx, userId, *param)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Project] error when call projectRepository.GetByUserID", slog.String("error", err.Error()))
+		return []model.Project{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	total, err := p.projectRepository.Count(ctx, userId, *param)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Project] error when call projectRepository.Count", slog.String("error", err.Error()))
+		return []model.Project{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	param.Total = total
+
+	err = p.countTasks(ctx, result)
+	if err != nil {
+		return []model.Project{}, err
+	}
+
+	return result, nil
+}
+
+func (p *Project) GetByID(ctx context.Context, id int64) (model.Project, error) {
+	result, err := p.find(ctx, id)
+	if err != nil {
+		return model.Project{}, err
+	}
+
+	projects := []model.Project{result}
+	err = p.countTasks(ctx, projects)
+	if err != nil {
+		return model.Project{}, err
+	}
+
+	return projects[0], nil
+}
+
+func (p *Project) Update(ctx context.Context, request model.Project) (model.Project, error) {
+	check, err := p.find(ctx, request.ID)
+	if err != nil {
+		return model.Project{}, err
+	}
+
+	request.UserID = check.UserID
+	request.UpdatedAt = time.Now()
+	result, err := p.projectRepository.Update(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Project] error when call projectRepository.Update", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Project{}, errs.NewErrs(http.StatusNotFound, "project not found")
+		}
+
+		return model.Project{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	projects := []model.Project{result}
+	err = p.countTasks(ctx, projects)
+	if err != nil {
+		return model.Project{}, err
+	}
+
+	return projects[0], nil
+}
+
+func (p *Project) Archive(ctx context.Context, id int64) (model.Project, error) {
+	check, err := p.find(ctx, id)
+	if err != nil {
+		return model.Project{}, err
+	}
+
+	if check.ArchivedAt != nil {
+		slog.ErrorContext(ctx, "[Usecase.Project] error project is already archived", slog.Int64("id", id))
+		return model.Project{}, errs.NewErrs(http.StatusConflict, "project is already archived")
+	}
+
+	now := time.Now()
+	return p.archive(ctx, check, &now)
+}
+
+func (p *Project) Unarchive(ctx context.Context, id int64) (model.Project, error) {
+	check, err := p.find(ctx, id)
+	if err != nil {
+		return model.Project{}, err
+	}
+
+	if check.ArchivedAt == nil {
+		slog.ErrorContext(ctx, "[Usecase.Project] error project is not archived", slog.Int64("id", id))
+		return model.Project{}, errs.NewErrs(http.StatusConflict, "project is not archived")
+	}
+
+	return p.archive(ctx, check, nil)
+}
+
+func (p *Project) Delete(ctx context.Context, id int64) error {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Project] error when get user id from context")
+		return errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	err := p.projectRepository.Delete(ctx, id, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Project] error when call projectRepository.Delete", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return errs.NewErrs(http.StatusNotFound, "project not found")
+		}
+
+		if err == project.ErrProjectHasTasks {
+			return errs.NewErrs(http.StatusConflict, "project still has tasks")
+		}
+
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return nil
+}
+
+func (p *Project) archive(ctx context.Context, check model.Project, archivedAt *time.Time) (model.Project, error) {
+	result, err := p.projectRepository.Archive(ctx, check.ID, check.UserID, archivedAt)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Project] error when call projectRepository.Archive", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Project{}, errs.NewErrs(http.StatusNotFound, "project not found")
+		}
+
+		return model.Project{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	projects := []model.Project{result}
+	err = p.countTasks(ctx, projects)
+	if err != nil {
+		return model.Project{}, err
+	}
+
+	return projects[0], nil
+}
+
+func (p *Project) find(ctx context.Context, id int64) (model.Project, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Project] error when get user id from context")
+		return model.Project{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	result, err := p.projectRepository.GetByID(ctx, id, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Project] error when call projectRepository.GetByID", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Project{}, errs.NewErrs(http.StatusNotFound, "project not found")
+		}
+
+		return model.Project{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (p *Project) countTasks(ctx context.Context, projects []model.Project) error {
+	if len(projects) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(projects))
+	for i := range projects {
+		ids = append(ids, projects[i].ID)
+	}
+
+	counts, err := p.projectRepository.CountTasks(ctx, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Project] error when call projectRepository.CountTasks", slog.String("error", err.Error()))
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	for i := range projects {
+		projects[i].TaskCounts = counts[projects[i].ID]
+		if projects[i].TaskCounts == nil {
+			projects[i].TaskCounts = map[string]int64{}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,469 @@
+package project_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rzfhlv/go-task/internal/model"
+	repository "github.com/rzfhlv/go-task/internal/repository/project"
+	projectmocks "github.com/rzfhlv/go-task/internal/repository/project/mocks"
+	"github.com/rzfhlv/go-task/internal/usecase/project"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+	"github.com/rzfhlv/go-task/pkg/param"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	userId = int64(1)
+
+	archivedAt = time.Date(2023, time.August, 15, 12, 0, 0, 0, time.UTC)
+
+	projectModel = model.Project{
+		ID:          1,
+		Name:        "Launch",
+		Description: "q3 launch",
+		UserID:      userId,
+	}
+
+	archivedProjectModel = model.Project{
+		ID:          1,
+		Name:        "Launch",
+		Description: "q3 launch",
+		UserID:      userId,
+		ArchivedAt:  &archivedAt,
+	}
+
+	taskCounts = map[int64]map[string]int64{
+		1: {"todo": 2, "done": 1},
+	}
+)
+
+func withCounts(p model.Project, counts map[string]int64) model.Project {
+	p.TaskCounts = counts
+	return p
+}
+
+func TestProjectCreate(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockDeps   func(projectRepository *projectmocks.MockProjectRepository)
+		wantResult model.Project
+		wantErr    error
+	}{
+		{
+			name: "success",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("Create", mock.Anything, model.Project{Name: "Launch", Description: "q3 launch", UserID: userId}).Return(projectModel, nil)
+			},
+			wantResult: withCounts(projectModel, map[string]int64{}),
+			wantErr:    nil,
+		},
+		{
+			name: "error when create project",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("Create", mock.Anything, mock.Anything).Return(model.Project{}, errors.New("some error"))
+			},
+			wantResult: model.Project{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when user id is missing",
+			ctx:  context.Background(),
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: model.Project{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectRepository := projectmocks.MockProjectRepository{}
+
+			tt.mockDeps(&projectRepository)
+
+			usecase := project.New(&projectRepository)
+			result, err := usecase.Create(tt.ctx, model.Project{Name: "Launch", Description: "q3 launch"})
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestProjectGetByUserID(t *testing.T) {
+	tests := []struct {
+		name       string
+		mockDeps   func(projectRepository *projectmocks.MockProjectRepository)
+		wantResult []model.Project
+		wantTotal  int64
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("GetByUserID", mock.Anything, userId, mock.Anything).Return([]model.Project{projectModel, {ID: 2, Name: "Empty", UserID: userId}}, nil)
+				projectRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(2), nil)
+				projectRepository.On("CountTasks", mock.Anything, []int64{1, 2}).Return(taskCounts, nil)
+			},
+			wantResult: []model.Project{
+				withCounts(projectModel, taskCounts[1]),
+				{ID: 2, Name: "Empty", UserID: userId, TaskCounts: map[string]int64{}},
+			},
+			wantTotal: 2,
+			wantErr:   nil,
+		},
+		{
+			name: "success with empty result",
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("GetByUserID", mock.Anything, userId, mock.Anything).Return([]model.Project{}, nil)
+				projectRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(0), nil)
+			},
+			wantResult: []model.Project{},
+			wantTotal:  0,
+			wantErr:    nil,
+		},
+		{
+			name: "error when get projects",
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("GetByUserID", mock.Anything, userId, mock.Anything).Return([]model.Project{}, errors.New("some error"))
+			},
+			wantResult: []model.Project{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when count projects",
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("GetByUserID", mock.Anything, userId, mock.Anything).Return([]model.Project{projectModel}, nil)
+				projectRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(0), errors.New("some error"))
+			},
+			wantResult: []model.Project{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when count tasks",
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("GetByUserID", mock.Anything, userId, mock.Anything).Return([]model.Project{projectModel}, nil)
+				projectRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(1), nil)
+				projectRepository.On("CountTasks", mock.Anything, []int64{1}).Return(map[int64]map[string]int64{}, errors.New("some error"))
+			},
+			wantResult: []model.Project{},
+			wantTotal:  1,
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectRepository := projectmocks.MockProjectRepository{}
+
+			tt.mockDeps(&projectRepository)
+
+			param := param.Param{Page: 1, Limit: 10}
+			usecase := project.New(&projectRepository)
+			result, err := usecase.GetByUserID(context.Background(), userId, &param)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantTotal, param.Total)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestProjectGetByID(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockDeps   func(projectRepository *projectmocks.MockProjectRepository)
+		wantResult model.Project
+		wantErr    error
+	}{
+		{
+			name: "success",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("GetByID", mock.Anything, projectModel.ID, userId).Return(projectModel, nil)
+				projectRepository.On("CountTasks", mock.Anything, []int64{1}).Return(taskCounts, nil)
+			},
+			wantResult: withCounts(projectModel, taskCounts[1]),
+			wantErr:    nil,
+		},
+		{
+			name: "error when project is not found",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("GetByID", mock.Anything, projectModel.ID, userId).Return(model.Project{}, sql.ErrNoRows)
+			},
+			wantResult: model.Project{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "project not found"),
+		},
+		{
+			name: "error when get project",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("GetByID", mock.Anything, projectModel.ID, userId).Return(model.Project{}, errors.New("some error"))
+			},
+			wantResult: model.Project{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when user id is missing",
+			ctx:  context.Background(),
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.AssertNotCalled(t, "GetByID")
+			},
+			wantResult: model.Project{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectRepository := projectmocks.MockProjectRepository{}
+
+			tt.mockDeps(&projectRepository)
+
+			usecase := project.New(&projectRepository)
+			result, err := usecase.GetByID(tt.ctx, projectModel.ID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestProjectUpdate(t *testing.T) {
+	ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+	request := model.Project{ID: 1, Name: "Relaunch", Description: "q4 launch"}
+	updated := model.Project{ID: 1, Name: "Relaunch", Description: "q4 launch", UserID: userId}
+
+	tests := []struct {
+		name       string
+		mockDeps   func(projectRepository *projectmocks.MockProjectRepository)
+		wantResult model.Project
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("GetByID", mock.Anything, projectModel.ID, userId).Return(projectModel, nil)
+				projectRepository.On("Update", mock.Anything, mock.MatchedBy(func(p model.Project) bool {
+					return p.ID == 1 && p.Name == "Relaunch" && p.UserID == userId && !p.UpdatedAt.IsZero()
+				})).Return(updated, nil)
+				projectRepository.On("CountTasks", mock.Anything, []int64{1}).Return(map[int64]map[string]int64{}, nil)
+			},
+			wantResult: withCounts(updated, map[string]int64{}),
+			wantErr:    nil,
+		},
+		{
+			name: "error when project is not found",
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("GetByID", mock.Anything, projectModel.ID, userId).Return(model.Project{}, sql.ErrNoRows)
+			},
+			wantResult: model.Project{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "project not found"),
+		},
+		{
+			name: "error when update project",
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("GetByID", mock.Anything, projectModel.ID, userId).Return(projectModel, nil)
+				projectRepository.On("Update", mock.Anything, mock.Anything).Return(model.Project{}, errors.New("some error"))
+			},
+			wantResult: model.Project{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectRepository := projectmocks.MockProjectRepository{}
+
+			tt.mockDeps(&projectRepository)
+
+			usecase := project.New(&projectRepository)
+			result, err := usecase.Update(ctx, request)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestProjectArchive(t *testing.T) {
+	ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+	tests := []struct {
+		name       string
+		mockDeps   func(projectRepository *projectmocks.MockProjectRepository)
+		wantResult model.Project
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("GetByID", mock.Anything, projectModel.ID, userId).Return(projectModel, nil)
+				projectRepository.On("Archive", mock.Anything, projectModel.ID, userId, mock.AnythingOfType("*time.Time")).Return(archivedProjectModel, nil)
+				projectRepository.On("CountTasks", mock.Anything, []int64{1}).Return(taskCounts, nil)
+			},
+			wantResult: withCounts(archivedProjectModel, taskCounts[1]),
+			wantErr:    nil,
+		},
+		{
+			name: "error when project is already archived",
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("GetByID", mock.Anything, projectModel.ID, userId).Return(archivedProjectModel, nil)
+			},
+			wantResult: model.Project{},
+			wantErr:    errs.NewErrs(http.StatusConflict, "project is already archived"),
+		},
+		{
+			name: "error when archive project",
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("GetByID", mock.Anything, projectModel.ID, userId).Return(projectModel, nil)
+				projectRepository.On("Archive", mock.Anything, projectModel.ID, userId, mock.Anything).Return(model.Project{}, errors.New("some error"))
+			},
+			wantResult: model.Project{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectRepository := projectmocks.MockProjectRepository{}
+
+			tt.mockDeps(&projectRepository)
+
+			usecase := project.New(&projectRepository)
+			result, err := usecase.Archive(ctx, projectModel.ID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestProjectUnarchive(t *testing.T) {
+	ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+	tests := []struct {
+		name       string
+		mockDeps   func(projectRepository *projectmocks.MockProjectRepository)
+		wantResult model.Project
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("GetByID", mock.Anything, projectModel.ID, userId).Return(archivedProjectModel, nil)
+				projectRepository.On("Archive", mock.Anything, projectModel.ID, userId, (*time.Time)(nil)).Return(projectModel, nil)
+				projectRepository.On("CountTasks", mock.Anything, []int64{1}).Return(taskCounts, nil)
+			},
+			wantResult: withCounts(projectModel, taskCounts[1]),
+			wantErr:    nil,
+		},
+		{
+			name: "error when project is not archived",
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("GetByID", mock.Anything, projectModel.ID, userId).Return(projectModel, nil)
+			},
+			wantResult: model.Project{},
+			wantErr:    errs.NewErrs(http.StatusConflict, "project is not archived"),
+		},
+		{
+			name: "error when project is not found",
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("GetByID", mock.Anything, projectModel.ID, userId).Return(archivedProjectModel, nil)
+				projectRepository.On("Archive", mock.Anything, projectModel.ID, userId, mock.Anything).Return(model.Project{}, sql.ErrNoRows)
+			},
+			wantResult: model.Project{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "project not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectRepository := projectmocks.MockProjectRepository{}
+
+			tt.mockDeps(&projectRepository)
+
+			usecase := project.New(&projectRepository)
+			result, err := usecase.Unarchive(ctx, projectModel.ID)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestProjectDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		mockDeps func(projectRepository *projectmocks.MockProjectRepository)
+		wantErr  error
+	}{
+		{
+			name: "success",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("Delete", mock.Anything, projectModel.ID, userId).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when project is not found",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("Delete", mock.Anything, projectModel.ID, userId).Return(sql.ErrNoRows)
+			},
+			wantErr: errs.NewErrs(http.StatusNotFound, "project not found"),
+		},
+		{
+			name: "error when project still has tasks",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("Delete", mock.Anything, projectModel.ID, userId).Return(repository.ErrProjectHasTasks)
+			},
+			wantErr: errs.NewErrs(http.StatusConflict, "project still has tasks"),
+		},
+		{
+			name: "error when delete project",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.On("Delete", mock.Anything, projectModel.ID, userId).Return(errors.New("some error"))
+			},
+			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when user id is missing",
+			ctx:  context.Background(),
+			mockDeps: func(projectRepository *projectmocks.MockProjectRepository) {
+				projectRepository.AssertNotCalled(t, "Delete")
+			},
+			wantErr: errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectRepository := projectmocks.MockProjectRepository{}
+
+			tt.mockDeps(&projectRepository)
+
+			usecase := project.New(&projectRepository)
+			err := usecase.Delete(tt.ctx, projectModel.ID)
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,258 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/rzfhlv/go-task/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockReminderUsecase is an autogenerated mock type for the ReminderUsecase type
+type MockReminderUsecase struct {
+	mock.Mock
+}
+
+type MockReminderUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReminderUsecase) EXPECT() *MockReminderUsecase_Expecter {
+	return &MockReminderUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, taskId, _a2
+func (_m *MockReminderUsecase) Create(ctx context.Context, taskId int64, _a2 model.Reminder) (model.Reminder, error) {
+	ret := _m.Called(ctx, taskId, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Reminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.Reminder) (model.Reminder, error)); ok {
+		return rf(ctx, taskId, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.Reminder) model.Reminder); ok {
+		r0 = rf(ctx, taskId, _a2)
+	} else {
+		r0 = ret.Get(0).(model.Reminder)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.Reminder) error); ok {
+		r1 = rf(ctx, taskId, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReminderUsecase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockReminderUsecase_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+//   - _a2 model.Reminder
+func (_e *MockReminderUsecase_Expecter) Create(ctx interface{}, taskId interface{}, _a2 interface{}) *MockReminderUsecase_Create_Call {
+	return &MockReminderUsecase_Create_Call{Call: _e.mock.On("Create", ctx, taskId, _a2)}
+}
+
+func (_c *MockReminderUsecase_Create_Call) Run(run func(ctx context.Context, taskId int64, _a2 model.Reminder)) *MockReminderUsecase_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.Reminder))
+	})
+	return _c
+}
+
+func (_c *MockReminderUsecase_Create_Call) Return(_a0 model.Reminder, _a1 error) *MockReminderUsecase_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReminderUsecase_Create_Call) RunAndReturn(run func(context.Context, int64, model.Reminder) (model.Reminder, error)) *MockReminderUsecase_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, taskId, id
+func (_m *MockReminderUsecase) Delete(ctx context.Context, taskId int64, id int64) error {
+	ret := _m.Called(ctx, taskId, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, taskId, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReminderUsecase_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockReminderUsecase_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+//   - id int64
+func (_e *MockReminderUsecase_Expecter) Delete(ctx interface{}, taskId interface{}, id interface{}) *MockReminderUsecase_Delete_Call {
+	return &MockReminderUsecase_Delete_Call{Call: _e.mock.On("Delete", ctx, taskId, id)}
+}
+
+func (_c *MockReminderUsecase_Delete_Call) Run(run func(ctx context.Context, taskId int64, id int64)) *MockReminderUsecase_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockReminderUsecase_Delete_Call) Return(_a0 error) *MockReminderUsecase_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReminderUsecase_Delete_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockReminderUsecase_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Dispatch provides a mock function with given fields: ctx
+func (_m *MockReminderUsecase) Dispatch(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dispatch")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReminderUsecase_Dispatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dispatch'
+type MockReminderUsecase_Dispatch_Call struct {
+	*mock.Call
+}
+
+// Dispatch is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockReminderUsecase_Expecter) Dispatch(ctx interface{}) *MockReminderUsecase_Dispatch_Call {
+	return &MockReminderUsecase_Dispatch_Call{Call: _e.mock.On("Dispatch", ctx)}
+}
+
+func (_c *MockReminderUsecase_Dispatch_Call) Run(run func(ctx context.Context)) *MockReminderUsecase_Dispatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockReminderUsecase_Dispatch_Call) Return(_a0 int, _a1 error) *MockReminderUsecase_Dispatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReminderUsecase_Dispatch_Call) RunAndReturn(run func(context.Context) (int, error)) *MockReminderUsecase_Dispatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTaskID provides a mock function with given fields: ctx, taskId
+func (_m *MockReminderUsecase) GetByTaskID(ctx context.Context, taskId int64) ([]model.Reminder, error) {
+	ret := _m.Called(ctx, taskId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTaskID")
+	}
+
+	var r0 []model.Reminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.Reminder, error)); ok {
+		return rf(ctx, taskId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.Reminder); ok {
+		r0 = rf(ctx, taskId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Reminder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, taskId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReminderUsecase_GetByTaskID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTaskID'
+type MockReminderUsecase_GetByTaskID_Call struct {
+	*mock.Call
+}
+
+// GetByTaskID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+func (_e *MockReminderUsecase_Expecter) GetByTaskID(ctx interface{}, taskId interface{}) *MockReminderUsecase_GetByTaskID_Call {
+	return &MockReminderUsecase_GetByTaskID_Call{Call: _e.mock.On("GetByTaskID", ctx, taskId)}
+}
+
+func (_c *MockReminderUsecase_GetByTaskID_Call) Run(run func(ctx context.Context, taskId int64)) *MockReminderUsecase_GetByTaskID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockReminderUsecase_GetByTaskID_Call) Return(_a0 []model.Reminder, _a1 error) *MockReminderUsecase_GetByTaskID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReminderUsecase_GetByTaskID_Call) RunAndReturn(run func(context.Context, int64) ([]model.Reminder, error)) *MockReminderUsecase_GetByTaskID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReminderUsecase creates a new instance of MockReminderUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReminderUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReminderUsecase {
+	mock := &MockReminderUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
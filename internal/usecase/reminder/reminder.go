@@ -0,0 +1,178 @@
+package reminder
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/rzfhlv/go-task/config"
+	"github.com/rzfhlv/go-task/internal/infrastructure/notifier"
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/repository/reminder"
+	"github.com/rzfhlv/go-task/internal/usecase/task"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+)
+
+type ReminderUsecase interface {
+	Create(ctx context.Context, taskId int64, reminder model.Reminder) (model.Reminder, error)
+	GetByTaskID(ctx context.Context, taskId int64) ([]model.Reminder, error)
+	Delete(ctx context.Context, taskId, id int64) error
+	Dispatch(ctx context.Context) (int, error)
+}
+
+type Reminder struct {
+	reminderRepository reminder.ReminderRepository
+	taskUsecase        task.TaskUsecase
+	notifier           notifier.Notifier
+	batchSize          int
+	lease              time.Duration
+	maxAttempts        int
+}
+
+func New(reminderRepository reminder.ReminderRepository, taskUsecase task.TaskUsecase, notifier notifier.Notifier, cfg *config.Configuration) ReminderUsecase {
+	batchSize := cfg.Reminder.BatchSize
+	if batchSize <= 0 {
+		batchSize = model.ReminderBatchSize
+	}
+
+	lease := cfg.Reminder.Lease
+	if lease <= 0 {
+		lease = model.ReminderLease
+	}
+
+	maxAttempts := cfg.Reminder.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = model.ReminderMaxAttempts
+	}
+
+	return &Reminder{
+		reminderRepository: reminderRepository,
+		taskUsecase:        taskUsecase,
+		notifier:           notifier,
+		batchSize:          batchSize,
+		lease:              lease,
+		maxAttempts:        maxAttempts,
+	}
+}
+
+func (r *Reminder) Create(ctx context.Context, taskId int64, request model.Reminder) (model.Reminder, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Reminder] error when get user id from context")
+		return model.Reminder{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	task, err := r.taskUsecase.GetByID(ctx, taskId)
+	if err != nil {
+		return model.Reminder{}, err
+	}
+
+	if request.OffsetMinutes != nil && task.DueAt == nil {
+		return model.Reminder{}, errs.NewErrs(http.StatusBadRequest, "due_at is required for offset reminder")
+	}
+
+	if request.RemindAt != nil && !request.RemindAt.After(time.Now()) {
+		return model.Reminder{}, errs.NewErrs(http.StatusBadRequest, "remind_at must be in the future")
+	}
+
+	request.TaskID = taskId
+	request.UserID = userId
+	result, err := r.reminderRepository.Create(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Reminder] error when call reminderRepository.Create", slog.String("error", err.Error()))
+		return model.Reminder{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (r *Reminder) GetByTaskID(ctx context.Context, taskId int64) ([]model.Reminder, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Reminder] error when get user id from context")
+		return []model.Reminder{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	_, err := r.taskUsecase.GetByID(ctx, taskId)
+	if err != nil {
+		return []model.Reminder{}, err
+	}
+
+	result, err := r.reminderRepository.GetByTaskID(ctx, taskId, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Reminder] error when call reminderRepository.GetByTaskID", slog.String("error", err.Error()))
+		return []model.Reminder{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (r *Reminder) Delete(ctx context.Context, taskId, id int64) error {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Reminder] error when get user id from context")
+		return errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	_, err := r.taskUsecase.GetByID(ctx, taskId)
+	if err != nil {
+		return err
+	}
+
+	err = r.reminderRepository.Delete(ctx, id, taskId, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Reminder] error when call reminderRepository.Delete", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return errs.NewErrs(http.StatusNotFound, "reminder not found")
+		}
+
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return nil
+}
+
+func (r *Reminder) Dispatch(ctx context.Context) (int, error) {
+	due, err := r.reminderRepository.Claim(ctx, time.Now(), r.lease, r.maxAttempts, r.batchSize)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Reminder] error when call reminderRepository.Claim", slog.String("error", err.Error()))
+		return 0, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	sent := 0
+	for _, reminder := range due {
+		err = r.notifier.Notify(ctx, notifier.Message{
+			Event:          notifier.EventTaskReminder,
+			ID:             reminder.ID,
+			IdempotencyKey: fmt.Sprintf("%s:%d", notifier.EventTaskReminder, reminder.ID),
+			UserID:         reminder.UserID,
+			TaskID:         reminder.TaskID,
+			Title:          reminder.Title,
+			DueAt:          reminder.DueAt,
+			FireAt:         reminder.FireAt,
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "[Usecase.Reminder] error when call notifier.Notify", slog.Int64("id", reminder.ID), slog.Int("attempts", reminder.Attempts), slog.String("error", err.Error()))
+			continue
+		}
+
+		err = r.reminderRepository.MarkSent(ctx, reminder.ID, reminder.LockedUntil, time.Now())
+		if err == sql.ErrNoRows {
+			slog.WarnContext(ctx, "[Usecase.Reminder] reminder lease lost before mark sent", slog.Int64("id", reminder.ID))
+			continue
+		}
+
+		if err != nil {
+			slog.ErrorContext(ctx, "[Usecase.Reminder] error when call reminderRepository.MarkSent", slog.Int64("id", reminder.ID), slog.String("error", err.Error()))
+			continue
+		}
+
+		sent++
+	}
+
+	return sent, nil
+}
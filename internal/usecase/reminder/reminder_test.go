@@ -0,0 +1,386 @@
+package reminder_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rzfhlv/go-task/config"
+	"github.com/rzfhlv/go-task/internal/infrastructure/notifier"
+	notifiermocks "github.com/rzfhlv/go-task/internal/infrastructure/notifier/mocks"
+	"github.com/rzfhlv/go-task/internal/model"
+	remindermocks "github.com/rzfhlv/go-task/internal/repository/reminder/mocks"
+	"github.com/rzfhlv/go-task/internal/usecase/reminder"
+	taskmocks "github.com/rzfhlv/go-task/internal/usecase/task/mocks"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	userId   = int64(1)
+	taskId   = int64(2)
+	remindAt = time.Now().Add(time.Hour)
+	dueAt    = time.Now().Add(24 * time.Hour)
+	offset   = 30
+
+	cfg = &config.Configuration{}
+
+	reminderModel = model.Reminder{
+		ID:       1,
+		TaskID:   taskId,
+		UserID:   userId,
+		RemindAt: &remindAt,
+		FireAt:   &remindAt,
+	}
+)
+
+func TestReminderCreate(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		request    model.Reminder
+		mockDeps   func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase)
+		wantResult model.Reminder
+		wantErr    error
+	}{
+		{
+			name:    "success with remind at",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.Reminder{RemindAt: &remindAt},
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				reminderRepository.On("Create", mock.Anything, model.Reminder{TaskID: taskId, UserID: userId, RemindAt: &remindAt}).Return(reminderModel, nil)
+			},
+			wantResult: reminderModel,
+			wantErr:    nil,
+		},
+		{
+			name:    "success with offset",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.Reminder{OffsetMinutes: &offset},
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId, DueAt: &dueAt}, nil)
+				reminderRepository.On("Create", mock.Anything, model.Reminder{TaskID: taskId, UserID: userId, OffsetMinutes: &offset}).Return(reminderModel, nil)
+			},
+			wantResult: reminderModel,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when offset is used without due date",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.Reminder{OffsetMinutes: &offset},
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				reminderRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: model.Reminder{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "due_at is required for offset reminder"),
+		},
+		{
+			name:    "error when remind at is in the past",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.Reminder{RemindAt: &past},
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				reminderRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: model.Reminder{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "remind_at must be in the future"),
+		},
+		{
+			name:    "error when task is not accessible",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.Reminder{RemindAt: &remindAt},
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+				reminderRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: model.Reminder{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name:    "error when create reminder",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.Reminder{RemindAt: &remindAt},
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				reminderRepository.On("Create", mock.Anything, mock.Anything).Return(model.Reminder{}, errors.New("some error"))
+			},
+			wantResult: model.Reminder{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:    "error when user id is missing",
+			ctx:     context.Background(),
+			request: model.Reminder{RemindAt: &remindAt},
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetByID")
+				reminderRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: model.Reminder{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reminderRepository := remindermocks.MockReminderRepository{}
+			taskUsecase := taskmocks.MockTaskUsecase{}
+			notifierClient := notifiermocks.MockNotifier{}
+
+			tt.mockDeps(&reminderRepository, &taskUsecase)
+
+			usecase := reminder.New(&reminderRepository, &taskUsecase, &notifierClient, cfg)
+			result, err := usecase.Create(tt.ctx, taskId, tt.request)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestReminderGetByTaskID(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockDeps   func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase)
+		wantResult []model.Reminder
+		wantErr    error
+	}{
+		{
+			name: "success",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				reminderRepository.On("GetByTaskID", mock.Anything, taskId, userId).Return([]model.Reminder{reminderModel}, nil)
+			},
+			wantResult: []model.Reminder{reminderModel},
+			wantErr:    nil,
+		},
+		{
+			name: "error when task is not accessible",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+				reminderRepository.AssertNotCalled(t, "GetByTaskID")
+			},
+			wantResult: []model.Reminder{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name: "error when get reminders",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				reminderRepository.On("GetByTaskID", mock.Anything, taskId, userId).Return([]model.Reminder{}, errors.New("some error"))
+			},
+			wantResult: []model.Reminder{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when user id is missing",
+			ctx:  context.Background(),
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetByID")
+				reminderRepository.AssertNotCalled(t, "GetByTaskID")
+			},
+			wantResult: []model.Reminder{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reminderRepository := remindermocks.MockReminderRepository{}
+			taskUsecase := taskmocks.MockTaskUsecase{}
+			notifierClient := notifiermocks.MockNotifier{}
+
+			tt.mockDeps(&reminderRepository, &taskUsecase)
+
+			usecase := reminder.New(&reminderRepository, &taskUsecase, &notifierClient, cfg)
+			result, err := usecase.GetByTaskID(tt.ctx, taskId)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestReminderDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		mockDeps func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase)
+		wantErr  error
+	}{
+		{
+			name: "success",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				reminderRepository.On("Delete", mock.Anything, reminderModel.ID, taskId, userId).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when reminder is not found",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				reminderRepository.On("Delete", mock.Anything, reminderModel.ID, taskId, userId).Return(sql.ErrNoRows)
+			},
+			wantErr: errs.NewErrs(http.StatusNotFound, "reminder not found"),
+		},
+		{
+			name: "error when delete reminder",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				reminderRepository.On("Delete", mock.Anything, reminderModel.ID, taskId, userId).Return(errors.New("some error"))
+			},
+			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when task is not accessible",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+				reminderRepository.AssertNotCalled(t, "Delete")
+			},
+			wantErr: errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name: "error when user id is missing",
+			ctx:  context.Background(),
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetByID")
+				reminderRepository.AssertNotCalled(t, "Delete")
+			},
+			wantErr: errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reminderRepository := remindermocks.MockReminderRepository{}
+			taskUsecase := taskmocks.MockTaskUsecase{}
+			notifierClient := notifiermocks.MockNotifier{}
+
+			tt.mockDeps(&reminderRepository, &taskUsecase)
+
+			usecase := reminder.New(&reminderRepository, &taskUsecase, &notifierClient, cfg)
+			err := usecase.Delete(tt.ctx, taskId, reminderModel.ID)
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestReminderDispatch(t *testing.T) {
+	lockedUntil := time.Now().Add(model.ReminderLease)
+	first := model.DueReminder{ID: 1, TaskID: taskId, UserID: userId, Title: "write report", DueAt: &dueAt, FireAt: remindAt, Attempts: 1, LockedUntil: lockedUntil}
+	second := model.DueReminder{ID: 2, TaskID: taskId, UserID: userId, Title: "write report", DueAt: &dueAt, FireAt: remindAt, Attempts: 3, LockedUntil: lockedUntil}
+
+	tests := []struct {
+		name       string
+		mockDeps   func(reminderRepository *remindermocks.MockReminderRepository, notifierClient *notifiermocks.MockNotifier)
+		wantResult int
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, notifierClient *notifiermocks.MockNotifier) {
+				reminderRepository.On("Claim", mock.Anything, mock.Anything, model.ReminderLease, model.ReminderMaxAttempts, model.ReminderBatchSize).Return([]model.DueReminder{first, second}, nil)
+				notifierClient.On("Notify", mock.Anything, notifier.Message{
+					Event:          notifier.EventTaskReminder,
+					ID:             first.ID,
+					IdempotencyKey: "task.reminder:1",
+					UserID:         userId,
+					TaskID:         taskId,
+					Title:          first.Title,
+					DueAt:          &dueAt,
+					FireAt:         remindAt,
+				}).Return(nil)
+				notifierClient.On("Notify", mock.Anything, mock.MatchedBy(func(message notifier.Message) bool { return message.ID == second.ID })).Return(nil)
+				reminderRepository.On("MarkSent", mock.Anything, first.ID, lockedUntil, mock.Anything).Return(nil)
+				reminderRepository.On("MarkSent", mock.Anything, second.ID, lockedUntil, mock.Anything).Return(nil)
+			},
+			wantResult: 2,
+			wantErr:    nil,
+		},
+		{
+			name: "success when nothing is due",
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, notifierClient *notifiermocks.MockNotifier) {
+				reminderRepository.On("Claim", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]model.DueReminder{}, nil)
+				notifierClient.AssertNotCalled(t, "Notify")
+			},
+			wantResult: 0,
+			wantErr:    nil,
+		},
+		{
+			name: "success when notify fails keeps the reminder for retry",
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, notifierClient *notifiermocks.MockNotifier) {
+				reminderRepository.On("Claim", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]model.DueReminder{first, second}, nil)
+				notifierClient.On("Notify", mock.Anything, mock.MatchedBy(func(message notifier.Message) bool { return message.ID == first.ID })).Return(errors.New("some error"))
+				notifierClient.On("Notify", mock.Anything, mock.MatchedBy(func(message notifier.Message) bool { return message.ID == second.ID })).Return(nil)
+				reminderRepository.On("MarkSent", mock.Anything, second.ID, lockedUntil, mock.Anything).Return(nil)
+			},
+			wantResult: 1,
+			wantErr:    nil,
+		},
+		{
+			name: "success when mark sent fails",
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, notifierClient *notifiermocks.MockNotifier) {
+				reminderRepository.On("Claim", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]model.DueReminder{first}, nil)
+				notifierClient.On("Notify", mock.Anything, mock.Anything).Return(nil)
+				reminderRepository.On("MarkSent", mock.Anything, first.ID, lockedUntil, mock.Anything).Return(errors.New("some error"))
+			},
+			wantResult: 0,
+			wantErr:    nil,
+		},
+		{
+			name: "success when lease is lost before mark sent",
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, notifierClient *notifiermocks.MockNotifier) {
+				reminderRepository.On("Claim", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]model.DueReminder{first, second}, nil)
+				notifierClient.On("Notify", mock.Anything, mock.Anything).Return(nil)
+				reminderRepository.On("MarkSent", mock.Anything, first.ID, lockedUntil, mock.Anything).Return(sql.ErrNoRows)
+				reminderRepository.On("MarkSent", mock.Anything, second.ID, lockedUntil, mock.Anything).Return(nil)
+			},
+			wantResult: 1,
+			wantErr:    nil,
+		},
+		{
+			name: "error when claim reminders",
+			mockDeps: func(reminderRepository *remindermocks.MockReminderRepository, notifierClient *notifiermocks.MockNotifier) {
+				reminderRepository.On("Claim", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]model.DueReminder{}, errors.New("some error"))
+				notifierClient.AssertNotCalled(t, "Notify")
+			},
+			wantResult: 0,
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reminderRepository := remindermocks.MockReminderRepository{}
+			taskUsecase := taskmocks.MockTaskUsecase{}
+			notifierClient := notifiermocks.MockNotifier{}
+
+			tt.mockDeps(&reminderRepository, &notifierClient)
+
+			usecase := reminder.New(&reminderRepository, &taskUsecase, &notifierClient, cfg)
+			result, err := usecase.Dispatch(context.Background())
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+			reminderRepository.AssertExpectations(t)
+		})
+	}
+}
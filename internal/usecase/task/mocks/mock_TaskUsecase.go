@@ -5,9 +5,11 @@ package mocks
 import (
 	context "context"
 
-	model "github.com/rzfhlv/go-task/internal/model"
+	etag "github.com/rzfhlv/go-task/pkg/etag"
 	mock "github.com/stretchr/testify/mock"
 
+	model "github.com/rzfhlv/go-task/internal/model"
+
 	param "github.com/rzfhlv/go-task/pkg/param"
 )
 
@@ -24,27 +26,1300 @@ func (_m *MockTaskUsecase) EXPECT() *MockTaskUsecase_Expecter {
 	return &MockTaskUsecase_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: ctx, _a1
-func (_m *MockTaskUsecase) Create(ctx context.Context, _a1 model.Task) (model.Task, error) {
-	ret := _m.Called(ctx, _a1)
+// AddChecklistItem provides a mock function with given fields: ctx, id, request
+func (_m *MockTaskUsecase) AddChecklistItem(ctx context.Context, id int64, request model.TaskChecklistRequest) (model.TaskChecklistItem, error) {
+	ret := _m.Called(ctx, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddChecklistItem")
+	}
+
+	var r0 model.TaskChecklistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TaskChecklistRequest) (model.TaskChecklistItem, error)); ok {
+		return rf(ctx, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TaskChecklistRequest) model.TaskChecklistItem); ok {
+		r0 = rf(ctx, id, request)
+	} else {
+		r0 = ret.Get(0).(model.TaskChecklistItem)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.TaskChecklistRequest) error); ok {
+		r1 = rf(ctx, id, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_AddChecklistItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddChecklistItem'
+type MockTaskUsecase_AddChecklistItem_Call struct {
+	*mock.Call
+}
+
+// AddChecklistItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - request model.TaskChecklistRequest
+func (_e *MockTaskUsecase_Expecter) AddChecklistItem(ctx interface{}, id interface{}, request interface{}) *MockTaskUsecase_AddChecklistItem_Call {
+	return &MockTaskUsecase_AddChecklistItem_Call{Call: _e.mock.On("AddChecklistItem", ctx, id, request)}
+}
+
+func (_c *MockTaskUsecase_AddChecklistItem_Call) Run(run func(ctx context.Context, id int64, request model.TaskChecklistRequest)) *MockTaskUsecase_AddChecklistItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.TaskChecklistRequest))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_AddChecklistItem_Call) Return(_a0 model.TaskChecklistItem, _a1 error) *MockTaskUsecase_AddChecklistItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_AddChecklistItem_Call) RunAndReturn(run func(context.Context, int64, model.TaskChecklistRequest) (model.TaskChecklistItem, error)) *MockTaskUsecase_AddChecklistItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddDependency provides a mock function with given fields: ctx, id, request
+func (_m *MockTaskUsecase) AddDependency(ctx context.Context, id int64, request model.TaskDependencyRequest) (model.TaskDependency, error) {
+	ret := _m.Called(ctx, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDependency")
+	}
+
+	var r0 model.TaskDependency
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TaskDependencyRequest) (model.TaskDependency, error)); ok {
+		return rf(ctx, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TaskDependencyRequest) model.TaskDependency); ok {
+		r0 = rf(ctx, id, request)
+	} else {
+		r0 = ret.Get(0).(model.TaskDependency)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.TaskDependencyRequest) error); ok {
+		r1 = rf(ctx, id, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_AddDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDependency'
+type MockTaskUsecase_AddDependency_Call struct {
+	*mock.Call
+}
+
+// AddDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - request model.TaskDependencyRequest
+func (_e *MockTaskUsecase_Expecter) AddDependency(ctx interface{}, id interface{}, request interface{}) *MockTaskUsecase_AddDependency_Call {
+	return &MockTaskUsecase_AddDependency_Call{Call: _e.mock.On("AddDependency", ctx, id, request)}
+}
+
+func (_c *MockTaskUsecase_AddDependency_Call) Run(run func(ctx context.Context, id int64, request model.TaskDependencyRequest)) *MockTaskUsecase_AddDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.TaskDependencyRequest))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_AddDependency_Call) Return(_a0 model.TaskDependency, _a1 error) *MockTaskUsecase_AddDependency_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_AddDependency_Call) RunAndReturn(run func(context.Context, int64, model.TaskDependencyRequest) (model.TaskDependency, error)) *MockTaskUsecase_AddDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AttachLabel provides a mock function with given fields: ctx, id, request
+func (_m *MockTaskUsecase) AttachLabel(ctx context.Context, id int64, request model.TaskLabelRequest) (model.Task, error) {
+	ret := _m.Called(ctx, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachLabel")
+	}
+
+	var r0 model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TaskLabelRequest) (model.Task, error)); ok {
+		return rf(ctx, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TaskLabelRequest) model.Task); ok {
+		r0 = rf(ctx, id, request)
+	} else {
+		r0 = ret.Get(0).(model.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.TaskLabelRequest) error); ok {
+		r1 = rf(ctx, id, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_AttachLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttachLabel'
+type MockTaskUsecase_AttachLabel_Call struct {
+	*mock.Call
+}
+
+// AttachLabel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - request model.TaskLabelRequest
+func (_e *MockTaskUsecase_Expecter) AttachLabel(ctx interface{}, id interface{}, request interface{}) *MockTaskUsecase_AttachLabel_Call {
+	return &MockTaskUsecase_AttachLabel_Call{Call: _e.mock.On("AttachLabel", ctx, id, request)}
+}
+
+func (_c *MockTaskUsecase_AttachLabel_Call) Run(run func(ctx context.Context, id int64, request model.TaskLabelRequest)) *MockTaskUsecase_AttachLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.TaskLabelRequest))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_AttachLabel_Call) Return(_a0 model.Task, _a1 error) *MockTaskUsecase_AttachLabel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_AttachLabel_Call) RunAndReturn(run func(context.Context, int64, model.TaskLabelRequest) (model.Task, error)) *MockTaskUsecase_AttachLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Authorize provides a mock function with given fields: ctx, id, role
+func (_m *MockTaskUsecase) Authorize(ctx context.Context, id int64, role string) (model.Task, error) {
+	ret := _m.Called(ctx, id, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authorize")
+	}
+
+	var r0 model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (model.Task, error)); ok {
+		return rf(ctx, id, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) model.Task); ok {
+		r0 = rf(ctx, id, role)
+	} else {
+		r0 = ret.Get(0).(model.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, id, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_Authorize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authorize'
+type MockTaskUsecase_Authorize_Call struct {
+	*mock.Call
+}
+
+// Authorize is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - role string
+func (_e *MockTaskUsecase_Expecter) Authorize(ctx interface{}, id interface{}, role interface{}) *MockTaskUsecase_Authorize_Call {
+	return &MockTaskUsecase_Authorize_Call{Call: _e.mock.On("Authorize", ctx, id, role)}
+}
+
+func (_c *MockTaskUsecase_Authorize_Call) Run(run func(ctx context.Context, id int64, role string)) *MockTaskUsecase_Authorize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_Authorize_Call) Return(_a0 model.Task, _a1 error) *MockTaskUsecase_Authorize_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_Authorize_Call) RunAndReturn(run func(context.Context, int64, string) (model.Task, error)) *MockTaskUsecase_Authorize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Board provides a mock function with given fields: ctx, request
+func (_m *MockTaskUsecase) Board(ctx context.Context, request model.TaskBoardRequest) (model.TaskBoard, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Board")
+	}
+
+	var r0 model.TaskBoard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskBoardRequest) (model.TaskBoard, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskBoardRequest) model.TaskBoard); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Get(0).(model.TaskBoard)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TaskBoardRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_Board_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Board'
+type MockTaskUsecase_Board_Call struct {
+	*mock.Call
+}
+
+// Board is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request model.TaskBoardRequest
+func (_e *MockTaskUsecase_Expecter) Board(ctx interface{}, request interface{}) *MockTaskUsecase_Board_Call {
+	return &MockTaskUsecase_Board_Call{Call: _e.mock.On("Board", ctx, request)}
+}
+
+func (_c *MockTaskUsecase_Board_Call) Run(run func(ctx context.Context, request model.TaskBoardRequest)) *MockTaskUsecase_Board_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TaskBoardRequest))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_Board_Call) Return(_a0 model.TaskBoard, _a1 error) *MockTaskUsecase_Board_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_Board_Call) RunAndReturn(run func(context.Context, model.TaskBoardRequest) (model.TaskBoard, error)) *MockTaskUsecase_Board_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Bulk provides a mock function with given fields: ctx, request
+func (_m *MockTaskUsecase) Bulk(ctx context.Context, request model.TaskBulkRequest) ([]model.TaskBulkResult, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Bulk")
+	}
+
+	var r0 []model.TaskBulkResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskBulkRequest) ([]model.TaskBulkResult, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskBulkRequest) []model.TaskBulkResult); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TaskBulkResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TaskBulkRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_Bulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Bulk'
+type MockTaskUsecase_Bulk_Call struct {
+	*mock.Call
+}
+
+// Bulk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request model.TaskBulkRequest
+func (_e *MockTaskUsecase_Expecter) Bulk(ctx interface{}, request interface{}) *MockTaskUsecase_Bulk_Call {
+	return &MockTaskUsecase_Bulk_Call{Call: _e.mock.On("Bulk", ctx, request)}
+}
+
+func (_c *MockTaskUsecase_Bulk_Call) Run(run func(ctx context.Context, request model.TaskBulkRequest)) *MockTaskUsecase_Bulk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TaskBulkRequest))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_Bulk_Call) Return(_a0 []model.TaskBulkResult, _a1 error) *MockTaskUsecase_Bulk_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_Bulk_Call) RunAndReturn(run func(context.Context, model.TaskBulkRequest) ([]model.TaskBulkResult, error)) *MockTaskUsecase_Bulk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Burndown provides a mock function with given fields: ctx, request
+func (_m *MockTaskUsecase) Burndown(ctx context.Context, request model.TaskBurndownRequest) (model.TaskBurndown, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Burndown")
+	}
+
+	var r0 model.TaskBurndown
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskBurndownRequest) (model.TaskBurndown, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TaskBurndownRequest) model.TaskBurndown); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Get(0).(model.TaskBurndown)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TaskBurndownRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_Burndown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Burndown'
+type MockTaskUsecase_Burndown_Call struct {
+	*mock.Call
+}
+
+// Burndown is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request model.TaskBurndownRequest
+func (_e *MockTaskUsecase_Expecter) Burndown(ctx interface{}, request interface{}) *MockTaskUsecase_Burndown_Call {
+	return &MockTaskUsecase_Burndown_Call{Call: _e.mock.On("Burndown", ctx, request)}
+}
+
+func (_c *MockTaskUsecase_Burndown_Call) Run(run func(ctx context.Context, request model.TaskBurndownRequest)) *MockTaskUsecase_Burndown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TaskBurndownRequest))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_Burndown_Call) Return(_a0 model.TaskBurndown, _a1 error) *MockTaskUsecase_Burndown_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_Burndown_Call) RunAndReturn(run func(context.Context, model.TaskBurndownRequest) (model.TaskBurndown, error)) *MockTaskUsecase_Burndown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *MockTaskUsecase) Create(ctx context.Context, _a1 model.Task) (model.Task, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Task) (model.Task, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Task) model.Task); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Task) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockTaskUsecase_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Task
+func (_e *MockTaskUsecase_Expecter) Create(ctx interface{}, _a1 interface{}) *MockTaskUsecase_Create_Call {
+	return &MockTaskUsecase_Create_Call{Call: _e.mock.On("Create", ctx, _a1)}
+}
+
+func (_c *MockTaskUsecase_Create_Call) Run(run func(ctx context.Context, _a1 model.Task)) *MockTaskUsecase_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Task))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_Create_Call) Return(_a0 model.Task, _a1 error) *MockTaskUsecase_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_Create_Call) RunAndReturn(run func(context.Context, model.Task) (model.Task, error)) *MockTaskUsecase_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, ifMatch, permanent
+func (_m *MockTaskUsecase) Delete(ctx context.Context, id int64, ifMatch etag.Match, permanent bool) error {
+	ret := _m.Called(ctx, id, ifMatch, permanent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, etag.Match, bool) error); ok {
+		r0 = rf(ctx, id, ifMatch, permanent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskUsecase_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockTaskUsecase_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - ifMatch etag.Match
+//   - permanent bool
+func (_e *MockTaskUsecase_Expecter) Delete(ctx interface{}, id interface{}, ifMatch interface{}, permanent interface{}) *MockTaskUsecase_Delete_Call {
+	return &MockTaskUsecase_Delete_Call{Call: _e.mock.On("Delete", ctx, id, ifMatch, permanent)}
+}
+
+func (_c *MockTaskUsecase_Delete_Call) Run(run func(ctx context.Context, id int64, ifMatch etag.Match, permanent bool)) *MockTaskUsecase_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(etag.Match), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_Delete_Call) Return(_a0 error) *MockTaskUsecase_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskUsecase_Delete_Call) RunAndReturn(run func(context.Context, int64, etag.Match, bool) error) *MockTaskUsecase_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DetachLabel provides a mock function with given fields: ctx, id, labelId
+func (_m *MockTaskUsecase) DetachLabel(ctx context.Context, id int64, labelId int64) (model.Task, error) {
+	ret := _m.Called(ctx, id, labelId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachLabel")
+	}
+
+	var r0 model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Task, error)); ok {
+		return rf(ctx, id, labelId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Task); ok {
+		r0 = rf(ctx, id, labelId)
+	} else {
+		r0 = ret.Get(0).(model.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, labelId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_DetachLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetachLabel'
+type MockTaskUsecase_DetachLabel_Call struct {
+	*mock.Call
+}
+
+// DetachLabel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - labelId int64
+func (_e *MockTaskUsecase_Expecter) DetachLabel(ctx interface{}, id interface{}, labelId interface{}) *MockTaskUsecase_DetachLabel_Call {
+	return &MockTaskUsecase_DetachLabel_Call{Call: _e.mock.On("DetachLabel", ctx, id, labelId)}
+}
+
+func (_c *MockTaskUsecase_DetachLabel_Call) Run(run func(ctx context.Context, id int64, labelId int64)) *MockTaskUsecase_DetachLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_DetachLabel_Call) Return(_a0 model.Task, _a1 error) *MockTaskUsecase_DetachLabel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_DetachLabel_Call) RunAndReturn(run func(context.Context, int64, int64) (model.Task, error)) *MockTaskUsecase_DetachLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockTaskUsecase) GetByID(ctx context.Context, id int64) (model.Task, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.Task, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.Task); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockTaskUsecase_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockTaskUsecase_Expecter) GetByID(ctx interface{}, id interface{}) *MockTaskUsecase_GetByID_Call {
+	return &MockTaskUsecase_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockTaskUsecase_GetByID_Call) Run(run func(ctx context.Context, id int64)) *MockTaskUsecase_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetByID_Call) Return(_a0 model.Task, _a1 error) *MockTaskUsecase_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetByID_Call) RunAndReturn(run func(context.Context, int64) (model.Task, error)) *MockTaskUsecase_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIDWithChildren provides a mock function with given fields: ctx, id
+func (_m *MockTaskUsecase) GetByIDWithChildren(ctx context.Context, id int64) (model.Task, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDWithChildren")
+	}
+
+	var r0 model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.Task, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.Task); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_GetByIDWithChildren_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDWithChildren'
+type MockTaskUsecase_GetByIDWithChildren_Call struct {
+	*mock.Call
+}
+
+// GetByIDWithChildren is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockTaskUsecase_Expecter) GetByIDWithChildren(ctx interface{}, id interface{}) *MockTaskUsecase_GetByIDWithChildren_Call {
+	return &MockTaskUsecase_GetByIDWithChildren_Call{Call: _e.mock.On("GetByIDWithChildren", ctx, id)}
+}
+
+func (_c *MockTaskUsecase_GetByIDWithChildren_Call) Run(run func(ctx context.Context, id int64)) *MockTaskUsecase_GetByIDWithChildren_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetByIDWithChildren_Call) Return(_a0 model.Task, _a1 error) *MockTaskUsecase_GetByIDWithChildren_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetByIDWithChildren_Call) RunAndReturn(run func(context.Context, int64) (model.Task, error)) *MockTaskUsecase_GetByIDWithChildren_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByProjectID provides a mock function with given fields: ctx, projectId, _a2
+func (_m *MockTaskUsecase) GetByProjectID(ctx context.Context, projectId int64, _a2 *param.Param) ([]model.Task, error) {
+	ret := _m.Called(ctx, projectId, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByProjectID")
+	}
+
+	var r0 []model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) ([]model.Task, error)); ok {
+		return rf(ctx, projectId, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) []model.Task); ok {
+		r0 = rf(ctx, projectId, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *param.Param) error); ok {
+		r1 = rf(ctx, projectId, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_GetByProjectID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByProjectID'
+type MockTaskUsecase_GetByProjectID_Call struct {
+	*mock.Call
+}
+
+// GetByProjectID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectId int64
+//   - _a2 *param.Param
+func (_e *MockTaskUsecase_Expecter) GetByProjectID(ctx interface{}, projectId interface{}, _a2 interface{}) *MockTaskUsecase_GetByProjectID_Call {
+	return &MockTaskUsecase_GetByProjectID_Call{Call: _e.mock.On("GetByProjectID", ctx, projectId, _a2)}
+}
+
+func (_c *MockTaskUsecase_GetByProjectID_Call) Run(run func(ctx context.Context, projectId int64, _a2 *param.Param)) *MockTaskUsecase_GetByProjectID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*param.Param))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetByProjectID_Call) Return(_a0 []model.Task, _a1 error) *MockTaskUsecase_GetByProjectID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetByProjectID_Call) RunAndReturn(run func(context.Context, int64, *param.Param) ([]model.Task, error)) *MockTaskUsecase_GetByProjectID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUserID provides a mock function with given fields: ctx, userId, _a2
+func (_m *MockTaskUsecase) GetByUserID(ctx context.Context, userId int64, _a2 *param.Param) ([]model.Task, error) {
+	ret := _m.Called(ctx, userId, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
+	}
+
+	var r0 []model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) ([]model.Task, error)); ok {
+		return rf(ctx, userId, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) []model.Task); ok {
+		r0 = rf(ctx, userId, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *param.Param) error); ok {
+		r1 = rf(ctx, userId, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
+type MockTaskUsecase_GetByUserID_Call struct {
+	*mock.Call
+}
+
+// GetByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - _a2 *param.Param
+func (_e *MockTaskUsecase_Expecter) GetByUserID(ctx interface{}, userId interface{}, _a2 interface{}) *MockTaskUsecase_GetByUserID_Call {
+	return &MockTaskUsecase_GetByUserID_Call{Call: _e.mock.On("GetByUserID", ctx, userId, _a2)}
+}
+
+func (_c *MockTaskUsecase_GetByUserID_Call) Run(run func(ctx context.Context, userId int64, _a2 *param.Param)) *MockTaskUsecase_GetByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*param.Param))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetByUserID_Call) Return(_a0 []model.Task, _a1 error) *MockTaskUsecase_GetByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetByUserID_Call) RunAndReturn(run func(context.Context, int64, *param.Param) ([]model.Task, error)) *MockTaskUsecase_GetByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByWorkspaceID provides a mock function with given fields: ctx, workspaceId, _a2
+func (_m *MockTaskUsecase) GetByWorkspaceID(ctx context.Context, workspaceId int64, _a2 *param.Param) ([]model.Task, error) {
+	ret := _m.Called(ctx, workspaceId, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByWorkspaceID")
+	}
+
+	var r0 []model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) ([]model.Task, error)); ok {
+		return rf(ctx, workspaceId, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) []model.Task); ok {
+		r0 = rf(ctx, workspaceId, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *param.Param) error); ok {
+		r1 = rf(ctx, workspaceId, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_GetByWorkspaceID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByWorkspaceID'
+type MockTaskUsecase_GetByWorkspaceID_Call struct {
+	*mock.Call
+}
+
+// GetByWorkspaceID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workspaceId int64
+//   - _a2 *param.Param
+func (_e *MockTaskUsecase_Expecter) GetByWorkspaceID(ctx interface{}, workspaceId interface{}, _a2 interface{}) *MockTaskUsecase_GetByWorkspaceID_Call {
+	return &MockTaskUsecase_GetByWorkspaceID_Call{Call: _e.mock.On("GetByWorkspaceID", ctx, workspaceId, _a2)}
+}
+
+func (_c *MockTaskUsecase_GetByWorkspaceID_Call) Run(run func(ctx context.Context, workspaceId int64, _a2 *param.Param)) *MockTaskUsecase_GetByWorkspaceID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*param.Param))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetByWorkspaceID_Call) Return(_a0 []model.Task, _a1 error) *MockTaskUsecase_GetByWorkspaceID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetByWorkspaceID_Call) RunAndReturn(run func(context.Context, int64, *param.Param) ([]model.Task, error)) *MockTaskUsecase_GetByWorkspaceID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDependencies provides a mock function with given fields: ctx, id
+func (_m *MockTaskUsecase) GetDependencies(ctx context.Context, id int64) (model.TaskDependencies, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDependencies")
+	}
+
+	var r0 model.TaskDependencies
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.TaskDependencies, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.TaskDependencies); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.TaskDependencies)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_GetDependencies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDependencies'
+type MockTaskUsecase_GetDependencies_Call struct {
+	*mock.Call
+}
+
+// GetDependencies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockTaskUsecase_Expecter) GetDependencies(ctx interface{}, id interface{}) *MockTaskUsecase_GetDependencies_Call {
+	return &MockTaskUsecase_GetDependencies_Call{Call: _e.mock.On("GetDependencies", ctx, id)}
+}
+
+func (_c *MockTaskUsecase_GetDependencies_Call) Run(run func(ctx context.Context, id int64)) *MockTaskUsecase_GetDependencies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetDependencies_Call) Return(_a0 model.TaskDependencies, _a1 error) *MockTaskUsecase_GetDependencies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetDependencies_Call) RunAndReturn(run func(context.Context, int64) (model.TaskDependencies, error)) *MockTaskUsecase_GetDependencies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDependencyGraph provides a mock function with given fields: ctx, id
+func (_m *MockTaskUsecase) GetDependencyGraph(ctx context.Context, id int64) (model.TaskDependencyGraph, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDependencyGraph")
+	}
+
+	var r0 model.TaskDependencyGraph
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.TaskDependencyGraph, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.TaskDependencyGraph); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.TaskDependencyGraph)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_GetDependencyGraph_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDependencyGraph'
+type MockTaskUsecase_GetDependencyGraph_Call struct {
+	*mock.Call
+}
+
+// GetDependencyGraph is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockTaskUsecase_Expecter) GetDependencyGraph(ctx interface{}, id interface{}) *MockTaskUsecase_GetDependencyGraph_Call {
+	return &MockTaskUsecase_GetDependencyGraph_Call{Call: _e.mock.On("GetDependencyGraph", ctx, id)}
+}
+
+func (_c *MockTaskUsecase_GetDependencyGraph_Call) Run(run func(ctx context.Context, id int64)) *MockTaskUsecase_GetDependencyGraph_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetDependencyGraph_Call) Return(_a0 model.TaskDependencyGraph, _a1 error) *MockTaskUsecase_GetDependencyGraph_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetDependencyGraph_Call) RunAndReturn(run func(context.Context, int64) (model.TaskDependencyGraph, error)) *MockTaskUsecase_GetDependencyGraph_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHistory provides a mock function with given fields: ctx, id, _a2
+func (_m *MockTaskUsecase) GetHistory(ctx context.Context, id int64, _a2 *param.Param) ([]model.TaskHistory, error) {
+	ret := _m.Called(ctx, id, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistory")
+	}
+
+	var r0 []model.TaskHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) ([]model.TaskHistory, error)); ok {
+		return rf(ctx, id, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) []model.TaskHistory); ok {
+		r0 = rf(ctx, id, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TaskHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *param.Param) error); ok {
+		r1 = rf(ctx, id, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_GetHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHistory'
+type MockTaskUsecase_GetHistory_Call struct {
+	*mock.Call
+}
+
+// GetHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - _a2 *param.Param
+func (_e *MockTaskUsecase_Expecter) GetHistory(ctx interface{}, id interface{}, _a2 interface{}) *MockTaskUsecase_GetHistory_Call {
+	return &MockTaskUsecase_GetHistory_Call{Call: _e.mock.On("GetHistory", ctx, id, _a2)}
+}
+
+func (_c *MockTaskUsecase_GetHistory_Call) Run(run func(ctx context.Context, id int64, _a2 *param.Param)) *MockTaskUsecase_GetHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*param.Param))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetHistory_Call) Return(_a0 []model.TaskHistory, _a1 error) *MockTaskUsecase_GetHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetHistory_Call) RunAndReturn(run func(context.Context, int64, *param.Param) ([]model.TaskHistory, error)) *MockTaskUsecase_GetHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubtasks provides a mock function with given fields: ctx, id, _a2
+func (_m *MockTaskUsecase) GetSubtasks(ctx context.Context, id int64, _a2 *param.Param) ([]model.Task, error) {
+	ret := _m.Called(ctx, id, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubtasks")
+	}
+
+	var r0 []model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) ([]model.Task, error)); ok {
+		return rf(ctx, id, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) []model.Task); ok {
+		r0 = rf(ctx, id, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *param.Param) error); ok {
+		r1 = rf(ctx, id, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_GetSubtasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubtasks'
+type MockTaskUsecase_GetSubtasks_Call struct {
+	*mock.Call
+}
+
+// GetSubtasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - _a2 *param.Param
+func (_e *MockTaskUsecase_Expecter) GetSubtasks(ctx interface{}, id interface{}, _a2 interface{}) *MockTaskUsecase_GetSubtasks_Call {
+	return &MockTaskUsecase_GetSubtasks_Call{Call: _e.mock.On("GetSubtasks", ctx, id, _a2)}
+}
+
+func (_c *MockTaskUsecase_GetSubtasks_Call) Run(run func(ctx context.Context, id int64, _a2 *param.Param)) *MockTaskUsecase_GetSubtasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*param.Param))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetSubtasks_Call) Return(_a0 []model.Task, _a1 error) *MockTaskUsecase_GetSubtasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetSubtasks_Call) RunAndReturn(run func(context.Context, int64, *param.Param) ([]model.Task, error)) *MockTaskUsecase_GetSubtasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrashByUserID provides a mock function with given fields: ctx, userId, _a2
+func (_m *MockTaskUsecase) GetTrashByUserID(ctx context.Context, userId int64, _a2 *param.Param) ([]model.Task, error) {
+	ret := _m.Called(ctx, userId, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrashByUserID")
+	}
+
+	var r0 []model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) ([]model.Task, error)); ok {
+		return rf(ctx, userId, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) []model.Task); ok {
+		r0 = rf(ctx, userId, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *param.Param) error); ok {
+		r1 = rf(ctx, userId, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_GetTrashByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrashByUserID'
+type MockTaskUsecase_GetTrashByUserID_Call struct {
+	*mock.Call
+}
+
+// GetTrashByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+//   - _a2 *param.Param
+func (_e *MockTaskUsecase_Expecter) GetTrashByUserID(ctx interface{}, userId interface{}, _a2 interface{}) *MockTaskUsecase_GetTrashByUserID_Call {
+	return &MockTaskUsecase_GetTrashByUserID_Call{Call: _e.mock.On("GetTrashByUserID", ctx, userId, _a2)}
+}
+
+func (_c *MockTaskUsecase_GetTrashByUserID_Call) Run(run func(ctx context.Context, userId int64, _a2 *param.Param)) *MockTaskUsecase_GetTrashByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*param.Param))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetTrashByUserID_Call) Return(_a0 []model.Task, _a1 error) *MockTaskUsecase_GetTrashByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_GetTrashByUserID_Call) RunAndReturn(run func(context.Context, int64, *param.Param) ([]model.Task, error)) *MockTaskUsecase_GetTrashByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Move provides a mock function with given fields: ctx, id, request
+func (_m *MockTaskUsecase) Move(ctx context.Context, id int64, request model.TaskMove) (model.Task, error) {
+	ret := _m.Called(ctx, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Move")
+	}
+
+	var r0 model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TaskMove) (model.Task, error)); ok {
+		return rf(ctx, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TaskMove) model.Task); ok {
+		r0 = rf(ctx, id, request)
+	} else {
+		r0 = ret.Get(0).(model.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.TaskMove) error); ok {
+		r1 = rf(ctx, id, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_Move_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Move'
+type MockTaskUsecase_Move_Call struct {
+	*mock.Call
+}
+
+// Move is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - request model.TaskMove
+func (_e *MockTaskUsecase_Expecter) Move(ctx interface{}, id interface{}, request interface{}) *MockTaskUsecase_Move_Call {
+	return &MockTaskUsecase_Move_Call{Call: _e.mock.On("Move", ctx, id, request)}
+}
+
+func (_c *MockTaskUsecase_Move_Call) Run(run func(ctx context.Context, id int64, request model.TaskMove)) *MockTaskUsecase_Move_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.TaskMove))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_Move_Call) Return(_a0 model.Task, _a1 error) *MockTaskUsecase_Move_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_Move_Call) RunAndReturn(run func(context.Context, int64, model.TaskMove) (model.Task, error)) *MockTaskUsecase_Move_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Patch provides a mock function with given fields: ctx, id, patch
+func (_m *MockTaskUsecase) Patch(ctx context.Context, id int64, patch model.TaskPatch) (model.Task, error) {
+	ret := _m.Called(ctx, id, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TaskPatch) (model.Task, error)); ok {
+		return rf(ctx, id, patch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TaskPatch) model.Task); ok {
+		r0 = rf(ctx, id, patch)
+	} else {
+		r0 = ret.Get(0).(model.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.TaskPatch) error); ok {
+		r1 = rf(ctx, id, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type MockTaskUsecase_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - patch model.TaskPatch
+func (_e *MockTaskUsecase_Expecter) Patch(ctx interface{}, id interface{}, patch interface{}) *MockTaskUsecase_Patch_Call {
+	return &MockTaskUsecase_Patch_Call{Call: _e.mock.On("Patch", ctx, id, patch)}
+}
+
+func (_c *MockTaskUsecase_Patch_Call) Run(run func(ctx context.Context, id int64, patch model.TaskPatch)) *MockTaskUsecase_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.TaskPatch))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_Patch_Call) Return(_a0 model.Task, _a1 error) *MockTaskUsecase_Patch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_Patch_Call) RunAndReturn(run func(context.Context, int64, model.TaskPatch) (model.Task, error)) *MockTaskUsecase_Patch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function with given fields: ctx
+func (_m *MockTaskUsecase) Purge(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Purge")
 	}
 
-	var r0 model.Task
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.Task) (model.Task, error)); ok {
-		return rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, model.Task) model.Task); ok {
-		r0 = rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(model.Task)
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, model.Task) error); ok {
-		r1 = rf(ctx, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -52,46 +1327,45 @@ func (_m *MockTaskUsecase) Create(ctx context.Context, _a1 model.Task) (model.Ta
 	return r0, r1
 }
 
-// MockTaskUsecase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockTaskUsecase_Create_Call struct {
+// MockTaskUsecase_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockTaskUsecase_Purge_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// Purge is a helper method to define mock.On call
 //   - ctx context.Context
-//   - _a1 model.Task
-func (_e *MockTaskUsecase_Expecter) Create(ctx interface{}, _a1 interface{}) *MockTaskUsecase_Create_Call {
-	return &MockTaskUsecase_Create_Call{Call: _e.mock.On("Create", ctx, _a1)}
+func (_e *MockTaskUsecase_Expecter) Purge(ctx interface{}) *MockTaskUsecase_Purge_Call {
+	return &MockTaskUsecase_Purge_Call{Call: _e.mock.On("Purge", ctx)}
 }
 
-func (_c *MockTaskUsecase_Create_Call) Run(run func(ctx context.Context, _a1 model.Task)) *MockTaskUsecase_Create_Call {
+func (_c *MockTaskUsecase_Purge_Call) Run(run func(ctx context.Context)) *MockTaskUsecase_Purge_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.Task))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockTaskUsecase_Create_Call) Return(_a0 model.Task, _a1 error) *MockTaskUsecase_Create_Call {
+func (_c *MockTaskUsecase_Purge_Call) Return(_a0 int64, _a1 error) *MockTaskUsecase_Purge_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTaskUsecase_Create_Call) RunAndReturn(run func(context.Context, model.Task) (model.Task, error)) *MockTaskUsecase_Create_Call {
+func (_c *MockTaskUsecase_Purge_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockTaskUsecase_Purge_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function with given fields: ctx, id
-func (_m *MockTaskUsecase) Delete(ctx context.Context, id int64) error {
-	ret := _m.Called(ctx, id)
+// RemoveChecklistItem provides a mock function with given fields: ctx, id, itemId
+func (_m *MockTaskUsecase) RemoveChecklistItem(ctx context.Context, id int64, itemId int64) error {
+	ret := _m.Called(ctx, id, itemId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for RemoveChecklistItem")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, id, itemId)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -99,41 +1373,150 @@ func (_m *MockTaskUsecase) Delete(ctx context.Context, id int64) error {
 	return r0
 }
 
-// MockTaskUsecase_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockTaskUsecase_Delete_Call struct {
+// MockTaskUsecase_RemoveChecklistItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveChecklistItem'
+type MockTaskUsecase_RemoveChecklistItem_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// RemoveChecklistItem is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int64
-func (_e *MockTaskUsecase_Expecter) Delete(ctx interface{}, id interface{}) *MockTaskUsecase_Delete_Call {
-	return &MockTaskUsecase_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+//   - itemId int64
+func (_e *MockTaskUsecase_Expecter) RemoveChecklistItem(ctx interface{}, id interface{}, itemId interface{}) *MockTaskUsecase_RemoveChecklistItem_Call {
+	return &MockTaskUsecase_RemoveChecklistItem_Call{Call: _e.mock.On("RemoveChecklistItem", ctx, id, itemId)}
 }
 
-func (_c *MockTaskUsecase_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockTaskUsecase_Delete_Call {
+func (_c *MockTaskUsecase_RemoveChecklistItem_Call) Run(run func(ctx context.Context, id int64, itemId int64)) *MockTaskUsecase_RemoveChecklistItem_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
 	})
 	return _c
 }
 
-func (_c *MockTaskUsecase_Delete_Call) Return(_a0 error) *MockTaskUsecase_Delete_Call {
+func (_c *MockTaskUsecase_RemoveChecklistItem_Call) Return(_a0 error) *MockTaskUsecase_RemoveChecklistItem_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockTaskUsecase_Delete_Call) RunAndReturn(run func(context.Context, int64) error) *MockTaskUsecase_Delete_Call {
+func (_c *MockTaskUsecase_RemoveChecklistItem_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockTaskUsecase_RemoveChecklistItem_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function with given fields: ctx, id
-func (_m *MockTaskUsecase) GetByID(ctx context.Context, id int64) (model.Task, error) {
+// RemoveDependency provides a mock function with given fields: ctx, id, blockerId
+func (_m *MockTaskUsecase) RemoveDependency(ctx context.Context, id int64, blockerId int64) error {
+	ret := _m.Called(ctx, id, blockerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveDependency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, id, blockerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskUsecase_RemoveDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveDependency'
+type MockTaskUsecase_RemoveDependency_Call struct {
+	*mock.Call
+}
+
+// RemoveDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - blockerId int64
+func (_e *MockTaskUsecase_Expecter) RemoveDependency(ctx interface{}, id interface{}, blockerId interface{}) *MockTaskUsecase_RemoveDependency_Call {
+	return &MockTaskUsecase_RemoveDependency_Call{Call: _e.mock.On("RemoveDependency", ctx, id, blockerId)}
+}
+
+func (_c *MockTaskUsecase_RemoveDependency_Call) Run(run func(ctx context.Context, id int64, blockerId int64)) *MockTaskUsecase_RemoveDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_RemoveDependency_Call) Return(_a0 error) *MockTaskUsecase_RemoveDependency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskUsecase_RemoveDependency_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockTaskUsecase_RemoveDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReorderChecklist provides a mock function with given fields: ctx, id, request
+func (_m *MockTaskUsecase) ReorderChecklist(ctx context.Context, id int64, request model.TaskChecklistOrderRequest) ([]model.TaskChecklistItem, error) {
+	ret := _m.Called(ctx, id, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReorderChecklist")
+	}
+
+	var r0 []model.TaskChecklistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TaskChecklistOrderRequest) ([]model.TaskChecklistItem, error)); ok {
+		return rf(ctx, id, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TaskChecklistOrderRequest) []model.TaskChecklistItem); ok {
+		r0 = rf(ctx, id, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TaskChecklistItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.TaskChecklistOrderRequest) error); ok {
+		r1 = rf(ctx, id, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_ReorderChecklist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReorderChecklist'
+type MockTaskUsecase_ReorderChecklist_Call struct {
+	*mock.Call
+}
+
+// ReorderChecklist is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - request model.TaskChecklistOrderRequest
+func (_e *MockTaskUsecase_Expecter) ReorderChecklist(ctx interface{}, id interface{}, request interface{}) *MockTaskUsecase_ReorderChecklist_Call {
+	return &MockTaskUsecase_ReorderChecklist_Call{Call: _e.mock.On("ReorderChecklist", ctx, id, request)}
+}
+
+func (_c *MockTaskUsecase_ReorderChecklist_Call) Run(run func(ctx context.Context, id int64, request model.TaskChecklistOrderRequest)) *MockTaskUsecase_ReorderChecklist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.TaskChecklistOrderRequest))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_ReorderChecklist_Call) Return(_a0 []model.TaskChecklistItem, _a1 error) *MockTaskUsecase_ReorderChecklist_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_ReorderChecklist_Call) RunAndReturn(run func(context.Context, int64, model.TaskChecklistOrderRequest) ([]model.TaskChecklistItem, error)) *MockTaskUsecase_ReorderChecklist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, id
+func (_m *MockTaskUsecase) Restore(ctx context.Context, id int64) (model.Task, error) {
 	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for Restore")
 	}
 
 	var r0 model.Task
@@ -156,58 +1539,56 @@ func (_m *MockTaskUsecase) GetByID(ctx context.Context, id int64) (model.Task, e
 	return r0, r1
 }
 
-// MockTaskUsecase_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockTaskUsecase_GetByID_Call struct {
+// MockTaskUsecase_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockTaskUsecase_Restore_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// Restore is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int64
-func (_e *MockTaskUsecase_Expecter) GetByID(ctx interface{}, id interface{}) *MockTaskUsecase_GetByID_Call {
-	return &MockTaskUsecase_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+func (_e *MockTaskUsecase_Expecter) Restore(ctx interface{}, id interface{}) *MockTaskUsecase_Restore_Call {
+	return &MockTaskUsecase_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
 }
 
-func (_c *MockTaskUsecase_GetByID_Call) Run(run func(ctx context.Context, id int64)) *MockTaskUsecase_GetByID_Call {
+func (_c *MockTaskUsecase_Restore_Call) Run(run func(ctx context.Context, id int64)) *MockTaskUsecase_Restore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockTaskUsecase_GetByID_Call) Return(_a0 model.Task, _a1 error) *MockTaskUsecase_GetByID_Call {
+func (_c *MockTaskUsecase_Restore_Call) Return(_a0 model.Task, _a1 error) *MockTaskUsecase_Restore_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTaskUsecase_GetByID_Call) RunAndReturn(run func(context.Context, int64) (model.Task, error)) *MockTaskUsecase_GetByID_Call {
+func (_c *MockTaskUsecase_Restore_Call) RunAndReturn(run func(context.Context, int64) (model.Task, error)) *MockTaskUsecase_Restore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByUserID provides a mock function with given fields: ctx, userId, _a2
-func (_m *MockTaskUsecase) GetByUserID(ctx context.Context, userId int64, _a2 *param.Param) ([]model.Task, error) {
-	ret := _m.Called(ctx, userId, _a2)
+// Revert provides a mock function with given fields: ctx, id, revision, ifMatch, force
+func (_m *MockTaskUsecase) Revert(ctx context.Context, id int64, revision int64, ifMatch etag.Match, force bool) (model.Task, error) {
+	ret := _m.Called(ctx, id, revision, ifMatch, force)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByUserID")
+		panic("no return value specified for Revert")
 	}
 
-	var r0 []model.Task
+	var r0 model.Task
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) ([]model.Task, error)); ok {
-		return rf(ctx, userId, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, etag.Match, bool) (model.Task, error)); ok {
+		return rf(ctx, id, revision, ifMatch, force)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) []model.Task); ok {
-		r0 = rf(ctx, userId, _a2)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, etag.Match, bool) model.Task); ok {
+		r0 = rf(ctx, id, revision, ifMatch, force)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]model.Task)
-		}
+		r0 = ret.Get(0).(model.Task)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, *param.Param) error); ok {
-		r1 = rf(ctx, userId, _a2)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, etag.Match, bool) error); ok {
+		r1 = rf(ctx, id, revision, ifMatch, force)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -215,32 +1596,150 @@ func (_m *MockTaskUsecase) GetByUserID(ctx context.Context, userId int64, _a2 *p
 	return r0, r1
 }
 
-// MockTaskUsecase_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
-type MockTaskUsecase_GetByUserID_Call struct {
+// MockTaskUsecase_Revert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revert'
+type MockTaskUsecase_Revert_Call struct {
 	*mock.Call
 }
 
-// GetByUserID is a helper method to define mock.On call
+// Revert is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userId int64
-//   - _a2 *param.Param
-func (_e *MockTaskUsecase_Expecter) GetByUserID(ctx interface{}, userId interface{}, _a2 interface{}) *MockTaskUsecase_GetByUserID_Call {
-	return &MockTaskUsecase_GetByUserID_Call{Call: _e.mock.On("GetByUserID", ctx, userId, _a2)}
+//   - id int64
+//   - revision int64
+//   - ifMatch etag.Match
+//   - force bool
+func (_e *MockTaskUsecase_Expecter) Revert(ctx interface{}, id interface{}, revision interface{}, ifMatch interface{}, force interface{}) *MockTaskUsecase_Revert_Call {
+	return &MockTaskUsecase_Revert_Call{Call: _e.mock.On("Revert", ctx, id, revision, ifMatch, force)}
 }
 
-func (_c *MockTaskUsecase_GetByUserID_Call) Run(run func(ctx context.Context, userId int64, _a2 *param.Param)) *MockTaskUsecase_GetByUserID_Call {
+func (_c *MockTaskUsecase_Revert_Call) Run(run func(ctx context.Context, id int64, revision int64, ifMatch etag.Match, force bool)) *MockTaskUsecase_Revert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(*param.Param))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(etag.Match), args[4].(bool))
 	})
 	return _c
 }
 
-func (_c *MockTaskUsecase_GetByUserID_Call) Return(_a0 []model.Task, _a1 error) *MockTaskUsecase_GetByUserID_Call {
+func (_c *MockTaskUsecase_Revert_Call) Return(_a0 model.Task, _a1 error) *MockTaskUsecase_Revert_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockTaskUsecase_GetByUserID_Call) RunAndReturn(run func(context.Context, int64, *param.Param) ([]model.Task, error)) *MockTaskUsecase_GetByUserID_Call {
+func (_c *MockTaskUsecase_Revert_Call) RunAndReturn(run func(context.Context, int64, int64, etag.Match, bool) (model.Task, error)) *MockTaskUsecase_Revert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ToggleChecklistItem provides a mock function with given fields: ctx, id, itemId
+func (_m *MockTaskUsecase) ToggleChecklistItem(ctx context.Context, id int64, itemId int64) (model.TaskChecklistItem, error) {
+	ret := _m.Called(ctx, id, itemId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ToggleChecklistItem")
+	}
+
+	var r0 model.TaskChecklistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.TaskChecklistItem, error)); ok {
+		return rf(ctx, id, itemId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.TaskChecklistItem); ok {
+		r0 = rf(ctx, id, itemId)
+	} else {
+		r0 = ret.Get(0).(model.TaskChecklistItem)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, id, itemId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_ToggleChecklistItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToggleChecklistItem'
+type MockTaskUsecase_ToggleChecklistItem_Call struct {
+	*mock.Call
+}
+
+// ToggleChecklistItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - itemId int64
+func (_e *MockTaskUsecase_Expecter) ToggleChecklistItem(ctx interface{}, id interface{}, itemId interface{}) *MockTaskUsecase_ToggleChecklistItem_Call {
+	return &MockTaskUsecase_ToggleChecklistItem_Call{Call: _e.mock.On("ToggleChecklistItem", ctx, id, itemId)}
+}
+
+func (_c *MockTaskUsecase_ToggleChecklistItem_Call) Run(run func(ctx context.Context, id int64, itemId int64)) *MockTaskUsecase_ToggleChecklistItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_ToggleChecklistItem_Call) Return(_a0 model.TaskChecklistItem, _a1 error) *MockTaskUsecase_ToggleChecklistItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_ToggleChecklistItem_Call) RunAndReturn(run func(context.Context, int64, int64) (model.TaskChecklistItem, error)) *MockTaskUsecase_ToggleChecklistItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Transition provides a mock function with given fields: ctx, id, status
+func (_m *MockTaskUsecase) Transition(ctx context.Context, id int64, status string) (model.Task, error) {
+	ret := _m.Called(ctx, id, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transition")
+	}
+
+	var r0 model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (model.Task, error)); ok {
+		return rf(ctx, id, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) model.Task); ok {
+		r0 = rf(ctx, id, status)
+	} else {
+		r0 = ret.Get(0).(model.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, id, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_Transition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transition'
+type MockTaskUsecase_Transition_Call struct {
+	*mock.Call
+}
+
+// Transition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - status string
+func (_e *MockTaskUsecase_Expecter) Transition(ctx interface{}, id interface{}, status interface{}) *MockTaskUsecase_Transition_Call {
+	return &MockTaskUsecase_Transition_Call{Call: _e.mock.On("Transition", ctx, id, status)}
+}
+
+func (_c *MockTaskUsecase_Transition_Call) Run(run func(ctx context.Context, id int64, status string)) *MockTaskUsecase_Transition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_Transition_Call) Return(_a0 model.Task, _a1 error) *MockTaskUsecase_Transition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_Transition_Call) RunAndReturn(run func(context.Context, int64, string) (model.Task, error)) *MockTaskUsecase_Transition_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -302,6 +1801,63 @@ func (_c *MockTaskUsecase_Update_Call) RunAndReturn(run func(context.Context, mo
 	return _c
 }
 
+// UpdateSeries provides a mock function with given fields: ctx, _a1
+func (_m *MockTaskUsecase) UpdateSeries(ctx context.Context, _a1 model.Task) (model.Task, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSeries")
+	}
+
+	var r0 model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Task) (model.Task, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Task) model.Task); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Task)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Task) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskUsecase_UpdateSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSeries'
+type MockTaskUsecase_UpdateSeries_Call struct {
+	*mock.Call
+}
+
+// UpdateSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Task
+func (_e *MockTaskUsecase_Expecter) UpdateSeries(ctx interface{}, _a1 interface{}) *MockTaskUsecase_UpdateSeries_Call {
+	return &MockTaskUsecase_UpdateSeries_Call{Call: _e.mock.On("UpdateSeries", ctx, _a1)}
+}
+
+func (_c *MockTaskUsecase_UpdateSeries_Call) Run(run func(ctx context.Context, _a1 model.Task)) *MockTaskUsecase_UpdateSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Task))
+	})
+	return _c
+}
+
+func (_c *MockTaskUsecase_UpdateSeries_Call) Return(_a0 model.Task, _a1 error) *MockTaskUsecase_UpdateSeries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskUsecase_UpdateSeries_Call) RunAndReturn(run func(context.Context, model.Task) (model.Task, error)) *MockTaskUsecase_UpdateSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockTaskUsecase creates a new instance of MockTaskUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockTaskUsecase(t interface {
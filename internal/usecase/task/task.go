@@ -1,34 +1,101 @@
 package task
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/rzfhlv/go-task/config"
 	"github.com/rzfhlv/go-task/internal/model"
 	"github.com/rzfhlv/go-task/internal/repository/task"
+	"github.com/rzfhlv/go-task/pkg/cursor"
 	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/etag"
 	"github.com/rzfhlv/go-task/pkg/middleware/auth"
 	"github.com/rzfhlv/go-task/pkg/param"
+	"github.com/rzfhlv/go-task/pkg/rank"
+	"github.com/rzfhlv/go-task/pkg/rrule"
+	"github.com/rzfhlv/go-task/pkg/workflow"
 )
 
 type TaskUsecase interface {
 	Create(ctx context.Context, task model.Task) (model.Task, error)
 	GetByUserID(ctx context.Context, userId int64, param *param.Param) ([]model.Task, error)
 	GetByID(ctx context.Context, id int64) (model.Task, error)
+	Authorize(ctx context.Context, id int64, role string) (model.Task, error)
 	Update(ctx context.Context, task model.Task) (model.Task, error)
-	Delete(ctx context.Context, id int64) error
+	UpdateSeries(ctx context.Context, task model.Task) (model.Task, error)
+	Delete(ctx context.Context, id int64, ifMatch etag.Match, permanent bool) error
+	GetTrashByUserID(ctx context.Context, userId int64, param *param.Param) ([]model.Task, error)
+	Restore(ctx context.Context, id int64) (model.Task, error)
+	Purge(ctx context.Context) (int64, error)
+	Transition(ctx context.Context, id int64, status string) (model.Task, error)
+	Patch(ctx context.Context, id int64, patch model.TaskPatch) (model.Task, error)
+	Bulk(ctx context.Context, request model.TaskBulkRequest) ([]model.TaskBulkResult, error)
+	GetSubtasks(ctx context.Context, id int64, param *param.Param) ([]model.Task, error)
+	GetByProjectID(ctx context.Context, projectId int64, param *param.Param) ([]model.Task, error)
+	GetByWorkspaceID(ctx context.Context, workspaceId int64, param *param.Param) ([]model.Task, error)
+	GetByIDWithChildren(ctx context.Context, id int64) (model.Task, error)
+	GetDependencies(ctx context.Context, id int64) (model.TaskDependencies, error)
+	AddDependency(ctx context.Context, id int64, request model.TaskDependencyRequest) (model.TaskDependency, error)
+	RemoveDependency(ctx context.Context, id, blockerId int64) error
+	GetDependencyGraph(ctx context.Context, id int64) (model.TaskDependencyGraph, error)
+	AttachLabel(ctx context.Context, id int64, request model.TaskLabelRequest) (model.Task, error)
+	DetachLabel(ctx context.Context, id, labelId int64) (model.Task, error)
+	AddChecklistItem(ctx context.Context, id int64, request model.TaskChecklistRequest) (model.TaskChecklistItem, error)
+	ToggleChecklistItem(ctx context.Context, id, itemId int64) (model.TaskChecklistItem, error)
+	ReorderChecklist(ctx context.Context, id int64, request model.TaskChecklistOrderRequest) ([]model.TaskChecklistItem, error)
+	RemoveChecklistItem(ctx context.Context, id, itemId int64) error
+	GetHistory(ctx context.Context, id int64, param *param.Param) ([]model.TaskHistory, error)
+	Revert(ctx context.Context, id, revision int64, ifMatch etag.Match, force bool) (model.Task, error)
+	Burndown(ctx context.Context, request model.TaskBurndownRequest) (model.TaskBurndown, error)
+	Move(ctx context.Context, id int64, request model.TaskMove) (model.Task, error)
+	Board(ctx context.Context, request model.TaskBoardRequest) (model.TaskBoard, error)
 }
 
+var errBulkAborted = errors.New("bulk operation aborted")
+
 type Task struct {
 	taskRepository task.TaskRepository
+	workflow       *workflow.Workflow
+	cursorSecret   string
+	requireIfMatch bool
+	trashRetention time.Duration
+	cascade        bool
 }
 
-func New(taskRepository task.TaskRepository) TaskUsecase {
+func New(taskRepository task.TaskRepository, cfg *config.Configuration) TaskUsecase {
+	initial := cfg.Task.DefaultStatus
+	if initial == "" {
+		initial = model.TaskStatusTodo
+	}
+
+	transitions := cfg.Task.Transitions
+	if len(transitions) == 0 {
+		transitions = model.TaskTransitions
+	}
+
+	trashRetention := cfg.Task.TrashRetention
+	if trashRetention <= 0 {
+		trashRetention = model.TaskTrashRetention
+	}
+
 	return &Task{
 		taskRepository: taskRepository,
+		workflow:       workflow.New(initial, transitions),
+		cursorSecret:   cfg.App.CursorSecret,
+		requireIfMatch: cfg.Task.RequireIfMatch,
+		trashRetention: trashRetention,
+		cascade:        cfg.Task.OnParentDelete == model.TaskParentDeleteCascade,
 	}
 }
 
@@ -40,20 +107,88 @@ func (t *Task) Create(ctx context.Context, task model.Task) (model.Task, error)
 		return model.Task{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
 	}
 
-	task.UserID = userID
-	result, err := t.taskRepository.Create(ctx, task)
+	if task.Status == "" {
+		task.Status = t.workflow.Initial()
+	}
+
+	if !t.workflow.Has(task.Status) {
+		slog.ErrorContext(ctx, "[Usecase.Task] error unknown task status", slog.String("status", task.Status))
+		return model.Task{}, errs.NewErrs(http.StatusBadRequest, "invalid status")
+	}
+
+	if task.Priority == "" {
+		task.Priority = model.TaskPriorityMedium
+	}
+
+	if task.Recurrence != "" {
+		err := t.checkRecurrence(ctx, task.Recurrence, task.DueAt)
+		if err != nil {
+			return model.Task{}, err
+		}
+	}
+
+	err := t.checkWorkspace(ctx, task.WorkspaceID, userID, model.WorkspaceRoleEditor)
 	if err != nil {
-		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.Create", slog.String("error", err.Error()))
-		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+		return model.Task{}, err
 	}
 
-	return result, nil
+	err = t.checkParent(ctx, 0, task.ParentID, userID)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	err = t.checkProject(ctx, task.ProjectID, userID)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	err = t.checkAssignee(ctx, task.AssigneeID, task.WorkspaceID, userID)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	task.AssignedAt = assignedAt(task.AssigneeID)
+	task.UserID = userID
+	if task.Recurrence != "" {
+		return t.createSeries(ctx, task)
+	}
+
+	return t.create(ctx, task)
 }
 
 func (t *Task) GetByUserID(ctx context.Context, userId int64, param *param.Param) ([]model.Task, error) {
+	if param.Cursor != "" {
+		keyset, err := cursor.Decode(param.Cursor, t.cursorSecret)
+		if err != nil {
+			slog.ErrorContext(ctx, "[Usecase.Task] error when decode cursor", slog.String("error", err.Error()))
+			return []model.Task{}, errs.NewErrs(http.StatusBadRequest, "invalid cursor")
+		}
+
+		param.Sort = keyset.Sort
+		param.Keyset = &keyset
+		param.CursorMode = true
+	}
+
+	if param.CursorMode {
+		if len(param.Sorts()) > 1 {
+			return []model.Task{}, errs.NewErrs(http.StatusBadRequest, "cursor pagination supports a single sort field")
+		}
+
+		param.Page = 1
+	}
+
+	err := t.filters(ctx, userId, param)
+	if err != nil {
+		return []model.Task{}, err
+	}
+
 	result, err := t.taskRepository.GetByUserID(ctx, userId, *param)
 	if err != nil {
 		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetByUserID", slog.String("error", err.Error()))
+		if err == task.ErrInvalidSort {
+			return []model.Task{}, errs.NewErrs(http.StatusBadRequest, "invalid sort field")
+		}
+
 		return []model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
 	}
 
@@ -61,77 +196,2378 @@ func (t *Task) GetByUserID(ctx context.Context, userId int64, param *param.Param
 		result = []model.Task{}
 	}
 
-	total, err := t.taskRepository.Count(ctx)
+	total, err := t.taskRepository.Count(ctx, userId, *param)
 	if err != nil {
 		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.Count", slog.String("error", err.Error()))
 		return []model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
 	}
 
 	param.Total = total
+	result, err = t.paginate(ctx, userId, param, result)
+	if err != nil {
+		return []model.Task{}, err
+	}
+
+	err = t.enrich(ctx, result)
+	if err != nil {
+		return []model.Task{}, err
+	}
+
 	return result, nil
 }
 
 func (t *Task) GetByID(ctx context.Context, id int64) (model.Task, error) {
+	result, err := t.find(ctx, id, model.WorkspaceRoleViewer)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	tasks := []model.Task{result}
+	err = t.enrich(ctx, tasks)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	err = t.attachChecklist(ctx, &tasks[0])
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	return tasks[0], nil
+}
+
+func (t *Task) GetByIDWithChildren(ctx context.Context, id int64) (model.Task, error) {
 	userId, ok := ctx.Value(auth.IdKey).(int64)
 	if !ok {
 		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
 		return model.Task{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
 	}
 
-	result, err := t.taskRepository.GetByID(ctx, id, userId)
+	result, err := t.find(ctx, id, model.WorkspaceRoleViewer)
 	if err != nil {
-		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetByID", slog.String("error", err.Error()))
+		return model.Task{}, err
+	}
+
+	children, err := t.taskRepository.GetByParentID(ctx, id, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetByParentID", slog.String("error", err.Error()))
+		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	tasks := append([]model.Task{result}, children...)
+	err = t.enrich(ctx, tasks)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	result = tasks[0]
+	result.Children = tasks[1:]
+
+	err = t.attachChecklist(ctx, &result)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) GetSubtasks(ctx context.Context, id int64, param *param.Param) ([]model.Task, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return []model.Task{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	_, err := t.find(ctx, id, model.WorkspaceRoleViewer)
+	if err != nil {
+		return []model.Task{}, err
+	}
+
+	param.ParentID = &id
+	return t.GetByUserID(ctx, userId, param)
+}
+
+func (t *Task) GetByProjectID(ctx context.Context, projectId int64, param *param.Param) ([]model.Task, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return []model.Task{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	_, err := t.taskRepository.GetProject(ctx, projectId, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetProject", slog.String("error", err.Error()))
 		if err == sql.ErrNoRows {
-			return model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found")
+			return []model.Task{}, errs.NewErrs(http.StatusNotFound, "project not found")
 		}
 
-		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+		return []model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	param.ProjectID = &projectId
+	return t.GetByUserID(ctx, userId, param)
+}
+
+func (t *Task) GetByWorkspaceID(ctx context.Context, workspaceId int64, param *param.Param) ([]model.Task, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return []model.Task{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	_, err := t.taskRepository.GetRole(ctx, workspaceId, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetRole", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return []model.Task{}, errs.NewErrs(http.StatusNotFound, "workspace not found")
+		}
+
+		return []model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	param.WorkspaceID = &workspaceId
+	return t.GetByUserID(ctx, userId, param)
+}
+
+func (t *Task) GetDependencies(ctx context.Context, id int64) (model.TaskDependencies, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return model.TaskDependencies{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	_, err := t.find(ctx, id, model.WorkspaceRoleViewer)
+	if err != nil {
+		return model.TaskDependencies{}, err
+	}
+
+	blockers, err := t.taskRepository.GetDependenciesByBlockedID(ctx, []int64{id})
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetDependenciesByBlockedID", slog.String("error", err.Error()))
+		return model.TaskDependencies{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	blocking, err := t.taskRepository.GetDependenciesByBlockerID(ctx, []int64{id})
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetDependenciesByBlockerID", slog.String("error", err.Error()))
+		return model.TaskDependencies{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	ids := []int64{}
+	for _, dependency := range blockers {
+		ids = append(ids, dependency.BlockerID)
+	}
+
+	for _, dependency := range blocking {
+		ids = append(ids, dependency.BlockedID)
+	}
+
+	tasks, err := t.taskRepository.GetByIDs(ctx, ids, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetByIDs", slog.String("error", err.Error()))
+		return model.TaskDependencies{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	result := model.TaskDependencies{BlockedBy: []model.Task{}, Blocks: []model.Task{}}
+	for _, task := range tasks {
+		if slices.ContainsFunc(blockers, func(dependency model.TaskDependency) bool { return dependency.BlockerID == task.ID }) {
+			result.BlockedBy = append(result.BlockedBy, task)
+		}
+
+		if slices.ContainsFunc(blocking, func(dependency model.TaskDependency) bool { return dependency.BlockedID == task.ID }) {
+			result.Blocks = append(result.Blocks, task)
+		}
 	}
 
 	return result, nil
 }
 
-func (t *Task) Update(ctx context.Context, task model.Task) (model.Task, error) {
+func (t *Task) AddDependency(ctx context.Context, id int64, request model.TaskDependencyRequest) (model.TaskDependency, error) {
 	userId, ok := ctx.Value(auth.IdKey).(int64)
 	if !ok {
 		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
-		return model.Task{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+		return model.TaskDependency{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	if request.BlockerID == id {
+		slog.ErrorContext(ctx, "[Usecase.Task] error task cannot block itself", slog.Int64("id", id))
+		return model.TaskDependency{}, errs.NewErrs(http.StatusBadRequest, "task cannot block itself")
 	}
 
-	check, err := t.GetByID(ctx, task.ID)
+	_, err := t.find(ctx, id, model.WorkspaceRoleEditor)
 	if err != nil {
-		return model.Task{}, err
+		return model.TaskDependency{}, err
 	}
 
-	task.UpdatedAt = time.Now()
-	result, err := t.taskRepository.Update(ctx, task, userId)
+	_, err = t.taskRepository.GetByID(ctx, request.BlockerID, userId)
 	if err != nil {
-		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.Update", slog.String("error", err.Error()))
-		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetByID", slog.String("error", err.Error()), slog.Int64("blocker_id", request.BlockerID))
+		if err == sql.ErrNoRows {
+			return model.TaskDependency{}, errs.NewErrs(http.StatusBadRequest, "blocker task not found")
+		}
+
+		return model.TaskDependency{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
 	}
 
-	result.CreatedAt = check.CreatedAt
+	downstream, err := t.walk(ctx, id, true)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when walk task dependencies", slog.String("error", err.Error()))
+		return model.TaskDependency{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	if slices.ContainsFunc(downstream, func(dependency model.TaskDependency) bool { return dependency.BlockedID == request.BlockerID }) {
+		slog.ErrorContext(ctx, "[Usecase.Task] error task dependency creates a cycle", slog.Int64("id", id), slog.Int64("blocker_id", request.BlockerID))
+		return model.TaskDependency{}, errs.NewErrs(http.StatusConflict, "task dependency would create a cycle")
+	}
+
+	result, err := t.taskRepository.CreateDependency(ctx, request.BlockerID, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.CreateDependency", slog.String("error", err.Error()))
+		if err == task.ErrDependencyExists {
+			return model.TaskDependency{}, errs.NewErrs(http.StatusConflict, "task dependency already exists")
+		}
+
+		return model.TaskDependency{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
 
 	return result, nil
 }
 
-func (t *Task) Delete(ctx context.Context, id int64) error {
+func (t *Task) RemoveDependency(ctx context.Context, id, blockerId int64) error {
 	userId, ok := ctx.Value(auth.IdKey).(int64)
 	if !ok {
 		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
 		return errs.NewErrs(http.StatusForbidden, "forbidden access")
 	}
 
-	_, err := t.GetByID(ctx, id)
+	_, err := t.find(ctx, id, model.WorkspaceRoleEditor)
 	if err != nil {
 		return err
 	}
 
-	err = t.taskRepository.Delete(ctx, id, userId)
+	err = t.taskRepository.DeleteDependency(ctx, blockerId, id, userId)
 	if err != nil {
-		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.Delete", slog.String("error", err.Error()))
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.DeleteDependency", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return errs.NewErrs(http.StatusNotFound, "task dependency not found")
+		}
+
 		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
 	}
 
 	return nil
 }
+
+func (t *Task) GetDependencyGraph(ctx context.Context, id int64) (model.TaskDependencyGraph, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return model.TaskDependencyGraph{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	_, err := t.find(ctx, id, model.WorkspaceRoleViewer)
+	if err != nil {
+		return model.TaskDependencyGraph{}, err
+	}
+
+	upstream, err := t.walk(ctx, id, false)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when walk task dependencies", slog.String("error", err.Error()))
+		return model.TaskDependencyGraph{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	downstream, err := t.walk(ctx, id, true)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when walk task dependencies", slog.String("error", err.Error()))
+		return model.TaskDependencyGraph{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	edges := append(upstream, downstream...)
+	ids := []int64{id}
+	for _, edge := range edges {
+		ids = append(ids, edge.BlockerID, edge.BlockedID)
+	}
+
+	slices.Sort(ids)
+	nodes, err := t.taskRepository.GetByIDs(ctx, slices.Compact(ids), userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetByIDs", slog.String("error", err.Error()))
+		return model.TaskDependencyGraph{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return model.TaskDependencyGraph{Nodes: nodes, Edges: edges}, nil
+}
+
+func (t *Task) AttachLabel(ctx context.Context, id int64, request model.TaskLabelRequest) (model.Task, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return model.Task{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	_, err := t.find(ctx, id, model.WorkspaceRoleEditor)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	err = t.taskRepository.AttachLabel(ctx, id, request.LabelID, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.AttachLabel", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Task{}, errs.NewErrs(http.StatusBadRequest, "label not found")
+		}
+
+		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return t.GetByID(ctx, id)
+}
+
+func (t *Task) DetachLabel(ctx context.Context, id, labelId int64) (model.Task, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return model.Task{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	_, err := t.find(ctx, id, model.WorkspaceRoleEditor)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	err = t.taskRepository.DetachLabel(ctx, id, labelId, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.DetachLabel", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Task{}, errs.NewErrs(http.StatusNotFound, "task label not found")
+		}
+
+		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return t.GetByID(ctx, id)
+}
+
+func (t *Task) AddChecklistItem(ctx context.Context, id int64, request model.TaskChecklistRequest) (model.TaskChecklistItem, error) {
+	_, err := t.find(ctx, id, model.WorkspaceRoleEditor)
+	if err != nil {
+		return model.TaskChecklistItem{}, err
+	}
+
+	result, err := t.taskRepository.CreateChecklistItem(ctx, model.TaskChecklistItem{TaskID: id, Text: request.Text})
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.CreateChecklistItem", slog.String("error", err.Error()))
+		return model.TaskChecklistItem{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (t *Task) ToggleChecklistItem(ctx context.Context, id, itemId int64) (model.TaskChecklistItem, error) {
+	_, err := t.find(ctx, id, model.WorkspaceRoleEditor)
+	if err != nil {
+		return model.TaskChecklistItem{}, err
+	}
+
+	result, err := t.taskRepository.ToggleChecklistItem(ctx, itemId, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.ToggleChecklistItem", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.TaskChecklistItem{}, errs.NewErrs(http.StatusNotFound, "checklist item not found")
+		}
+
+		return model.TaskChecklistItem{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (t *Task) ReorderChecklist(ctx context.Context, id int64, request model.TaskChecklistOrderRequest) ([]model.TaskChecklistItem, error) {
+	_, err := t.find(ctx, id, model.WorkspaceRoleEditor)
+	if err != nil {
+		return []model.TaskChecklistItem{}, err
+	}
+
+	current, err := t.taskRepository.GetChecklist(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetChecklist", slog.String("error", err.Error()))
+		return []model.TaskChecklistItem{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	remaining := map[int64]bool{}
+	for _, item := range current {
+		remaining[item.ID] = true
+	}
+
+	for _, itemId := range request.ItemIDs {
+		if !remaining[itemId] {
+			return []model.TaskChecklistItem{}, errs.NewErrs(http.StatusBadRequest, "item_ids must list every checklist item exactly once")
+		}
+
+		delete(remaining, itemId)
+	}
+
+	if len(remaining) > 0 {
+		return []model.TaskChecklistItem{}, errs.NewErrs(http.StatusBadRequest, "item_ids must list every checklist item exactly once")
+	}
+
+	err = t.taskRepository.WithTransaction(ctx, func(repository task.TaskRepository) error {
+		for i, itemId := range request.ItemIDs {
+			err := repository.MoveChecklistItem(ctx, itemId, id, i+1)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.MoveChecklistItem", slog.String("error", err.Error()))
+		return []model.TaskChecklistItem{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	result, err := t.taskRepository.GetChecklist(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetChecklist", slog.String("error", err.Error()))
+		return []model.TaskChecklistItem{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (t *Task) RemoveChecklistItem(ctx context.Context, id, itemId int64) error {
+	_, err := t.find(ctx, id, model.WorkspaceRoleEditor)
+	if err != nil {
+		return err
+	}
+
+	err = t.taskRepository.DeleteChecklistItem(ctx, itemId, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.DeleteChecklistItem", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return errs.NewErrs(http.StatusNotFound, "checklist item not found")
+		}
+
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return nil
+}
+
+func (t *Task) GetHistory(ctx context.Context, id int64, param *param.Param) ([]model.TaskHistory, error) {
+	_, err := t.find(ctx, id, model.WorkspaceRoleViewer)
+	if err != nil {
+		return []model.TaskHistory{}, err
+	}
+
+	result, err := t.taskRepository.GetHistory(ctx, id, param.Limit, param.CalculateOffset())
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetHistory", slog.String("error", err.Error()))
+		return []model.TaskHistory{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	total, err := t.taskRepository.CountHistory(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.CountHistory", slog.String("error", err.Error()))
+		return []model.TaskHistory{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	param.Total = total
+
+	return result, nil
+}
+
+func (t *Task) Revert(ctx context.Context, id, revision int64, ifMatch etag.Match, force bool) (model.Task, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return model.Task{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	check, err := t.find(ctx, id, model.WorkspaceRoleEditor)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	err = t.precondition(ctx, ifMatch, check)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	entries, err := t.taskRepository.GetHistorySince(ctx, id, revision)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetHistorySince", slog.String("error", err.Error()))
+		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	if len(entries) == 0 || entries[len(entries)-1].Revision != revision {
+		slog.ErrorContext(ctx, "[Usecase.Task] error revision not found", slog.Int64("revision", revision))
+		return model.Task{}, errs.NewErrs(http.StatusNotFound, "revision not found")
+	}
+
+	conflicts := revertConflicts(entries[:len(entries)-1])
+	if !force && len(conflicts) > 0 {
+		slog.ErrorContext(ctx, "[Usecase.Task] error revert conflicts with later change", slog.Int64("revision", revision), slog.String("fields", strings.Join(conflicts, ",")))
+		return model.Task{}, errs.NewErrs(http.StatusConflict, fmt.Sprintf("revert conflicts with later changes to %s, use force to revert", strings.Join(conflicts, ", ")))
+	}
+
+	target := check
+	for _, entry := range entries[:len(entries)-1] {
+		for _, change := range entry.Changes {
+			err = revertValue(&target, change)
+			if err != nil {
+				slog.ErrorContext(ctx, "[Usecase.Task] error when revert history value", slog.String("field", change.Field), slog.String("error", err.Error()))
+				return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+			}
+		}
+	}
+
+	if len(entries) == 1 {
+		return check, nil
+	}
+
+	return t.update(ctx, model.TaskActionRevert, check, target, userId)
+}
+
+func (t *Task) Burndown(ctx context.Context, request model.TaskBurndownRequest) (model.TaskBurndown, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return model.TaskBurndown{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	from, err := time.Parse(time.DateOnly, request.From)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when parse from", slog.String("error", err.Error()))
+		return model.TaskBurndown{}, errs.NewErrs(http.StatusBadRequest, "invalid from")
+	}
+
+	to, err := time.Parse(time.DateOnly, request.To)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when parse to", slog.String("error", err.Error()))
+		return model.TaskBurndown{}, errs.NewErrs(http.StatusBadRequest, "invalid to")
+	}
+
+	if to.Before(from) {
+		slog.ErrorContext(ctx, "[Usecase.Task] error burndown range is empty", slog.Time("from", from), slog.Time("to", to))
+		return model.TaskBurndown{}, errs.NewErrs(http.StatusBadRequest, "to must not be before from")
+	}
+
+	days := int(to.Sub(from).Hours()/24) + 1
+	if days > model.TaskBurndownMaxDays {
+		slog.ErrorContext(ctx, "[Usecase.Task] error burndown range is too long", slog.Int("days", days))
+		return model.TaskBurndown{}, errs.NewErrs(http.StatusBadRequest, fmt.Sprintf("burndown range must not exceed %d days", model.TaskBurndownMaxDays))
+	}
+
+	metric := request.Metric
+	if metric == "" {
+		metric = model.TaskBurndownMetricPoints
+	}
+
+	if request.ProjectID != nil {
+		_, err = t.taskRepository.GetProject(ctx, *request.ProjectID, userId)
+		if err != nil {
+			slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetProject", slog.String("error", err.Error()))
+			if err == sql.ErrNoRows {
+				return model.TaskBurndown{}, errs.NewErrs(http.StatusNotFound, "project not found")
+			}
+
+			return model.TaskBurndown{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+		}
+	}
+
+	tasks, err := t.taskRepository.GetBurndownTasks(ctx, userId, request.ProjectID, to.AddDate(0, 0, 1))
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetBurndownTasks", slog.String("error", err.Error()))
+		return model.TaskBurndown{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	ids := make([]int64, 0, len(tasks))
+	states := map[int64]*model.Task{}
+	deleted := map[int64]bool{}
+	for i := range tasks {
+		ids = append(ids, tasks[i].ID)
+		states[tasks[i].ID] = &tasks[i]
+		deleted[tasks[i].ID] = tasks[i].DeletedAt != nil
+	}
+
+	history, err := t.taskRepository.GetHistoryByTaskIDs(ctx, ids, from.AddDate(0, 0, 1))
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetHistoryByTaskIDs", slog.String("error", err.Error()))
+		return model.TaskBurndown{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	points := make([]model.TaskBurndownPoint, days)
+	applied := 0
+	for i := days - 1; i >= 0; i-- {
+		end := from.AddDate(0, 0, i+1)
+		for ; applied < len(history) && !history[applied].CreatedAt.Before(end); applied++ {
+			entry := history[applied]
+			switch entry.Action {
+			case model.TaskActionDelete:
+				deleted[entry.TaskID] = false
+			case model.TaskActionRestore:
+				deleted[entry.TaskID] = true
+			}
+
+			for _, change := range entry.Changes {
+				err = revertValue(states[entry.TaskID], change)
+				if err != nil {
+					slog.ErrorContext(ctx, "[Usecase.Task] error when revert history value", slog.String("error", err.Error()))
+					return model.TaskBurndown{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+				}
+			}
+		}
+
+		point := model.TaskBurndownPoint{Date: from.AddDate(0, 0, i).Format(time.DateOnly)}
+		for _, task := range tasks {
+			if !task.CreatedAt.Before(end) || deleted[task.ID] || task.Status == model.TaskStatusCancelled {
+				continue
+			}
+
+			if request.ProjectID != nil && !sameID(task.ProjectID, request.ProjectID) {
+				continue
+			}
+
+			value := burndownValue(task, metric)
+			point.Scope += value
+			if task.Status == model.TaskStatusDone {
+				point.Completed += value
+			}
+		}
+
+		point.Remaining = point.Scope - point.Completed
+		points[i] = point
+	}
+
+	step := 0.0
+	if days > 1 {
+		step = float64(points[0].Remaining) / float64(days-1)
+	}
+
+	for i := range points {
+		points[i].Ideal = float64(points[0].Remaining) - step*float64(i)
+	}
+
+	return model.TaskBurndown{
+		ProjectID: request.ProjectID,
+		From:      from.Format(time.DateOnly),
+		To:        to.Format(time.DateOnly),
+		Metric:    metric,
+		Points:    points,
+	}, nil
+}
+
+func (t *Task) Move(ctx context.Context, id int64, request model.TaskMove) (model.Task, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return model.Task{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	if !t.workflow.Has(request.Status) {
+		slog.ErrorContext(ctx, "[Usecase.Task] error unknown task status", slog.String("status", request.Status))
+		return model.Task{}, errs.NewErrs(http.StatusBadRequest, "invalid status")
+	}
+
+	check, err := t.find(ctx, id, model.WorkspaceRoleEditor)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	action := model.TaskActionUpdate
+	if request.Status != check.Status {
+		if !t.workflow.Can(check.Status, request.Status) {
+			slog.ErrorContext(ctx, "[Usecase.Task] error invalid status transition", slog.String("from", check.Status), slog.String("to", request.Status))
+			return model.Task{}, errs.NewErrs(http.StatusConflict, fmt.Sprintf("cannot transition task from %s to %s", check.Status, request.Status))
+		}
+
+		err = t.checkBlockers(ctx, id, request.Status)
+		if err != nil {
+			return model.Task{}, err
+		}
+
+		action = model.TaskActionTransition
+	}
+
+	patch := model.TaskPatch{
+		Status:    &request.Status,
+		Fields:    []string{"status", "position"},
+		UpdatedAt: time.Now(),
+		Version:   check.Version,
+	}
+	result, err := t.save(ctx, action, check, func(scoped *Task) (model.Task, error) {
+		position, err := scoped.between(ctx, check, userId, request)
+		if err != nil {
+			return model.Task{}, err
+		}
+
+		patch.Position = &position
+		return scoped.taskRepository.Patch(ctx, id, userId, patch)
+	})
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return model.Task{}, httpErr
+		}
+
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.Patch", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found")
+		}
+
+		if isVersionConflict(err) {
+			return model.Task{}, errs.NewErrs(http.StatusPreconditionFailed, "task has been modified")
+		}
+
+		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (t *Task) Board(ctx context.Context, request model.TaskBoardRequest) (model.TaskBoard, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return model.TaskBoard{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	if request.ProjectID != nil {
+		_, err := t.taskRepository.GetProject(ctx, *request.ProjectID, userId)
+		if err != nil {
+			slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetProject", slog.String("error", err.Error()))
+			if err == sql.ErrNoRows {
+				return model.TaskBoard{}, errs.NewErrs(http.StatusNotFound, "project not found")
+			}
+
+			return model.TaskBoard{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+		}
+	}
+
+	filter := param.Param{
+		Priority:  request.Priority,
+		Labels:    request.Labels,
+		LabelMode: request.LabelMode,
+		Assignee:  request.Assignee,
+		Q:         request.Q,
+		ProjectID: request.ProjectID,
+	}
+	err := t.filters(ctx, userId, &filter)
+	if err != nil {
+		return model.TaskBoard{}, err
+	}
+
+	limit := request.Limit
+	if limit <= 0 {
+		limit = model.TaskBoardDefaultLimit
+	}
+
+	tasks, err := t.taskRepository.GetBoard(ctx, userId, filter, limit)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetBoard", slog.String("error", err.Error()))
+		return model.TaskBoard{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	totals, err := t.taskRepository.CountByStatus(ctx, userId, filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.CountByStatus", slog.String("error", err.Error()))
+		return model.TaskBoard{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	err = t.enrich(ctx, tasks)
+	if err != nil {
+		return model.TaskBoard{}, err
+	}
+
+	board := model.TaskBoard{Columns: []model.TaskBoardColumn{}}
+	columns := map[string]int{}
+	for _, status := range model.TaskBoardStatuses {
+		columns[status] = len(board.Columns)
+		board.Columns = append(board.Columns, model.TaskBoardColumn{Status: status, Total: totals[status], Tasks: []model.Task{}})
+	}
+
+	for _, task := range tasks {
+		index, ok := columns[task.Status]
+		if !ok {
+			index = len(board.Columns)
+			columns[task.Status] = index
+			board.Columns = append(board.Columns, model.TaskBoardColumn{Status: task.Status, Total: totals[task.Status], Tasks: []model.Task{}})
+		}
+
+		board.Columns[index].Tasks = append(board.Columns[index].Tasks, task)
+	}
+
+	return board, nil
+}
+
+func (t *Task) attachChecklist(ctx context.Context, task *model.Task) error {
+	checklist, err := t.taskRepository.GetChecklist(ctx, task.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetChecklist", slog.String("error", err.Error()))
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	task.Checklist = checklist
+
+	return nil
+}
+
+func (t *Task) walk(ctx context.Context, id int64, downstream bool) ([]model.TaskDependency, error) {
+	result := []model.TaskDependency{}
+	visited := map[int64]bool{id: true}
+	frontier := []int64{id}
+	for len(frontier) > 0 {
+		getDependencies := t.taskRepository.GetDependenciesByBlockedID
+		if downstream {
+			getDependencies = t.taskRepository.GetDependenciesByBlockerID
+		}
+
+		dependencies, err := getDependencies(ctx, frontier)
+		if err != nil {
+			return []model.TaskDependency{}, err
+		}
+
+		frontier = []int64{}
+		for _, dependency := range dependencies {
+			result = append(result, dependency)
+
+			next := dependency.BlockerID
+			if downstream {
+				next = dependency.BlockedID
+			}
+
+			if !visited[next] {
+				visited[next] = true
+				frontier = append(frontier, next)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (t *Task) Authorize(ctx context.Context, id int64, role string) (model.Task, error) {
+	return t.find(ctx, id, role)
+}
+
+func (t *Task) find(ctx context.Context, id int64, role string) (model.Task, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return model.Task{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	result, err := t.taskRepository.GetByID(ctx, id, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetByID", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found")
+		}
+
+		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	err = t.permit(ctx, result, userId, role)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	return result, nil
+}
+
+func (t *Task) Update(ctx context.Context, task model.Task) (model.Task, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return model.Task{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	check, err := t.find(ctx, task.ID, model.WorkspaceRoleEditor)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	err = t.precondition(ctx, task.IfMatch, check)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	return t.update(ctx, model.TaskActionUpdate, check, task, userId)
+}
+
+func (t *Task) update(ctx context.Context, action string, check, task model.Task, userId int64) (model.Task, error) {
+	if task.Status == "" {
+		task.Status = check.Status
+	}
+
+	if task.Priority == "" {
+		task.Priority = check.Priority
+	}
+
+	if task.Status != check.Status && !t.workflow.Can(check.Status, task.Status) {
+		slog.ErrorContext(ctx, "[Usecase.Task] error invalid status transition", slog.String("from", check.Status), slog.String("to", task.Status))
+		return model.Task{}, errs.NewErrs(http.StatusConflict, fmt.Sprintf("cannot transition task from %s to %s", check.Status, task.Status))
+	}
+
+	var err error
+	if task.Status != check.Status {
+		err = t.checkBlockers(ctx, task.ID, task.Status)
+		if err != nil {
+			return model.Task{}, err
+		}
+	}
+
+	if !sameID(task.ParentID, check.ParentID) {
+		err = t.checkParent(ctx, task.ID, task.ParentID, userId)
+		if err != nil {
+			return model.Task{}, err
+		}
+	}
+
+	if !sameID(task.ProjectID, check.ProjectID) {
+		err = t.checkProject(ctx, task.ProjectID, userId)
+		if err != nil {
+			return model.Task{}, err
+		}
+	}
+
+	task.AssignedAt = check.AssignedAt
+	if !sameID(task.AssigneeID, check.AssigneeID) {
+		err = t.checkAssignee(ctx, task.AssigneeID, check.WorkspaceID, userId)
+		if err != nil {
+			return model.Task{}, err
+		}
+
+		task.AssignedAt = assignedAt(task.AssigneeID)
+	}
+
+	task.WorkspaceID = check.WorkspaceID
+	task.Position = check.Position
+	task.UpdatedAt = time.Now()
+	task.Version = check.Version
+	result, err := t.save(ctx, action, check, func(scoped *Task) (model.Task, error) {
+		if task.Status != check.Status {
+			task.Position, err = scoped.position(ctx, laneOf(check, task.Status))
+			if err != nil {
+				return model.Task{}, err
+			}
+		}
+
+		return scoped.taskRepository.Update(ctx, task, userId)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.Update", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found")
+		}
+
+		if isVersionConflict(err) {
+			return model.Task{}, errs.NewErrs(http.StatusPreconditionFailed, "task has been modified")
+		}
+
+		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	result.CreatedAt = check.CreatedAt
+
+	return result, nil
+}
+
+func (t *Task) UpdateSeries(ctx context.Context, data model.Task) (model.Task, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return model.Task{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	check, err := t.find(ctx, data.ID, model.WorkspaceRoleEditor)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	occurrence, err := t.taskRepository.GetOccurrence(ctx, data.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetOccurrence", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Task{}, errs.NewErrs(http.StatusBadRequest, "task is not recurring")
+		}
+
+		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	series, err := t.taskRepository.GetSeries(ctx, occurrence.SeriesID)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetSeries", slog.String("error", err.Error()))
+		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	rule := series.Rule
+	if data.Recurrence != "" {
+		rule = data.Recurrence
+	}
+
+	err = t.checkRecurrence(ctx, rule, data.DueAt)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	rescheduled := rule != series.Rule || check.DueAt == nil || !data.DueAt.Equal(*check.DueAt)
+	if rescheduled {
+		series.Rule, series.DTStart, series.Offset = rule, *data.DueAt, occurrence.Occurrence-1
+	}
+
+	series.Title, series.Description, series.Priority = data.Title, data.Description, data.Priority
+	if series.Priority == "" {
+		series.Priority = check.Priority
+	}
+
+	series.ProjectID, series.AssigneeID = data.ProjectID, data.AssigneeID
+
+	result := model.Task{}
+	err = t.taskRepository.WithTransaction(ctx, func(repository task.TaskRepository) error {
+		scoped := *t
+		scoped.taskRepository = repository
+
+		err := repository.UpdateSeries(ctx, series)
+		if err != nil {
+			return err
+		}
+
+		result, err = scoped.Update(ctx, data)
+		if err != nil {
+			return err
+		}
+
+		return scoped.reschedule(ctx, series, occurrence, *result.DueAt, rescheduled, userId)
+	})
+	if err != nil {
+		if httpErr, ok := err.(*errs.HttpError); ok {
+			return model.Task{}, httpErr
+		}
+
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.UpdateSeries", slog.String("error", err.Error()))
+		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	result.Recurrence, result.SeriesID, result.Occurrence = series.Rule, &series.ID, occurrence.Occurrence
+
+	return result, nil
+}
+
+func (t *Task) Delete(ctx context.Context, id int64, ifMatch etag.Match, permanent bool) error {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	check, err := t.taskRepository.GetByID(ctx, id, userId)
+	if err == sql.ErrNoRows && permanent {
+		check, err = t.taskRepository.GetTrashedByID(ctx, id, userId)
+	}
+
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetByID", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return errs.NewErrs(http.StatusNotFound, "task not found")
+		}
+
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	err = t.permit(ctx, check, userId, model.WorkspaceRoleEditor)
+	if err != nil {
+		return err
+	}
+
+	err = t.precondition(ctx, ifMatch, check)
+	if err != nil {
+		return err
+	}
+
+	err = t.taskRepository.WithTransaction(ctx, func(repository task.TaskRepository) error {
+		scoped := *t
+		scoped.taskRepository = repository
+
+		switch {
+		case t.cascade && permanent:
+			descendants, err := repository.GetDescendants(ctx, id, userId)
+			if err != nil {
+				return err
+			}
+
+			for _, descendant := range descendants {
+				err = scoped.record(ctx, model.TaskActionDestroy, descendant, descendant)
+				if err != nil {
+					return err
+				}
+			}
+
+			err = repository.DestroyDescendants(ctx, id, userId)
+			if err != nil {
+				return err
+			}
+		case t.cascade:
+			descendants, err := repository.DeleteDescendants(ctx, id, userId)
+			if err != nil {
+				return err
+			}
+
+			for _, descendant := range descendants {
+				err = scoped.record(ctx, model.TaskActionDelete, descendant, descendant)
+				if err != nil {
+					return err
+				}
+			}
+		default:
+			children, err := repository.Reparent(ctx, id, userId, check.ParentID)
+			if err != nil {
+				return err
+			}
+
+			for _, child := range children {
+				before := child
+				before.ParentID = &check.ID
+				err = scoped.record(ctx, model.TaskActionUpdate, before, child)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if permanent {
+			err := scoped.record(ctx, model.TaskActionDestroy, check, check)
+			if err != nil {
+				return err
+			}
+
+			return repository.Destroy(ctx, id, userId, check.Version)
+		}
+
+		err := repository.Delete(ctx, id, userId, check.Version)
+		if err != nil {
+			return err
+		}
+
+		return scoped.record(ctx, model.TaskActionDelete, check, check)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.Delete", slog.String("error", err.Error()), slog.Bool("permanent", permanent))
+		if err == sql.ErrNoRows {
+			return errs.NewErrs(http.StatusNotFound, "task not found")
+		}
+
+		if isVersionConflict(err) {
+			return errs.NewErrs(http.StatusPreconditionFailed, "task has been modified")
+		}
+
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return nil
+}
+
+func (t *Task) GetTrashByUserID(ctx context.Context, userId int64, param *param.Param) ([]model.Task, error) {
+	param.Trashed = true
+	return t.GetByUserID(ctx, userId, param)
+}
+
+func (t *Task) Restore(ctx context.Context, id int64) (model.Task, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return model.Task{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	check, err := t.taskRepository.GetTrashedByID(ctx, id, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetTrashedByID", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found in trash")
+		}
+
+		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	err = t.permit(ctx, check, userId, model.WorkspaceRoleEditor)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	result := model.Task{}
+	err = t.taskRepository.WithTransaction(ctx, func(repository task.TaskRepository) error {
+		scoped := *t
+		scoped.taskRepository = repository
+
+		children, err := repository.RestoreDescendants(ctx, id, userId)
+		if err != nil {
+			return err
+		}
+
+		result, err = repository.Restore(ctx, id, userId)
+		if err != nil {
+			return err
+		}
+
+		err = scoped.record(ctx, model.TaskActionRestore, check, result)
+		if err != nil {
+			return err
+		}
+
+		for _, child := range children {
+			err = scoped.record(ctx, model.TaskActionRestore, child, child)
+			if err != nil {
+				return err
+			}
+		}
+
+		reattached, err := repository.Reattach(ctx, id, userId, result.ParentID)
+		if err != nil {
+			return err
+		}
+
+		for _, child := range reattached {
+			before := child
+			before.ParentID = result.ParentID
+			err = scoped.record(ctx, model.TaskActionUpdate, before, child)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.Restore", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found in trash")
+		}
+
+		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (t *Task) Purge(ctx context.Context) (int64, error) {
+	before := time.Now().Add(-t.trashRetention)
+
+	var result int64
+	err := t.taskRepository.WithTransaction(ctx, func(repository task.TaskRepository) error {
+		scoped := *t
+		scoped.taskRepository = repository
+
+		expired, err := repository.GetPurgeable(ctx, before)
+		if err != nil {
+			return err
+		}
+
+		for _, trashed := range expired {
+			err = scoped.record(ctx, model.TaskActionPurge, trashed, trashed)
+			if err != nil {
+				return err
+			}
+		}
+
+		result, err = repository.Purge(ctx, before)
+		return err
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.Purge", slog.String("error", err.Error()))
+		return 0, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (t *Task) Transition(ctx context.Context, id int64, status string) (model.Task, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return model.Task{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	if !t.workflow.Has(status) {
+		slog.ErrorContext(ctx, "[Usecase.Task] error unknown task status", slog.String("status", status))
+		return model.Task{}, errs.NewErrs(http.StatusBadRequest, "invalid status")
+	}
+
+	check, err := t.find(ctx, id, model.WorkspaceRoleEditor)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	if !t.workflow.Can(check.Status, status) {
+		slog.ErrorContext(ctx, "[Usecase.Task] error invalid status transition", slog.String("from", check.Status), slog.String("to", status))
+		return model.Task{}, errs.NewErrs(http.StatusConflict, fmt.Sprintf("cannot transition task from %s to %s", check.Status, status))
+	}
+
+	err = t.checkBlockers(ctx, id, status)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	before := check
+	check.Status = status
+	check.UpdatedAt = time.Now()
+	result, err := t.save(ctx, model.TaskActionTransition, before, func(scoped *Task) (model.Task, error) {
+		if before.Status != status {
+			check.Position, err = scoped.position(ctx, laneOf(check, status))
+			if err != nil {
+				return model.Task{}, err
+			}
+		}
+
+		return scoped.taskRepository.Update(ctx, check, userId)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.Update", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found")
+		}
+
+		if isVersionConflict(err) {
+			return model.Task{}, errs.NewErrs(http.StatusPreconditionFailed, "task has been modified")
+		}
+
+		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (t *Task) Patch(ctx context.Context, id int64, patch model.TaskPatch) (model.Task, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return model.Task{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	check, err := t.find(ctx, id, model.WorkspaceRoleEditor)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	err = t.precondition(ctx, patch.IfMatch, check)
+	if err != nil {
+		return model.Task{}, err
+	}
+
+	if len(patch.Fields) == 0 {
+		return check, nil
+	}
+
+	if patch.Status != nil && *patch.Status != check.Status && !t.workflow.Can(check.Status, *patch.Status) {
+		slog.ErrorContext(ctx, "[Usecase.Task] error invalid status transition", slog.String("from", check.Status), slog.String("to", *patch.Status))
+		return model.Task{}, errs.NewErrs(http.StatusConflict, fmt.Sprintf("cannot transition task from %s to %s", check.Status, *patch.Status))
+	}
+
+	if patch.Status != nil && *patch.Status != check.Status {
+		err = t.checkBlockers(ctx, id, *patch.Status)
+		if err != nil {
+			return model.Task{}, err
+		}
+	}
+
+	if patch.Has("parent_id") && !sameID(patch.ParentID, check.ParentID) {
+		err = t.checkParent(ctx, id, patch.ParentID, userId)
+		if err != nil {
+			return model.Task{}, err
+		}
+	}
+
+	if patch.Has("project_id") && !sameID(patch.ProjectID, check.ProjectID) {
+		err = t.checkProject(ctx, patch.ProjectID, userId)
+		if err != nil {
+			return model.Task{}, err
+		}
+	}
+
+	if patch.Has("assignee_id") {
+		patch.AssignedAt = check.AssignedAt
+		if !sameID(patch.AssigneeID, check.AssigneeID) {
+			err = t.checkAssignee(ctx, patch.AssigneeID, check.WorkspaceID, userId)
+			if err != nil {
+				return model.Task{}, err
+			}
+
+			patch.AssignedAt = assignedAt(patch.AssigneeID)
+		}
+	}
+
+	patch.UpdatedAt = time.Now()
+	patch.Version = check.Version
+	result, err := t.save(ctx, model.TaskActionUpdate, check, func(scoped *Task) (model.Task, error) {
+		if patch.Status != nil && *patch.Status != check.Status {
+			position, err := scoped.position(ctx, laneOf(check, *patch.Status))
+			if err != nil {
+				return model.Task{}, err
+			}
+
+			patch.Position = &position
+			patch.Fields = append(patch.Fields, "position")
+		}
+
+		return scoped.taskRepository.Patch(ctx, id, userId, patch)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.Patch", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found")
+		}
+
+		if isVersionConflict(err) {
+			return model.Task{}, errs.NewErrs(http.StatusPreconditionFailed, "task has been modified")
+		}
+
+		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (t *Task) Bulk(ctx context.Context, request model.TaskBulkRequest) ([]model.TaskBulkResult, error) {
+	if _, ok := ctx.Value(auth.IdKey).(int64); !ok {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get user id from context")
+		return []model.TaskBulkResult{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	results := make([]model.TaskBulkResult, len(request.Operations))
+	for i, operation := range request.Operations {
+		results[i] = model.TaskBulkResult{
+			Index:  i,
+			Op:     operation.Op,
+			ID:     operation.ID,
+			Status: model.TaskBulkStatusSkipped,
+		}
+	}
+
+	failed := -1
+	err := t.taskRepository.WithTransaction(ctx, func(repository task.TaskRepository) error {
+		for i, operation := range request.Operations {
+			err := repository.WithTransaction(ctx, func(item task.TaskRepository) error {
+				scoped := *t
+				scoped.taskRepository = item
+
+				result, err := scoped.apply(ctx, operation)
+				if err != nil {
+					return err
+				}
+
+				results[i].Status, results[i].Code, results[i].Task = model.TaskBulkStatusSuccess, http.StatusOK, result
+				if result != nil {
+					results[i].ID = result.ID
+				}
+
+				return nil
+			})
+			if err == nil {
+				continue
+			}
+
+			results[i].Status, results[i].Code, results[i].Error = model.TaskBulkStatusFailed, http.StatusInternalServerError, "something went wrong"
+			if httpErr, ok := err.(*errs.HttpError); ok {
+				results[i].Code, results[i].Error = httpErr.StatusCode, httpErr.Message
+			}
+
+			if request.Atomic {
+				failed = i
+				return errBulkAborted
+			}
+		}
+
+		return nil
+	})
+
+	if err == errBulkAborted {
+		for i := range results[:failed] {
+			results[i].Status, results[i].Code, results[i].Task = model.TaskBulkStatusRolledBack, 0, nil
+		}
+
+		return results, errs.NewErrs(results[failed].Code, "bulk operation rolled back")
+	}
+
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.WithTransaction", slog.String("error", err.Error()))
+		return []model.TaskBulkResult{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return results, nil
+}
+
+func (t *Task) apply(ctx context.Context, operation model.TaskBulkOperation) (*model.Task, error) {
+	var (
+		result model.Task
+		err    error
+	)
+
+	switch operation.Op {
+	case model.TaskBulkCreate:
+		result, err = t.Create(ctx, *operation.Task)
+	case model.TaskBulkUpdate:
+		data := *operation.Task
+		data.ID, data.IfMatch = operation.ID, etag.Exact(operation.Version)
+		result, err = t.Update(ctx, data)
+	case model.TaskBulkTransition:
+		result, err = t.Transition(ctx, operation.ID, operation.Status)
+	case model.TaskBulkDelete:
+		return nil, t.Delete(ctx, operation.ID, etag.Exact(operation.Version), operation.Permanent)
+	default:
+		return nil, errs.NewErrs(http.StatusBadRequest, "invalid bulk operation")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (t *Task) precondition(ctx context.Context, ifMatch etag.Match, current model.Task) error {
+	if !ifMatch.Present {
+		if t.requireIfMatch {
+			slog.ErrorContext(ctx, "[Usecase.Task] error missing task version")
+			return errs.NewErrs(http.StatusPreconditionRequired, "if-match header is required")
+		}
+
+		return nil
+	}
+
+	if !ifMatch.Matches(current.Version) {
+		slog.ErrorContext(ctx, "[Usecase.Task] error stale task version", slog.Any("if_match", ifMatch.Versions), slog.Int64("current", current.Version))
+		return errs.NewErrs(http.StatusPreconditionFailed, "task has been modified")
+	}
+
+	return nil
+}
+
+func (t *Task) checkParent(ctx context.Context, id int64, parentId *int64, userId int64) error {
+	if parentId == nil {
+		return nil
+	}
+
+	ancestors, err := t.taskRepository.Ancestors(ctx, *parentId, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.Ancestors", slog.String("error", err.Error()))
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	if len(ancestors) == 0 {
+		slog.ErrorContext(ctx, "[Usecase.Task] error parent task not found", slog.Int64("parent_id", *parentId))
+		return errs.NewErrs(http.StatusBadRequest, "parent task not found")
+	}
+
+	if slices.Contains(ancestors, id) {
+		slog.ErrorContext(ctx, "[Usecase.Task] error parent task creates a cycle", slog.Int64("id", id), slog.Int64("parent_id", *parentId))
+		return errs.NewErrs(http.StatusConflict, "parent task would create a cycle")
+	}
+
+	return nil
+}
+
+func (t *Task) checkProject(ctx context.Context, projectId *int64, userId int64) error {
+	if projectId == nil {
+		return nil
+	}
+
+	project, err := t.taskRepository.GetProject(ctx, *projectId, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetProject", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return errs.NewErrs(http.StatusBadRequest, "project not found")
+		}
+
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	if project.ArchivedAt != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error project is archived", slog.Int64("project_id", *projectId))
+		return errs.NewErrs(http.StatusConflict, "project is archived")
+	}
+
+	return nil
+}
+
+func (t *Task) checkAssignee(ctx context.Context, assigneeId, workspaceId *int64, userId int64) error {
+	if assigneeId == nil {
+		return nil
+	}
+
+	if workspaceId == nil {
+		if *assigneeId != userId {
+			slog.ErrorContext(ctx, "[Usecase.Task] error assignee has no access to task", slog.Int64("assignee_id", *assigneeId))
+			return errs.NewErrs(http.StatusBadRequest, "assignee has no access to task")
+		}
+
+		return nil
+	}
+
+	_, err := t.taskRepository.GetRole(ctx, *workspaceId, *assigneeId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetRole", slog.String("error", err.Error()), slog.Int64("assignee_id", *assigneeId))
+		if err == sql.ErrNoRows {
+			return errs.NewErrs(http.StatusBadRequest, "assignee has no access to task")
+		}
+
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return nil
+}
+
+func (t *Task) permit(ctx context.Context, task model.Task, userId int64, role string) error {
+	if task.WorkspaceID == nil || role == model.WorkspaceRoleViewer {
+		return nil
+	}
+
+	return t.checkWorkspace(ctx, task.WorkspaceID, userId, role)
+}
+
+func (t *Task) checkWorkspace(ctx context.Context, workspaceId *int64, userId int64, role string) error {
+	if workspaceId == nil {
+		return nil
+	}
+
+	current, err := t.taskRepository.GetRole(ctx, *workspaceId, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetRole", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return errs.NewErrs(http.StatusBadRequest, "workspace not found")
+		}
+
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	if !model.WorkspaceRoleAllows(current, role) {
+		slog.ErrorContext(ctx, "[Usecase.Task] error insufficient workspace role", slog.Int64("workspace_id", *workspaceId), slog.String("role", current))
+		return errs.NewErrs(http.StatusForbidden, "insufficient workspace role")
+	}
+
+	return nil
+}
+
+func (t *Task) filters(ctx context.Context, userId int64, param *param.Param) error {
+	if param.LabelMode != "" && param.LabelMode != model.LabelModeAny && param.LabelMode != model.LabelModeAll {
+		slog.ErrorContext(ctx, "[Usecase.Task] error unknown label mode", slog.String("label_mode", param.LabelMode))
+		return errs.NewErrs(http.StatusBadRequest, "invalid label mode")
+	}
+
+	switch param.Assignee {
+	case "":
+	case model.TaskAssigneeMe:
+		param.AssigneeID = &userId
+	case model.TaskAssigneeNone:
+		param.Unassigned = true
+	default:
+		assigneeId, err := strconv.ParseInt(param.Assignee, 10, 64)
+		if err != nil {
+			slog.ErrorContext(ctx, "[Usecase.Task] error invalid assignee", slog.String("assignee", param.Assignee))
+			return errs.NewErrs(http.StatusBadRequest, "invalid assignee")
+		}
+
+		param.AssigneeID = &assigneeId
+	}
+
+	return nil
+}
+
+func (t *Task) between(ctx context.Context, check model.Task, userId int64, request model.TaskMove) (string, error) {
+	id := check.ID
+	ids := []int64{}
+	for _, neighbour := range []*int64{request.PrevID, request.NextID} {
+		if neighbour == nil {
+			continue
+		}
+
+		if *neighbour == id {
+			slog.ErrorContext(ctx, "[Usecase.Task] error task cannot be its own neighbour", slog.Int64("id", id))
+			return "", errs.NewErrs(http.StatusBadRequest, "task cannot be moved next to itself")
+		}
+
+		ids = append(ids, *neighbour)
+	}
+
+	lane := laneOf(check, request.Status)
+	err := t.taskRepository.LockPositions(ctx, lane)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.LockPositions", slog.String("error", err.Error()))
+		return "", errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	neighbours, err := t.taskRepository.GetByIDs(ctx, ids, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetByIDs", slog.String("error", err.Error()))
+		return "", errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	positions := map[int64]string{}
+	for _, neighbour := range neighbours {
+		if neighbour.Status != request.Status {
+			slog.ErrorContext(ctx, "[Usecase.Task] error neighbour task in another status", slog.Int64("neighbour_id", neighbour.ID), slog.String("status", neighbour.Status))
+			return "", errs.NewErrs(http.StatusBadRequest, fmt.Sprintf("neighbour task %d is not in status %s", neighbour.ID, request.Status))
+		}
+
+		if !sameID(neighbour.WorkspaceID, check.WorkspaceID) {
+			slog.ErrorContext(ctx, "[Usecase.Task] error neighbour task on another board", slog.Int64("neighbour_id", neighbour.ID))
+			return "", errs.NewErrs(http.StatusBadRequest, fmt.Sprintf("neighbour task %d is not on the same board", neighbour.ID))
+		}
+
+		positions[neighbour.ID] = neighbour.Position
+	}
+
+	lower, upper := "", ""
+	for _, neighbour := range []*int64{request.PrevID, request.NextID} {
+		if neighbour == nil {
+			continue
+		}
+
+		if _, ok := positions[*neighbour]; !ok {
+			slog.ErrorContext(ctx, "[Usecase.Task] error neighbour task not found", slog.Int64("neighbour_id", *neighbour))
+			return "", errs.NewErrs(http.StatusBadRequest, "neighbour task not found")
+		}
+	}
+
+	switch {
+	case request.PrevID != nil && request.NextID != nil:
+		lower, upper = positions[*request.PrevID], positions[*request.NextID]
+	case request.PrevID != nil:
+		lower = positions[*request.PrevID]
+		upper, err = t.taskRepository.NextPosition(ctx, lane, lower)
+	case request.NextID != nil:
+		upper = positions[*request.NextID]
+		lower, err = t.taskRepository.PrevPosition(ctx, lane, upper)
+	default:
+		lower, err = t.taskRepository.LastPosition(ctx, lane)
+	}
+
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when get neighbour position", slog.String("error", err.Error()))
+		return "", errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	position, err := rank.Between(lower, upper)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when rank task position", slog.String("lower", lower), slog.String("upper", upper), slog.String("error", err.Error()))
+		return "", errs.NewErrs(http.StatusConflict, "neighbour tasks are out of order")
+	}
+
+	return position, nil
+}
+
+func (t *Task) position(ctx context.Context, lane model.TaskLane) (string, error) {
+	err := t.taskRepository.LockPositions(ctx, lane)
+	if err != nil {
+		return "", err
+	}
+
+	last, err := t.taskRepository.LastPosition(ctx, lane)
+	if err != nil {
+		return "", err
+	}
+
+	return rank.Between(last, "")
+}
+
+func (t *Task) checkBlockers(ctx context.Context, id int64, status string) error {
+	if status != model.TaskStatusDone {
+		return nil
+	}
+
+	open, err := t.taskRepository.CountOpenBlockers(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.CountOpenBlockers", slog.String("error", err.Error()))
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	if open > 0 {
+		slog.ErrorContext(ctx, "[Usecase.Task] error task is blocked by open tasks", slog.Int64("id", id), slog.Int64("open", open))
+		return errs.NewErrs(http.StatusConflict, "task is blocked by open tasks")
+	}
+
+	return nil
+}
+
+func (t *Task) checkRecurrence(ctx context.Context, recurrence string, dueAt *time.Time) error {
+	if dueAt == nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error recurring task without due date")
+		return errs.NewErrs(http.StatusBadRequest, "due_at is required for recurring task")
+	}
+
+	_, err := rrule.Parse(recurrence)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when parse recurrence", slog.String("error", err.Error()))
+		return errs.NewErrs(http.StatusBadRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (t *Task) create(ctx context.Context, data model.Task) (model.Task, error) {
+	result := model.Task{}
+	err := t.taskRepository.WithTransaction(ctx, func(repository task.TaskRepository) error {
+		scoped := *t
+		scoped.taskRepository = repository
+
+		var err error
+		data.Position, err = scoped.position(ctx, laneOf(data, data.Status))
+		if err != nil {
+			return err
+		}
+
+		result, err = repository.Create(ctx, data)
+		if err != nil {
+			return err
+		}
+
+		return scoped.record(ctx, model.TaskActionCreate, model.Task{}, result)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.Create", slog.String("error", err.Error()))
+		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (t *Task) createSeries(ctx context.Context, data model.Task) (model.Task, error) {
+	result := model.Task{}
+	series := model.TaskSeries{}
+	err := t.taskRepository.WithTransaction(ctx, func(repository task.TaskRepository) error {
+		scoped := *t
+		scoped.taskRepository = repository
+
+		var err error
+		data.Position, err = scoped.position(ctx, laneOf(data, data.Status))
+		if err != nil {
+			return err
+		}
+
+		result, err = repository.Create(ctx, data)
+		if err != nil {
+			return err
+		}
+
+		series, err = repository.CreateSeries(ctx, model.TaskSeries{
+			Rule:        data.Recurrence,
+			DTStart:     *data.DueAt,
+			Title:       data.Title,
+			Description: data.Description,
+			Priority:    data.Priority,
+			ProjectID:   data.ProjectID,
+			WorkspaceID: data.WorkspaceID,
+			AssigneeID:  data.AssigneeID,
+			UserID:      data.UserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = repository.CreateOccurrence(ctx, model.TaskOccurrence{TaskID: result.ID, SeriesID: series.ID, Occurrence: 1, ScheduledAt: *data.DueAt})
+		if err != nil {
+			return err
+		}
+
+		return scoped.record(ctx, model.TaskActionCreate, model.Task{}, result)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.CreateSeries", slog.String("error", err.Error()))
+		return model.Task{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	result.Recurrence, result.SeriesID, result.Occurrence = series.Rule, &series.ID, 1
+
+	return result, nil
+}
+
+func (t *Task) save(ctx context.Context, action string, before model.Task, fn func(scoped *Task) (model.Task, error)) (model.Task, error) {
+	result := model.Task{}
+	err := t.taskRepository.WithTransaction(ctx, func(repository task.TaskRepository) error {
+		scoped := *t
+		scoped.taskRepository = repository
+
+		var err error
+		result, err = fn(&scoped)
+		if err != nil {
+			return err
+		}
+
+		err = scoped.record(ctx, action, before, result)
+		if err != nil {
+			return err
+		}
+
+		if result.Status == before.Status || result.Status != model.TaskStatusDone {
+			return nil
+		}
+
+		return scoped.recur(ctx, result)
+	})
+
+	return result, err
+}
+
+func (t *Task) record(ctx context.Context, action string, before, after model.Task) error {
+	changes := diff(before, after, action == model.TaskActionCreate)
+	if len(changes) == 0 && (action == model.TaskActionUpdate || action == model.TaskActionTransition) {
+		return nil
+	}
+
+	actorId, _ := ctx.Value(auth.IdKey).(int64)
+	_, err := t.taskRepository.CreateHistory(ctx, model.TaskHistory{
+		TaskID:  after.ID,
+		Action:  action,
+		ActorID: actorId,
+		Changes: changes,
+	})
+
+	return err
+}
+
+func (t *Task) recur(ctx context.Context, done model.Task) error {
+	occurrence, err := t.taskRepository.GetOccurrence(ctx, done.ID)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if occurrence.Latest > occurrence.Occurrence {
+		return nil
+	}
+
+	series, err := t.taskRepository.GetSeries(ctx, occurrence.SeriesID)
+	if err != nil {
+		return err
+	}
+
+	rule, ok, err := seriesRule(series)
+	if err != nil || !ok {
+		return err
+	}
+
+	after := occurrence.ScheduledAt
+	if series.DTStart.After(after) {
+		after = series.DTStart
+	}
+
+	next, ok := rule.Next(series.DTStart, after)
+	if !ok {
+		return nil
+	}
+
+	position, err := t.position(ctx, model.TaskLane{WorkspaceID: series.WorkspaceID, UserID: series.UserID, Status: t.workflow.Initial()})
+	if err != nil {
+		return err
+	}
+
+	created, err := t.taskRepository.Create(ctx, model.Task{
+		Title:       series.Title,
+		Description: series.Description,
+		Status:      t.workflow.Initial(),
+		Priority:    series.Priority,
+		DueAt:       &next,
+		ParentID:    done.ParentID,
+		ProjectID:   series.ProjectID,
+		WorkspaceID: series.WorkspaceID,
+		AssigneeID:  series.AssigneeID,
+		AssignedAt:  assignedAt(series.AssigneeID),
+		Position:    position,
+		UserID:      series.UserID,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = t.taskRepository.CreateOccurrence(ctx, model.TaskOccurrence{TaskID: created.ID, SeriesID: series.ID, Occurrence: occurrence.Occurrence + 1, ScheduledAt: next})
+	if err != nil {
+		return err
+	}
+
+	return t.record(ctx, model.TaskActionCreate, model.Task{}, created)
+}
+
+func (t *Task) reschedule(ctx context.Context, series model.TaskSeries, occurrence model.TaskOccurrence, dueAt time.Time, rescheduled bool, userId int64) error {
+	future, err := t.taskRepository.GetFutureOccurrences(ctx, series.ID, occurrence.Occurrence, userId)
+	if err != nil {
+		return err
+	}
+
+	rule, ok, err := seriesRule(series)
+	if err != nil {
+		return err
+	}
+
+	for _, check := range future {
+		data := check
+		data.Title, data.Description, data.Priority = series.Title, series.Description, series.Priority
+		data.ProjectID, data.AssigneeID = series.ProjectID, series.AssigneeID
+		if rescheduled {
+			next, found := time.Time{}, false
+			if ok {
+				next, found = rule.Next(series.DTStart, dueAt)
+			}
+
+			if !found {
+				err = t.taskRepository.Delete(ctx, check.ID, userId, check.Version)
+				if err != nil {
+					return err
+				}
+
+				err = t.record(ctx, model.TaskActionDelete, check, check)
+				if err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			err = t.taskRepository.UpdateOccurrence(ctx, model.TaskOccurrence{TaskID: check.ID, SeriesID: series.ID, ScheduledAt: next})
+			if err != nil {
+				return err
+			}
+
+			data.DueAt, dueAt = &next, next
+		}
+
+		_, err = t.update(ctx, model.TaskActionUpdate, check, data, userId)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *Task) enrich(ctx context.Context, tasks []model.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(tasks))
+	for i := range tasks {
+		ids = append(ids, tasks[i].ID)
+	}
+
+	progress, err := t.taskRepository.Progress(ctx, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.Progress", slog.String("error", err.Error()))
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	labels, err := t.taskRepository.GetLabels(ctx, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetLabels", slog.String("error", err.Error()))
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	comments, err := t.taskRepository.CountComments(ctx, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.CountComments", slog.String("error", err.Error()))
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	checklists, err := t.taskRepository.ChecklistSummary(ctx, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.ChecklistSummary", slog.String("error", err.Error()))
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	occurrences, err := t.taskRepository.GetOccurrences(ctx, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetOccurrences", slog.String("error", err.Error()))
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	tracked, err := t.taskRepository.TrackedTime(ctx, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.TrackedTime", slog.String("error", err.Error()))
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	for i := range tasks {
+		if occurrence, ok := occurrences[tasks[i].ID]; ok {
+			tasks[i].Recurrence, tasks[i].SeriesID, tasks[i].Occurrence = occurrence.Rule, &occurrence.SeriesID, occurrence.Occurrence
+		}
+
+		rollup := progress[tasks[i].ID]
+		tasks[i].Progress = &rollup
+		tasks[i].Labels = labels[tasks[i].ID]
+		tasks[i].CommentCount = comments[tasks[i].ID]
+		tasks[i].ChecklistDone = checklists[tasks[i].ID].Done
+		tasks[i].ChecklistTotal = checklists[tasks[i].ID].Total
+		tasks[i].TrackedSeconds = tracked[tasks[i].ID]
+	}
+
+	return nil
+}
+
+func assignedAt(assigneeId *int64) *time.Time {
+	if assigneeId == nil {
+		return nil
+	}
+
+	now := time.Now()
+	return &now
+}
+
+func revertConflicts(entries []model.TaskHistory) []string {
+	touched := map[string]bool{}
+	conflicted := map[string]bool{}
+	for i := len(entries) - 1; i >= 0; i-- {
+		for _, change := range entries[i].Changes {
+			if touched[change.Field] {
+				conflicted[change.Field] = true
+			}
+
+			touched[change.Field] = true
+		}
+	}
+
+	result := []string{}
+	for _, field := range model.TaskHistoryFields {
+		if conflicted[field] {
+			result = append(result, field)
+		}
+	}
+
+	return result
+}
+
+func laneOf(data model.Task, status string) model.TaskLane {
+	return model.TaskLane{WorkspaceID: data.WorkspaceID, UserID: data.UserID, Status: status}
+}
+
+func seriesRule(series model.TaskSeries) (rrule.Rule, bool, error) {
+	rule, err := rrule.Parse(series.Rule)
+	if err != nil {
+		return rrule.Rule{}, false, err
+	}
+
+	if rule.Count > 0 {
+		rule.Count -= series.Offset
+		if rule.Count < 1 {
+			return rrule.Rule{}, false, nil
+		}
+	}
+
+	return rule, true, nil
+}
+
+func diff(before, after model.Task, created bool) model.TaskChanges {
+	result := model.TaskChanges{}
+	for _, field := range model.TaskHistoryFields {
+		old, _ := json.Marshal(historyValue(before, field))
+		if created {
+			old = []byte("null")
+		}
+
+		new, _ := json.Marshal(historyValue(after, field))
+		if bytes.Equal(old, new) {
+			continue
+		}
+
+		result = append(result, model.TaskChange{Field: field, Old: old, New: new})
+	}
+
+	return result
+}
+
+func historyValue(task model.Task, field string) any {
+	switch field {
+	case "title":
+		return task.Title
+	case "description":
+		return task.Description
+	case "status":
+		return task.Status
+	case "priority":
+		return task.Priority
+	case "due_at":
+		if task.DueAt == nil {
+			return nil
+		}
+
+		return task.DueAt.UTC().Truncate(time.Microsecond)
+	case "parent_id":
+		return task.ParentID
+	case "project_id":
+		return task.ProjectID
+	case "assignee_id":
+		return task.AssigneeID
+	case "estimate_minutes":
+		return task.EstimateMinutes
+	case "story_points":
+		return task.StoryPoints
+	default:
+		return nil
+	}
+}
+
+func revertValue(task *model.Task, change model.TaskChange) error {
+	switch change.Field {
+	case "title":
+		return json.Unmarshal(change.Old, &task.Title)
+	case "description":
+		return json.Unmarshal(change.Old, &task.Description)
+	case "status":
+		return json.Unmarshal(change.Old, &task.Status)
+	case "priority":
+		return json.Unmarshal(change.Old, &task.Priority)
+	case "due_at":
+		task.DueAt = nil
+		return json.Unmarshal(change.Old, &task.DueAt)
+	case "parent_id":
+		task.ParentID = nil
+		return json.Unmarshal(change.Old, &task.ParentID)
+	case "project_id":
+		task.ProjectID = nil
+		return json.Unmarshal(change.Old, &task.ProjectID)
+	case "assignee_id":
+		task.AssigneeID = nil
+		return json.Unmarshal(change.Old, &task.AssigneeID)
+	case "estimate_minutes":
+		task.EstimateMinutes = nil
+		return json.Unmarshal(change.Old, &task.EstimateMinutes)
+	case "story_points":
+		task.StoryPoints = nil
+		return json.Unmarshal(change.Old, &task.StoryPoints)
+	default:
+		return nil
+	}
+}
+
+func burndownValue(task model.Task, metric string) int64 {
+	value := task.StoryPoints
+	if metric == model.TaskBurndownMetricMinutes {
+		value = task.EstimateMinutes
+	}
+
+	if value == nil {
+		return 0
+	}
+
+	return int64(*value)
+}
+
+func sameID(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func isVersionConflict(err error) bool {
+	return err == task.ErrVersionConflict
+}
+
+func (t *Task) paginate(ctx context.Context, userId int64, param *param.Param, result []model.Task) ([]model.Task, error) {
+	if !param.CursorMode {
+		return result, nil
+	}
+
+	hasNext := param.Total > int64(len(result))
+	hasPrev := false
+	if keyset := param.Keyset; keyset != nil {
+		more := len(result) > param.Limit
+		if more {
+			result = result[:param.Limit]
+		}
+
+		if keyset.Backward {
+			slices.Reverse(result)
+		}
+
+		if len(result) == 0 {
+			return result, nil
+		}
+
+		var err error
+		if keyset.Backward {
+			hasPrev = more
+			hasNext, err = t.adjacent(ctx, userId, *param, result[len(result)-1], false)
+		} else {
+			hasNext = more
+			hasPrev, err = t.adjacent(ctx, userId, *param, result[0], true)
+		}
+		if err != nil {
+			return []model.Task{}, err
+		}
+	}
+
+	if len(result) == 0 {
+		return result, nil
+	}
+
+	if hasNext {
+		param.NextCursor = cursor.Encode(keysetOf(param.Sort, result[len(result)-1], false), t.cursorSecret)
+	}
+
+	if hasPrev {
+		param.PrevCursor = cursor.Encode(keysetOf(param.Sort, result[0], true), t.cursorSecret)
+	}
+
+	return result, nil
+}
+
+func (t *Task) adjacent(ctx context.Context, userId int64, param param.Param, edge model.Task, backward bool) (bool, error) {
+	keyset := keysetOf(param.Sort, edge, backward)
+	param.Keyset = &keyset
+	param.Limit = 1
+
+	result, err := t.taskRepository.GetByUserID(ctx, userId, param)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Task] error when call taskRepository.GetByUserID", slog.String("error", err.Error()))
+		return false, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return len(result) > 0, nil
+}
+
+func keysetOf(sort string, task model.Task, backward bool) cursor.Cursor {
+	return cursor.Cursor{
+		Sort:     sort,
+		Value:    sortValue(strings.TrimPrefix(sort, "-"), task),
+		ID:       task.ID,
+		Backward: backward,
+	}
+}
+
+func sortValue(field string, task model.Task) string {
+	switch field {
+	case "title":
+		return task.Title
+	case "status":
+		return task.Status
+	case "priority":
+		return strconv.Itoa(model.TaskPriorityRank[task.Priority])
+	case "due_at":
+		if task.DueAt == nil {
+			return "infinity"
+		}
+
+		return task.DueAt.Format(time.RFC3339Nano)
+	case "created_at":
+		return task.CreatedAt.Format(time.RFC3339Nano)
+	case "updated_at":
+		return task.UpdatedAt.Format(time.RFC3339Nano)
+	case "deleted_at":
+		if task.DeletedAt == nil {
+			return "infinity"
+		}
+
+		return task.DeletedAt.Format(time.RFC3339Nano)
+	case "assigned_at":
+		if task.AssignedAt == nil {
+			return "infinity"
+		}
+
+		return task.AssignedAt.Format(time.RFC3339Nano)
+	case "position":
+		return task.Position
+	default:
+		return strconv.FormatInt(task.ID, 10)
+	}
+}
@@ -5,12 +5,18 @@ import (
 	"database/sql"
 	"errors"
 	"net/http"
+	"slices"
 	"testing"
+	"time"
 
+	"github.com/rzfhlv/go-task/config"
 	"github.com/rzfhlv/go-task/internal/model"
+	taskrepository "github.com/rzfhlv/go-task/internal/repository/task"
 	taskmocks "github.com/rzfhlv/go-task/internal/repository/task/mocks"
 	"github.com/rzfhlv/go-task/internal/usecase/task"
+	"github.com/rzfhlv/go-task/pkg/cursor"
 	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/etag"
 	"github.com/rzfhlv/go-task/pkg/middleware/auth"
 	"github.com/rzfhlv/go-task/pkg/param"
 	"github.com/stretchr/testify/assert"
@@ -39,6 +45,7 @@ func TestTaskCreate(t *testing.T) {
 
 	tests := []struct {
 		name       string
+		request    func(task model.Task) model.Task
 		reqContext func(ctx context.Context) context.Context
 		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
 		wantResult model.Task
@@ -92,6 +99,36 @@ func TestTaskCreate(t *testing.T) {
 			wantResult: model.Task{},
 			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
 		},
+		{
+			name: "success append to end of status column",
+			reqContext: func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, auth.IdKey, int64(1))
+				return ctx
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("LockPositions", mock.Anything, model.TaskLane{UserID: 1, Status: "todo"}).Return(nil)
+				taskRepository.On("LastPosition", mock.Anything, model.TaskLane{UserID: 1, Status: "todo"}).Return("V00009", nil)
+				taskRepository.On("Create", mock.Anything, mock.MatchedBy(func(task model.Task) bool {
+					return task.Position == "V0000A"
+				})).Return(taskModel, nil)
+			},
+			wantResult: taskModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when get last position",
+			reqContext: func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, auth.IdKey, int64(1))
+				return ctx
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("LockPositions", mock.Anything, model.TaskLane{UserID: 1, Status: "todo"}).Return(nil)
+				taskRepository.On("LastPosition", mock.Anything, model.TaskLane{UserID: 1, Status: "todo"}).Return("", errors.New("some error"))
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
 		{
 			name: "error when get user id from context",
 			reqContext: func(ctx context.Context) context.Context {
@@ -104,6 +141,42 @@ func TestTaskCreate(t *testing.T) {
 			wantResult: model.Task{},
 			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
 		},
+		{
+			name: "success with default status",
+			request: func(task model.Task) model.Task {
+				task.Status = ""
+				return task
+			},
+			reqContext: func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, auth.IdKey, int64(1))
+				return ctx
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("Create", mock.Anything, mock.MatchedBy(func(task model.Task) bool {
+					return task.Status == model.TaskStatusTodo &&
+						task.Priority == model.TaskPriorityMedium &&
+						task.UserID == int64(1)
+				})).Return(taskModel, nil)
+			},
+			wantResult: taskModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when status is unknown",
+			request: func(task model.Task) model.Task {
+				task.Status = "finished"
+				return task
+			},
+			reqContext: func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, auth.IdKey, int64(1))
+				return ctx
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "invalid status"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -114,9 +187,20 @@ func TestTaskCreate(t *testing.T) {
 			ctx = tt.reqContext(ctx)
 
 			tt.mockDeps(&taskRepository)
+			taskRepository.On("WithTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(repository taskrepository.TaskRepository) error) error {
+				return fn(&taskRepository)
+			}).Maybe()
+			taskRepository.On("CreateHistory", mock.Anything, mock.Anything).Return(model.TaskHistory{}, nil).Maybe()
+			taskRepository.On("LockPositions", mock.Anything, mock.Anything).Return(nil).Maybe()
+			taskRepository.On("LastPosition", mock.Anything, mock.Anything).Return("V", nil).Maybe()
 
-			usecase := task.New(&taskRepository)
-			result, err := usecase.Create(ctx, createRequest)
+			request := createRequest
+			if tt.request != nil {
+				request = tt.request(request)
+			}
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.Create(ctx, request)
 
 			assert.Equal(t, tt.wantResult, result)
 			assert.Equal(t, tt.wantErr, err)
@@ -126,6 +210,7 @@ func TestTaskCreate(t *testing.T) {
 
 func TestTaskGetByUserID(t *testing.T) {
 	userId := int64(1)
+	seriesId := int64(5)
 	paramReq := param.Param{
 		Page:  1,
 		Limit: 1,
@@ -161,12 +246,118 @@ func TestTaskGetByUserID(t *testing.T) {
 					return requestUserId == userId
 				}), mock.MatchedBy(func(param param.Param) bool {
 					return param.Page == paramReq.Page && param.Limit == paramReq.Limit
-				})).Return(tasks, nil)
+				})).Return(slices.Clone(tasks), nil)
 
-				taskRepository.On("Count", mock.Anything).Return(int64(2), nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(2), nil)
+				taskRepository.On("Progress", mock.Anything, []int64{1, 2}).Return(map[int64]model.TaskProgress{1: {Done: 1, Total: 2}}, nil)
+				taskRepository.On("GetLabels", mock.Anything, []int64{1, 2}).Return(map[int64][]model.Label{1: {{ID: 1, Name: "urgent", Colour: "#ff0000"}}}, nil)
+				taskRepository.On("CountComments", mock.Anything, []int64{1, 2}).Return(map[int64]int64{2: 3}, nil)
+				taskRepository.On("ChecklistSummary", mock.Anything, []int64{1, 2}).Return(map[int64]model.TaskChecklistSummary{1: {Done: 1, Total: 3}}, nil)
+				taskRepository.On("GetOccurrences", mock.Anything, []int64{1, 2}).Return(map[int64]model.TaskOccurrence{2: {TaskID: 2, SeriesID: 5, Occurrence: 3, Rule: "FREQ=WEEKLY"}}, nil)
+				taskRepository.On("TrackedTime", mock.Anything, []int64{1, 2}).Return(map[int64]int64{1: 5400}, nil)
 			},
-			wantResult: tasks,
-			wantErr:    nil,
+			wantResult: []model.Task{
+				{
+					ID:             1,
+					Title:          "Unit Test",
+					Description:    "for completness",
+					Status:         "todo",
+					UserID:         1,
+					Progress:       &model.TaskProgress{Done: 1, Total: 2},
+					Labels:         []model.Label{{ID: 1, Name: "urgent", Colour: "#ff0000"}},
+					ChecklistDone:  1,
+					ChecklistTotal: 3,
+					TrackedSeconds: 5400,
+				},
+				{
+					ID:           2,
+					Title:        "Code Review",
+					Description:  "for completness",
+					Status:       "todo",
+					UserID:       1,
+					Progress:     &model.TaskProgress{},
+					CommentCount: 3,
+					Recurrence:   "FREQ=WEEKLY",
+					SeriesID:     &seriesId,
+					Occurrence:   3,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when get progress task to repository",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.Anything).Return(slices.Clone(tasks), nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(2), nil)
+				taskRepository.On("Progress", mock.Anything, []int64{1, 2}).Return(map[int64]model.TaskProgress{}, errors.New("some error"))
+			},
+			wantResult: []model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when get labels task to repository",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.Anything).Return(slices.Clone(tasks), nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(2), nil)
+				taskRepository.On("Progress", mock.Anything, []int64{1, 2}).Return(map[int64]model.TaskProgress{}, nil)
+				taskRepository.On("GetLabels", mock.Anything, []int64{1, 2}).Return(map[int64][]model.Label{}, errors.New("some error"))
+			},
+			wantResult: []model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when count comments task to repository",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.Anything).Return(slices.Clone(tasks), nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(2), nil)
+				taskRepository.On("Progress", mock.Anything, []int64{1, 2}).Return(map[int64]model.TaskProgress{}, nil)
+				taskRepository.On("GetLabels", mock.Anything, []int64{1, 2}).Return(map[int64][]model.Label{}, nil)
+				taskRepository.On("CountComments", mock.Anything, []int64{1, 2}).Return(map[int64]int64{}, errors.New("some error"))
+			},
+			wantResult: []model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when get checklist summary task to repository",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.Anything).Return(slices.Clone(tasks), nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(2), nil)
+				taskRepository.On("Progress", mock.Anything, []int64{1, 2}).Return(map[int64]model.TaskProgress{}, nil)
+				taskRepository.On("GetLabels", mock.Anything, []int64{1, 2}).Return(map[int64][]model.Label{}, nil)
+				taskRepository.On("CountComments", mock.Anything, []int64{1, 2}).Return(map[int64]int64{}, nil)
+				taskRepository.On("ChecklistSummary", mock.Anything, []int64{1, 2}).Return(map[int64]model.TaskChecklistSummary{}, errors.New("some error"))
+			},
+			wantResult: []model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when get occurrences task to repository",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.Anything).Return(slices.Clone(tasks), nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(2), nil)
+				taskRepository.On("Progress", mock.Anything, []int64{1, 2}).Return(map[int64]model.TaskProgress{}, nil)
+				taskRepository.On("GetLabels", mock.Anything, []int64{1, 2}).Return(map[int64][]model.Label{}, nil)
+				taskRepository.On("CountComments", mock.Anything, []int64{1, 2}).Return(map[int64]int64{}, nil)
+				taskRepository.On("ChecklistSummary", mock.Anything, []int64{1, 2}).Return(map[int64]model.TaskChecklistSummary{}, nil)
+				taskRepository.On("GetOccurrences", mock.Anything, []int64{1, 2}).Return(map[int64]model.TaskOccurrence{}, errors.New("some error"))
+			},
+			wantResult: []model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when get tracked time task to repository",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.Anything).Return(slices.Clone(tasks), nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(2), nil)
+				taskRepository.On("Progress", mock.Anything, []int64{1, 2}).Return(map[int64]model.TaskProgress{}, nil)
+				taskRepository.On("GetLabels", mock.Anything, []int64{1, 2}).Return(map[int64][]model.Label{}, nil)
+				taskRepository.On("CountComments", mock.Anything, []int64{1, 2}).Return(map[int64]int64{}, nil)
+				taskRepository.On("ChecklistSummary", mock.Anything, []int64{1, 2}).Return(map[int64]model.TaskChecklistSummary{}, nil)
+				taskRepository.On("GetOccurrences", mock.Anything, []int64{1, 2}).Return(map[int64]model.TaskOccurrence{}, nil)
+				taskRepository.On("TrackedTime", mock.Anything, []int64{1, 2}).Return(map[int64]int64{}, errors.New("some error"))
+			},
+			wantResult: []model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
 		},
 		{
 			name: "error when get count task to repository",
@@ -177,7 +368,7 @@ func TestTaskGetByUserID(t *testing.T) {
 					return param.Page == paramReq.Page && param.Limit == paramReq.Limit
 				})).Return(tasks, nil)
 
-				taskRepository.On("Count", mock.Anything).Return(int64(0), errors.New("some error"))
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(0), errors.New("some error"))
 			},
 			wantResult: []model.Task{},
 			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
@@ -191,7 +382,7 @@ func TestTaskGetByUserID(t *testing.T) {
 					return param.Page == paramReq.Page && param.Limit == paramReq.Limit
 				})).Return([]model.Task{}, nil)
 
-				taskRepository.On("Count", mock.Anything).Return(int64(2), nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(2), nil)
 			},
 			wantResult: []model.Task{},
 			wantErr:    nil,
@@ -210,6 +401,16 @@ func TestTaskGetByUserID(t *testing.T) {
 			wantResult: []model.Task{},
 			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
 		},
+		{
+			name: "error when sort field is invalid",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.Anything).Return([]model.Task{}, taskrepository.ErrInvalidSort)
+
+				taskRepository.AssertNotCalled(t, "Count")
+			},
+			wantResult: []model.Task{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "invalid sort field"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -218,7 +419,7 @@ func TestTaskGetByUserID(t *testing.T) {
 
 			tt.mockDeps(&taskRepository)
 
-			usecase := task.New(&taskRepository)
+			usecase := task.New(&taskRepository, &config.Configuration{})
 			result, err := usecase.GetByUserID(context.Background(), userId, &paramReq)
 
 			assert.Equal(t, tt.wantResult, result)
@@ -227,6 +428,237 @@ func TestTaskGetByUserID(t *testing.T) {
 	}
 }
 
+func TestTaskGetByUserIDCursor(t *testing.T) {
+	userId := int64(1)
+	secret := "verysecret"
+	updatedAt := time.Date(2023, time.August, 15, 12, 0, 0, 0, time.UTC)
+
+	first := model.Task{ID: 1, Title: "Unit Test", Status: "todo", UserID: userId, UpdatedAt: updatedAt, Progress: &model.TaskProgress{}}
+	second := model.Task{ID: 2, Title: "Code Review", Status: "todo", UserID: userId, UpdatedAt: updatedAt, Progress: &model.TaskProgress{}}
+
+	tests := []struct {
+		name       string
+		param      param.Param
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult []model.Task
+		wantNext   *cursor.Cursor
+		wantPrev   *cursor.Cursor
+		wantErr    error
+	}{
+		{
+			name:  "success page mode issues no cursors",
+			param: param.Param{Page: 1, Limit: 1},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.Anything).Return([]model.Task{first}, nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(2), nil)
+			},
+			wantResult: []model.Task{first},
+			wantNext:   nil,
+			wantPrev:   nil,
+			wantErr:    nil,
+		},
+		{
+			name:  "success first cursor page issues next cursor only",
+			param: param.Param{Page: 3, Limit: 1, CursorMode: true},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.MatchedBy(func(p param.Param) bool {
+					return p.Page == 1 && p.Keyset == nil
+				})).Return([]model.Task{first}, nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(2), nil)
+			},
+			wantResult: []model.Task{first},
+			wantNext:   &cursor.Cursor{Value: "1", ID: 1},
+			wantPrev:   nil,
+			wantErr:    nil,
+		},
+		{
+			name:  "success forward cursor trims extra row",
+			param: param.Param{Page: 1, Limit: 1, Cursor: cursor.Encode(cursor.Cursor{Sort: "-updated_at", Value: "x", ID: 3}, secret)},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.MatchedBy(func(p param.Param) bool {
+					return p.Sort == "-updated_at" && p.Keyset != nil && p.Keyset.ID == 3
+				})).Return([]model.Task{second, first}, nil)
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.MatchedBy(func(p param.Param) bool {
+					return p.Limit == 1 && p.Keyset != nil && p.Keyset.ID == 2 && p.Keyset.Backward
+				})).Return([]model.Task{{ID: 3}}, nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(3), nil)
+			},
+			wantResult: []model.Task{second},
+			wantNext:   &cursor.Cursor{Sort: "-updated_at", Value: "2023-08-15T12:00:00Z", ID: 2},
+			wantPrev:   &cursor.Cursor{Sort: "-updated_at", Value: "2023-08-15T12:00:00Z", ID: 2, Backward: true},
+			wantErr:    nil,
+		},
+		{
+			name:  "success forward cursor omits prev cursor without earlier rows",
+			param: param.Param{Page: 1, Limit: 1, Cursor: cursor.Encode(cursor.Cursor{Value: "1", ID: 1}, secret)},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.MatchedBy(func(p param.Param) bool {
+					return p.Keyset != nil && p.Keyset.ID == 1
+				})).Return([]model.Task{second}, nil)
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.MatchedBy(func(p param.Param) bool {
+					return p.Keyset != nil && p.Keyset.ID == 2 && p.Keyset.Backward
+				})).Return([]model.Task{}, nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(2), nil)
+			},
+			wantResult: []model.Task{second},
+			wantNext:   nil,
+			wantPrev:   nil,
+			wantErr:    nil,
+		},
+		{
+			name:  "success backward cursor restores order",
+			param: param.Param{Page: 1, Limit: 2, Cursor: cursor.Encode(cursor.Cursor{Value: "3", ID: 3, Backward: true}, secret)},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.MatchedBy(func(p param.Param) bool {
+					return p.Keyset != nil && p.Keyset.ID == 3
+				})).Return([]model.Task{second, first}, nil)
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.MatchedBy(func(p param.Param) bool {
+					return p.Limit == 1 && p.Keyset != nil && p.Keyset.ID == 2 && !p.Keyset.Backward
+				})).Return([]model.Task{{ID: 3}}, nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(3), nil)
+			},
+			wantResult: []model.Task{first, second},
+			wantNext:   &cursor.Cursor{Value: "2", ID: 2},
+			wantPrev:   nil,
+			wantErr:    nil,
+		},
+		{
+			name:  "error when probe for adjacent rows fails",
+			param: param.Param{Page: 1, Limit: 1, Cursor: cursor.Encode(cursor.Cursor{Value: "1", ID: 1}, secret)},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.MatchedBy(func(p param.Param) bool {
+					return p.Keyset != nil && p.Keyset.ID == 1
+				})).Return([]model.Task{second}, nil)
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.Anything).Return([]model.Task{}, errors.New("some error"))
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(2), nil)
+			},
+			wantResult: []model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:  "error when cursor mode uses multiple sort fields",
+			param: param.Param{Page: 1, Limit: 1, Sort: "-updated_at,title", CursorMode: true},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "GetByUserID")
+			},
+			wantResult: []model.Task{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "cursor pagination supports a single sort field"),
+		},
+		{
+			name:  "error when cursor is invalid",
+			param: param.Param{Page: 1, Limit: 1, Cursor: cursor.Encode(cursor.Cursor{ID: 3}, "anothersecret")},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "GetByUserID")
+			},
+			wantResult: []model.Task{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "invalid cursor"),
+		},
+		{
+			name:  "error when label mode is invalid",
+			param: param.Param{Page: 1, Limit: 1, Labels: "urgent", LabelMode: "some"},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "GetByUserID")
+			},
+			wantResult: []model.Task{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "invalid label mode"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			tt.mockDeps(&taskRepository)
+			taskRepository.On("Progress", mock.Anything, mock.Anything).Return(map[int64]model.TaskProgress{}, nil).Maybe()
+			taskRepository.On("GetLabels", mock.Anything, mock.Anything).Return(map[int64][]model.Label{}, nil).Maybe()
+			taskRepository.On("CountComments", mock.Anything, mock.Anything).Return(map[int64]int64{}, nil)
+			taskRepository.On("ChecklistSummary", mock.Anything, mock.Anything).Return(map[int64]model.TaskChecklistSummary{}, nil)
+			taskRepository.On("GetOccurrences", mock.Anything, mock.Anything).Return(map[int64]model.TaskOccurrence{}, nil)
+			taskRepository.On("TrackedTime", mock.Anything, mock.Anything).Return(map[int64]int64{}, nil)
+
+			usecase := task.New(&taskRepository, &config.Configuration{App: config.AppConfiguration{CursorSecret: secret}})
+			result, err := usecase.GetByUserID(context.Background(), userId, &tt.param)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+
+			for _, c := range []struct {
+				token string
+				want  *cursor.Cursor
+			}{{tt.param.NextCursor, tt.wantNext}, {tt.param.PrevCursor, tt.wantPrev}} {
+				if c.want == nil {
+					assert.Empty(t, c.token)
+					continue
+				}
+
+				decoded, err := cursor.Decode(c.token, secret)
+				assert.NoError(t, err)
+				assert.Equal(t, *c.want, decoded)
+			}
+		})
+	}
+}
+
+func TestTaskAuthorize(t *testing.T) {
+	taskId := int64(1)
+	userId := int64(1)
+	workspaceId := int64(4)
+	taskModel := model.Task{ID: taskId, Title: "Unit Test", Status: model.TaskStatusTodo, WorkspaceID: &workspaceId, UserID: 2, Version: 1}
+
+	tests := []struct {
+		name       string
+		role       string
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult model.Task
+		wantErr    error
+	}{
+		{
+			name: "success with sufficient role",
+			role: model.WorkspaceRoleEditor,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return(model.WorkspaceRoleEditor, nil)
+			},
+			wantResult: taskModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when role is insufficient",
+			role: model.WorkspaceRoleOwner,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return(model.WorkspaceRoleEditor, nil)
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "insufficient workspace role"),
+		},
+		{
+			name: "error when task is not found",
+			role: model.WorkspaceRoleEditor,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{}, sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "GetRole")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			tt.mockDeps(&taskRepository)
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.Authorize(context.WithValue(context.Background(), auth.IdKey, userId), taskId, tt.role)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
 func TestTaskGetByID(t *testing.T) {
 	taskId := int64(1)
 	userId := int64(1)
@@ -239,6 +671,17 @@ func TestTaskGetByID(t *testing.T) {
 		UserID:      userId,
 	}
 
+	checklist := []model.TaskChecklistItem{
+		{ID: 1, TaskID: taskId, Text: "write tests", Checked: true, Position: 1},
+		{ID: 2, TaskID: taskId, Text: "review", Position: 2},
+	}
+
+	taskWithProgress := taskModel
+	taskWithProgress.Progress = &model.TaskProgress{Done: 1, Total: 3}
+	taskWithProgress.Checklist = checklist
+	taskWithProgress.ChecklistDone = 1
+	taskWithProgress.ChecklistTotal = 2
+
 	tests := []struct {
 		name       string
 		reqContext func(ctx context.Context) context.Context
@@ -258,8 +701,16 @@ func TestTaskGetByID(t *testing.T) {
 				}), mock.MatchedBy(func(uid int64) bool {
 					return uid == userId
 				})).Return(taskModel, nil)
+
+				taskRepository.On("Progress", mock.Anything, []int64{taskId}).Return(map[int64]model.TaskProgress{taskId: {Done: 1, Total: 3}}, nil)
+				taskRepository.On("GetLabels", mock.Anything, []int64{taskId}).Return(map[int64][]model.Label{}, nil)
+				taskRepository.On("CountComments", mock.Anything, []int64{taskId}).Return(map[int64]int64{}, nil)
+				taskRepository.On("ChecklistSummary", mock.Anything, []int64{taskId}).Return(map[int64]model.TaskChecklistSummary{taskId: {Done: 1, Total: 2}}, nil)
+				taskRepository.On("GetOccurrences", mock.Anything, []int64{taskId}).Return(map[int64]model.TaskOccurrence{}, nil)
+				taskRepository.On("TrackedTime", mock.Anything, []int64{taskId}).Return(map[int64]int64{}, nil)
+				taskRepository.On("GetChecklist", mock.Anything, taskId).Return(checklist, nil)
 			},
-			wantResult: taskModel,
+			wantResult: taskWithProgress,
 			wantErr:    nil,
 		},
 		{
@@ -317,7 +768,7 @@ func TestTaskGetByID(t *testing.T) {
 
 			tt.mockDeps(&taskRepository)
 
-			usecase := task.New(&taskRepository)
+			usecase := task.New(&taskRepository, &config.Configuration{})
 			result, err := usecase.GetByID(ctx, taskId)
 
 			assert.Equal(t, tt.wantResult, result)
@@ -342,11 +793,15 @@ func TestTaskUpdate(t *testing.T) {
 		Title:       "Unit Test",
 		Description: "for completness",
 		Status:      "todo",
+		Priority:    "high",
 		UserID:      userId,
+		Version:     2,
 	}
 
 	tests := []struct {
 		name       string
+		cfg        config.Configuration
+		request    func(task model.Task) model.Task
 		reqContext func(ctx context.Context) context.Context
 		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
 		wantResult model.Task
@@ -431,62 +886,249 @@ func TestTaskUpdate(t *testing.T) {
 			wantResult: model.Task{},
 			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			taskRepository := taskmocks.MockTaskRepository{}
-
-			ctx := context.Background()
-			ctx = tt.reqContext(ctx)
-
-			tt.mockDeps(&taskRepository)
-
-			usecase := task.New(&taskRepository)
-			result, err := usecase.Update(ctx, updateRequest)
-
-			assert.Equal(t, tt.wantResult, result)
-			assert.Equal(t, tt.wantErr, err)
-		})
-	}
-}
-
-func TestTaskDelete(t *testing.T) {
-	taskId := int64(1)
-	userId := int64(1)
-
-	taskModel := model.Task{
-		ID:          taskId,
-		Title:       "Unit Test",
-		Description: "for completness",
-		Status:      "todo",
-		UserID:      userId,
-	}
-
-	tests := []struct {
-		name       string
-		reqContext func(ctx context.Context) context.Context
-		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
-		wantErr    error
-	}{
 		{
-			name: "success",
+			name: "success keep current status and priority when empty",
+			request: func(task model.Task) model.Task {
+				task.Status = ""
+				task.Priority = ""
+				return task
+			},
 			reqContext: func(ctx context.Context) context.Context {
 				ctx = context.WithValue(ctx, auth.IdKey, userId)
 				return ctx
 			},
 			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
-				taskRepository.On("GetByID", mock.Anything, mock.MatchedBy(func(id int64) bool {
-					return id == taskId
-				}), mock.MatchedBy(func(uid int64) bool {
-					return uid == userId
-				})).Return(taskModel, nil)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+
+				taskRepository.On("Update", mock.Anything, mock.MatchedBy(func(ts model.Task) bool {
+					return ts.Status == taskModel.Status && ts.Priority == taskModel.Priority
+				}), userId).Return(taskModel, nil)
+			},
+			wantResult: taskModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when status transition is not allowed",
+			request: func(task model.Task) model.Task {
+				task.Status = model.TaskStatusDone
+				return task
+			},
+			reqContext: func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, auth.IdKey, userId)
+				return ctx
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+
+				taskRepository.AssertNotCalled(t, "Update")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusConflict, "cannot transition task from todo to done"),
+		},
+		{
+			name: "success with matching version",
+			request: func(task model.Task) model.Task {
+				task.IfMatch = etag.Exact(2)
+				return task
+			},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+
+				taskRepository.On("Update", mock.Anything, mock.MatchedBy(func(ts model.Task) bool {
+					return ts.Version == taskModel.Version
+				}), userId).Return(taskModel, nil)
+			},
+			wantResult: taskModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when version is stale",
+			request: func(task model.Task) model.Task {
+				task.IfMatch = etag.Exact(1)
+				return task
+			},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+
+				taskRepository.AssertNotCalled(t, "Update")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusPreconditionFailed, "task has been modified"),
+		},
+		{
+			name: "error when version is required",
+			cfg:  config.Configuration{Task: config.TaskConfiguration{RequireIfMatch: true}},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+
+				taskRepository.AssertNotCalled(t, "Update")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusPreconditionRequired, "if-match header is required"),
+		},
+		{
+			name: "success with wildcard when version is required",
+			cfg:  config.Configuration{Task: config.TaskConfiguration{RequireIfMatch: true}},
+			request: func(task model.Task) model.Task {
+				task.IfMatch = etag.Match{Present: true, Any: true}
+				return task
+			},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+
+				taskRepository.On("Update", mock.Anything, mock.MatchedBy(func(ts model.Task) bool {
+					return ts.Version == taskModel.Version
+				}), userId).Return(taskModel, nil)
+			},
+			wantResult: taskModel,
+			wantErr:    nil,
+		},
+		{
+			name: "success with version in list",
+			request: func(task model.Task) model.Task {
+				task.IfMatch = etag.Match{Present: true, Versions: []int64{1, 2}}
+				return task
+			},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+
+				taskRepository.On("Update", mock.Anything, mock.Anything, userId).Return(taskModel, nil)
+			},
+			wantResult: taskModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when only weak etags are given",
+			cfg:  config.Configuration{Task: config.TaskConfiguration{RequireIfMatch: true}},
+			request: func(task model.Task) model.Task {
+				task.IfMatch = etag.Match{Present: true, Versions: []int64{}}
+				return task
+			},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+
+				taskRepository.AssertNotCalled(t, "Update")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusPreconditionFailed, "task has been modified"),
+		},
+		{
+			name: "error when task is modified concurrently",
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+
+				taskRepository.On("Update", mock.Anything, mock.Anything, userId).Return(model.Task{}, taskrepository.ErrVersionConflict)
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusPreconditionFailed, "task has been modified"),
+		},
+		{
+			name: "error when task is removed concurrently",
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+
+				taskRepository.On("Update", mock.Anything, mock.Anything, userId).Return(model.Task{}, sql.ErrNoRows)
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.Background()
+			ctx = tt.reqContext(ctx)
+
+			tt.mockDeps(&taskRepository)
+			taskRepository.On("WithTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(repository taskrepository.TaskRepository) error) error {
+				return fn(&taskRepository)
+			}).Maybe()
+			taskRepository.On("CreateHistory", mock.Anything, mock.Anything).Return(model.TaskHistory{}, nil).Maybe()
+
+			request := updateRequest
+			if tt.request != nil {
+				request = tt.request(request)
+			}
+
+			usecase := task.New(&taskRepository, &tt.cfg)
+			result, err := usecase.Update(ctx, request)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskDelete(t *testing.T) {
+	taskId := int64(1)
+	userId := int64(1)
+
+	taskModel := model.Task{
+		ID:          taskId,
+		Title:       "Unit Test",
+		Description: "for completness",
+		Status:      "todo",
+		UserID:      userId,
+		Version:     2,
+	}
+
+	parentId := int64(5)
+	childModel := taskModel
+	childModel.ParentID = &parentId
+
+	tests := []struct {
+		name       string
+		cfg        config.Configuration
+		ifMatch    etag.Match
+		permanent  bool
+		reqContext func(ctx context.Context) context.Context
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			reqContext: func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, auth.IdKey, userId)
+				return ctx
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, mock.MatchedBy(func(id int64) bool {
+					return id == taskId
+				}), mock.MatchedBy(func(uid int64) bool {
+					return uid == userId
+				})).Return(taskModel, nil)
 
 				taskRepository.On("Delete", mock.Anything, mock.MatchedBy(func(id int64) bool {
 					return id == taskId
 				}), mock.MatchedBy(func(uid int64) bool {
 					return uid == userId
-				})).Return(nil)
+				}), taskModel.Version).Return(nil)
 			},
 			wantErr: nil,
 		},
@@ -507,7 +1149,7 @@ func TestTaskDelete(t *testing.T) {
 					return id == taskId
 				}), mock.MatchedBy(func(uid int64) bool {
 					return uid == userId
-				})).Return(errors.New("some error"))
+				}), taskModel.Version).Return(errors.New("some error"))
 			},
 			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
 		},
@@ -540,6 +1182,168 @@ func TestTaskDelete(t *testing.T) {
 			},
 			wantErr: errs.NewErrs(http.StatusForbidden, "forbidden access"),
 		},
+		{
+			name:    "success with matching version",
+			ifMatch: etag.Exact(2),
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Delete", mock.Anything, taskId, userId, taskModel.Version).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "error when version is stale",
+			ifMatch: etag.Exact(1),
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.AssertNotCalled(t, "Delete")
+			},
+			wantErr: errs.NewErrs(http.StatusPreconditionFailed, "task has been modified"),
+		},
+		{
+			name: "error when version is required",
+			cfg:  config.Configuration{Task: config.TaskConfiguration{RequireIfMatch: true}},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.AssertNotCalled(t, "Delete")
+			},
+			wantErr: errs.NewErrs(http.StatusPreconditionRequired, "if-match header is required"),
+		},
+		{
+			name: "error when task is modified concurrently",
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Delete", mock.Anything, taskId, userId, taskModel.Version).Return(taskrepository.ErrVersionConflict)
+			},
+			wantErr: errs.NewErrs(http.StatusPreconditionFailed, "task has been modified"),
+		},
+		{
+			name: "error when task is removed concurrently",
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Delete", mock.Anything, taskId, userId, taskModel.Version).Return(sql.ErrNoRows)
+			},
+			wantErr: errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name:      "success delete permanently",
+			permanent: true,
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Destroy", mock.Anything, taskId, userId, taskModel.Version).Return(nil)
+				taskRepository.AssertNotCalled(t, "Delete")
+			},
+			wantErr: nil,
+		},
+		{
+			name:      "success delete trashed task permanently",
+			permanent: true,
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{}, sql.ErrNoRows)
+				taskRepository.On("GetTrashedByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Destroy", mock.Anything, taskId, userId, taskModel.Version).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when task is not found",
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{}, sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "GetTrashedByID")
+				taskRepository.AssertNotCalled(t, "Delete")
+			},
+			wantErr: errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name:      "error when delete task permanently",
+			permanent: true,
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Destroy", mock.Anything, taskId, userId, taskModel.Version).Return(errors.New("some error"))
+			},
+			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when reparent children",
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(childModel, nil)
+				taskRepository.On("Reparent", mock.Anything, taskId, userId, childModel.ParentID).Return([]model.Task{}, errors.New("some error"))
+				taskRepository.AssertNotCalled(t, "Delete")
+			},
+			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "success cascade delete",
+			cfg:  config.Configuration{Task: config.TaskConfiguration{OnParentDelete: model.TaskParentDeleteCascade}},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("DeleteDescendants", mock.Anything, taskId, userId).Return([]model.Task{}, nil)
+				taskRepository.On("Delete", mock.Anything, taskId, userId, taskModel.Version).Return(nil)
+				taskRepository.AssertNotCalled(t, "Reparent")
+			},
+			wantErr: nil,
+		},
+		{
+			name:      "success cascade delete permanently",
+			cfg:       config.Configuration{Task: config.TaskConfiguration{OnParentDelete: model.TaskParentDeleteCascade}},
+			permanent: true,
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("GetDescendants", mock.Anything, taskId, userId).Return([]model.Task{}, nil)
+				taskRepository.On("DestroyDescendants", mock.Anything, taskId, userId).Return(nil)
+				taskRepository.On("Destroy", mock.Anything, taskId, userId, taskModel.Version).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when cascade delete",
+			cfg:  config.Configuration{Task: config.TaskConfiguration{OnParentDelete: model.TaskParentDeleteCascade}},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("DeleteDescendants", mock.Anything, taskId, userId).Return([]model.Task{}, errors.New("some error"))
+				taskRepository.AssertNotCalled(t, "Delete")
+			},
+			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -550,11 +1354,3757 @@ func TestTaskDelete(t *testing.T) {
 			ctx = tt.reqContext(ctx)
 
 			tt.mockDeps(&taskRepository)
+			taskRepository.On("CreateHistory", mock.Anything, mock.Anything).Return(model.TaskHistory{}, nil).Maybe()
+			taskRepository.On("WithTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(repository taskrepository.TaskRepository) error) error {
+				return fn(&taskRepository)
+			}).Maybe()
+			taskRepository.On("Reparent", mock.Anything, taskId, userId, (*int64)(nil)).Return([]model.Task{}, nil).Maybe()
+
+			usecase := task.New(&taskRepository, &tt.cfg)
+			err := usecase.Delete(ctx, taskId, tt.ifMatch, tt.permanent)
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskTransition(t *testing.T) {
+	taskId := int64(1)
+	userId := int64(1)
+
+	taskModel := model.Task{
+		ID:          taskId,
+		Title:       "Unit Test",
+		Description: "for completness",
+		Status:      model.TaskStatusTodo,
+		UserID:      userId,
+	}
+
+	transitioned := taskModel
+	transitioned.Status = model.TaskStatusInProgress
 
-			usecase := task.New(&taskRepository)
-			err := usecase.Delete(ctx, taskId)
+	done := taskModel
+	done.Status = model.TaskStatusDone
 
+	seriesId := int64(5)
+	dtstart := time.Date(2026, time.January, 5, 9, 0, 0, 0, time.UTC)
+	scheduled := dtstart.AddDate(0, 0, 7)
+	next := dtstart.AddDate(0, 0, 14)
+	series := model.TaskSeries{ID: seriesId, Rule: "FREQ=WEEKLY", DTStart: dtstart, Title: "Unit Test", Description: "for completness", Priority: model.TaskPriorityMedium, UserID: userId}
+
+	transaction := func(taskRepository *taskmocks.MockTaskRepository) {
+		taskRepository.On("WithTransaction", mock.Anything, mock.Anything).
+			Return(func(ctx context.Context, fn func(taskrepository.TaskRepository) error) error {
+				return fn(taskRepository)
+			})
+	}
+
+	tests := []struct {
+		name       string
+		status     string
+		reqContext func(ctx context.Context) context.Context
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult model.Task
+		wantErr    error
+	}{
+		{
+			name:   "success",
+			status: model.TaskStatusInProgress,
+			reqContext: func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, auth.IdKey, userId)
+				return ctx
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+
+				taskRepository.On("Update", mock.Anything, mock.MatchedBy(func(ts model.Task) bool {
+					return ts.ID == taskId && ts.Status == model.TaskStatusInProgress
+				}), userId).Return(transitioned, nil)
+			},
+			wantResult: transitioned,
+			wantErr:    nil,
+		},
+		{
+			name:   "error when update task",
+			status: model.TaskStatusInProgress,
+			reqContext: func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, auth.IdKey, userId)
+				return ctx
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+
+				taskRepository.On("Update", mock.Anything, mock.Anything, userId).Return(model.Task{}, errors.New("some error"))
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:   "error when transition is not allowed",
+			status: model.TaskStatusDone,
+			reqContext: func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, auth.IdKey, userId)
+				return ctx
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+
+				taskRepository.AssertNotCalled(t, "Update")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusConflict, "cannot transition task from todo to done"),
+		},
+		{
+			name:   "error when status is unknown",
+			status: "finished",
+			reqContext: func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, auth.IdKey, userId)
+				return ctx
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "GetByID")
+				taskRepository.AssertNotCalled(t, "Update")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "invalid status"),
+		},
+		{
+			name:   "error when get by id",
+			status: model.TaskStatusInProgress,
+			reqContext: func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, auth.IdKey, userId)
+				return ctx
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{}, sql.ErrNoRows)
+
+				taskRepository.AssertNotCalled(t, "Update")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name:   "error when get user id from context",
+			status: model.TaskStatusInProgress,
+			reqContext: func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, idKey, userId)
+				return ctx
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "GetByID")
+				taskRepository.AssertNotCalled(t, "Update")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+		{
+			name:   "success when blockers are closed",
+			status: model.TaskStatusDone,
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(transitioned, nil)
+				taskRepository.On("CountOpenBlockers", mock.Anything, taskId).Return(int64(0), nil)
+				transaction(taskRepository)
+				taskRepository.On("Update", mock.Anything, mock.Anything, userId).Return(done, nil)
+				taskRepository.On("GetOccurrence", mock.Anything, taskId).Return(model.TaskOccurrence{}, sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: done,
+			wantErr:    nil,
+		},
+		{
+			name:   "success when recurring task generates next occurrence",
+			status: model.TaskStatusDone,
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(transitioned, nil)
+				taskRepository.On("CountOpenBlockers", mock.Anything, taskId).Return(int64(0), nil)
+				transaction(taskRepository)
+				taskRepository.On("Update", mock.Anything, mock.Anything, userId).Return(done, nil)
+				taskRepository.On("GetOccurrence", mock.Anything, taskId).Return(model.TaskOccurrence{TaskID: taskId, SeriesID: seriesId, Occurrence: 2, ScheduledAt: scheduled, Latest: 2}, nil)
+				taskRepository.On("GetSeries", mock.Anything, seriesId).Return(series, nil)
+				taskRepository.On("Create", mock.Anything, mock.MatchedBy(func(ts model.Task) bool {
+					return ts.Title == series.Title && ts.Status == model.TaskStatusTodo && ts.Priority == series.Priority && ts.DueAt.Equal(next) && ts.UserID == userId
+				})).Return(model.Task{ID: 9}, nil)
+				taskRepository.On("CreateOccurrence", mock.Anything, model.TaskOccurrence{TaskID: 9, SeriesID: seriesId, Occurrence: 3, ScheduledAt: next}).Return(nil)
+			},
+			wantResult: done,
+			wantErr:    nil,
+		},
+		{
+			name:   "success when recurring task has already generated next occurrence",
+			status: model.TaskStatusDone,
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(transitioned, nil)
+				taskRepository.On("CountOpenBlockers", mock.Anything, taskId).Return(int64(0), nil)
+				transaction(taskRepository)
+				taskRepository.On("Update", mock.Anything, mock.Anything, userId).Return(done, nil)
+				taskRepository.On("GetOccurrence", mock.Anything, taskId).Return(model.TaskOccurrence{TaskID: taskId, SeriesID: seriesId, Occurrence: 2, ScheduledAt: scheduled, Latest: 3}, nil)
+				taskRepository.AssertNotCalled(t, "GetSeries")
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: done,
+			wantErr:    nil,
+		},
+		{
+			name:   "success when recurring series is exhausted",
+			status: model.TaskStatusDone,
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				exhausted := series
+				exhausted.Rule = "FREQ=WEEKLY;COUNT=2"
+
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(transitioned, nil)
+				taskRepository.On("CountOpenBlockers", mock.Anything, taskId).Return(int64(0), nil)
+				transaction(taskRepository)
+				taskRepository.On("Update", mock.Anything, mock.Anything, userId).Return(done, nil)
+				taskRepository.On("GetOccurrence", mock.Anything, taskId).Return(model.TaskOccurrence{TaskID: taskId, SeriesID: seriesId, Occurrence: 2, ScheduledAt: scheduled, Latest: 2}, nil)
+				taskRepository.On("GetSeries", mock.Anything, seriesId).Return(exhausted, nil)
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: done,
+			wantErr:    nil,
+		},
+		{
+			name:   "success when rescheduled series carries count across rule change",
+			status: model.TaskStatusDone,
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				rescheduled := series
+				rescheduled.Rule, rescheduled.DTStart, rescheduled.Offset = "FREQ=WEEKLY;COUNT=3", scheduled, 2
+
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(transitioned, nil)
+				taskRepository.On("CountOpenBlockers", mock.Anything, taskId).Return(int64(0), nil)
+				transaction(taskRepository)
+				taskRepository.On("Update", mock.Anything, mock.Anything, userId).Return(done, nil)
+				taskRepository.On("GetOccurrence", mock.Anything, taskId).Return(model.TaskOccurrence{TaskID: taskId, SeriesID: seriesId, Occurrence: 3, ScheduledAt: scheduled, Latest: 3}, nil)
+				taskRepository.On("GetSeries", mock.Anything, seriesId).Return(rescheduled, nil)
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: done,
+			wantErr:    nil,
+		},
+		{
+			name:   "error when create next occurrence",
+			status: model.TaskStatusDone,
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(transitioned, nil)
+				taskRepository.On("CountOpenBlockers", mock.Anything, taskId).Return(int64(0), nil)
+				transaction(taskRepository)
+				taskRepository.On("Update", mock.Anything, mock.Anything, userId).Return(done, nil)
+				taskRepository.On("GetOccurrence", mock.Anything, taskId).Return(model.TaskOccurrence{TaskID: taskId, SeriesID: seriesId, Occurrence: 2, ScheduledAt: scheduled, Latest: 2}, nil)
+				taskRepository.On("GetSeries", mock.Anything, seriesId).Return(series, nil)
+				taskRepository.On("Create", mock.Anything, mock.Anything).Return(model.Task{}, errors.New("some error"))
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:   "error when task is blocked by open tasks",
+			status: model.TaskStatusDone,
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(transitioned, nil)
+				taskRepository.On("CountOpenBlockers", mock.Anything, taskId).Return(int64(2), nil)
+				taskRepository.AssertNotCalled(t, "Update")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusConflict, "task is blocked by open tasks"),
+		},
+		{
+			name:   "error when count open blockers",
+			status: model.TaskStatusDone,
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(transitioned, nil)
+				taskRepository.On("CountOpenBlockers", mock.Anything, taskId).Return(int64(0), errors.New("some error"))
+				taskRepository.AssertNotCalled(t, "Update")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.Background()
+			ctx = tt.reqContext(ctx)
+
+			tt.mockDeps(&taskRepository)
+			taskRepository.On("WithTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(repository taskrepository.TaskRepository) error) error {
+				return fn(&taskRepository)
+			}).Maybe()
+			taskRepository.On("CreateHistory", mock.Anything, mock.Anything).Return(model.TaskHistory{}, nil).Maybe()
+			taskRepository.On("LockPositions", mock.Anything, mock.Anything).Return(nil).Maybe()
+			taskRepository.On("LastPosition", mock.Anything, mock.Anything).Return("V", nil).Maybe()
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.Transition(ctx, taskId, tt.status)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskPatch(t *testing.T) {
+	taskId := int64(1)
+	userId := int64(1)
+	title := "Patched"
+	done := model.TaskStatusDone
+	inProgress := model.TaskStatusInProgress
+
+	taskModel := model.Task{
+		ID:          taskId,
+		Title:       "Unit Test",
+		Description: "for completness",
+		Status:      model.TaskStatusTodo,
+		UserID:      userId,
+		Version:     1,
+	}
+
+	patched := taskModel
+	patched.Title = title
+
+	tests := []struct {
+		name       string
+		patch      model.TaskPatch
+		reqContext func(ctx context.Context) context.Context
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult model.Task
+		wantErr    error
+	}{
+		{
+			name:  "success",
+			patch: model.TaskPatch{Title: &title, Fields: []string{"title"}},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Patch", mock.Anything, taskId, userId, mock.MatchedBy(func(p model.TaskPatch) bool {
+					return *p.Title == title && !p.UpdatedAt.IsZero() && p.Version == taskModel.Version
+				})).Return(patched, nil)
+			},
+			wantResult: patched,
+			wantErr:    nil,
+		},
+		{
+			name:  "success with allowed status transition",
+			patch: model.TaskPatch{Status: &inProgress, Fields: []string{"status"}},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Patch", mock.Anything, taskId, userId, mock.Anything).Return(patched, nil)
+			},
+			wantResult: patched,
+			wantErr:    nil,
+		},
+		{
+			name:  "success with empty patch",
+			patch: model.TaskPatch{},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.AssertNotCalled(t, "Patch")
+			},
+			wantResult: taskModel,
+			wantErr:    nil,
+		},
+		{
+			name:  "error when status transition is not allowed",
+			patch: model.TaskPatch{Status: &done, Fields: []string{"status"}},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.AssertNotCalled(t, "Patch")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusConflict, "cannot transition task from todo to done"),
+		},
+		{
+			name:  "error when patch task",
+			patch: model.TaskPatch{Title: &title, Fields: []string{"title"}},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Patch", mock.Anything, taskId, userId, mock.Anything).Return(model.Task{}, errors.New("some error"))
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:  "error when patched task is gone",
+			patch: model.TaskPatch{Title: &title, Fields: []string{"title"}},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Patch", mock.Anything, taskId, userId, mock.Anything).Return(model.Task{}, sql.ErrNoRows)
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name:  "error when version is stale",
+			patch: model.TaskPatch{Title: &title, Fields: []string{"title"}, IfMatch: etag.Exact(3)},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.AssertNotCalled(t, "Patch")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusPreconditionFailed, "task has been modified"),
+		},
+		{
+			name:  "error when task is modified concurrently",
+			patch: model.TaskPatch{Title: &title, Fields: []string{"title"}},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Patch", mock.Anything, taskId, userId, mock.Anything).Return(model.Task{}, taskrepository.ErrVersionConflict)
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusPreconditionFailed, "task has been modified"),
+		},
+		{
+			name:  "error when get by id",
+			patch: model.TaskPatch{Title: &title, Fields: []string{"title"}},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{}, sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "Patch")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name:  "error when get user id from context",
+			patch: model.TaskPatch{Title: &title, Fields: []string{"title"}},
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, idKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "GetByID")
+				taskRepository.AssertNotCalled(t, "Patch")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := tt.reqContext(context.Background())
+
+			tt.mockDeps(&taskRepository)
+			taskRepository.On("WithTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(repository taskrepository.TaskRepository) error) error {
+				return fn(&taskRepository)
+			}).Maybe()
+			taskRepository.On("CreateHistory", mock.Anything, mock.Anything).Return(model.TaskHistory{}, nil).Maybe()
+			taskRepository.On("LockPositions", mock.Anything, mock.Anything).Return(nil).Maybe()
+			taskRepository.On("LastPosition", mock.Anything, mock.Anything).Return("V", nil).Maybe()
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.Patch(ctx, taskId, tt.patch)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskGetTrashByUserID(t *testing.T) {
+	userId := int64(1)
+	deletedAt := time.Date(2023, time.August, 15, 12, 0, 0, 0, time.UTC)
+	taskModels := []model.Task{
+		{
+			ID:        1,
+			Title:     "Unit Test",
+			Status:    model.TaskStatusTodo,
+			DeletedAt: &deletedAt,
+		},
+	}
+
+	taskRepository := taskmocks.MockTaskRepository{}
+	taskRepository.On("GetByUserID", mock.Anything, userId, mock.MatchedBy(func(p param.Param) bool {
+		return p.Trashed
+	})).Return(taskModels, nil)
+	taskRepository.On("Count", mock.Anything, userId, mock.MatchedBy(func(p param.Param) bool {
+		return p.Trashed
+	})).Return(int64(1), nil)
+	taskRepository.On("Progress", mock.Anything, []int64{1}).Return(map[int64]model.TaskProgress{}, nil)
+	taskRepository.On("GetLabels", mock.Anything, []int64{1}).Return(map[int64][]model.Label{}, nil)
+	taskRepository.On("CountComments", mock.Anything, []int64{1}).Return(map[int64]int64{}, nil)
+	taskRepository.On("ChecklistSummary", mock.Anything, []int64{1}).Return(map[int64]model.TaskChecklistSummary{}, nil)
+	taskRepository.On("GetOccurrences", mock.Anything, []int64{1}).Return(map[int64]model.TaskOccurrence{}, nil)
+	taskRepository.On("TrackedTime", mock.Anything, []int64{1}).Return(map[int64]int64{}, nil)
+
+	paramPkg := param.Param{Page: 1, Limit: 10}
+	usecase := task.New(&taskRepository, &config.Configuration{})
+	result, err := usecase.GetTrashByUserID(context.Background(), userId, &paramPkg)
+
+	assert.Equal(t, []model.Task{{
+		ID:        1,
+		Title:     "Unit Test",
+		Status:    model.TaskStatusTodo,
+		DeletedAt: &deletedAt,
+		Progress:  &model.TaskProgress{},
+	}}, result)
+	assert.Nil(t, err)
+	assert.True(t, paramPkg.Trashed)
+	assert.Equal(t, int64(1), paramPkg.Total)
+}
+
+func TestTaskRestore(t *testing.T) {
+	taskId := int64(1)
+	userId := int64(1)
+
+	taskModel := model.Task{
+		ID:          taskId,
+		Title:       "Unit Test",
+		Description: "for completness",
+		Status:      model.TaskStatusTodo,
+		UserID:      userId,
+		Version:     3,
+	}
+
+	tests := []struct {
+		name       string
+		reqContext func(ctx context.Context) context.Context
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetTrashedByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Restore", mock.Anything, taskId, userId).Return(taskModel, nil)
+			},
+			wantResult: taskModel,
+			wantErr:    nil,
+		},
+		{
+			name: "success restores cascaded children",
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				child := model.Task{ID: 5, Title: "Child", ParentID: &taskId, UserID: userId}
+				taskRepository.On("GetTrashedByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("RestoreDescendants", mock.Anything, taskId, userId).Return([]model.Task{child}, nil)
+				taskRepository.On("Restore", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("CreateHistory", mock.Anything, mock.MatchedBy(func(h model.TaskHistory) bool {
+					return h.TaskID == taskId && h.Action == model.TaskActionRestore
+				})).Return(model.TaskHistory{}, nil).Once()
+				taskRepository.On("CreateHistory", mock.Anything, mock.MatchedBy(func(h model.TaskHistory) bool {
+					return h.TaskID == child.ID && h.Action == model.TaskActionRestore
+				})).Return(model.TaskHistory{}, nil).Once()
+			},
+			wantResult: taskModel,
+			wantErr:    nil,
+		},
+		{
+			name: "success reattaches reparented children",
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				child := model.Task{ID: 7, Title: "Child", ParentID: &taskId, UserID: userId}
+				taskRepository.On("GetTrashedByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Restore", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Reattach", mock.Anything, taskId, userId, taskModel.ParentID).Return([]model.Task{child}, nil)
+				taskRepository.On("CreateHistory", mock.Anything, mock.MatchedBy(func(h model.TaskHistory) bool {
+					return h.TaskID == child.ID && h.Action == model.TaskActionUpdate && len(h.Changes) == 1 && h.Changes[0].Field == "parent_id"
+				})).Return(model.TaskHistory{}, nil).Once()
+			},
+			wantResult: taskModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when reattach reparented children",
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetTrashedByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Restore", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Reattach", mock.Anything, taskId, userId, taskModel.ParentID).Return([]model.Task{}, errors.New("some error"))
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when restore cascaded children",
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetTrashedByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("RestoreDescendants", mock.Anything, taskId, userId).Return([]model.Task{}, errors.New("some error"))
+				taskRepository.AssertNotCalled(t, "Restore")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when task is not in trash",
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetTrashedByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Restore", mock.Anything, taskId, userId).Return(model.Task{}, sql.ErrNoRows)
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found in trash"),
+		},
+		{
+			name: "error when get trashed task",
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetTrashedByID", mock.Anything, taskId, userId).Return(model.Task{}, sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "Restore")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found in trash"),
+		},
+		{
+			name: "error when restore task",
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetTrashedByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Restore", mock.Anything, taskId, userId).Return(model.Task{}, errors.New("some error"))
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when get user id from context",
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, idKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "Restore")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := tt.reqContext(context.Background())
+
+			tt.mockDeps(&taskRepository)
+			taskRepository.On("WithTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(repository taskrepository.TaskRepository) error) error {
+				return fn(&taskRepository)
+			}).Maybe()
+			taskRepository.On("CreateHistory", mock.Anything, mock.Anything).Return(model.TaskHistory{}, nil).Maybe()
+			taskRepository.On("RestoreDescendants", mock.Anything, mock.Anything, mock.Anything).Return([]model.Task{}, nil).Maybe()
+			taskRepository.On("Reattach", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]model.Task{}, nil).Maybe()
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.Restore(ctx, taskId)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskPurge(t *testing.T) {
+	expired := []model.Task{{ID: 3, Title: "Unit Test", UserID: 1, Version: 2}, {ID: 4, Title: "Integration Test", UserID: 1, Version: 1}}
+
+	tests := []struct {
+		name       string
+		cfg        config.Configuration
+		retention  time.Duration
+		getErr     error
+		mockErr    error
+		wantResult int64
+		wantErr    error
+	}{
+		{
+			name:       "success with default retention",
+			retention:  model.TaskTrashRetention,
+			wantResult: 2,
+			wantErr:    nil,
+		},
+		{
+			name:       "success with configured retention",
+			cfg:        config.Configuration{Task: config.TaskConfiguration{TrashRetention: time.Hour}},
+			retention:  time.Hour,
+			wantResult: 2,
+			wantErr:    nil,
+		},
+		{
+			name:       "error when get purgeable tasks",
+			retention:  model.TaskTrashRetention,
+			getErr:     errors.New("some error"),
+			wantResult: 0,
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:       "error when purge tasks",
+			retention:  model.TaskTrashRetention,
+			mockErr:    errors.New("some error"),
+			wantResult: 0,
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+			taskRepository.On("WithTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(repository taskrepository.TaskRepository) error) error {
+				return fn(&taskRepository)
+			})
+			retained := mock.MatchedBy(func(before time.Time) bool {
+				age := time.Since(before)
+				return age >= tt.retention && age < tt.retention+time.Minute
+			})
+			taskRepository.On("GetPurgeable", mock.Anything, retained).Return(expired, tt.getErr)
+			for _, trashed := range expired {
+				taskRepository.On("CreateHistory", mock.Anything, model.TaskHistory{TaskID: trashed.ID, Action: model.TaskActionPurge, Changes: model.TaskChanges{}}).Return(model.TaskHistory{}, nil).Maybe()
+			}
+			taskRepository.On("Purge", mock.Anything, retained).Return(tt.wantResult, tt.mockErr).Maybe()
+
+			usecase := task.New(&taskRepository, &tt.cfg)
+			result, err := usecase.Purge(context.Background())
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.getErr == nil {
+				taskRepository.AssertNumberOfCalls(t, "CreateHistory", len(expired))
+			}
+		})
+	}
+}
+
+func TestTaskBulk(t *testing.T) {
+	userId := int64(1)
+
+	existing := model.Task{
+		ID:          2,
+		Title:       "Unit Test",
+		Description: "for completness",
+		Status:      model.TaskStatusTodo,
+		Priority:    model.TaskPriorityMedium,
+		UserID:      userId,
+		Version:     1,
+	}
+
+	created := model.Task{
+		ID:          3,
+		Title:       "Bulk",
+		Description: "created in bulk",
+		Status:      model.TaskStatusTodo,
+		Priority:    model.TaskPriorityMedium,
+		UserID:      userId,
+		Version:     1,
+	}
+
+	request := model.TaskBulkRequest{
+		Operations: []model.TaskBulkOperation{
+			{Op: model.TaskBulkCreate, Task: &model.Task{Title: "Bulk", Description: "created in bulk"}},
+			{Op: model.TaskBulkTransition, ID: 2, Status: model.TaskStatusDone},
+			{Op: model.TaskBulkDelete, ID: 2},
+		},
+	}
+
+	withTransaction := func(taskRepository *taskmocks.MockTaskRepository) {
+		taskRepository.On("WithTransaction", mock.Anything, mock.Anything).
+			Return(func(ctx context.Context, fn func(taskrepository.TaskRepository) error) error {
+				return fn(taskRepository)
+			})
+	}
+
+	tests := []struct {
+		name       string
+		atomic     bool
+		reqContext func(ctx context.Context) context.Context
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult []model.TaskBulkResult
+		wantErr    error
+	}{
+		{
+			name: "success with best effort",
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				withTransaction(taskRepository)
+				taskRepository.On("Create", mock.Anything, mock.Anything).Return(created, nil)
+				taskRepository.On("GetByID", mock.Anything, int64(2), userId).Return(existing, nil)
+				taskRepository.On("Delete", mock.Anything, int64(2), userId, existing.Version).Return(nil)
+			},
+			wantResult: []model.TaskBulkResult{
+				{Index: 0, Op: model.TaskBulkCreate, ID: 3, Status: model.TaskBulkStatusSuccess, Code: http.StatusOK, Task: &created},
+				{Index: 1, Op: model.TaskBulkTransition, ID: 2, Status: model.TaskBulkStatusFailed, Code: http.StatusConflict, Error: "cannot transition task from todo to done"},
+				{Index: 2, Op: model.TaskBulkDelete, ID: 2, Status: model.TaskBulkStatusSuccess, Code: http.StatusOK},
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "error when atomic operation fails",
+			atomic: true,
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				withTransaction(taskRepository)
+				taskRepository.On("Create", mock.Anything, mock.Anything).Return(created, nil)
+				taskRepository.On("GetByID", mock.Anything, int64(2), userId).Return(existing, nil)
+				taskRepository.AssertNotCalled(t, "Delete")
+			},
+			wantResult: []model.TaskBulkResult{
+				{Index: 0, Op: model.TaskBulkCreate, ID: 3, Status: model.TaskBulkStatusRolledBack},
+				{Index: 1, Op: model.TaskBulkTransition, ID: 2, Status: model.TaskBulkStatusFailed, Code: http.StatusConflict, Error: "cannot transition task from todo to done"},
+				{Index: 2, Op: model.TaskBulkDelete, ID: 2, Status: model.TaskBulkStatusSkipped},
+			},
+			wantErr: errs.NewErrs(http.StatusConflict, "bulk operation rolled back"),
+		},
+		{
+			name: "error when start transaction",
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, auth.IdKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("WithTransaction", mock.Anything, mock.Anything).Return(sql.ErrConnDone)
+			},
+			wantResult: []model.TaskBulkResult{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when get user id from context",
+			reqContext: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, idKey, userId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "WithTransaction")
+			},
+			wantResult: []model.TaskBulkResult{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := tt.reqContext(context.Background())
+
+			tt.mockDeps(&taskRepository)
+			taskRepository.On("WithTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(repository taskrepository.TaskRepository) error) error {
+				return fn(&taskRepository)
+			}).Maybe()
+			taskRepository.On("CreateHistory", mock.Anything, mock.Anything).Return(model.TaskHistory{}, nil).Maybe()
+			taskRepository.On("LockPositions", mock.Anything, mock.Anything).Return(nil).Maybe()
+			taskRepository.On("LastPosition", mock.Anything, mock.Anything).Return("V", nil).Maybe()
+			taskRepository.On("Reparent", mock.Anything, mock.Anything, mock.Anything, (*int64)(nil)).Return([]model.Task{}, nil).Maybe()
+
+			bulk := request
+			bulk.Atomic = tt.atomic
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.Bulk(ctx, bulk)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskParent(t *testing.T) {
+	taskId := int64(1)
+	userId := int64(1)
+	parentId := int64(5)
+
+	taskModel := model.Task{
+		ID:     taskId,
+		Title:  "Unit Test",
+		Status: model.TaskStatusTodo,
+		UserID: userId,
+	}
+
+	child := taskModel
+	child.ParentID = &parentId
+
+	tests := []struct {
+		name     string
+		patch    bool
+		mockDeps func(taskRepository *taskmocks.MockTaskRepository)
+		wantErr  error
+	}{
+		{
+			name: "success create subtask",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("Ancestors", mock.Anything, parentId, userId).Return([]int64{parentId}, nil)
+				taskRepository.On("Create", mock.Anything, mock.MatchedBy(func(task model.Task) bool {
+					return task.ParentID != nil && *task.ParentID == parentId
+				})).Return(child, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when parent task is not found",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("Ancestors", mock.Anything, parentId, userId).Return([]int64{}, nil)
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantErr: errs.NewErrs(http.StatusBadRequest, "parent task not found"),
+		},
+		{
+			name: "error when get ancestors",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("Ancestors", mock.Anything, parentId, userId).Return([]int64{}, errors.New("some error"))
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:  "error when parent creates a cycle",
+			patch: true,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("Ancestors", mock.Anything, parentId, userId).Return([]int64{parentId, taskId}, nil)
+				taskRepository.AssertNotCalled(t, "Patch")
+			},
+			wantErr: errs.NewErrs(http.StatusConflict, "parent task would create a cycle"),
+		},
+		{
+			name:  "success when parent is unchanged",
+			patch: true,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(child, nil)
+				taskRepository.On("Patch", mock.Anything, taskId, userId, mock.Anything).Return(child, nil)
+				taskRepository.AssertNotCalled(t, "Ancestors")
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+			taskRepository.On("WithTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(repository taskrepository.TaskRepository) error) error {
+				return fn(&taskRepository)
+			}).Maybe()
+			taskRepository.On("CreateHistory", mock.Anything, mock.Anything).Return(model.TaskHistory{}, nil).Maybe()
+			taskRepository.On("LockPositions", mock.Anything, mock.Anything).Return(nil).Maybe()
+			taskRepository.On("LastPosition", mock.Anything, mock.Anything).Return("V", nil).Maybe()
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+
+			var err error
+			if tt.patch {
+				_, err = usecase.Patch(ctx, taskId, model.TaskPatch{ParentID: &parentId, Fields: []string{"parent_id"}})
+			} else {
+				_, err = usecase.Create(ctx, model.Task{Title: "Unit Test", ParentID: &parentId})
+			}
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskGetSubtasks(t *testing.T) {
+	taskId := int64(1)
+	userId := int64(1)
+
+	children := []model.Task{
+		{ID: 2, Title: "Write Test", Status: model.TaskStatusDone, UserID: userId},
+	}
+
+	tests := []struct {
+		name       string
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult []model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId}, nil)
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.MatchedBy(func(p param.Param) bool {
+					return p.ParentID != nil && *p.ParentID == taskId
+				})).Return(slices.Clone(children), nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(1), nil)
+				taskRepository.On("Progress", mock.Anything, []int64{2}).Return(map[int64]model.TaskProgress{}, nil)
+				taskRepository.On("GetLabels", mock.Anything, []int64{2}).Return(map[int64][]model.Label{}, nil)
+				taskRepository.On("CountComments", mock.Anything, []int64{2}).Return(map[int64]int64{}, nil)
+				taskRepository.On("ChecklistSummary", mock.Anything, []int64{2}).Return(map[int64]model.TaskChecklistSummary{}, nil)
+				taskRepository.On("GetOccurrences", mock.Anything, []int64{2}).Return(map[int64]model.TaskOccurrence{}, nil)
+				taskRepository.On("TrackedTime", mock.Anything, []int64{2}).Return(map[int64]int64{}, nil)
+			},
+			wantResult: []model.Task{
+				{ID: 2, Title: "Write Test", Status: model.TaskStatusDone, UserID: userId, Progress: &model.TaskProgress{}},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when parent task is not found",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{}, sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "GetByUserID")
+			},
+			wantResult: []model.Task{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+
+			paramPkg := param.Param{Page: 1, Limit: 10}
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.GetSubtasks(ctx, taskId, &paramPkg)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskGetByIDWithChildren(t *testing.T) {
+	taskId := int64(1)
+	userId := int64(1)
+
+	taskModel := model.Task{ID: taskId, Title: "Unit Test", Status: model.TaskStatusTodo, UserID: userId}
+	child := model.Task{ID: 2, Title: "Write Test", Status: model.TaskStatusDone, ParentID: &taskId, UserID: userId}
+
+	tests := []struct {
+		name       string
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("GetByParentID", mock.Anything, taskId, userId).Return([]model.Task{child}, nil)
+				taskRepository.On("Progress", mock.Anything, []int64{taskId, 2}).Return(map[int64]model.TaskProgress{taskId: {Done: 1, Total: 1}}, nil)
+				taskRepository.On("GetLabels", mock.Anything, []int64{taskId, 2}).Return(map[int64][]model.Label{}, nil)
+				taskRepository.On("CountComments", mock.Anything, []int64{taskId, 2}).Return(map[int64]int64{}, nil)
+				taskRepository.On("ChecklistSummary", mock.Anything, []int64{taskId, 2}).Return(map[int64]model.TaskChecklistSummary{}, nil)
+				taskRepository.On("GetOccurrences", mock.Anything, []int64{taskId, 2}).Return(map[int64]model.TaskOccurrence{}, nil)
+				taskRepository.On("TrackedTime", mock.Anything, []int64{taskId, 2}).Return(map[int64]int64{}, nil)
+				taskRepository.On("GetChecklist", mock.Anything, taskId).Return([]model.TaskChecklistItem{}, nil)
+			},
+			wantResult: model.Task{
+				ID:        taskId,
+				Title:     "Unit Test",
+				Status:    model.TaskStatusTodo,
+				UserID:    userId,
+				Progress:  &model.TaskProgress{Done: 1, Total: 1},
+				Checklist: []model.TaskChecklistItem{},
+				Children: []model.Task{
+					{ID: 2, Title: "Write Test", Status: model.TaskStatusDone, ParentID: &taskId, UserID: userId, Progress: &model.TaskProgress{}},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when get children",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("GetByParentID", mock.Anything, taskId, userId).Return([]model.Task{}, errors.New("some error"))
+				taskRepository.AssertNotCalled(t, "Progress")
+				taskRepository.AssertNotCalled(t, "GetLabels")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.GetByIDWithChildren(ctx, taskId)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskAddDependency(t *testing.T) {
+	taskId := int64(1)
+	blockerId := int64(2)
+	userId := int64(1)
+
+	dependency := model.TaskDependency{BlockerID: blockerId, BlockedID: taskId}
+
+	tests := []struct {
+		name       string
+		blockerId  int64
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult model.TaskDependency
+		wantErr    error
+	}{
+		{
+			name:      "success",
+			blockerId: blockerId,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId}, nil)
+				taskRepository.On("GetByID", mock.Anything, blockerId, userId).Return(model.Task{ID: blockerId}, nil)
+				taskRepository.On("GetDependenciesByBlockerID", mock.Anything, []int64{taskId}).Return([]model.TaskDependency{{BlockerID: taskId, BlockedID: 3}}, nil)
+				taskRepository.On("GetDependenciesByBlockerID", mock.Anything, []int64{3}).Return([]model.TaskDependency{}, nil)
+				taskRepository.On("CreateDependency", mock.Anything, blockerId, taskId).Return(dependency, nil)
+			},
+			wantResult: dependency,
+			wantErr:    nil,
+		},
+		{
+			name:      "error when task blocks itself",
+			blockerId: taskId,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "CreateDependency")
+			},
+			wantResult: model.TaskDependency{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "task cannot block itself"),
+		},
+		{
+			name:      "error when blocker task is not found",
+			blockerId: blockerId,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId}, nil)
+				taskRepository.On("GetByID", mock.Anything, blockerId, userId).Return(model.Task{}, sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "CreateDependency")
+			},
+			wantResult: model.TaskDependency{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "blocker task not found"),
+		},
+		{
+			name:      "error when dependency creates a cycle",
+			blockerId: blockerId,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId}, nil)
+				taskRepository.On("GetByID", mock.Anything, blockerId, userId).Return(model.Task{ID: blockerId}, nil)
+				taskRepository.On("GetDependenciesByBlockerID", mock.Anything, []int64{taskId}).Return([]model.TaskDependency{{BlockerID: taskId, BlockedID: 3}}, nil)
+				taskRepository.On("GetDependenciesByBlockerID", mock.Anything, []int64{3}).Return([]model.TaskDependency{{BlockerID: 3, BlockedID: blockerId}}, nil)
+				taskRepository.On("GetDependenciesByBlockerID", mock.Anything, []int64{blockerId}).Return([]model.TaskDependency{}, nil)
+				taskRepository.AssertNotCalled(t, "CreateDependency")
+			},
+			wantResult: model.TaskDependency{},
+			wantErr:    errs.NewErrs(http.StatusConflict, "task dependency would create a cycle"),
+		},
+		{
+			name:      "error when dependency already exists",
+			blockerId: blockerId,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId}, nil)
+				taskRepository.On("GetByID", mock.Anything, blockerId, userId).Return(model.Task{ID: blockerId}, nil)
+				taskRepository.On("GetDependenciesByBlockerID", mock.Anything, []int64{taskId}).Return([]model.TaskDependency{}, nil)
+				taskRepository.On("CreateDependency", mock.Anything, blockerId, taskId).Return(model.TaskDependency{}, taskrepository.ErrDependencyExists)
+			},
+			wantResult: model.TaskDependency{},
+			wantErr:    errs.NewErrs(http.StatusConflict, "task dependency already exists"),
+		},
+		{
+			name:      "error when walk dependencies",
+			blockerId: blockerId,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId}, nil)
+				taskRepository.On("GetByID", mock.Anything, blockerId, userId).Return(model.Task{ID: blockerId}, nil)
+				taskRepository.On("GetDependenciesByBlockerID", mock.Anything, []int64{taskId}).Return([]model.TaskDependency{}, errors.New("some error"))
+				taskRepository.AssertNotCalled(t, "CreateDependency")
+			},
+			wantResult: model.TaskDependency{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.AddDependency(ctx, taskId, model.TaskDependencyRequest{BlockerID: tt.blockerId})
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskRemoveDependency(t *testing.T) {
+	taskId := int64(1)
+	blockerId := int64(2)
+	userId := int64(1)
+
+	tests := []struct {
+		name     string
+		mockDeps func(taskRepository *taskmocks.MockTaskRepository)
+		wantErr  error
+	}{
+		{
+			name: "success",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId}, nil)
+				taskRepository.On("DeleteDependency", mock.Anything, blockerId, taskId, userId).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when task is not found",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{}, sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "DeleteDependency")
+			},
+			wantErr: errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name: "error when dependency is not found",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId}, nil)
+				taskRepository.On("DeleteDependency", mock.Anything, blockerId, taskId, userId).Return(sql.ErrNoRows)
+			},
+			wantErr: errs.NewErrs(http.StatusNotFound, "task dependency not found"),
+		},
+		{
+			name: "error when delete dependency",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId}, nil)
+				taskRepository.On("DeleteDependency", mock.Anything, blockerId, taskId, userId).Return(errors.New("some error"))
+			},
+			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			err := usecase.RemoveDependency(ctx, taskId, blockerId)
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskGetDependencies(t *testing.T) {
+	taskId := int64(1)
+	userId := int64(1)
+
+	blocker := model.Task{ID: 2, Title: "Design", UserID: userId}
+	blocked := model.Task{ID: 3, Title: "Release", UserID: userId}
+
+	taskRepository := taskmocks.MockTaskRepository{}
+	taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId}, nil)
+	taskRepository.On("GetDependenciesByBlockedID", mock.Anything, []int64{taskId}).Return([]model.TaskDependency{{BlockerID: 2, BlockedID: taskId}}, nil)
+	taskRepository.On("GetDependenciesByBlockerID", mock.Anything, []int64{taskId}).Return([]model.TaskDependency{{BlockerID: taskId, BlockedID: 3}}, nil)
+	taskRepository.On("GetByIDs", mock.Anything, []int64{2, 3}, userId).Return([]model.Task{blocker, blocked}, nil)
+
+	ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+	usecase := task.New(&taskRepository, &config.Configuration{})
+	result, err := usecase.GetDependencies(ctx, taskId)
+
+	assert.Equal(t, model.TaskDependencies{BlockedBy: []model.Task{blocker}, Blocks: []model.Task{blocked}}, result)
+	assert.Nil(t, err)
+}
+
+func TestTaskGetDependencyGraph(t *testing.T) {
+	taskId := int64(1)
+	userId := int64(1)
+
+	nodes := []model.Task{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}}
+
+	tests := []struct {
+		name       string
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult model.TaskDependencyGraph
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId}, nil)
+				taskRepository.On("GetDependenciesByBlockedID", mock.Anything, []int64{1}).Return([]model.TaskDependency{{BlockerID: 2, BlockedID: 1}}, nil)
+				taskRepository.On("GetDependenciesByBlockedID", mock.Anything, []int64{2}).Return([]model.TaskDependency{}, nil)
+				taskRepository.On("GetDependenciesByBlockerID", mock.Anything, []int64{1}).Return([]model.TaskDependency{{BlockerID: 1, BlockedID: 3}}, nil)
+				taskRepository.On("GetDependenciesByBlockerID", mock.Anything, []int64{3}).Return([]model.TaskDependency{{BlockerID: 3, BlockedID: 4}}, nil)
+				taskRepository.On("GetDependenciesByBlockerID", mock.Anything, []int64{4}).Return([]model.TaskDependency{}, nil)
+				taskRepository.On("GetByIDs", mock.Anything, []int64{1, 2, 3, 4}, userId).Return(nodes, nil)
+			},
+			wantResult: model.TaskDependencyGraph{
+				Nodes: nodes,
+				Edges: []model.TaskDependency{
+					{BlockerID: 2, BlockedID: 1},
+					{BlockerID: 1, BlockedID: 3},
+					{BlockerID: 3, BlockedID: 4},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when task is not found",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{}, sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "GetDependenciesByBlockedID")
+			},
+			wantResult: model.TaskDependencyGraph{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name: "error when get nodes",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId}, nil)
+				taskRepository.On("GetDependenciesByBlockedID", mock.Anything, []int64{1}).Return([]model.TaskDependency{}, nil)
+				taskRepository.On("GetDependenciesByBlockerID", mock.Anything, []int64{1}).Return([]model.TaskDependency{}, nil)
+				taskRepository.On("GetByIDs", mock.Anything, []int64{1}, userId).Return([]model.Task{}, errors.New("some error"))
+			},
+			wantResult: model.TaskDependencyGraph{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.GetDependencyGraph(ctx, taskId)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskAttachLabel(t *testing.T) {
+	taskId := int64(1)
+	labelId := int64(3)
+	userId := int64(1)
+	request := model.TaskLabelRequest{LabelID: labelId}
+	label := model.Label{ID: labelId, Name: "urgent", Colour: "#ff0000"}
+
+	tests := []struct {
+		name       string
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId}, nil)
+				taskRepository.On("AttachLabel", mock.Anything, taskId, labelId, userId).Return(nil)
+				taskRepository.On("Progress", mock.Anything, []int64{taskId}).Return(map[int64]model.TaskProgress{}, nil)
+				taskRepository.On("GetLabels", mock.Anything, []int64{taskId}).Return(map[int64][]model.Label{taskId: {label}}, nil)
+				taskRepository.On("CountComments", mock.Anything, []int64{taskId}).Return(map[int64]int64{}, nil)
+				taskRepository.On("ChecklistSummary", mock.Anything, []int64{taskId}).Return(map[int64]model.TaskChecklistSummary{}, nil)
+				taskRepository.On("GetOccurrences", mock.Anything, []int64{taskId}).Return(map[int64]model.TaskOccurrence{}, nil)
+				taskRepository.On("TrackedTime", mock.Anything, []int64{taskId}).Return(map[int64]int64{}, nil)
+				taskRepository.On("GetChecklist", mock.Anything, taskId).Return([]model.TaskChecklistItem{}, nil)
+			},
+			wantResult: model.Task{ID: taskId, Progress: &model.TaskProgress{}, Labels: []model.Label{label}, Checklist: []model.TaskChecklistItem{}},
+			wantErr:    nil,
+		},
+		{
+			name: "error when task is not found",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{}, sql.ErrNoRows)
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name: "error when label is not found",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId}, nil)
+				taskRepository.On("AttachLabel", mock.Anything, taskId, labelId, userId).Return(sql.ErrNoRows)
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "label not found"),
+		},
+		{
+			name: "error when attach label",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId}, nil)
+				taskRepository.On("AttachLabel", mock.Anything, taskId, labelId, userId).Return(errors.New("some error"))
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.AttachLabel(ctx, taskId, request)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskDetachLabel(t *testing.T) {
+	taskId := int64(1)
+	labelId := int64(3)
+	userId := int64(1)
+
+	tests := []struct {
+		name       string
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("DetachLabel", mock.Anything, taskId, labelId, userId).Return(nil)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId}, nil)
+				taskRepository.On("Progress", mock.Anything, []int64{taskId}).Return(map[int64]model.TaskProgress{}, nil)
+				taskRepository.On("GetLabels", mock.Anything, []int64{taskId}).Return(map[int64][]model.Label{}, nil)
+				taskRepository.On("CountComments", mock.Anything, []int64{taskId}).Return(map[int64]int64{}, nil)
+				taskRepository.On("ChecklistSummary", mock.Anything, []int64{taskId}).Return(map[int64]model.TaskChecklistSummary{}, nil)
+				taskRepository.On("GetOccurrences", mock.Anything, []int64{taskId}).Return(map[int64]model.TaskOccurrence{}, nil)
+				taskRepository.On("TrackedTime", mock.Anything, []int64{taskId}).Return(map[int64]int64{}, nil)
+				taskRepository.On("GetChecklist", mock.Anything, taskId).Return([]model.TaskChecklistItem{}, nil)
+			},
+			wantResult: model.Task{ID: taskId, Progress: &model.TaskProgress{}, Checklist: []model.TaskChecklistItem{}},
+			wantErr:    nil,
+		},
+		{
+			name: "error when label is not attached",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId}, nil)
+				taskRepository.On("DetachLabel", mock.Anything, taskId, labelId, userId).Return(sql.ErrNoRows)
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task label not found"),
+		},
+		{
+			name: "error when detach label",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId}, nil)
+				taskRepository.On("DetachLabel", mock.Anything, taskId, labelId, userId).Return(errors.New("some error"))
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.DetachLabel(ctx, taskId, labelId)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskProject(t *testing.T) {
+	taskId := int64(1)
+	userId := int64(1)
+	projectId := int64(7)
+	archivedAt := time.Date(2023, time.August, 15, 12, 0, 0, 0, time.UTC)
+
+	taskModel := model.Task{
+		ID:     taskId,
+		Title:  "Unit Test",
+		Status: model.TaskStatusTodo,
+		UserID: userId,
+	}
+
+	moved := taskModel
+	moved.ProjectID = &projectId
+
+	tests := []struct {
+		name     string
+		patch    bool
+		mockDeps func(taskRepository *taskmocks.MockTaskRepository)
+		wantErr  error
+	}{
+		{
+			name: "success create task in project",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetProject", mock.Anything, projectId, userId).Return(model.Project{ID: projectId}, nil)
+				taskRepository.On("Create", mock.Anything, mock.MatchedBy(func(task model.Task) bool {
+					return task.ProjectID != nil && *task.ProjectID == projectId
+				})).Return(moved, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when project is not found",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetProject", mock.Anything, projectId, userId).Return(model.Project{}, sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantErr: errs.NewErrs(http.StatusBadRequest, "project not found"),
+		},
+		{
+			name: "error when project is archived",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetProject", mock.Anything, projectId, userId).Return(model.Project{ID: projectId, ArchivedAt: &archivedAt}, nil)
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantErr: errs.NewErrs(http.StatusConflict, "project is archived"),
+		},
+		{
+			name: "error when get project",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetProject", mock.Anything, projectId, userId).Return(model.Project{}, errors.New("some error"))
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:  "success move task to project",
+			patch: true,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(taskModel, nil)
+				taskRepository.On("GetProject", mock.Anything, projectId, userId).Return(model.Project{ID: projectId}, nil)
+				taskRepository.On("Patch", mock.Anything, taskId, userId, mock.Anything).Return(moved, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:  "success when project is unchanged",
+			patch: true,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(moved, nil)
+				taskRepository.On("Patch", mock.Anything, taskId, userId, mock.Anything).Return(moved, nil)
+				taskRepository.AssertNotCalled(t, "GetProject")
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+			taskRepository.On("WithTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(repository taskrepository.TaskRepository) error) error {
+				return fn(&taskRepository)
+			}).Maybe()
+			taskRepository.On("CreateHistory", mock.Anything, mock.Anything).Return(model.TaskHistory{}, nil).Maybe()
+			taskRepository.On("LockPositions", mock.Anything, mock.Anything).Return(nil).Maybe()
+			taskRepository.On("LastPosition", mock.Anything, mock.Anything).Return("V", nil).Maybe()
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+
+			var err error
+			if tt.patch {
+				_, err = usecase.Patch(ctx, taskId, model.TaskPatch{ProjectID: &projectId, Fields: []string{"project_id"}})
+			} else {
+				_, err = usecase.Create(ctx, model.Task{Title: "Unit Test", ProjectID: &projectId})
+			}
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskGetByProjectID(t *testing.T) {
+	projectId := int64(7)
+	userId := int64(1)
+
+	tasks := []model.Task{
+		{ID: 2, Title: "Write Test", Status: model.TaskStatusTodo, ProjectID: &projectId, UserID: userId},
+	}
+
+	tests := []struct {
+		name       string
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult []model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetProject", mock.Anything, projectId, userId).Return(model.Project{ID: projectId}, nil)
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.MatchedBy(func(p param.Param) bool {
+					return p.ProjectID != nil && *p.ProjectID == projectId
+				})).Return(slices.Clone(tasks), nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(1), nil)
+				taskRepository.On("Progress", mock.Anything, []int64{2}).Return(map[int64]model.TaskProgress{}, nil)
+				taskRepository.On("GetLabels", mock.Anything, []int64{2}).Return(map[int64][]model.Label{}, nil)
+				taskRepository.On("CountComments", mock.Anything, []int64{2}).Return(map[int64]int64{}, nil)
+				taskRepository.On("ChecklistSummary", mock.Anything, []int64{2}).Return(map[int64]model.TaskChecklistSummary{}, nil)
+				taskRepository.On("GetOccurrences", mock.Anything, []int64{2}).Return(map[int64]model.TaskOccurrence{}, nil)
+				taskRepository.On("TrackedTime", mock.Anything, []int64{2}).Return(map[int64]int64{}, nil)
+			},
+			wantResult: []model.Task{
+				{ID: 2, Title: "Write Test", Status: model.TaskStatusTodo, ProjectID: &projectId, UserID: userId, Progress: &model.TaskProgress{}},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when project is not found",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetProject", mock.Anything, projectId, userId).Return(model.Project{}, sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "GetByUserID")
+			},
+			wantResult: []model.Task{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "project not found"),
+		},
+		{
+			name: "error when get project",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetProject", mock.Anything, projectId, userId).Return(model.Project{}, errors.New("some error"))
+				taskRepository.AssertNotCalled(t, "GetByUserID")
+			},
+			wantResult: []model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+
+			paramPkg := param.Param{Page: 1, Limit: 10}
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.GetByProjectID(ctx, projectId, &paramPkg)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskWorkspacePermission(t *testing.T) {
+	taskId := int64(1)
+	userId := int64(1)
+	workspaceId := int64(3)
+
+	shared := model.Task{
+		ID:          taskId,
+		Title:       "Unit Test",
+		Status:      model.TaskStatusTodo,
+		WorkspaceID: &workspaceId,
+		UserID:      int64(2),
+		Version:     1,
+	}
+
+	tests := []struct {
+		name     string
+		call     func(usecase task.TaskUsecase, ctx context.Context) error
+		mockDeps func(taskRepository *taskmocks.MockTaskRepository)
+		wantErr  error
+	}{
+		{
+			name: "success create task in workspace as editor",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				_, err := usecase.Create(ctx, model.Task{Title: "Unit Test", WorkspaceID: &workspaceId})
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return(model.WorkspaceRoleEditor, nil)
+				taskRepository.On("Create", mock.Anything, mock.MatchedBy(func(task model.Task) bool {
+					return task.WorkspaceID != nil && *task.WorkspaceID == workspaceId && task.UserID == userId
+				})).Return(shared, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when create task in workspace as viewer",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				_, err := usecase.Create(ctx, model.Task{Title: "Unit Test", WorkspaceID: &workspaceId})
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return(model.WorkspaceRoleViewer, nil)
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantErr: errs.NewErrs(http.StatusForbidden, "insufficient workspace role"),
+		},
+		{
+			name: "error when create task in unknown workspace",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				_, err := usecase.Create(ctx, model.Task{Title: "Unit Test", WorkspaceID: &workspaceId})
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return("", sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantErr: errs.NewErrs(http.StatusBadRequest, "workspace not found"),
+		},
+		{
+			name: "error when get workspace role",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				_, err := usecase.Create(ctx, model.Task{Title: "Unit Test", WorkspaceID: &workspaceId})
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return("", errors.New("some error"))
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "success read workspace task as viewer",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				_, err := usecase.GetByID(ctx, taskId)
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(shared, nil)
+				taskRepository.On("Progress", mock.Anything, []int64{taskId}).Return(map[int64]model.TaskProgress{}, nil)
+				taskRepository.On("GetLabels", mock.Anything, []int64{taskId}).Return(map[int64][]model.Label{}, nil)
+				taskRepository.On("CountComments", mock.Anything, []int64{taskId}).Return(map[int64]int64{}, nil)
+				taskRepository.On("ChecklistSummary", mock.Anything, []int64{taskId}).Return(map[int64]model.TaskChecklistSummary{}, nil)
+				taskRepository.On("GetOccurrences", mock.Anything, []int64{taskId}).Return(map[int64]model.TaskOccurrence{}, nil)
+				taskRepository.On("TrackedTime", mock.Anything, []int64{taskId}).Return(map[int64]int64{}, nil)
+				taskRepository.On("GetChecklist", mock.Anything, taskId).Return([]model.TaskChecklistItem{}, nil)
+				taskRepository.AssertNotCalled(t, "GetRole")
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success transition workspace task as editor",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				_, err := usecase.Transition(ctx, taskId, model.TaskStatusInProgress)
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(shared, nil)
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return(model.WorkspaceRoleEditor, nil)
+				taskRepository.On("Update", mock.Anything, mock.Anything, userId).Return(shared, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when update workspace task as viewer",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				_, err := usecase.Update(ctx, model.Task{ID: taskId, Title: "Renamed"})
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(shared, nil)
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return(model.WorkspaceRoleViewer, nil)
+				taskRepository.AssertNotCalled(t, "Update")
+			},
+			wantErr: errs.NewErrs(http.StatusForbidden, "insufficient workspace role"),
+		},
+		{
+			name: "error when delete workspace task as viewer",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				return usecase.Delete(ctx, taskId, etag.Match{}, false)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(shared, nil)
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return(model.WorkspaceRoleViewer, nil)
+				taskRepository.AssertNotCalled(t, "WithTransaction")
+			},
+			wantErr: errs.NewErrs(http.StatusForbidden, "insufficient workspace role"),
+		},
+		{
+			name: "error when restore workspace task as viewer",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				_, err := usecase.Restore(ctx, taskId)
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetTrashedByID", mock.Anything, taskId, userId).Return(shared, nil)
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return(model.WorkspaceRoleViewer, nil)
+				taskRepository.AssertNotCalled(t, "Restore")
+			},
+			wantErr: errs.NewErrs(http.StatusForbidden, "insufficient workspace role"),
+		},
+		{
+			name: "error when detach label from workspace task as viewer",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				_, err := usecase.DetachLabel(ctx, taskId, int64(4))
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(shared, nil)
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return(model.WorkspaceRoleViewer, nil)
+				taskRepository.AssertNotCalled(t, "DetachLabel")
+			},
+			wantErr: errs.NewErrs(http.StatusForbidden, "insufficient workspace role"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+			taskRepository.On("WithTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(repository taskrepository.TaskRepository) error) error {
+				return fn(&taskRepository)
+			}).Maybe()
+			taskRepository.On("CreateHistory", mock.Anything, mock.Anything).Return(model.TaskHistory{}, nil).Maybe()
+			taskRepository.On("LockPositions", mock.Anything, mock.Anything).Return(nil).Maybe()
+			taskRepository.On("LastPosition", mock.Anything, mock.Anything).Return("V", nil).Maybe()
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			err := tt.call(usecase, ctx)
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskGetByWorkspaceID(t *testing.T) {
+	workspaceId := int64(3)
+	userId := int64(1)
+
+	tasks := []model.Task{
+		{ID: 2, Title: "Write Test", Status: model.TaskStatusTodo, WorkspaceID: &workspaceId, UserID: userId},
+	}
+
+	tests := []struct {
+		name       string
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult []model.Task
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return(model.WorkspaceRoleViewer, nil)
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.MatchedBy(func(p param.Param) bool {
+					return p.WorkspaceID != nil && *p.WorkspaceID == workspaceId
+				})).Return(slices.Clone(tasks), nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(1), nil)
+				taskRepository.On("Progress", mock.Anything, []int64{2}).Return(map[int64]model.TaskProgress{}, nil)
+				taskRepository.On("GetLabels", mock.Anything, []int64{2}).Return(map[int64][]model.Label{}, nil)
+				taskRepository.On("CountComments", mock.Anything, []int64{2}).Return(map[int64]int64{}, nil)
+				taskRepository.On("ChecklistSummary", mock.Anything, []int64{2}).Return(map[int64]model.TaskChecklistSummary{}, nil)
+				taskRepository.On("GetOccurrences", mock.Anything, []int64{2}).Return(map[int64]model.TaskOccurrence{}, nil)
+				taskRepository.On("TrackedTime", mock.Anything, []int64{2}).Return(map[int64]int64{}, nil)
+			},
+			wantResult: []model.Task{
+				{ID: 2, Title: "Write Test", Status: model.TaskStatusTodo, WorkspaceID: &workspaceId, UserID: userId, Progress: &model.TaskProgress{}},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when user is not a member",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return("", sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "GetByUserID")
+			},
+			wantResult: []model.Task{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "workspace not found"),
+		},
+		{
+			name: "error when get workspace role",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return("", errors.New("some error"))
+				taskRepository.AssertNotCalled(t, "GetByUserID")
+			},
+			wantResult: []model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.GetByWorkspaceID(ctx, workspaceId, &param.Param{Page: 1, Limit: 10})
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskAssignee(t *testing.T) {
+	taskId := int64(1)
+	userId := int64(1)
+	assigneeId := int64(2)
+	workspaceId := int64(3)
+	assignedAt := time.Date(2023, time.August, 15, 12, 0, 0, 0, time.UTC)
+
+	personal := model.Task{
+		ID:      taskId,
+		Title:   "Unit Test",
+		Status:  model.TaskStatusTodo,
+		UserID:  userId,
+		Version: 1,
+	}
+
+	shared := personal
+	shared.WorkspaceID = &workspaceId
+
+	assigned := shared
+	assigned.AssigneeID = &assigneeId
+	assigned.AssignedAt = &assignedAt
+
+	tests := []struct {
+		name     string
+		call     func(usecase task.TaskUsecase, ctx context.Context) error
+		mockDeps func(taskRepository *taskmocks.MockTaskRepository)
+		wantErr  error
+	}{
+		{
+			name: "success create personal task assigned to owner",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				_, err := usecase.Create(ctx, model.Task{Title: "Unit Test", AssigneeID: &userId})
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("Create", mock.Anything, mock.MatchedBy(func(task model.Task) bool {
+					return task.AssigneeID != nil && *task.AssigneeID == userId && task.AssignedAt != nil
+				})).Return(personal, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when create personal task assigned to other user",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				_, err := usecase.Create(ctx, model.Task{Title: "Unit Test", AssigneeID: &assigneeId})
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantErr: errs.NewErrs(http.StatusBadRequest, "assignee has no access to task"),
+		},
+		{
+			name: "success create workspace task assigned to member",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				_, err := usecase.Create(ctx, model.Task{Title: "Unit Test", WorkspaceID: &workspaceId, AssigneeID: &assigneeId})
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return(model.WorkspaceRoleEditor, nil)
+				taskRepository.On("GetRole", mock.Anything, workspaceId, assigneeId).Return(model.WorkspaceRoleViewer, nil)
+				taskRepository.On("Create", mock.Anything, mock.MatchedBy(func(task model.Task) bool {
+					return task.AssigneeID != nil && *task.AssigneeID == assigneeId && task.AssignedAt != nil
+				})).Return(assigned, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when assignee is not a workspace member",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				_, err := usecase.Create(ctx, model.Task{Title: "Unit Test", WorkspaceID: &workspaceId, AssigneeID: &assigneeId})
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return(model.WorkspaceRoleEditor, nil)
+				taskRepository.On("GetRole", mock.Anything, workspaceId, assigneeId).Return("", sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantErr: errs.NewErrs(http.StatusBadRequest, "assignee has no access to task"),
+		},
+		{
+			name: "error when get assignee workspace role",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				_, err := usecase.Create(ctx, model.Task{Title: "Unit Test", WorkspaceID: &workspaceId, AssigneeID: &assigneeId})
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return(model.WorkspaceRoleEditor, nil)
+				taskRepository.On("GetRole", mock.Anything, workspaceId, assigneeId).Return("", errors.New("some error"))
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "success update task assignee",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				_, err := usecase.Update(ctx, model.Task{ID: taskId, Title: "Unit Test", AssigneeID: &assigneeId})
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(shared, nil)
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return(model.WorkspaceRoleEditor, nil)
+				taskRepository.On("GetRole", mock.Anything, workspaceId, assigneeId).Return(model.WorkspaceRoleEditor, nil)
+				taskRepository.On("Update", mock.Anything, mock.MatchedBy(func(task model.Task) bool {
+					return task.AssigneeID != nil && *task.AssigneeID == assigneeId && task.AssignedAt != nil
+				}), userId).Return(assigned, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success update keeps assignment time when assignee is unchanged",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				_, err := usecase.Update(ctx, model.Task{ID: taskId, Title: "Unit Test", AssigneeID: &assigneeId})
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(assigned, nil)
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return(model.WorkspaceRoleEditor, nil)
+				taskRepository.On("Update", mock.Anything, mock.MatchedBy(func(task model.Task) bool {
+					return task.AssignedAt != nil && task.AssignedAt.Equal(assignedAt)
+				}), userId).Return(assigned, nil)
+				taskRepository.AssertNotCalled(t, "GetRole", mock.Anything, workspaceId, assigneeId)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success unassign task",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				_, err := usecase.Patch(ctx, taskId, model.TaskPatch{Fields: []string{"assignee_id"}})
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(assigned, nil)
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return(model.WorkspaceRoleEditor, nil)
+				taskRepository.On("Patch", mock.Anything, taskId, userId, mock.MatchedBy(func(patch model.TaskPatch) bool {
+					return patch.AssigneeID == nil && patch.AssignedAt == nil
+				})).Return(shared, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when patch assignee without access",
+			call: func(usecase task.TaskUsecase, ctx context.Context) error {
+				_, err := usecase.Patch(ctx, taskId, model.TaskPatch{AssigneeID: &assigneeId, Fields: []string{"assignee_id"}})
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(personal, nil)
+				taskRepository.AssertNotCalled(t, "Patch")
+			},
+			wantErr: errs.NewErrs(http.StatusBadRequest, "assignee has no access to task"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+			taskRepository.On("WithTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(repository taskrepository.TaskRepository) error) error {
+				return fn(&taskRepository)
+			}).Maybe()
+			taskRepository.On("CreateHistory", mock.Anything, mock.Anything).Return(model.TaskHistory{}, nil).Maybe()
+			taskRepository.On("LockPositions", mock.Anything, mock.Anything).Return(nil).Maybe()
+			taskRepository.On("LastPosition", mock.Anything, mock.Anything).Return("V", nil).Maybe()
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			err := tt.call(usecase, ctx)
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskGetByUserIDAssignee(t *testing.T) {
+	userId := int64(1)
+	assigneeId := int64(2)
+
+	tests := []struct {
+		name     string
+		assignee string
+		mockDeps func(taskRepository *taskmocks.MockTaskRepository)
+		wantErr  error
+	}{
+		{
+			name:     "success assigned to me",
+			assignee: model.TaskAssigneeMe,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.MatchedBy(func(p param.Param) bool {
+					return p.AssigneeID != nil && *p.AssigneeID == userId && !p.Unassigned
+				})).Return([]model.Task{}, nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(0), nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:     "success assigned to user id",
+			assignee: "2",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.MatchedBy(func(p param.Param) bool {
+					return p.AssigneeID != nil && *p.AssigneeID == assigneeId
+				})).Return([]model.Task{}, nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(0), nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:     "success unassigned",
+			assignee: model.TaskAssigneeNone,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByUserID", mock.Anything, userId, mock.MatchedBy(func(p param.Param) bool {
+					return p.AssigneeID == nil && p.Unassigned
+				})).Return([]model.Task{}, nil)
+				taskRepository.On("Count", mock.Anything, userId, mock.Anything).Return(int64(0), nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:     "error when assignee is invalid",
+			assignee: "someone",
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "GetByUserID")
+			},
+			wantErr: errs.NewErrs(http.StatusBadRequest, "invalid assignee"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			_, err := usecase.GetByUserID(ctx, userId, &param.Param{Page: 1, Limit: 10, Assignee: tt.assignee})
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskChecklist(t *testing.T) {
+	taskId := int64(1)
+	itemId := int64(2)
+	userId := int64(1)
+
+	item := model.TaskChecklistItem{ID: itemId, TaskID: taskId, Text: "write tests", Position: 1}
+	checklist := []model.TaskChecklistItem{
+		{ID: 1, TaskID: taskId, Text: "review", Position: 1},
+		{ID: itemId, TaskID: taskId, Text: "write tests", Position: 2},
+	}
+	reordered := []model.TaskChecklistItem{
+		{ID: itemId, TaskID: taskId, Text: "write tests", Position: 1},
+		{ID: 1, TaskID: taskId, Text: "review", Position: 2},
+	}
+
+	transaction := func(taskRepository *taskmocks.MockTaskRepository) {
+		taskRepository.On("WithTransaction", mock.Anything, mock.Anything).
+			Return(func(ctx context.Context, fn func(taskrepository.TaskRepository) error) error {
+				return fn(taskRepository)
+			})
+	}
+
+	tests := []struct {
+		name       string
+		call       func(ctx context.Context, usecase task.TaskUsecase) (any, error)
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult any
+		wantErr    error
+	}{
+		{
+			name: "success add checklist item",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (any, error) {
+				return usecase.AddChecklistItem(ctx, taskId, model.TaskChecklistRequest{Text: "write tests"})
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId, UserID: userId}, nil)
+				taskRepository.On("CreateChecklistItem", mock.Anything, model.TaskChecklistItem{TaskID: taskId, Text: "write tests"}).Return(item, nil)
+			},
+			wantResult: item,
+			wantErr:    nil,
+		},
+		{
+			name: "error when add checklist item to missing task",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (any, error) {
+				return usecase.AddChecklistItem(ctx, taskId, model.TaskChecklistRequest{Text: "write tests"})
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{}, sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "CreateChecklistItem")
+			},
+			wantResult: model.TaskChecklistItem{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name: "error when create checklist item",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (any, error) {
+				return usecase.AddChecklistItem(ctx, taskId, model.TaskChecklistRequest{Text: "write tests"})
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId, UserID: userId}, nil)
+				taskRepository.On("CreateChecklistItem", mock.Anything, mock.Anything).Return(model.TaskChecklistItem{}, errors.New("some error"))
+			},
+			wantResult: model.TaskChecklistItem{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "success toggle checklist item",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (any, error) {
+				return usecase.ToggleChecklistItem(ctx, taskId, itemId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId, UserID: userId}, nil)
+				taskRepository.On("ToggleChecklistItem", mock.Anything, itemId, taskId).Return(model.TaskChecklistItem{ID: itemId, TaskID: taskId, Checked: true}, nil)
+			},
+			wantResult: model.TaskChecklistItem{ID: itemId, TaskID: taskId, Checked: true},
+			wantErr:    nil,
+		},
+		{
+			name: "error when toggle missing checklist item",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (any, error) {
+				return usecase.ToggleChecklistItem(ctx, taskId, itemId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId, UserID: userId}, nil)
+				taskRepository.On("ToggleChecklistItem", mock.Anything, itemId, taskId).Return(model.TaskChecklistItem{}, sql.ErrNoRows)
+			},
+			wantResult: model.TaskChecklistItem{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "checklist item not found"),
+		},
+		{
+			name: "error when toggle checklist item in workspace as viewer",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (any, error) {
+				return usecase.ToggleChecklistItem(ctx, taskId, itemId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				workspaceId := int64(3)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId, UserID: int64(9), WorkspaceID: &workspaceId}, nil)
+				taskRepository.On("GetRole", mock.Anything, workspaceId, userId).Return(model.WorkspaceRoleViewer, nil)
+				taskRepository.AssertNotCalled(t, "ToggleChecklistItem")
+			},
+			wantResult: model.TaskChecklistItem{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "insufficient workspace role"),
+		},
+		{
+			name: "success reorder checklist",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (any, error) {
+				return usecase.ReorderChecklist(ctx, taskId, model.TaskChecklistOrderRequest{ItemIDs: []int64{itemId, 1}})
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId, UserID: userId}, nil)
+				taskRepository.On("GetChecklist", mock.Anything, taskId).Return(checklist, nil).Once()
+				transaction(taskRepository)
+				taskRepository.On("MoveChecklistItem", mock.Anything, itemId, taskId, 1).Return(nil)
+				taskRepository.On("MoveChecklistItem", mock.Anything, int64(1), taskId, 2).Return(nil)
+				taskRepository.On("GetChecklist", mock.Anything, taskId).Return(reordered, nil).Once()
+			},
+			wantResult: reordered,
+			wantErr:    nil,
+		},
+		{
+			name: "error when reorder checklist with missing item",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (any, error) {
+				return usecase.ReorderChecklist(ctx, taskId, model.TaskChecklistOrderRequest{ItemIDs: []int64{itemId}})
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId, UserID: userId}, nil)
+				taskRepository.On("GetChecklist", mock.Anything, taskId).Return(checklist, nil)
+				taskRepository.AssertNotCalled(t, "WithTransaction")
+			},
+			wantResult: []model.TaskChecklistItem{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "item_ids must list every checklist item exactly once"),
+		},
+		{
+			name: "error when reorder checklist with duplicate item",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (any, error) {
+				return usecase.ReorderChecklist(ctx, taskId, model.TaskChecklistOrderRequest{ItemIDs: []int64{itemId, itemId, 1}})
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId, UserID: userId}, nil)
+				taskRepository.On("GetChecklist", mock.Anything, taskId).Return(checklist, nil)
+				taskRepository.AssertNotCalled(t, "WithTransaction")
+			},
+			wantResult: []model.TaskChecklistItem{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "item_ids must list every checklist item exactly once"),
+		},
+		{
+			name: "error when move checklist item",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (any, error) {
+				return usecase.ReorderChecklist(ctx, taskId, model.TaskChecklistOrderRequest{ItemIDs: []int64{itemId, 1}})
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId, UserID: userId}, nil)
+				taskRepository.On("GetChecklist", mock.Anything, taskId).Return(checklist, nil)
+				transaction(taskRepository)
+				taskRepository.On("MoveChecklistItem", mock.Anything, itemId, taskId, 1).Return(errors.New("some error"))
+			},
+			wantResult: []model.TaskChecklistItem{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "success remove checklist item",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (any, error) {
+				return nil, usecase.RemoveChecklistItem(ctx, taskId, itemId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId, UserID: userId}, nil)
+				taskRepository.On("DeleteChecklistItem", mock.Anything, itemId, taskId).Return(nil)
+			},
+			wantResult: nil,
+			wantErr:    nil,
+		},
+		{
+			name: "error when remove missing checklist item",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (any, error) {
+				return nil, usecase.RemoveChecklistItem(ctx, taskId, itemId)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId, UserID: userId}, nil)
+				taskRepository.On("DeleteChecklistItem", mock.Anything, itemId, taskId).Return(sql.ErrNoRows)
+			},
+			wantResult: nil,
+			wantErr:    errs.NewErrs(http.StatusNotFound, "checklist item not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := tt.call(ctx, usecase)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+			taskRepository.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskRecurrence(t *testing.T) {
+	taskId := int64(1)
+	userId := int64(1)
+	seriesId := int64(5)
+
+	dueAt := time.Date(2026, time.January, 5, 9, 0, 0, 0, time.UTC)
+	movedAt := dueAt.Add(time.Hour)
+
+	request := model.Task{Title: "Water plants", Description: "balcony", Recurrence: "FREQ=WEEKLY;BYDAY=MO", DueAt: &dueAt}
+	created := model.Task{ID: taskId, Title: "Water plants", Description: "balcony", Status: model.TaskStatusTodo, Priority: model.TaskPriorityMedium, DueAt: &dueAt, UserID: userId, Version: 1}
+	series := model.TaskSeries{ID: seriesId, Rule: "FREQ=WEEKLY;BYDAY=MO", DTStart: dueAt, Title: "Water plants", Description: "balcony", Priority: model.TaskPriorityMedium, UserID: userId}
+	occurrence := model.TaskOccurrence{TaskID: taskId, SeriesID: seriesId, Occurrence: 2, ScheduledAt: dueAt, Rule: series.Rule, Latest: 2}
+	futureAt := dueAt.AddDate(0, 0, 7)
+	rescheduledAt := movedAt.AddDate(0, 0, 3)
+	future := model.Task{ID: 9, Title: "Water plants", Description: "balcony", Status: model.TaskStatusTodo, Priority: model.TaskPriorityMedium, DueAt: &futureAt, UserID: userId, Version: 1}
+
+	transaction := func(taskRepository *taskmocks.MockTaskRepository) {
+		taskRepository.On("WithTransaction", mock.Anything, mock.Anything).
+			Return(func(ctx context.Context, fn func(taskrepository.TaskRepository) error) error {
+				return fn(taskRepository)
+			})
+	}
+
+	tests := []struct {
+		name       string
+		call       func(ctx context.Context, usecase task.TaskUsecase) (model.Task, error)
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult model.Task
+		wantErr    error
+	}{
+		{
+			name: "success create recurring task",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (model.Task, error) {
+				return usecase.Create(ctx, request)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("Create", mock.Anything, mock.MatchedBy(func(ts model.Task) bool {
+					return ts.Title == request.Title && ts.UserID == userId
+				})).Return(created, nil)
+				taskRepository.On("CreateSeries", mock.Anything, model.TaskSeries{
+					Rule:        request.Recurrence,
+					DTStart:     dueAt,
+					Title:       request.Title,
+					Description: request.Description,
+					Priority:    model.TaskPriorityMedium,
+					UserID:      userId,
+				}).Return(series, nil)
+				taskRepository.On("CreateOccurrence", mock.Anything, model.TaskOccurrence{TaskID: taskId, SeriesID: seriesId, Occurrence: 1, ScheduledAt: dueAt}).Return(nil)
+			},
+			wantResult: model.Task{ID: taskId, Title: "Water plants", Description: "balcony", Status: model.TaskStatusTodo, Priority: model.TaskPriorityMedium, DueAt: &dueAt, UserID: userId, Version: 1, Recurrence: series.Rule, SeriesID: &seriesId, Occurrence: 1},
+			wantErr:    nil,
+		},
+		{
+			name: "error when create recurring task without due date",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (model.Task, error) {
+				data := request
+				data.DueAt = nil
+				return usecase.Create(ctx, data)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "due_at is required for recurring task"),
+		},
+		{
+			name: "error when create recurring task with invalid rule",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (model.Task, error) {
+				data := request
+				data.Recurrence = "FREQ=YEARLY"
+				return usecase.Create(ctx, data)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "invalid recurrence rule: unsupported FREQ YEARLY"),
+		},
+		{
+			name: "error when create series",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (model.Task, error) {
+				return usecase.Create(ctx, request)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("Create", mock.Anything, mock.Anything).Return(created, nil)
+				taskRepository.On("CreateSeries", mock.Anything, mock.Anything).Return(model.TaskSeries{}, errors.New("some error"))
+				taskRepository.AssertNotCalled(t, "CreateOccurrence")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "success update all future occurrences",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (model.Task, error) {
+				return usecase.UpdateSeries(ctx, model.Task{ID: taskId, Title: "Water all plants", Description: "balcony", DueAt: &movedAt, Recurrence: "FREQ=WEEKLY;BYDAY=MO,TH"})
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(created, nil)
+				taskRepository.On("GetOccurrence", mock.Anything, taskId).Return(occurrence, nil)
+				taskRepository.On("GetSeries", mock.Anything, seriesId).Return(series, nil)
+				transaction(taskRepository)
+				taskRepository.On("UpdateSeries", mock.Anything, model.TaskSeries{
+					ID:          seriesId,
+					Rule:        "FREQ=WEEKLY;BYDAY=MO,TH",
+					DTStart:     movedAt,
+					Title:       "Water all plants",
+					Description: "balcony",
+					Priority:    model.TaskPriorityMedium,
+					UserID:      userId,
+					Offset:      1,
+				}).Return(nil)
+				taskRepository.On("Update", mock.Anything, mock.MatchedBy(func(ts model.Task) bool {
+					return ts.ID == taskId && ts.Title == "Water all plants" && ts.DueAt.Equal(movedAt)
+				}), userId).Return(model.Task{ID: taskId, Title: "Water all plants", Description: "balcony", Status: model.TaskStatusTodo, Priority: model.TaskPriorityMedium, DueAt: &movedAt, Version: 2}, nil)
+				taskRepository.On("GetFutureOccurrences", mock.Anything, seriesId, 2, userId).Return([]model.Task{future}, nil)
+				taskRepository.On("UpdateOccurrence", mock.Anything, model.TaskOccurrence{TaskID: future.ID, SeriesID: seriesId, ScheduledAt: rescheduledAt}).Return(nil)
+				taskRepository.On("Update", mock.Anything, mock.MatchedBy(func(ts model.Task) bool {
+					return ts.ID == future.ID && ts.Title == "Water all plants" && ts.DueAt.Equal(rescheduledAt) && ts.Version == future.Version
+				}), userId).Return(model.Task{ID: future.ID, Title: "Water all plants", DueAt: &rescheduledAt, Version: 2}, nil)
+			},
+			wantResult: model.Task{ID: taskId, Title: "Water all plants", Description: "balcony", Status: model.TaskStatusTodo, Priority: model.TaskPriorityMedium, DueAt: &movedAt, Version: 2, Recurrence: "FREQ=WEEKLY;BYDAY=MO,TH", SeriesID: &seriesId, Occurrence: 2},
+			wantErr:    nil,
+		},
+		{
+			name: "success delete future occurrences beyond carried count",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (model.Task, error) {
+				return usecase.UpdateSeries(ctx, model.Task{ID: taskId, Title: "Water plants", Description: "balcony", DueAt: &movedAt, Recurrence: "FREQ=WEEKLY;BYDAY=MO;COUNT=2"})
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				counted := series
+				counted.Rule = "FREQ=WEEKLY;BYDAY=MO;COUNT=2"
+
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(created, nil)
+				taskRepository.On("GetOccurrence", mock.Anything, taskId).Return(model.TaskOccurrence{TaskID: taskId, SeriesID: seriesId, Occurrence: 2, ScheduledAt: dueAt, Rule: counted.Rule, Latest: 3}, nil)
+				taskRepository.On("GetSeries", mock.Anything, seriesId).Return(counted, nil)
+				transaction(taskRepository)
+				taskRepository.On("UpdateSeries", mock.Anything, mock.MatchedBy(func(ts model.TaskSeries) bool {
+					return ts.DTStart.Equal(movedAt) && ts.Offset == 1
+				})).Return(nil)
+				taskRepository.On("Update", mock.Anything, mock.Anything, userId).Return(model.Task{ID: taskId, Title: "Water plants", Description: "balcony", Status: model.TaskStatusTodo, Priority: model.TaskPriorityMedium, DueAt: &movedAt, Version: 2}, nil)
+				taskRepository.On("GetFutureOccurrences", mock.Anything, seriesId, 2, userId).Return([]model.Task{future}, nil)
+				taskRepository.On("Delete", mock.Anything, future.ID, userId, future.Version).Return(nil)
+				taskRepository.AssertNotCalled(t, "UpdateOccurrence")
+			},
+			wantResult: model.Task{ID: taskId, Title: "Water plants", Description: "balcony", Status: model.TaskStatusTodo, Priority: model.TaskPriorityMedium, DueAt: &movedAt, Version: 2, Recurrence: "FREQ=WEEKLY;BYDAY=MO;COUNT=2", SeriesID: &seriesId, Occurrence: 2},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get future occurrences rolls back series",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (model.Task, error) {
+				return usecase.UpdateSeries(ctx, model.Task{ID: taskId, Title: "Water plants", Description: "balcony", DueAt: &movedAt})
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(created, nil)
+				taskRepository.On("GetOccurrence", mock.Anything, taskId).Return(occurrence, nil)
+				taskRepository.On("GetSeries", mock.Anything, seriesId).Return(series, nil)
+				transaction(taskRepository)
+				taskRepository.On("UpdateSeries", mock.Anything, mock.Anything).Return(nil)
+				taskRepository.On("Update", mock.Anything, mock.Anything, userId).Return(model.Task{ID: taskId, DueAt: &movedAt, Version: 2}, nil)
+				taskRepository.On("GetFutureOccurrences", mock.Anything, seriesId, 2, userId).Return([]model.Task{}, errors.New("some error"))
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when update all future occurrences of a task that is not recurring",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (model.Task, error) {
+				return usecase.UpdateSeries(ctx, model.Task{ID: taskId, Title: "Water plants", Description: "balcony", DueAt: &dueAt})
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(created, nil)
+				taskRepository.On("GetOccurrence", mock.Anything, taskId).Return(model.TaskOccurrence{}, sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "UpdateSeries")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "task is not recurring"),
+		},
+		{
+			name: "error when update all future occurrences without due date",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (model.Task, error) {
+				return usecase.UpdateSeries(ctx, model.Task{ID: taskId, Title: "Water plants", Description: "balcony"})
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(created, nil)
+				taskRepository.On("GetOccurrence", mock.Anything, taskId).Return(occurrence, nil)
+				taskRepository.On("GetSeries", mock.Anything, seriesId).Return(series, nil)
+				taskRepository.AssertNotCalled(t, "UpdateSeries")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "due_at is required for recurring task"),
+		},
+		{
+			name: "error when update occurrence rolls back series",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (model.Task, error) {
+				return usecase.UpdateSeries(ctx, model.Task{ID: taskId, Title: "Water plants", Description: "balcony", DueAt: &dueAt, IfMatch: etag.Exact(7)})
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(created, nil)
+				taskRepository.On("GetOccurrence", mock.Anything, taskId).Return(occurrence, nil)
+				taskRepository.On("GetSeries", mock.Anything, seriesId).Return(series, nil)
+				transaction(taskRepository)
+				taskRepository.On("UpdateSeries", mock.Anything, series).Return(nil)
+				taskRepository.AssertNotCalled(t, "Update")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusPreconditionFailed, "task has been modified"),
+		},
+		{
+			name: "error when update series",
+			call: func(ctx context.Context, usecase task.TaskUsecase) (model.Task, error) {
+				return usecase.UpdateSeries(ctx, model.Task{ID: taskId, Title: "Water plants", Description: "balcony", DueAt: &dueAt})
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(created, nil)
+				taskRepository.On("GetOccurrence", mock.Anything, taskId).Return(occurrence, nil)
+				taskRepository.On("GetSeries", mock.Anything, seriesId).Return(series, nil)
+				transaction(taskRepository)
+				taskRepository.On("UpdateSeries", mock.Anything, mock.Anything).Return(errors.New("some error"))
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+			taskRepository.On("WithTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(repository taskrepository.TaskRepository) error) error {
+				return fn(&taskRepository)
+			}).Maybe()
+			taskRepository.On("CreateHistory", mock.Anything, mock.Anything).Return(model.TaskHistory{}, nil).Maybe()
+			taskRepository.On("LockPositions", mock.Anything, mock.Anything).Return(nil).Maybe()
+			taskRepository.On("LastPosition", mock.Anything, mock.Anything).Return("V", nil).Maybe()
+			taskRepository.On("GetFutureOccurrences", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]model.Task{}, nil).Maybe()
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := tt.call(ctx, usecase)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTaskHistory(t *testing.T) {
+	taskId := int64(1)
+	userId := int64(1)
+
+	dueAt := time.Date(2026, time.January, 5, 9, 0, 0, 0, time.FixedZone("WIB", 7*60*60))
+	current := model.Task{ID: taskId, Title: "Unit Test", Description: "for completness", Status: model.TaskStatusTodo, Priority: model.TaskPriorityMedium, UserID: userId, Version: 1}
+	updated := current
+	updated.Title, updated.DueAt, updated.Version = "Integration Test", &dueAt, 2
+	transitioned := current
+	transitioned.Status, transitioned.Version = model.TaskStatusInProgress, 2
+
+	transaction := func(taskRepository *taskmocks.MockTaskRepository) {
+		taskRepository.On("WithTransaction", mock.Anything, mock.Anything).
+			Return(func(ctx context.Context, fn func(taskrepository.TaskRepository) error) error {
+				return fn(taskRepository)
+			})
+	}
+
+	history := func(action string, changes model.TaskChanges) model.TaskHistory {
+		return model.TaskHistory{TaskID: taskId, Action: action, ActorID: userId, Changes: changes}
+	}
+
+	child := model.Task{ID: 5, Title: "Subtask", Status: model.TaskStatusTodo, Priority: model.TaskPriorityMedium, ParentID: &taskId, UserID: userId, Version: 2}
+	detached := child
+	detached.ParentID = nil
+	cascade := config.Configuration{Task: config.TaskConfiguration{OnParentDelete: model.TaskParentDeleteCascade}}
+
+	tests := []struct {
+		name     string
+		cfg      config.Configuration
+		call     func(ctx context.Context, usecase task.TaskUsecase) error
+		mockDeps func(taskRepository *taskmocks.MockTaskRepository)
+		wantErr  error
+	}{
+		{
+			name: "success record create",
+			call: func(ctx context.Context, usecase task.TaskUsecase) error {
+				_, err := usecase.Create(ctx, model.Task{Title: current.Title, Description: current.Description})
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("Create", mock.Anything, mock.Anything).Return(current, nil)
+				taskRepository.On("CreateHistory", mock.Anything, history(model.TaskActionCreate, model.TaskChanges{
+					{Field: "title", Old: []byte(`null`), New: []byte(`"Unit Test"`)},
+					{Field: "description", Old: []byte(`null`), New: []byte(`"for completness"`)},
+					{Field: "status", Old: []byte(`null`), New: []byte(`"todo"`)},
+					{Field: "priority", Old: []byte(`null`), New: []byte(`"medium"`)},
+				})).Return(model.TaskHistory{}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success record changed fields on update",
+			call: func(ctx context.Context, usecase task.TaskUsecase) error {
+				_, err := usecase.Update(ctx, model.Task{ID: taskId, Title: updated.Title, Description: current.Description, DueAt: &dueAt})
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("Update", mock.Anything, mock.Anything, userId).Return(updated, nil)
+				taskRepository.On("CreateHistory", mock.Anything, history(model.TaskActionUpdate, model.TaskChanges{
+					{Field: "title", Old: []byte(`"Unit Test"`), New: []byte(`"Integration Test"`)},
+					{Field: "due_at", Old: []byte(`null`), New: []byte(`"2026-01-05T02:00:00Z"`)},
+				})).Return(model.TaskHistory{}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success skip history when update changes nothing",
+			call: func(ctx context.Context, usecase task.TaskUsecase) error {
+				_, err := usecase.Update(ctx, model.Task{ID: taskId, Title: current.Title, Description: current.Description})
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("Update", mock.Anything, mock.Anything, userId).Return(current, nil)
+				taskRepository.AssertNotCalled(t, "CreateHistory")
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success record transition",
+			call: func(ctx context.Context, usecase task.TaskUsecase) error {
+				_, err := usecase.Transition(ctx, taskId, model.TaskStatusInProgress)
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("Update", mock.Anything, mock.Anything, userId).Return(transitioned, nil)
+				taskRepository.On("CreateHistory", mock.Anything, history(model.TaskActionTransition, model.TaskChanges{
+					{Field: "status", Old: []byte(`"todo"`), New: []byte(`"in_progress"`)},
+				})).Return(model.TaskHistory{}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success record delete",
+			call: func(ctx context.Context, usecase task.TaskUsecase) error {
+				return usecase.Delete(ctx, taskId, etag.Match{}, false)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("Reparent", mock.Anything, taskId, userId, (*int64)(nil)).Return([]model.Task{}, nil)
+				taskRepository.On("Delete", mock.Anything, taskId, userId, current.Version).Return(nil)
+				taskRepository.On("CreateHistory", mock.Anything, history(model.TaskActionDelete, model.TaskChanges{})).Return(model.TaskHistory{}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success record permanent delete",
+			call: func(ctx context.Context, usecase task.TaskUsecase) error {
+				return usecase.Delete(ctx, taskId, etag.Match{}, true)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("Reparent", mock.Anything, taskId, userId, (*int64)(nil)).Return([]model.Task{}, nil)
+				taskRepository.On("CreateHistory", mock.Anything, history(model.TaskActionDestroy, model.TaskChanges{})).Return(model.TaskHistory{}, nil)
+				taskRepository.On("Destroy", mock.Anything, taskId, userId, current.Version).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success record reparented children on delete",
+			call: func(ctx context.Context, usecase task.TaskUsecase) error {
+				return usecase.Delete(ctx, taskId, etag.Match{}, false)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("Reparent", mock.Anything, taskId, userId, (*int64)(nil)).Return([]model.Task{detached}, nil)
+				taskRepository.On("CreateHistory", mock.Anything, model.TaskHistory{TaskID: child.ID, Action: model.TaskActionUpdate, ActorID: userId, Changes: model.TaskChanges{
+					{Field: "parent_id", Old: []byte(`1`), New: []byte(`null`)},
+				}}).Return(model.TaskHistory{}, nil)
+				taskRepository.On("Delete", mock.Anything, taskId, userId, current.Version).Return(nil)
+				taskRepository.On("CreateHistory", mock.Anything, history(model.TaskActionDelete, model.TaskChanges{})).Return(model.TaskHistory{}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success record cascaded delete",
+			cfg:  cascade,
+			call: func(ctx context.Context, usecase task.TaskUsecase) error {
+				return usecase.Delete(ctx, taskId, etag.Match{}, false)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("DeleteDescendants", mock.Anything, taskId, userId).Return([]model.Task{child}, nil)
+				taskRepository.On("CreateHistory", mock.Anything, model.TaskHistory{TaskID: child.ID, Action: model.TaskActionDelete, ActorID: userId, Changes: model.TaskChanges{}}).Return(model.TaskHistory{}, nil)
+				taskRepository.On("Delete", mock.Anything, taskId, userId, current.Version).Return(nil)
+				taskRepository.On("CreateHistory", mock.Anything, history(model.TaskActionDelete, model.TaskChanges{})).Return(model.TaskHistory{}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "success record cascaded permanent delete",
+			cfg:  cascade,
+			call: func(ctx context.Context, usecase task.TaskUsecase) error {
+				return usecase.Delete(ctx, taskId, etag.Match{}, true)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("GetDescendants", mock.Anything, taskId, userId).Return([]model.Task{child}, nil)
+				taskRepository.On("CreateHistory", mock.Anything, model.TaskHistory{TaskID: child.ID, Action: model.TaskActionDestroy, ActorID: userId, Changes: model.TaskChanges{}}).Return(model.TaskHistory{}, nil)
+				taskRepository.On("DestroyDescendants", mock.Anything, taskId, userId).Return(nil)
+				taskRepository.On("CreateHistory", mock.Anything, history(model.TaskActionDestroy, model.TaskChanges{})).Return(model.TaskHistory{}, nil)
+				taskRepository.On("Destroy", mock.Anything, taskId, userId, current.Version).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when record permanent delete keeps the task",
+			call: func(ctx context.Context, usecase task.TaskUsecase) error {
+				return usecase.Delete(ctx, taskId, etag.Match{}, true)
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("Reparent", mock.Anything, taskId, userId, (*int64)(nil)).Return([]model.Task{}, nil)
+				taskRepository.On("CreateHistory", mock.Anything, mock.Anything).Return(model.TaskHistory{}, errors.New("some error"))
+				taskRepository.AssertNotCalled(t, "Destroy")
+			},
+			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "success record restore",
+			call: func(ctx context.Context, usecase task.TaskUsecase) error {
+				_, err := usecase.Restore(ctx, taskId)
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetTrashedByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("RestoreDescendants", mock.Anything, taskId, userId).Return([]model.Task{}, nil)
+				taskRepository.On("Restore", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("Reattach", mock.Anything, taskId, userId, current.ParentID).Return([]model.Task{}, nil)
+				taskRepository.On("CreateHistory", mock.Anything, history(model.TaskActionRestore, model.TaskChanges{})).Return(model.TaskHistory{}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when create history fails the update",
+			call: func(ctx context.Context, usecase task.TaskUsecase) error {
+				_, err := usecase.Update(ctx, model.Task{ID: taskId, Title: updated.Title, Description: current.Description, DueAt: &dueAt})
+				return err
+			},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("Update", mock.Anything, mock.Anything, userId).Return(updated, nil)
+				taskRepository.On("CreateHistory", mock.Anything, mock.Anything).Return(model.TaskHistory{}, errors.New("some error"))
+			},
+			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+			taskRepository.On("LockPositions", mock.Anything, mock.Anything).Return(nil).Maybe()
+			taskRepository.On("LastPosition", mock.Anything, mock.Anything).Return("V", nil).Maybe()
+
+			usecase := task.New(&taskRepository, &tt.cfg)
+			err := tt.call(ctx, usecase)
+
+			assert.Equal(t, tt.wantErr, err)
+			taskRepository.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskGetHistory(t *testing.T) {
+	taskId := int64(1)
+	userId := int64(1)
+
+	entries := []model.TaskHistory{
+		{ID: 2, TaskID: taskId, Revision: 2, Action: model.TaskActionTransition, ActorID: userId, Changes: model.TaskChanges{{Field: "status", Old: []byte(`"todo"`), New: []byte(`"done"`)}}},
+		{ID: 1, TaskID: taskId, Revision: 1, Action: model.TaskActionCreate, ActorID: userId, Changes: model.TaskChanges{}},
+	}
+
+	tests := []struct {
+		name       string
+		param      param.Param
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult []model.TaskHistory
+		wantTotal  int64
+		wantErr    error
+	}{
+		{
+			name:  "success",
+			param: param.Param{Page: 2, Limit: 2},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId, UserID: userId}, nil)
+				taskRepository.On("GetHistory", mock.Anything, taskId, 2, 2).Return(entries, nil)
+				taskRepository.On("CountHistory", mock.Anything, taskId).Return(int64(4), nil)
+			},
+			wantResult: entries,
+			wantTotal:  4,
+			wantErr:    nil,
+		},
+		{
+			name:  "error when task is not found",
+			param: param.Param{Page: 1, Limit: 10},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{}, sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "GetHistory")
+			},
+			wantResult: []model.TaskHistory{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name:  "error when get history",
+			param: param.Param{Page: 1, Limit: 10},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId, UserID: userId}, nil)
+				taskRepository.On("GetHistory", mock.Anything, taskId, 10, 0).Return([]model.TaskHistory{}, errors.New("some error"))
+			},
+			wantResult: []model.TaskHistory{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:  "error when count history",
+			param: param.Param{Page: 1, Limit: 10},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{ID: taskId, UserID: userId}, nil)
+				taskRepository.On("GetHistory", mock.Anything, taskId, 10, 0).Return(entries, nil)
+				taskRepository.On("CountHistory", mock.Anything, taskId).Return(int64(0), errors.New("some error"))
+			},
+			wantResult: []model.TaskHistory{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+
+			param := tt.param
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.GetHistory(ctx, taskId, &param)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantTotal, param.Total)
+		})
+	}
+}
+
+func TestTaskRevert(t *testing.T) {
+	taskId := int64(1)
+	userId := int64(1)
+	otherId := int64(2)
+
+	current := model.Task{ID: taskId, Title: "Integration Test", Description: "for completness", Status: model.TaskStatusInProgress, Priority: model.TaskPriorityMedium, UserID: userId, Version: 3}
+	revision3 := model.TaskHistory{TaskID: taskId, Revision: 3, Action: model.TaskActionUpdate, ActorID: userId, Changes: model.TaskChanges{
+		{Field: "title", Old: []byte(`"Unit Test"`), New: []byte(`"Integration Test"`)},
+	}}
+	revision2 := model.TaskHistory{TaskID: taskId, Revision: 2, Action: model.TaskActionTransition, ActorID: otherId, Changes: model.TaskChanges{
+		{Field: "status", Old: []byte(`"todo"`), New: []byte(`"in_progress"`)},
+	}}
+	revision1 := model.TaskHistory{TaskID: taskId, Revision: 1, Action: model.TaskActionCreate, ActorID: userId, Changes: model.TaskChanges{}}
+
+	retitled := current
+	retitled.Title, retitled.Version = "E2E Test", 4
+	revision4 := model.TaskHistory{TaskID: taskId, Revision: 4, Action: model.TaskActionUpdate, ActorID: userId, Changes: model.TaskChanges{
+		{Field: "title", Old: []byte(`"Integration Test"`), New: []byte(`"E2E Test"`)},
+	}}
+
+	reverted := current
+	reverted.Title, reverted.Version = "Unit Test", 4
+	forced := reverted
+	forced.Status = model.TaskStatusTodo
+
+	transaction := func(taskRepository *taskmocks.MockTaskRepository) {
+		taskRepository.On("WithTransaction", mock.Anything, mock.Anything).
+			Return(func(ctx context.Context, fn func(taskrepository.TaskRepository) error) error {
+				return fn(taskRepository)
+			})
+	}
+
+	tests := []struct {
+		name       string
+		revision   int64
+		ifMatch    etag.Match
+		force      bool
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult model.Task
+		wantErr    error
+	}{
+		{
+			name:     "success revert own change",
+			revision: 2,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("GetHistorySince", mock.Anything, taskId, int64(2)).Return([]model.TaskHistory{revision3, revision2}, nil)
+				taskRepository.On("Update", mock.Anything, mock.MatchedBy(func(task model.Task) bool {
+					return task.Title == "Unit Test" && task.Status == model.TaskStatusInProgress && task.Version == current.Version
+				}), userId).Return(reverted, nil)
+				taskRepository.On("CreateHistory", mock.Anything, model.TaskHistory{TaskID: taskId, Action: model.TaskActionRevert, ActorID: userId, Changes: model.TaskChanges{
+					{Field: "title", Old: []byte(`"Integration Test"`), New: []byte(`"Unit Test"`)},
+				}}).Return(model.TaskHistory{}, nil)
+			},
+			wantResult: reverted,
+			wantErr:    nil,
+		},
+		{
+			name:     "success revert later changes by another user on other fields",
+			revision: 1,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("GetHistorySince", mock.Anything, taskId, int64(1)).Return([]model.TaskHistory{revision3, revision2, revision1}, nil)
+				taskRepository.On("LockPositions", mock.Anything, model.TaskLane{UserID: userId, Status: model.TaskStatusTodo}).Return(nil)
+				taskRepository.On("LastPosition", mock.Anything, model.TaskLane{UserID: userId, Status: model.TaskStatusTodo}).Return("V", nil)
+				taskRepository.On("Update", mock.Anything, mock.MatchedBy(func(task model.Task) bool {
+					return task.Title == "Unit Test" && task.Status == model.TaskStatusTodo
+				}), userId).Return(forced, nil)
+				taskRepository.On("CreateHistory", mock.Anything, mock.MatchedBy(func(history model.TaskHistory) bool {
+					return history.Action == model.TaskActionRevert
+				})).Return(model.TaskHistory{}, nil)
+			},
+			wantResult: forced,
+			wantErr:    nil,
+		},
+		{
+			name:     "success force revert conflicting change",
+			revision: 2,
+			force:    true,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(retitled, nil)
+				taskRepository.On("GetHistorySince", mock.Anything, taskId, int64(2)).Return([]model.TaskHistory{revision4, revision3, revision2}, nil)
+				taskRepository.On("Update", mock.Anything, mock.MatchedBy(func(task model.Task) bool {
+					return task.Title == "Unit Test" && task.Status == model.TaskStatusInProgress
+				}), userId).Return(reverted, nil)
+				taskRepository.On("CreateHistory", mock.Anything, mock.MatchedBy(func(history model.TaskHistory) bool {
+					return history.Action == model.TaskActionRevert
+				})).Return(model.TaskHistory{}, nil)
+			},
+			wantResult: reverted,
+			wantErr:    nil,
+		},
+		{
+			name:     "success when task is already at revision",
+			revision: 3,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("GetHistorySince", mock.Anything, taskId, int64(3)).Return([]model.TaskHistory{revision3}, nil)
+				taskRepository.AssertNotCalled(t, "Update")
+			},
+			wantResult: current,
+			wantErr:    nil,
+		},
+		{
+			name:     "error when later revision by the same user touches a reverted field",
+			revision: 2,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(retitled, nil)
+				taskRepository.On("GetHistorySince", mock.Anything, taskId, int64(2)).Return([]model.TaskHistory{revision4, revision3, revision2}, nil)
+				taskRepository.AssertNotCalled(t, "Update")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusConflict, "revert conflicts with later changes to title, use force to revert"),
+		},
+		{
+			name:     "error when revision is not found",
+			revision: 9,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("GetHistorySince", mock.Anything, taskId, int64(9)).Return([]model.TaskHistory{}, nil)
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "revision not found"),
+		},
+		{
+			name:     "error when get history since revision",
+			revision: 2,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("GetHistorySince", mock.Anything, taskId, int64(2)).Return([]model.TaskHistory{}, errors.New("some error"))
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:     "error when version is stale",
+			revision: 2,
+			ifMatch:  etag.Exact(2),
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.AssertNotCalled(t, "GetHistorySince")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusPreconditionFailed, "task has been modified"),
+		},
+		{
+			name:     "error when task is not found",
+			revision: 2,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(model.Task{}, sql.ErrNoRows)
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name:     "error when task is modified while reverting",
+			revision: 2,
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("GetHistorySince", mock.Anything, taskId, int64(2)).Return([]model.TaskHistory{revision3, revision2}, nil)
+				taskRepository.On("Update", mock.Anything, mock.Anything, userId).Return(model.Task{}, taskrepository.ErrVersionConflict)
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusPreconditionFailed, "task has been modified"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			ctx := context.WithValue(context.Background(), auth.IdKey, userId)
+
+			tt.mockDeps(&taskRepository)
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.Revert(ctx, taskId, tt.revision, tt.ifMatch, tt.force)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+			taskRepository.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskBurndown(t *testing.T) {
+	userId := int64(1)
+	projectId := int64(7)
+	day := func(d, h int) time.Time { return time.Date(2023, time.August, d, h, 0, 0, 0, time.UTC) }
+	pointer := func(v int) *int { return &v }
+
+	tasks := func() []model.Task {
+		deletedAt := day(3, 12)
+		return []model.Task{
+			{ID: 1, Status: model.TaskStatusDone, ProjectID: &projectId, StoryPoints: pointer(5), EstimateMinutes: pointer(120), CreatedAt: day(1, 0).AddDate(0, 0, -2)},
+			{ID: 2, Status: model.TaskStatusTodo, ProjectID: &projectId, StoryPoints: pointer(2), CreatedAt: day(2, 8)},
+			{ID: 3, Status: model.TaskStatusTodo, ProjectID: &projectId, StoryPoints: pointer(8), EstimateMinutes: pointer(60), CreatedAt: day(1, 0).AddDate(0, 0, -2), DeletedAt: &deletedAt},
+		}
+	}
+	history := []model.TaskHistory{
+		{TaskID: 3, Revision: 2, Action: model.TaskActionDelete, Changes: model.TaskChanges{}, CreatedAt: day(3, 12)},
+		{TaskID: 1, Revision: 3, Action: model.TaskActionUpdate, Changes: model.TaskChanges{{Field: "story_points", Old: []byte(`3`), New: []byte(`5`)}}, CreatedAt: day(3, 9)},
+		{TaskID: 1, Revision: 2, Action: model.TaskActionTransition, Changes: model.TaskChanges{{Field: "status", Old: []byte(`"in_progress"`), New: []byte(`"done"`)}}, CreatedAt: day(2, 10)},
+		{TaskID: 2, Revision: 1, Action: model.TaskActionCreate, Changes: model.TaskChanges{{Field: "status", Old: []byte(`null`), New: []byte(`"todo"`)}, {Field: "story_points", Old: []byte(`null`), New: []byte(`2`)}}, CreatedAt: day(2, 8)},
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		request    model.TaskBurndownRequest
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult model.TaskBurndown
+		wantErr    error
+	}{
+		{
+			name:    "success with project",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskBurndownRequest{ProjectID: &projectId, From: "2023-08-01", To: "2023-08-03"},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetProject", mock.Anything, projectId, userId).Return(model.Project{ID: projectId}, nil)
+				taskRepository.On("GetBurndownTasks", mock.Anything, userId, &projectId, day(4, 0)).Return(tasks(), nil)
+				taskRepository.On("GetHistoryByTaskIDs", mock.Anything, []int64{1, 2, 3}, day(2, 0)).Return(history, nil)
+			},
+			wantResult: model.TaskBurndown{
+				ProjectID: &projectId,
+				From:      "2023-08-01",
+				To:        "2023-08-03",
+				Metric:    model.TaskBurndownMetricPoints,
+				Points: []model.TaskBurndownPoint{
+					{Date: "2023-08-01", Scope: 11, Completed: 0, Remaining: 11, Ideal: 11},
+					{Date: "2023-08-02", Scope: 13, Completed: 3, Remaining: 10, Ideal: 5.5},
+					{Date: "2023-08-03", Scope: 7, Completed: 5, Remaining: 2, Ideal: 0},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "success with minutes for a single day",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskBurndownRequest{From: "2023-08-02", To: "2023-08-02", Metric: model.TaskBurndownMetricMinutes},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetBurndownTasks", mock.Anything, userId, (*int64)(nil), day(3, 0)).Return(tasks(), nil)
+				taskRepository.On("GetHistoryByTaskIDs", mock.Anything, []int64{1, 2, 3}, day(3, 0)).Return(history[:2], nil)
+			},
+			wantResult: model.TaskBurndown{
+				From:   "2023-08-02",
+				To:     "2023-08-02",
+				Metric: model.TaskBurndownMetricMinutes,
+				Points: []model.TaskBurndownPoint{
+					{Date: "2023-08-02", Scope: 180, Completed: 120, Remaining: 60, Ideal: 60},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "error when from is invalid",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskBurndownRequest{From: "yesterday", To: "2023-08-03"},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "GetBurndownTasks")
+			},
+			wantResult: model.TaskBurndown{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "invalid from"),
+		},
+		{
+			name:    "error when to is invalid",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskBurndownRequest{From: "2023-08-01", To: "2023-08-03T00:00:00Z"},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "GetBurndownTasks")
+			},
+			wantResult: model.TaskBurndown{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "invalid to"),
+		},
+		{
+			name:    "error when to is before from",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskBurndownRequest{From: "2023-08-03", To: "2023-08-01"},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "GetBurndownTasks")
+			},
+			wantResult: model.TaskBurndown{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "to must not be before from"),
+		},
+		{
+			name:    "error when range is too long",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskBurndownRequest{From: "2022-08-01", To: "2023-08-03"},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "GetBurndownTasks")
+			},
+			wantResult: model.TaskBurndown{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "burndown range must not exceed 366 days"),
+		},
+		{
+			name:    "error when project is not found",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskBurndownRequest{ProjectID: &projectId, From: "2023-08-01", To: "2023-08-03"},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetProject", mock.Anything, projectId, userId).Return(model.Project{}, sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "GetBurndownTasks")
+			},
+			wantResult: model.TaskBurndown{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "project not found"),
+		},
+		{
+			name:    "error when get project",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskBurndownRequest{ProjectID: &projectId, From: "2023-08-01", To: "2023-08-03"},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetProject", mock.Anything, projectId, userId).Return(model.Project{}, errors.New("some error"))
+			},
+			wantResult: model.TaskBurndown{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:    "error when get burndown tasks",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskBurndownRequest{From: "2023-08-01", To: "2023-08-03"},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetBurndownTasks", mock.Anything, userId, (*int64)(nil), day(4, 0)).Return([]model.Task{}, errors.New("some error"))
+			},
+			wantResult: model.TaskBurndown{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:    "error when get history",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskBurndownRequest{From: "2023-08-01", To: "2023-08-03"},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetBurndownTasks", mock.Anything, userId, (*int64)(nil), day(4, 0)).Return(tasks(), nil)
+				taskRepository.On("GetHistoryByTaskIDs", mock.Anything, []int64{1, 2, 3}, day(2, 0)).Return([]model.TaskHistory{}, errors.New("some error"))
+			},
+			wantResult: model.TaskBurndown{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:    "error when user id is missing",
+			ctx:     context.Background(),
+			request: model.TaskBurndownRequest{From: "2023-08-01", To: "2023-08-03"},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "GetBurndownTasks")
+			},
+			wantResult: model.TaskBurndown{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			tt.mockDeps(&taskRepository)
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.Burndown(tt.ctx, tt.request)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+			taskRepository.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskMove(t *testing.T) {
+	taskId := int64(1)
+	userId := int64(1)
+	prevId := int64(2)
+	nextId := int64(3)
+
+	current := model.Task{ID: taskId, Title: "Unit Test", Description: "for completness", Status: model.TaskStatusTodo, Priority: model.TaskPriorityMedium, Position: "V", UserID: userId, Version: 2}
+	prev := model.Task{ID: prevId, Status: model.TaskStatusInProgress, Position: "V00001"}
+	next := model.Task{ID: nextId, Status: model.TaskStatusInProgress, Position: "V00002"}
+	moved := current
+	moved.Status, moved.Position, moved.Version = model.TaskStatusInProgress, "V00001V", 3
+	reordered := current
+	reordered.Position, reordered.Version = "UV", 3
+	todo := model.TaskLane{UserID: userId, Status: model.TaskStatusTodo}
+	inProgress := model.TaskLane{UserID: userId, Status: model.TaskStatusInProgress}
+
+	transaction := func(taskRepository *taskmocks.MockTaskRepository) {
+		taskRepository.On("WithTransaction", mock.Anything, mock.Anything).
+			Return(func(ctx context.Context, fn func(taskrepository.TaskRepository) error) error {
+				return fn(taskRepository)
+			})
+	}
+
+	patch := func(status, position string) any {
+		return mock.MatchedBy(func(patch model.TaskPatch) bool {
+			return *patch.Status == status && *patch.Position == position && slices.Equal(patch.Fields, []string{"status", "position"}) && patch.Version == current.Version
+		})
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		request    model.TaskMove
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult model.Task
+		wantErr    error
+	}{
+		{
+			name:    "success move between neighbours in another status",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskMove{Status: model.TaskStatusInProgress, PrevID: &prevId, NextID: &nextId},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("LockPositions", mock.Anything, inProgress).Return(nil)
+				taskRepository.On("GetByIDs", mock.Anything, []int64{prevId, nextId}, userId).Return([]model.Task{prev, next}, nil)
+				taskRepository.On("Patch", mock.Anything, taskId, userId, patch(model.TaskStatusInProgress, "V00001V")).Return(moved, nil)
+				taskRepository.On("CreateHistory", mock.Anything, model.TaskHistory{TaskID: taskId, Action: model.TaskActionTransition, ActorID: userId, Changes: model.TaskChanges{
+					{Field: "status", Old: []byte(`"todo"`), New: []byte(`"in_progress"`)},
+				}}).Return(model.TaskHistory{}, nil)
+			},
+			wantResult: moved,
+			wantErr:    nil,
+		},
+		{
+			name:    "success reorder after previous neighbour",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskMove{Status: model.TaskStatusTodo, PrevID: &prevId},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("LockPositions", mock.Anything, todo).Return(nil)
+				taskRepository.On("GetByIDs", mock.Anything, []int64{prevId}, userId).Return([]model.Task{{ID: prevId, Status: model.TaskStatusTodo, Position: "U"}}, nil)
+				taskRepository.On("NextPosition", mock.Anything, todo, "U").Return("V", nil)
+				taskRepository.On("Patch", mock.Anything, taskId, userId, patch(model.TaskStatusTodo, "UV")).Return(reordered, nil)
+				taskRepository.AssertNotCalled(t, "CreateHistory")
+			},
+			wantResult: reordered,
+			wantErr:    nil,
+		},
+		{
+			name:    "success reorder before next neighbour",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskMove{Status: model.TaskStatusTodo, NextID: &nextId},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("LockPositions", mock.Anything, todo).Return(nil)
+				taskRepository.On("GetByIDs", mock.Anything, []int64{nextId}, userId).Return([]model.Task{{ID: nextId, Status: model.TaskStatusTodo, Position: "V"}}, nil)
+				taskRepository.On("PrevPosition", mock.Anything, todo, "V").Return("U", nil)
+				taskRepository.On("Patch", mock.Anything, taskId, userId, patch(model.TaskStatusTodo, "UV")).Return(reordered, nil)
+			},
+			wantResult: reordered,
+			wantErr:    nil,
+		},
+		{
+			name:    "success move to end of column",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskMove{Status: model.TaskStatusInProgress},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("LockPositions", mock.Anything, inProgress).Return(nil)
+				taskRepository.On("GetByIDs", mock.Anything, []int64{}, userId).Return([]model.Task{}, nil)
+				taskRepository.On("LastPosition", mock.Anything, inProgress).Return("V00001", nil)
+				taskRepository.On("Patch", mock.Anything, taskId, userId, patch(model.TaskStatusInProgress, "V00002")).Return(moved, nil)
+				taskRepository.On("CreateHistory", mock.Anything, mock.Anything).Return(model.TaskHistory{}, nil)
+			},
+			wantResult: moved,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when task is its own neighbour",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskMove{Status: model.TaskStatusTodo, PrevID: &taskId},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.AssertNotCalled(t, "Patch")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "task cannot be moved next to itself"),
+		},
+		{
+			name:    "error when neighbour is not found",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskMove{Status: model.TaskStatusInProgress, PrevID: &prevId, NextID: &nextId},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("LockPositions", mock.Anything, inProgress).Return(nil)
+				taskRepository.On("GetByIDs", mock.Anything, []int64{prevId, nextId}, userId).Return([]model.Task{prev}, nil)
+				taskRepository.AssertNotCalled(t, "Patch")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "neighbour task not found"),
+		},
+		{
+			name:    "error when neighbour is in another status",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskMove{Status: model.TaskStatusTodo, PrevID: &prevId},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("LockPositions", mock.Anything, todo).Return(nil)
+				taskRepository.On("GetByIDs", mock.Anything, []int64{prevId}, userId).Return([]model.Task{prev}, nil)
+				taskRepository.AssertNotCalled(t, "Patch")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "neighbour task 2 is not in status todo"),
+		},
+		{
+			name:    "error when neighbour is on another board",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskMove{Status: model.TaskStatusTodo, PrevID: &prevId},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				workspaceId := int64(5)
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("LockPositions", mock.Anything, todo).Return(nil)
+				taskRepository.On("GetByIDs", mock.Anything, []int64{prevId}, userId).Return([]model.Task{{ID: prevId, Status: model.TaskStatusTodo, Position: "U", WorkspaceID: &workspaceId}}, nil)
+				taskRepository.AssertNotCalled(t, "Patch")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "neighbour task 2 is not on the same board"),
+		},
+		{
+			name:    "error when neighbours are out of order",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskMove{Status: model.TaskStatusInProgress, PrevID: &nextId, NextID: &prevId},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("LockPositions", mock.Anything, inProgress).Return(nil)
+				taskRepository.On("GetByIDs", mock.Anything, []int64{nextId, prevId}, userId).Return([]model.Task{prev, next}, nil)
+				taskRepository.AssertNotCalled(t, "Patch")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusConflict, "neighbour tasks are out of order"),
+		},
+		{
+			name:    "error when get neighbour position",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskMove{Status: model.TaskStatusInProgress},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("LockPositions", mock.Anything, inProgress).Return(nil)
+				taskRepository.On("GetByIDs", mock.Anything, []int64{}, userId).Return([]model.Task{}, nil)
+				taskRepository.On("LastPosition", mock.Anything, inProgress).Return("", errors.New("some error"))
+				taskRepository.AssertNotCalled(t, "Patch")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:    "error when status transition is not allowed",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskMove{Status: model.TaskStatusDone},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.AssertNotCalled(t, "GetByIDs")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusConflict, "cannot transition task from todo to done"),
+		},
+		{
+			name:    "error when status is unknown",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskMove{Status: "archived"},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "GetByID")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "invalid status"),
+		},
+		{
+			name:    "error when version conflict",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskMove{Status: model.TaskStatusInProgress, PrevID: &prevId, NextID: &nextId},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("LockPositions", mock.Anything, inProgress).Return(nil)
+				taskRepository.On("GetByIDs", mock.Anything, []int64{prevId, nextId}, userId).Return([]model.Task{prev, next}, nil)
+				taskRepository.On("Patch", mock.Anything, taskId, userId, patch(model.TaskStatusInProgress, "V00001V")).Return(model.Task{}, taskrepository.ErrVersionConflict)
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusPreconditionFailed, "task has been modified"),
+		},
+		{
+			name:    "error when task is removed",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskMove{Status: model.TaskStatusInProgress, PrevID: &prevId, NextID: &nextId},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				transaction(taskRepository)
+				taskRepository.On("GetByID", mock.Anything, taskId, userId).Return(current, nil)
+				taskRepository.On("LockPositions", mock.Anything, inProgress).Return(nil)
+				taskRepository.On("GetByIDs", mock.Anything, []int64{prevId, nextId}, userId).Return([]model.Task{prev, next}, nil)
+				taskRepository.On("Patch", mock.Anything, taskId, userId, patch(model.TaskStatusInProgress, "V00001V")).Return(model.Task{}, sql.ErrNoRows)
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name:    "error when user id is missing",
+			ctx:     context.Background(),
+			request: model.TaskMove{Status: model.TaskStatusInProgress},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "GetByID")
+			},
+			wantResult: model.Task{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			tt.mockDeps(&taskRepository)
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.Move(tt.ctx, taskId, tt.request)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+			taskRepository.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTaskBoard(t *testing.T) {
+	userId := int64(1)
+	projectId := int64(7)
+
+	tasks := func() []model.Task {
+		return []model.Task{
+			{ID: 1, Title: "Unit Test", Status: model.TaskStatusTodo, Position: "V"},
+			{ID: 2, Title: "Code Review", Status: model.TaskStatusTodo, Position: "W"},
+			{ID: 3, Title: "Release", Status: model.TaskStatusDone, Position: "V"},
+		}
+	}
+
+	enrich := func(taskRepository *taskmocks.MockTaskRepository) {
+		ids := []int64{1, 2, 3}
+		taskRepository.On("Progress", mock.Anything, ids).Return(map[int64]model.TaskProgress{}, nil)
+		taskRepository.On("GetLabels", mock.Anything, ids).Return(map[int64][]model.Label{}, nil)
+		taskRepository.On("CountComments", mock.Anything, ids).Return(map[int64]int64{2: 1}, nil)
+		taskRepository.On("ChecklistSummary", mock.Anything, ids).Return(map[int64]model.TaskChecklistSummary{}, nil)
+		taskRepository.On("GetOccurrences", mock.Anything, ids).Return(map[int64]model.TaskOccurrence{}, nil)
+		taskRepository.On("TrackedTime", mock.Anything, ids).Return(map[int64]int64{}, nil)
+	}
+
+	board := func() model.TaskBoard {
+		result := tasks()
+		for i := range result {
+			result[i].Progress = &model.TaskProgress{}
+		}
+
+		result[1].CommentCount = 1
+		return model.TaskBoard{Columns: []model.TaskBoardColumn{
+			{Status: model.TaskStatusTodo, Total: 3, Tasks: result[:2]},
+			{Status: model.TaskStatusInProgress, Total: 0, Tasks: []model.Task{}},
+			{Status: model.TaskStatusBlocked, Total: 0, Tasks: []model.Task{}},
+			{Status: model.TaskStatusDone, Total: 1, Tasks: result[2:]},
+			{Status: model.TaskStatusCancelled, Total: 0, Tasks: []model.Task{}},
+		}}
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		request    model.TaskBoardRequest
+		mockDeps   func(taskRepository *taskmocks.MockTaskRepository)
+		wantResult model.TaskBoard
+		wantErr    error
+	}{
+		{
+			name:    "success",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskBoardRequest{Assignee: model.TaskAssigneeMe},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetBoard", mock.Anything, userId, mock.MatchedBy(func(param param.Param) bool {
+					return param.AssigneeID != nil && *param.AssigneeID == userId && param.ProjectID == nil
+				}), model.TaskBoardDefaultLimit).Return(tasks(), nil)
+				taskRepository.On("CountByStatus", mock.Anything, userId, mock.Anything).Return(map[string]int64{model.TaskStatusTodo: 3, model.TaskStatusDone: 1}, nil)
+				enrich(taskRepository)
+			},
+			wantResult: board(),
+			wantErr:    nil,
+		},
+		{
+			name:    "success filtered by project with limit",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskBoardRequest{ProjectID: &projectId, Limit: 2},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetProject", mock.Anything, projectId, userId).Return(model.Project{ID: projectId}, nil)
+				taskRepository.On("GetBoard", mock.Anything, userId, mock.MatchedBy(func(param param.Param) bool {
+					return param.ProjectID != nil && *param.ProjectID == projectId
+				}), 2).Return(tasks(), nil)
+				taskRepository.On("CountByStatus", mock.Anything, userId, mock.Anything).Return(map[string]int64{model.TaskStatusTodo: 3, model.TaskStatusDone: 1}, nil)
+				enrich(taskRepository)
+			},
+			wantResult: board(),
+			wantErr:    nil,
+		},
+		{
+			name:    "error when project is not found",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskBoardRequest{ProjectID: &projectId},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetProject", mock.Anything, projectId, userId).Return(model.Project{}, sql.ErrNoRows)
+				taskRepository.AssertNotCalled(t, "GetBoard")
+			},
+			wantResult: model.TaskBoard{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "project not found"),
+		},
+		{
+			name:    "error when assignee is invalid",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskBoardRequest{Assignee: "someone"},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "GetBoard")
+			},
+			wantResult: model.TaskBoard{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "invalid assignee"),
+		},
+		{
+			name:    "error when get board",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskBoardRequest{},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetBoard", mock.Anything, userId, mock.Anything, model.TaskBoardDefaultLimit).Return([]model.Task{}, errors.New("some error"))
+			},
+			wantResult: model.TaskBoard{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:    "error when count by status",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TaskBoardRequest{},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.On("GetBoard", mock.Anything, userId, mock.Anything, model.TaskBoardDefaultLimit).Return(tasks(), nil)
+				taskRepository.On("CountByStatus", mock.Anything, userId, mock.Anything).Return(map[string]int64{}, errors.New("some error"))
+			},
+			wantResult: model.TaskBoard{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:    "error when user id is missing",
+			ctx:     context.Background(),
+			request: model.TaskBoardRequest{},
+			mockDeps: func(taskRepository *taskmocks.MockTaskRepository) {
+				taskRepository.AssertNotCalled(t, "GetBoard")
+			},
+			wantResult: model.TaskBoard{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepository := taskmocks.MockTaskRepository{}
+
+			tt.mockDeps(&taskRepository)
+
+			usecase := task.New(&taskRepository, &config.Configuration{})
+			result, err := usecase.Board(tt.ctx, tt.request)
+
+			assert.Equal(t, tt.wantResult, result)
 			assert.Equal(t, tt.wantErr, err)
+			taskRepository.AssertExpectations(t)
 		})
 	}
 }
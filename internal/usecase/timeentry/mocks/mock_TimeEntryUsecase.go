@@ -0,0 +1,377 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/rzfhlv/go-task/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	param "github.com/rzfhlv/go-task/pkg/param"
+)
+
+// MockTimeEntryUsecase is an autogenerated mock type for the TimeEntryUsecase type
+type MockTimeEntryUsecase struct {
+	mock.Mock
+}
+
+type MockTimeEntryUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTimeEntryUsecase) EXPECT() *MockTimeEntryUsecase_Expecter {
+	return &MockTimeEntryUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, taskId, request
+func (_m *MockTimeEntryUsecase) Create(ctx context.Context, taskId int64, request model.TimeEntryRequest) (model.TimeEntry, error) {
+	ret := _m.Called(ctx, taskId, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TimeEntryRequest) (model.TimeEntry, error)); ok {
+		return rf(ctx, taskId, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TimeEntryRequest) model.TimeEntry); ok {
+		r0 = rf(ctx, taskId, request)
+	} else {
+		r0 = ret.Get(0).(model.TimeEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.TimeEntryRequest) error); ok {
+		r1 = rf(ctx, taskId, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeEntryUsecase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockTimeEntryUsecase_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+//   - request model.TimeEntryRequest
+func (_e *MockTimeEntryUsecase_Expecter) Create(ctx interface{}, taskId interface{}, request interface{}) *MockTimeEntryUsecase_Create_Call {
+	return &MockTimeEntryUsecase_Create_Call{Call: _e.mock.On("Create", ctx, taskId, request)}
+}
+
+func (_c *MockTimeEntryUsecase_Create_Call) Run(run func(ctx context.Context, taskId int64, request model.TimeEntryRequest)) *MockTimeEntryUsecase_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.TimeEntryRequest))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryUsecase_Create_Call) Return(_a0 model.TimeEntry, _a1 error) *MockTimeEntryUsecase_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeEntryUsecase_Create_Call) RunAndReturn(run func(context.Context, int64, model.TimeEntryRequest) (model.TimeEntry, error)) *MockTimeEntryUsecase_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, taskId, id
+func (_m *MockTimeEntryUsecase) Delete(ctx context.Context, taskId int64, id int64) error {
+	ret := _m.Called(ctx, taskId, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, taskId, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTimeEntryUsecase_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockTimeEntryUsecase_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+//   - id int64
+func (_e *MockTimeEntryUsecase_Expecter) Delete(ctx interface{}, taskId interface{}, id interface{}) *MockTimeEntryUsecase_Delete_Call {
+	return &MockTimeEntryUsecase_Delete_Call{Call: _e.mock.On("Delete", ctx, taskId, id)}
+}
+
+func (_c *MockTimeEntryUsecase_Delete_Call) Run(run func(ctx context.Context, taskId int64, id int64)) *MockTimeEntryUsecase_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryUsecase_Delete_Call) Return(_a0 error) *MockTimeEntryUsecase_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTimeEntryUsecase_Delete_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockTimeEntryUsecase_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTaskID provides a mock function with given fields: ctx, taskId, _a2
+func (_m *MockTimeEntryUsecase) GetByTaskID(ctx context.Context, taskId int64, _a2 *param.Param) ([]model.TimeEntry, error) {
+	ret := _m.Called(ctx, taskId, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTaskID")
+	}
+
+	var r0 []model.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) ([]model.TimeEntry, error)); ok {
+		return rf(ctx, taskId, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *param.Param) []model.TimeEntry); ok {
+		r0 = rf(ctx, taskId, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TimeEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *param.Param) error); ok {
+		r1 = rf(ctx, taskId, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeEntryUsecase_GetByTaskID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTaskID'
+type MockTimeEntryUsecase_GetByTaskID_Call struct {
+	*mock.Call
+}
+
+// GetByTaskID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+//   - _a2 *param.Param
+func (_e *MockTimeEntryUsecase_Expecter) GetByTaskID(ctx interface{}, taskId interface{}, _a2 interface{}) *MockTimeEntryUsecase_GetByTaskID_Call {
+	return &MockTimeEntryUsecase_GetByTaskID_Call{Call: _e.mock.On("GetByTaskID", ctx, taskId, _a2)}
+}
+
+func (_c *MockTimeEntryUsecase_GetByTaskID_Call) Run(run func(ctx context.Context, taskId int64, _a2 *param.Param)) *MockTimeEntryUsecase_GetByTaskID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*param.Param))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryUsecase_GetByTaskID_Call) Return(_a0 []model.TimeEntry, _a1 error) *MockTimeEntryUsecase_GetByTaskID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeEntryUsecase_GetByTaskID_Call) RunAndReturn(run func(context.Context, int64, *param.Param) ([]model.TimeEntry, error)) *MockTimeEntryUsecase_GetByTaskID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Report provides a mock function with given fields: ctx, request
+func (_m *MockTimeEntryUsecase) Report(ctx context.Context, request model.TimeReportRequest) (model.TimeReport, error) {
+	ret := _m.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Report")
+	}
+
+	var r0 model.TimeReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TimeReportRequest) (model.TimeReport, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.TimeReportRequest) model.TimeReport); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Get(0).(model.TimeReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.TimeReportRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeEntryUsecase_Report_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Report'
+type MockTimeEntryUsecase_Report_Call struct {
+	*mock.Call
+}
+
+// Report is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request model.TimeReportRequest
+func (_e *MockTimeEntryUsecase_Expecter) Report(ctx interface{}, request interface{}) *MockTimeEntryUsecase_Report_Call {
+	return &MockTimeEntryUsecase_Report_Call{Call: _e.mock.On("Report", ctx, request)}
+}
+
+func (_c *MockTimeEntryUsecase_Report_Call) Run(run func(ctx context.Context, request model.TimeReportRequest)) *MockTimeEntryUsecase_Report_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.TimeReportRequest))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryUsecase_Report_Call) Return(_a0 model.TimeReport, _a1 error) *MockTimeEntryUsecase_Report_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeEntryUsecase_Report_Call) RunAndReturn(run func(context.Context, model.TimeReportRequest) (model.TimeReport, error)) *MockTimeEntryUsecase_Report_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: ctx, taskId, request
+func (_m *MockTimeEntryUsecase) Start(ctx context.Context, taskId int64, request model.TimerRequest) (model.TimeEntry, error) {
+	ret := _m.Called(ctx, taskId, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 model.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TimerRequest) (model.TimeEntry, error)); ok {
+		return rf(ctx, taskId, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TimerRequest) model.TimeEntry); ok {
+		r0 = rf(ctx, taskId, request)
+	} else {
+		r0 = ret.Get(0).(model.TimeEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.TimerRequest) error); ok {
+		r1 = rf(ctx, taskId, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeEntryUsecase_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type MockTimeEntryUsecase_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+//   - request model.TimerRequest
+func (_e *MockTimeEntryUsecase_Expecter) Start(ctx interface{}, taskId interface{}, request interface{}) *MockTimeEntryUsecase_Start_Call {
+	return &MockTimeEntryUsecase_Start_Call{Call: _e.mock.On("Start", ctx, taskId, request)}
+}
+
+func (_c *MockTimeEntryUsecase_Start_Call) Run(run func(ctx context.Context, taskId int64, request model.TimerRequest)) *MockTimeEntryUsecase_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.TimerRequest))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryUsecase_Start_Call) Return(_a0 model.TimeEntry, _a1 error) *MockTimeEntryUsecase_Start_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeEntryUsecase_Start_Call) RunAndReturn(run func(context.Context, int64, model.TimerRequest) (model.TimeEntry, error)) *MockTimeEntryUsecase_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with given fields: ctx, taskId
+func (_m *MockTimeEntryUsecase) Stop(ctx context.Context, taskId int64) (model.TimeEntry, error) {
+	ret := _m.Called(ctx, taskId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 model.TimeEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.TimeEntry, error)); ok {
+		return rf(ctx, taskId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.TimeEntry); ok {
+		r0 = rf(ctx, taskId)
+	} else {
+		r0 = ret.Get(0).(model.TimeEntry)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, taskId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeEntryUsecase_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type MockTimeEntryUsecase_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskId int64
+func (_e *MockTimeEntryUsecase_Expecter) Stop(ctx interface{}, taskId interface{}) *MockTimeEntryUsecase_Stop_Call {
+	return &MockTimeEntryUsecase_Stop_Call{Call: _e.mock.On("Stop", ctx, taskId)}
+}
+
+func (_c *MockTimeEntryUsecase_Stop_Call) Run(run func(ctx context.Context, taskId int64)) *MockTimeEntryUsecase_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockTimeEntryUsecase_Stop_Call) Return(_a0 model.TimeEntry, _a1 error) *MockTimeEntryUsecase_Stop_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeEntryUsecase_Stop_Call) RunAndReturn(run func(context.Context, int64) (model.TimeEntry, error)) *MockTimeEntryUsecase_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTimeEntryUsecase creates a new instance of MockTimeEntryUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTimeEntryUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTimeEntryUsecase {
+	mock := &MockTimeEntryUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,268 @@
+package timeentry
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/repository/timeentry"
+	"github.com/rzfhlv/go-task/internal/usecase/task"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+	"github.com/rzfhlv/go-task/pkg/param"
+)
+
+type TimeEntryUsecase interface {
+	Start(ctx context.Context, taskId int64, request model.TimerRequest) (model.TimeEntry, error)
+	Stop(ctx context.Context, taskId int64) (model.TimeEntry, error)
+	Create(ctx context.Context, taskId int64, request model.TimeEntryRequest) (model.TimeEntry, error)
+	GetByTaskID(ctx context.Context, taskId int64, param *param.Param) ([]model.TimeEntry, error)
+	Delete(ctx context.Context, taskId, id int64) error
+	Report(ctx context.Context, request model.TimeReportRequest) (model.TimeReport, error)
+}
+
+type TimeEntry struct {
+	timeEntryRepository timeentry.TimeEntryRepository
+	taskUsecase         task.TaskUsecase
+}
+
+func New(timeEntryRepository timeentry.TimeEntryRepository, taskUsecase task.TaskUsecase) TimeEntryUsecase {
+	return &TimeEntry{
+		timeEntryRepository: timeEntryRepository,
+		taskUsecase:         taskUsecase,
+	}
+}
+
+func (t *TimeEntry) Start(ctx context.Context, taskId int64, request model.TimerRequest) (model.TimeEntry, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error when get user id from context")
+		return model.TimeEntry{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	_, err := t.taskUsecase.GetByID(ctx, taskId)
+	if err != nil {
+		return model.TimeEntry{}, err
+	}
+
+	running, err := t.timeEntryRepository.GetRunning(ctx, userId)
+	if err == nil {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error timer is already running", slog.Int64("task_id", running.TaskID))
+		return model.TimeEntry{}, errs.NewErrs(http.StatusConflict, fmt.Sprintf("timer is already running on task %d", running.TaskID))
+	}
+
+	if err != sql.ErrNoRows {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error when call timeEntryRepository.GetRunning", slog.String("error", err.Error()))
+		return model.TimeEntry{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	result, err := t.timeEntryRepository.Create(ctx, model.TimeEntry{
+		TaskID:    taskId,
+		UserID:    userId,
+		StartedAt: time.Now(),
+		Note:      request.Note,
+		Source:    model.TimeEntrySourceTimer,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error when call timeEntryRepository.Create", slog.String("error", err.Error()))
+		if err == timeentry.ErrTimerRunning {
+			return model.TimeEntry{}, errs.NewErrs(http.StatusConflict, "timer is already running")
+		}
+
+		return model.TimeEntry{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (t *TimeEntry) Stop(ctx context.Context, taskId int64) (model.TimeEntry, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error when get user id from context")
+		return model.TimeEntry{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	_, err := t.taskUsecase.GetByID(ctx, taskId)
+	if err != nil {
+		return model.TimeEntry{}, err
+	}
+
+	running, err := t.timeEntryRepository.GetRunning(ctx, userId)
+	if err != nil && err != sql.ErrNoRows {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error when call timeEntryRepository.GetRunning", slog.String("error", err.Error()))
+		return model.TimeEntry{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	if err == sql.ErrNoRows || running.TaskID != taskId {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error no running timer on task", slog.Int64("task_id", taskId))
+		return model.TimeEntry{}, errs.NewErrs(http.StatusNotFound, "no running timer on this task")
+	}
+
+	result, err := t.timeEntryRepository.Stop(ctx, running.ID, userId, time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error when call timeEntryRepository.Stop", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.TimeEntry{}, errs.NewErrs(http.StatusNotFound, "no running timer on this task")
+		}
+
+		return model.TimeEntry{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (t *TimeEntry) Create(ctx context.Context, taskId int64, request model.TimeEntryRequest) (model.TimeEntry, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error when get user id from context")
+		return model.TimeEntry{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	if !request.EndedAt.After(*request.StartedAt) {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error ended at is not after started at")
+		return model.TimeEntry{}, errs.NewErrs(http.StatusBadRequest, "ended_at must be after started_at")
+	}
+
+	if request.EndedAt.After(time.Now()) {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error ended at is in the future")
+		return model.TimeEntry{}, errs.NewErrs(http.StatusBadRequest, "ended_at must not be in the future")
+	}
+
+	_, err := t.taskUsecase.GetByID(ctx, taskId)
+	if err != nil {
+		return model.TimeEntry{}, err
+	}
+
+	result, err := t.timeEntryRepository.Create(ctx, model.TimeEntry{
+		TaskID:    taskId,
+		UserID:    userId,
+		StartedAt: *request.StartedAt,
+		EndedAt:   request.EndedAt,
+		Note:      request.Note,
+		Source:    model.TimeEntrySourceManual,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error when call timeEntryRepository.Create", slog.String("error", err.Error()))
+		return model.TimeEntry{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (t *TimeEntry) GetByTaskID(ctx context.Context, taskId int64, param *param.Param) ([]model.TimeEntry, error) {
+	_, err := t.taskUsecase.GetByID(ctx, taskId)
+	if err != nil {
+		return []model.TimeEntry{}, err
+	}
+
+	result, err := t.timeEntryRepository.GetByTaskID(ctx, taskId, param.Limit, param.CalculateOffset())
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error when call timeEntryRepository.GetByTaskID", slog.String("error", err.Error()))
+		return []model.TimeEntry{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	total, err := t.timeEntryRepository.Count(ctx, taskId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error when call timeEntryRepository.Count", slog.String("error", err.Error()))
+		return []model.TimeEntry{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	param.Total = total
+
+	return result, nil
+}
+
+func (t *TimeEntry) Delete(ctx context.Context, taskId, id int64) error {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error when get user id from context")
+		return errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	_, err := t.taskUsecase.GetByID(ctx, taskId)
+	if err != nil {
+		return err
+	}
+
+	err = t.timeEntryRepository.Delete(ctx, id, taskId, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error when call timeEntryRepository.Delete", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return errs.NewErrs(http.StatusNotFound, "time entry not found")
+		}
+
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return nil
+}
+
+func (t *TimeEntry) Report(ctx context.Context, request model.TimeReportRequest) (model.TimeReport, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error when get user id from context")
+		return model.TimeReport{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	from, err := parseReportTime(request.From, false)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error when parse from", slog.String("error", err.Error()))
+		return model.TimeReport{}, errs.NewErrs(http.StatusBadRequest, "invalid from")
+	}
+
+	to, err := parseReportTime(request.To, true)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error when parse to", slog.String("error", err.Error()))
+		return model.TimeReport{}, errs.NewErrs(http.StatusBadRequest, "invalid to")
+	}
+
+	if !to.After(from) {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error report range is empty", slog.Time("from", from), slog.Time("to", to))
+		return model.TimeReport{}, errs.NewErrs(http.StatusBadRequest, "to must be after from")
+	}
+
+	if to.Sub(from) > model.TimeReportMaxRange {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error report range is too long", slog.Time("from", from), slog.Time("to", to))
+		return model.TimeReport{}, errs.NewErrs(http.StatusBadRequest, "report range must not exceed 366 days")
+	}
+
+	groupBy := request.GroupBy
+	if groupBy == "" {
+		groupBy = model.TimeReportGroupTask
+	}
+
+	items, err := t.timeEntryRepository.Report(ctx, userId, from, to, groupBy)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.TimeEntry] error when call timeEntryRepository.Report", slog.String("error", err.Error()))
+		return model.TimeReport{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	result := model.TimeReport{From: from, To: to, GroupBy: groupBy, Items: items}
+	for _, item := range items {
+		result.TotalSeconds += item.TotalSeconds
+	}
+
+	return result, nil
+}
+
+func parseReportTime(value string, end bool) (time.Time, error) {
+	result, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return result, nil
+	}
+
+	result, err = time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if end {
+		result = result.AddDate(0, 0, 1)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,606 @@
+package timeentry_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/repository/timeentry"
+	timeentrymocks "github.com/rzfhlv/go-task/internal/repository/timeentry/mocks"
+	taskmocks "github.com/rzfhlv/go-task/internal/usecase/task/mocks"
+	timeentryusecase "github.com/rzfhlv/go-task/internal/usecase/timeentry"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+	"github.com/rzfhlv/go-task/pkg/param"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	userId = int64(1)
+	taskId = int64(2)
+
+	startedAt = time.Date(2023, time.August, 15, 9, 0, 0, 0, time.UTC)
+	endedAt   = startedAt.Add(90 * time.Minute)
+
+	timeEntryModel = model.TimeEntry{
+		ID:              1,
+		TaskID:          taskId,
+		UserID:          userId,
+		StartedAt:       startedAt,
+		EndedAt:         &endedAt,
+		DurationSeconds: 5400,
+		Note:            "pairing session",
+		Source:          model.TimeEntrySourceManual,
+	}
+
+	runningModel = model.TimeEntry{
+		ID:        3,
+		TaskID:    taskId,
+		UserID:    userId,
+		StartedAt: startedAt,
+		Source:    model.TimeEntrySourceTimer,
+	}
+)
+
+func TestTimeEntryStart(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockDeps   func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase)
+		wantResult model.TimeEntry
+		wantErr    error
+	}{
+		{
+			name: "success",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("GetRunning", mock.Anything, userId).Return(model.TimeEntry{}, sql.ErrNoRows)
+				timeEntryRepository.On("Create", mock.Anything, mock.MatchedBy(func(entry model.TimeEntry) bool {
+					return entry.TaskID == taskId && entry.UserID == userId && entry.EndedAt == nil &&
+						entry.Note == "standup" && entry.Source == model.TimeEntrySourceTimer && !entry.StartedAt.IsZero()
+				})).Return(runningModel, nil)
+			},
+			wantResult: runningModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when timer is already running",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("GetRunning", mock.Anything, userId).Return(model.TimeEntry{ID: 9, TaskID: 7}, nil)
+				timeEntryRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusConflict, "timer is already running on task 7"),
+		},
+		{
+			name: "error when timer is started concurrently",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("GetRunning", mock.Anything, userId).Return(model.TimeEntry{}, sql.ErrNoRows)
+				timeEntryRepository.On("Create", mock.Anything, mock.Anything).Return(model.TimeEntry{}, timeentry.ErrTimerRunning)
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusConflict, "timer is already running"),
+		},
+		{
+			name: "error when get running timer",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("GetRunning", mock.Anything, userId).Return(model.TimeEntry{}, errors.New("some error"))
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when create time entry",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("GetRunning", mock.Anything, userId).Return(model.TimeEntry{}, sql.ErrNoRows)
+				timeEntryRepository.On("Create", mock.Anything, mock.Anything).Return(model.TimeEntry{}, errors.New("some error"))
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when task is not accessible",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+				timeEntryRepository.AssertNotCalled(t, "GetRunning")
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name: "error when user id is missing",
+			ctx:  context.Background(),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetByID")
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeEntryRepository := timeentrymocks.MockTimeEntryRepository{}
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&timeEntryRepository, &taskUsecase)
+
+			usecase := timeentryusecase.New(&timeEntryRepository, &taskUsecase)
+			result, err := usecase.Start(tt.ctx, taskId, model.TimerRequest{Note: "standup"})
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTimeEntryStop(t *testing.T) {
+	stopped := runningModel
+	stopped.EndedAt, stopped.DurationSeconds = &endedAt, 5400
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockDeps   func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase)
+		wantResult model.TimeEntry
+		wantErr    error
+	}{
+		{
+			name: "success",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("GetRunning", mock.Anything, userId).Return(runningModel, nil)
+				timeEntryRepository.On("Stop", mock.Anything, runningModel.ID, userId, mock.Anything).Return(stopped, nil)
+			},
+			wantResult: stopped,
+			wantErr:    nil,
+		},
+		{
+			name: "error when no timer is running",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("GetRunning", mock.Anything, userId).Return(model.TimeEntry{}, sql.ErrNoRows)
+				timeEntryRepository.AssertNotCalled(t, "Stop")
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "no running timer on this task"),
+		},
+		{
+			name: "error when timer is running on another task",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("GetRunning", mock.Anything, userId).Return(model.TimeEntry{ID: 9, TaskID: 7}, nil)
+				timeEntryRepository.AssertNotCalled(t, "Stop")
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "no running timer on this task"),
+		},
+		{
+			name: "error when timer is stopped concurrently",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("GetRunning", mock.Anything, userId).Return(runningModel, nil)
+				timeEntryRepository.On("Stop", mock.Anything, runningModel.ID, userId, mock.Anything).Return(model.TimeEntry{}, sql.ErrNoRows)
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "no running timer on this task"),
+		},
+		{
+			name: "error when stop timer",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("GetRunning", mock.Anything, userId).Return(runningModel, nil)
+				timeEntryRepository.On("Stop", mock.Anything, runningModel.ID, userId, mock.Anything).Return(model.TimeEntry{}, errors.New("some error"))
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when get running timer",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("GetRunning", mock.Anything, userId).Return(model.TimeEntry{}, errors.New("some error"))
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when user id is missing",
+			ctx:  context.Background(),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetByID")
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeEntryRepository := timeentrymocks.MockTimeEntryRepository{}
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&timeEntryRepository, &taskUsecase)
+
+			usecase := timeentryusecase.New(&timeEntryRepository, &taskUsecase)
+			result, err := usecase.Stop(tt.ctx, taskId)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTimeEntryCreate(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		request    model.TimeEntryRequest
+		mockDeps   func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase)
+		wantResult model.TimeEntry
+		wantErr    error
+	}{
+		{
+			name:    "success",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TimeEntryRequest{StartedAt: &startedAt, EndedAt: &endedAt, Note: timeEntryModel.Note},
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("Create", mock.Anything, model.TimeEntry{
+					TaskID:    taskId,
+					UserID:    userId,
+					StartedAt: startedAt,
+					EndedAt:   &endedAt,
+					Note:      timeEntryModel.Note,
+					Source:    model.TimeEntrySourceManual,
+				}).Return(timeEntryModel, nil)
+			},
+			wantResult: timeEntryModel,
+			wantErr:    nil,
+		},
+		{
+			name:    "error when ended at is before started at",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TimeEntryRequest{StartedAt: &endedAt, EndedAt: &startedAt},
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetByID")
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "ended_at must be after started_at"),
+		},
+		{
+			name:    "error when ended at is in the future",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TimeEntryRequest{StartedAt: &startedAt, EndedAt: &future},
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetByID")
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "ended_at must not be in the future"),
+		},
+		{
+			name:    "error when task is not accessible",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TimeEntryRequest{StartedAt: &startedAt, EndedAt: &endedAt},
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+				timeEntryRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name:    "error when create time entry",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TimeEntryRequest{StartedAt: &startedAt, EndedAt: &endedAt},
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("Create", mock.Anything, mock.Anything).Return(model.TimeEntry{}, errors.New("some error"))
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:    "error when user id is missing",
+			ctx:     context.Background(),
+			request: model.TimeEntryRequest{StartedAt: &startedAt, EndedAt: &endedAt},
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetByID")
+			},
+			wantResult: model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeEntryRepository := timeentrymocks.MockTimeEntryRepository{}
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&timeEntryRepository, &taskUsecase)
+
+			usecase := timeentryusecase.New(&timeEntryRepository, &taskUsecase)
+			result, err := usecase.Create(tt.ctx, taskId, tt.request)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTimeEntryGetByTaskID(t *testing.T) {
+	tests := []struct {
+		name       string
+		param      param.Param
+		mockDeps   func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase)
+		wantResult []model.TimeEntry
+		wantTotal  int64
+		wantErr    error
+	}{
+		{
+			name:  "success",
+			param: param.Param{Page: 2, Limit: 5},
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("GetByTaskID", mock.Anything, taskId, 5, 5).Return([]model.TimeEntry{timeEntryModel}, nil)
+				timeEntryRepository.On("Count", mock.Anything, taskId).Return(int64(6), nil)
+			},
+			wantResult: []model.TimeEntry{timeEntryModel},
+			wantTotal:  6,
+			wantErr:    nil,
+		},
+		{
+			name:  "error when task is not accessible",
+			param: param.Param{Page: 1, Limit: 10},
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+				timeEntryRepository.AssertNotCalled(t, "GetByTaskID")
+			},
+			wantResult: []model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name:  "error when get time entries",
+			param: param.Param{Page: 1, Limit: 10},
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("GetByTaskID", mock.Anything, taskId, 10, 0).Return([]model.TimeEntry{}, errors.New("some error"))
+			},
+			wantResult: []model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:  "error when count time entries",
+			param: param.Param{Page: 1, Limit: 10},
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("GetByTaskID", mock.Anything, taskId, 10, 0).Return([]model.TimeEntry{timeEntryModel}, nil)
+				timeEntryRepository.On("Count", mock.Anything, taskId).Return(int64(0), errors.New("some error"))
+			},
+			wantResult: []model.TimeEntry{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeEntryRepository := timeentrymocks.MockTimeEntryRepository{}
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&timeEntryRepository, &taskUsecase)
+
+			param := tt.param
+			usecase := timeentryusecase.New(&timeEntryRepository, &taskUsecase)
+			result, err := usecase.GetByTaskID(context.WithValue(context.Background(), auth.IdKey, userId), taskId, &param)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantTotal, param.Total)
+		})
+	}
+}
+
+func TestTimeEntryDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		mockDeps func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase)
+		wantErr  error
+	}{
+		{
+			name: "success",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("Delete", mock.Anything, timeEntryModel.ID, taskId, userId).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when time entry is not found",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("Delete", mock.Anything, timeEntryModel.ID, taskId, userId).Return(sql.ErrNoRows)
+			},
+			wantErr: errs.NewErrs(http.StatusNotFound, "time entry not found"),
+		},
+		{
+			name: "error when delete time entry",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{ID: taskId}, nil)
+				timeEntryRepository.On("Delete", mock.Anything, timeEntryModel.ID, taskId, userId).Return(errors.New("some error"))
+			},
+			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when task is not accessible",
+			ctx:  context.WithValue(context.Background(), auth.IdKey, userId),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.On("GetByID", mock.Anything, taskId).Return(model.Task{}, errs.NewErrs(http.StatusNotFound, "task not found"))
+				timeEntryRepository.AssertNotCalled(t, "Delete")
+			},
+			wantErr: errs.NewErrs(http.StatusNotFound, "task not found"),
+		},
+		{
+			name: "error when user id is missing",
+			ctx:  context.Background(),
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository, taskUsecase *taskmocks.MockTaskUsecase) {
+				taskUsecase.AssertNotCalled(t, "GetByID")
+			},
+			wantErr: errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeEntryRepository := timeentrymocks.MockTimeEntryRepository{}
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&timeEntryRepository, &taskUsecase)
+
+			usecase := timeentryusecase.New(&timeEntryRepository, &taskUsecase)
+			err := usecase.Delete(tt.ctx, taskId, timeEntryModel.ID)
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestTimeEntryReport(t *testing.T) {
+	from := time.Date(2023, time.August, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, time.September, 1, 0, 0, 0, 0, time.UTC)
+	items := []model.TimeReportItem{
+		{Key: "2", Name: "Unit Test", TotalSeconds: 5400, Entries: 2},
+		{Key: "4", Name: "Code Review", TotalSeconds: 1800, Entries: 1},
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		request    model.TimeReportRequest
+		mockDeps   func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository)
+		wantResult model.TimeReport
+		wantErr    error
+	}{
+		{
+			name:    "success with dates defaults to group by task",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TimeReportRequest{From: "2023-08-01", To: "2023-08-31"},
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository) {
+				timeEntryRepository.On("Report", mock.Anything, userId, from, to, model.TimeReportGroupTask).Return(items, nil)
+			},
+			wantResult: model.TimeReport{From: from, To: to, GroupBy: model.TimeReportGroupTask, TotalSeconds: 7200, Items: items},
+			wantErr:    nil,
+		},
+		{
+			name:    "success with timestamps group by day",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TimeReportRequest{From: "2023-08-01T00:00:00Z", To: "2023-09-01T00:00:00Z", GroupBy: model.TimeReportGroupDay},
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository) {
+				timeEntryRepository.On("Report", mock.Anything, userId, from, to, model.TimeReportGroupDay).Return([]model.TimeReportItem{}, nil)
+			},
+			wantResult: model.TimeReport{From: from, To: to, GroupBy: model.TimeReportGroupDay, Items: []model.TimeReportItem{}},
+			wantErr:    nil,
+		},
+		{
+			name:    "error when from is invalid",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TimeReportRequest{From: "yesterday", To: "2023-08-31"},
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository) {
+				timeEntryRepository.AssertNotCalled(t, "Report")
+			},
+			wantResult: model.TimeReport{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "invalid from"),
+		},
+		{
+			name:    "error when to is invalid",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TimeReportRequest{From: "2023-08-01", To: "31-08-2023"},
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository) {
+				timeEntryRepository.AssertNotCalled(t, "Report")
+			},
+			wantResult: model.TimeReport{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "invalid to"),
+		},
+		{
+			name:    "error when to is before from",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TimeReportRequest{From: "2023-08-31", To: "2023-08-01"},
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository) {
+				timeEntryRepository.AssertNotCalled(t, "Report")
+			},
+			wantResult: model.TimeReport{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "to must be after from"),
+		},
+		{
+			name:    "error when range is too long",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TimeReportRequest{From: "2022-01-01", To: "2023-08-31"},
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository) {
+				timeEntryRepository.AssertNotCalled(t, "Report")
+			},
+			wantResult: model.TimeReport{},
+			wantErr:    errs.NewErrs(http.StatusBadRequest, "report range must not exceed 366 days"),
+		},
+		{
+			name:    "error when report time entries",
+			ctx:     context.WithValue(context.Background(), auth.IdKey, userId),
+			request: model.TimeReportRequest{From: "2023-08-01", To: "2023-08-31", GroupBy: model.TimeReportGroupProject},
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository) {
+				timeEntryRepository.On("Report", mock.Anything, userId, from, to, model.TimeReportGroupProject).Return([]model.TimeReportItem{}, errors.New("some error"))
+			},
+			wantResult: model.TimeReport{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name:    "error when user id is missing",
+			ctx:     context.Background(),
+			request: model.TimeReportRequest{From: "2023-08-01", To: "2023-08-31"},
+			mockDeps: func(timeEntryRepository *timeentrymocks.MockTimeEntryRepository) {
+				timeEntryRepository.AssertNotCalled(t, "Report")
+			},
+			wantResult: model.TimeReport{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeEntryRepository := timeentrymocks.MockTimeEntryRepository{}
+			taskUsecase := taskmocks.MockTaskUsecase{}
+
+			tt.mockDeps(&timeEntryRepository)
+
+			usecase := timeentryusecase.New(&timeEntryRepository, &taskUsecase)
+			result, err := usecase.Report(tt.ctx, tt.request)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,538 @@
+// Code generated by mockery v2.45.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/rzfhlv/go-task/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWorkspaceUsecase is an autogenerated mock type for the WorkspaceUsecase type
+type MockWorkspaceUsecase struct {
+	mock.Mock
+}
+
+type MockWorkspaceUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWorkspaceUsecase) EXPECT() *MockWorkspaceUsecase_Expecter {
+	return &MockWorkspaceUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, _a1
+func (_m *MockWorkspaceUsecase) Create(ctx context.Context, _a1 model.Workspace) (model.Workspace, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Workspace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Workspace) (model.Workspace, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Workspace) model.Workspace); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Workspace)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Workspace) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceUsecase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockWorkspaceUsecase_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Workspace
+func (_e *MockWorkspaceUsecase_Expecter) Create(ctx interface{}, _a1 interface{}) *MockWorkspaceUsecase_Create_Call {
+	return &MockWorkspaceUsecase_Create_Call{Call: _e.mock.On("Create", ctx, _a1)}
+}
+
+func (_c *MockWorkspaceUsecase_Create_Call) Run(run func(ctx context.Context, _a1 model.Workspace)) *MockWorkspaceUsecase_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Workspace))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceUsecase_Create_Call) Return(_a0 model.Workspace, _a1 error) *MockWorkspaceUsecase_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceUsecase_Create_Call) RunAndReturn(run func(context.Context, model.Workspace) (model.Workspace, error)) *MockWorkspaceUsecase_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockWorkspaceUsecase) Delete(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkspaceUsecase_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockWorkspaceUsecase_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockWorkspaceUsecase_Expecter) Delete(ctx interface{}, id interface{}) *MockWorkspaceUsecase_Delete_Call {
+	return &MockWorkspaceUsecase_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockWorkspaceUsecase_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockWorkspaceUsecase_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceUsecase_Delete_Call) Return(_a0 error) *MockWorkspaceUsecase_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkspaceUsecase_Delete_Call) RunAndReturn(run func(context.Context, int64) error) *MockWorkspaceUsecase_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *MockWorkspaceUsecase) GetByID(ctx context.Context, id int64) (model.Workspace, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Workspace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.Workspace, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.Workspace); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Workspace)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceUsecase_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockWorkspaceUsecase_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockWorkspaceUsecase_Expecter) GetByID(ctx interface{}, id interface{}) *MockWorkspaceUsecase_GetByID_Call {
+	return &MockWorkspaceUsecase_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *MockWorkspaceUsecase_GetByID_Call) Run(run func(ctx context.Context, id int64)) *MockWorkspaceUsecase_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceUsecase_GetByID_Call) Return(_a0 model.Workspace, _a1 error) *MockWorkspaceUsecase_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceUsecase_GetByID_Call) RunAndReturn(run func(context.Context, int64) (model.Workspace, error)) *MockWorkspaceUsecase_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUserID provides a mock function with given fields: ctx, userId
+func (_m *MockWorkspaceUsecase) GetByUserID(ctx context.Context, userId int64) ([]model.Workspace, error) {
+	ret := _m.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUserID")
+	}
+
+	var r0 []model.Workspace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.Workspace, error)); ok {
+		return rf(ctx, userId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.Workspace); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Workspace)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceUsecase_GetByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUserID'
+type MockWorkspaceUsecase_GetByUserID_Call struct {
+	*mock.Call
+}
+
+// GetByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId int64
+func (_e *MockWorkspaceUsecase_Expecter) GetByUserID(ctx interface{}, userId interface{}) *MockWorkspaceUsecase_GetByUserID_Call {
+	return &MockWorkspaceUsecase_GetByUserID_Call{Call: _e.mock.On("GetByUserID", ctx, userId)}
+}
+
+func (_c *MockWorkspaceUsecase_GetByUserID_Call) Run(run func(ctx context.Context, userId int64)) *MockWorkspaceUsecase_GetByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceUsecase_GetByUserID_Call) Return(_a0 []model.Workspace, _a1 error) *MockWorkspaceUsecase_GetByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceUsecase_GetByUserID_Call) RunAndReturn(run func(context.Context, int64) ([]model.Workspace, error)) *MockWorkspaceUsecase_GetByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMembers provides a mock function with given fields: ctx, id
+func (_m *MockWorkspaceUsecase) GetMembers(ctx context.Context, id int64) ([]model.WorkspaceMember, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMembers")
+	}
+
+	var r0 []model.WorkspaceMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.WorkspaceMember, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.WorkspaceMember); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.WorkspaceMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceUsecase_GetMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMembers'
+type MockWorkspaceUsecase_GetMembers_Call struct {
+	*mock.Call
+}
+
+// GetMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockWorkspaceUsecase_Expecter) GetMembers(ctx interface{}, id interface{}) *MockWorkspaceUsecase_GetMembers_Call {
+	return &MockWorkspaceUsecase_GetMembers_Call{Call: _e.mock.On("GetMembers", ctx, id)}
+}
+
+func (_c *MockWorkspaceUsecase_GetMembers_Call) Run(run func(ctx context.Context, id int64)) *MockWorkspaceUsecase_GetMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceUsecase_GetMembers_Call) Return(_a0 []model.WorkspaceMember, _a1 error) *MockWorkspaceUsecase_GetMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceUsecase_GetMembers_Call) RunAndReturn(run func(context.Context, int64) ([]model.WorkspaceMember, error)) *MockWorkspaceUsecase_GetMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Invite provides a mock function with given fields: ctx, id, invitation
+func (_m *MockWorkspaceUsecase) Invite(ctx context.Context, id int64, invitation model.WorkspaceInvitation) (model.WorkspaceMember, error) {
+	ret := _m.Called(ctx, id, invitation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Invite")
+	}
+
+	var r0 model.WorkspaceMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.WorkspaceInvitation) (model.WorkspaceMember, error)); ok {
+		return rf(ctx, id, invitation)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.WorkspaceInvitation) model.WorkspaceMember); ok {
+		r0 = rf(ctx, id, invitation)
+	} else {
+		r0 = ret.Get(0).(model.WorkspaceMember)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.WorkspaceInvitation) error); ok {
+		r1 = rf(ctx, id, invitation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceUsecase_Invite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invite'
+type MockWorkspaceUsecase_Invite_Call struct {
+	*mock.Call
+}
+
+// Invite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - invitation model.WorkspaceInvitation
+func (_e *MockWorkspaceUsecase_Expecter) Invite(ctx interface{}, id interface{}, invitation interface{}) *MockWorkspaceUsecase_Invite_Call {
+	return &MockWorkspaceUsecase_Invite_Call{Call: _e.mock.On("Invite", ctx, id, invitation)}
+}
+
+func (_c *MockWorkspaceUsecase_Invite_Call) Run(run func(ctx context.Context, id int64, invitation model.WorkspaceInvitation)) *MockWorkspaceUsecase_Invite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.WorkspaceInvitation))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceUsecase_Invite_Call) Return(_a0 model.WorkspaceMember, _a1 error) *MockWorkspaceUsecase_Invite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceUsecase_Invite_Call) RunAndReturn(run func(context.Context, int64, model.WorkspaceInvitation) (model.WorkspaceMember, error)) *MockWorkspaceUsecase_Invite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: ctx, id, memberId
+func (_m *MockWorkspaceUsecase) RemoveMember(ctx context.Context, id int64, memberId int64) error {
+	ret := _m.Called(ctx, id, memberId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, id, memberId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWorkspaceUsecase_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type MockWorkspaceUsecase_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - memberId int64
+func (_e *MockWorkspaceUsecase_Expecter) RemoveMember(ctx interface{}, id interface{}, memberId interface{}) *MockWorkspaceUsecase_RemoveMember_Call {
+	return &MockWorkspaceUsecase_RemoveMember_Call{Call: _e.mock.On("RemoveMember", ctx, id, memberId)}
+}
+
+func (_c *MockWorkspaceUsecase_RemoveMember_Call) Run(run func(ctx context.Context, id int64, memberId int64)) *MockWorkspaceUsecase_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceUsecase_RemoveMember_Call) Return(_a0 error) *MockWorkspaceUsecase_RemoveMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkspaceUsecase_RemoveMember_Call) RunAndReturn(run func(context.Context, int64, int64) error) *MockWorkspaceUsecase_RemoveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, _a1
+func (_m *MockWorkspaceUsecase) Update(ctx context.Context, _a1 model.Workspace) (model.Workspace, error) {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.Workspace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Workspace) (model.Workspace, error)); ok {
+		return rf(ctx, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Workspace) model.Workspace); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(model.Workspace)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Workspace) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceUsecase_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockWorkspaceUsecase_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 model.Workspace
+func (_e *MockWorkspaceUsecase_Expecter) Update(ctx interface{}, _a1 interface{}) *MockWorkspaceUsecase_Update_Call {
+	return &MockWorkspaceUsecase_Update_Call{Call: _e.mock.On("Update", ctx, _a1)}
+}
+
+func (_c *MockWorkspaceUsecase_Update_Call) Run(run func(ctx context.Context, _a1 model.Workspace)) *MockWorkspaceUsecase_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Workspace))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceUsecase_Update_Call) Return(_a0 model.Workspace, _a1 error) *MockWorkspaceUsecase_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceUsecase_Update_Call) RunAndReturn(run func(context.Context, model.Workspace) (model.Workspace, error)) *MockWorkspaceUsecase_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMember provides a mock function with given fields: ctx, id, memberId, role
+func (_m *MockWorkspaceUsecase) UpdateMember(ctx context.Context, id int64, memberId int64, role string) (model.WorkspaceMember, error) {
+	ret := _m.Called(ctx, id, memberId, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMember")
+	}
+
+	var r0 model.WorkspaceMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string) (model.WorkspaceMember, error)); ok {
+		return rf(ctx, id, memberId, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string) model.WorkspaceMember); ok {
+		r0 = rf(ctx, id, memberId, role)
+	} else {
+		r0 = ret.Get(0).(model.WorkspaceMember)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, string) error); ok {
+		r1 = rf(ctx, id, memberId, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkspaceUsecase_UpdateMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMember'
+type MockWorkspaceUsecase_UpdateMember_Call struct {
+	*mock.Call
+}
+
+// UpdateMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - memberId int64
+//   - role string
+func (_e *MockWorkspaceUsecase_Expecter) UpdateMember(ctx interface{}, id interface{}, memberId interface{}, role interface{}) *MockWorkspaceUsecase_UpdateMember_Call {
+	return &MockWorkspaceUsecase_UpdateMember_Call{Call: _e.mock.On("UpdateMember", ctx, id, memberId, role)}
+}
+
+func (_c *MockWorkspaceUsecase_UpdateMember_Call) Run(run func(ctx context.Context, id int64, memberId int64, role string)) *MockWorkspaceUsecase_UpdateMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockWorkspaceUsecase_UpdateMember_Call) Return(_a0 model.WorkspaceMember, _a1 error) *MockWorkspaceUsecase_UpdateMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkspaceUsecase_UpdateMember_Call) RunAndReturn(run func(context.Context, int64, int64, string) (model.WorkspaceMember, error)) *MockWorkspaceUsecase_UpdateMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWorkspaceUsecase creates a new instance of MockWorkspaceUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWorkspaceUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWorkspaceUsecase {
+	mock := &MockWorkspaceUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
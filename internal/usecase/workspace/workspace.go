@@ -0,0 +1,229 @@
+package workspace
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/rzfhlv/go-task/internal/model"
+	"github.com/rzfhlv/go-task/internal/repository/workspace"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+)
+
+type WorkspaceUsecase interface {
+	Create(ctx context.Context, workspace model.Workspace) (model.Workspace, error)
+	GetByUserID(ctx context.Context, userId int64) ([]model.Workspace, error)
+	GetByID(ctx context.Context, id int64) (model.Workspace, error)
+	Update(ctx context.Context, workspace model.Workspace) (model.Workspace, error)
+	Delete(ctx context.Context, id int64) error
+	GetMembers(ctx context.Context, id int64) ([]model.WorkspaceMember, error)
+	Invite(ctx context.Context, id int64, invitation model.WorkspaceInvitation) (model.WorkspaceMember, error)
+	UpdateMember(ctx context.Context, id, memberId int64, role string) (model.WorkspaceMember, error)
+	RemoveMember(ctx context.Context, id, memberId int64) error
+}
+
+type Workspace struct {
+	workspaceRepository workspace.WorkspaceRepository
+}
+
+func New(workspaceRepository workspace.WorkspaceRepository) WorkspaceUsecase {
+	return &Workspace{
+		workspaceRepository: workspaceRepository,
+	}
+}
+
+func (w *Workspace) Create(ctx context.Context, request model.Workspace) (model.Workspace, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Workspace] error when get user id from context")
+		return model.Workspace{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	request.OwnerID = userId
+	result, err := w.workspaceRepository.Create(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Workspace] error when call workspaceRepository.Create", slog.String("error", err.Error()))
+		return model.Workspace{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (w *Workspace) GetByUserID(ctx context.Context, userId int64) ([]model.Workspace, error) {
+	result, err := w.workspaceRepository.GetByUserID(ctx, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Workspace] error when call workspaceRepository.GetByUserID", slog.String("error", err.Error()))
+		return []model.Workspace{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (w *Workspace) GetByID(ctx context.Context, id int64) (model.Workspace, error) {
+	return w.find(ctx, id, model.WorkspaceRoleViewer)
+}
+
+func (w *Workspace) Update(ctx context.Context, request model.Workspace) (model.Workspace, error) {
+	check, err := w.find(ctx, request.ID, model.WorkspaceRoleOwner)
+	if err != nil {
+		return model.Workspace{}, err
+	}
+
+	request.UpdatedAt = time.Now()
+	result, err := w.workspaceRepository.Update(ctx, request)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Workspace] error when call workspaceRepository.Update", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Workspace{}, errs.NewErrs(http.StatusNotFound, "workspace not found")
+		}
+
+		return model.Workspace{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	result.Role = check.Role
+
+	return result, nil
+}
+
+func (w *Workspace) Delete(ctx context.Context, id int64) error {
+	_, err := w.find(ctx, id, model.WorkspaceRoleOwner)
+	if err != nil {
+		return err
+	}
+
+	err = w.workspaceRepository.Delete(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Workspace] error when call workspaceRepository.Delete", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return errs.NewErrs(http.StatusNotFound, "workspace not found")
+		}
+
+		if err == workspace.ErrWorkspaceHasTasks {
+			return errs.NewErrs(http.StatusConflict, "workspace still has tasks")
+		}
+
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return nil
+}
+
+func (w *Workspace) GetMembers(ctx context.Context, id int64) ([]model.WorkspaceMember, error) {
+	_, err := w.find(ctx, id, model.WorkspaceRoleViewer)
+	if err != nil {
+		return []model.WorkspaceMember{}, err
+	}
+
+	result, err := w.workspaceRepository.GetMembers(ctx, id)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Workspace] error when call workspaceRepository.GetMembers", slog.String("error", err.Error()))
+		return []model.WorkspaceMember{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (w *Workspace) Invite(ctx context.Context, id int64, invitation model.WorkspaceInvitation) (model.WorkspaceMember, error) {
+	_, err := w.find(ctx, id, model.WorkspaceRoleOwner)
+	if err != nil {
+		return model.WorkspaceMember{}, err
+	}
+
+	result, err := w.workspaceRepository.AddMember(ctx, id, invitation.Email, invitation.Role)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Workspace] error when call workspaceRepository.AddMember", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.WorkspaceMember{}, errs.NewErrs(http.StatusNotFound, "user not found")
+		}
+
+		if err == workspace.ErrMemberExists {
+			return model.WorkspaceMember{}, errs.NewErrs(http.StatusConflict, "user is already a member")
+		}
+
+		return model.WorkspaceMember{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (w *Workspace) UpdateMember(ctx context.Context, id, memberId int64, role string) (model.WorkspaceMember, error) {
+	check, err := w.find(ctx, id, model.WorkspaceRoleOwner)
+	if err != nil {
+		return model.WorkspaceMember{}, err
+	}
+
+	if memberId == check.OwnerID {
+		slog.ErrorContext(ctx, "[Usecase.Workspace] error workspace owner role cannot be changed", slog.Int64("id", id))
+		return model.WorkspaceMember{}, errs.NewErrs(http.StatusConflict, "workspace owner role cannot be changed")
+	}
+
+	result, err := w.workspaceRepository.UpdateMember(ctx, id, memberId, role)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Workspace] error when call workspaceRepository.UpdateMember", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.WorkspaceMember{}, errs.NewErrs(http.StatusNotFound, "member not found")
+		}
+
+		return model.WorkspaceMember{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return result, nil
+}
+
+func (w *Workspace) RemoveMember(ctx context.Context, id, memberId int64) error {
+	check, err := w.find(ctx, id, model.WorkspaceRoleViewer)
+	if err != nil {
+		return err
+	}
+
+	if memberId == check.OwnerID {
+		slog.ErrorContext(ctx, "[Usecase.Workspace] error workspace owner cannot be removed", slog.Int64("id", id))
+		return errs.NewErrs(http.StatusConflict, "workspace owner cannot be removed")
+	}
+
+	userId, _ := ctx.Value(auth.IdKey).(int64)
+	if memberId != userId && !model.WorkspaceRoleAllows(check.Role, model.WorkspaceRoleOwner) {
+		slog.ErrorContext(ctx, "[Usecase.Workspace] error insufficient workspace role", slog.Int64("id", id), slog.String("role", check.Role))
+		return errs.NewErrs(http.StatusForbidden, "insufficient workspace role")
+	}
+
+	err = w.workspaceRepository.RemoveMember(ctx, id, memberId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Workspace] error when call workspaceRepository.RemoveMember", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return errs.NewErrs(http.StatusNotFound, "member not found")
+		}
+
+		return errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	return nil
+}
+
+func (w *Workspace) find(ctx context.Context, id int64, role string) (model.Workspace, error) {
+	userId, ok := ctx.Value(auth.IdKey).(int64)
+	if !ok {
+		slog.ErrorContext(ctx, "[Usecase.Workspace] error when get user id from context")
+		return model.Workspace{}, errs.NewErrs(http.StatusForbidden, "forbidden access")
+	}
+
+	result, err := w.workspaceRepository.GetByID(ctx, id, userId)
+	if err != nil {
+		slog.ErrorContext(ctx, "[Usecase.Workspace] error when call workspaceRepository.GetByID", slog.String("error", err.Error()))
+		if err == sql.ErrNoRows {
+			return model.Workspace{}, errs.NewErrs(http.StatusNotFound, "workspace not found")
+		}
+
+		return model.Workspace{}, errs.NewErrs(http.StatusInternalServerError, "something went wrong")
+	}
+
+	if !model.WorkspaceRoleAllows(result.Role, role) {
+		slog.ErrorContext(ctx, "[Usecase.Workspace] error insufficient workspace role", slog.Int64("id", id), slog.String("role", result.Role))
+		return model.Workspace{}, errs.NewErrs(http.StatusForbidden, "insufficient workspace role")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,570 @@
+package workspace_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/rzfhlv/go-task/internal/model"
+	repository "github.com/rzfhlv/go-task/internal/repository/workspace"
+	workspacemocks "github.com/rzfhlv/go-task/internal/repository/workspace/mocks"
+	"github.com/rzfhlv/go-task/internal/usecase/workspace"
+	"github.com/rzfhlv/go-task/pkg/errs"
+	"github.com/rzfhlv/go-task/pkg/middleware/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	userId   = int64(1)
+	memberId = int64(2)
+
+	ownerWorkspace = model.Workspace{
+		ID:      1,
+		Name:    "Platform",
+		OwnerID: userId,
+		Role:    model.WorkspaceRoleOwner,
+	}
+
+	editorWorkspace = model.Workspace{
+		ID:      1,
+		Name:    "Platform",
+		OwnerID: memberId,
+		Role:    model.WorkspaceRoleEditor,
+	}
+
+	viewerWorkspace = model.Workspace{
+		ID:      1,
+		Name:    "Platform",
+		OwnerID: memberId,
+		Role:    model.WorkspaceRoleViewer,
+	}
+
+	memberModel = model.WorkspaceMember{
+		WorkspaceID: 1,
+		UserID:      memberId,
+		Name:        "Jane",
+		Email:       "jane@example.com",
+		Role:        model.WorkspaceRoleEditor,
+	}
+
+	ctxWithUser = context.WithValue(context.Background(), auth.IdKey, userId)
+)
+
+func TestWorkspaceCreate(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockDeps   func(workspaceRepository *workspacemocks.MockWorkspaceRepository)
+		wantResult model.Workspace
+		wantErr    error
+	}{
+		{
+			name: "success",
+			ctx:  ctxWithUser,
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("Create", mock.Anything, model.Workspace{Name: "Platform", OwnerID: userId}).Return(ownerWorkspace, nil)
+			},
+			wantResult: ownerWorkspace,
+			wantErr:    nil,
+		},
+		{
+			name: "error when create workspace",
+			ctx:  ctxWithUser,
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("Create", mock.Anything, mock.Anything).Return(model.Workspace{}, errors.New("some error"))
+			},
+			wantResult: model.Workspace{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when user id is missing",
+			ctx:  context.Background(),
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.AssertNotCalled(t, "Create")
+			},
+			wantResult: model.Workspace{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceRepository := workspacemocks.MockWorkspaceRepository{}
+
+			tt.mockDeps(&workspaceRepository)
+
+			usecase := workspace.New(&workspaceRepository)
+			result, err := usecase.Create(tt.ctx, model.Workspace{Name: "Platform"})
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestWorkspaceGetByUserID(t *testing.T) {
+	tests := []struct {
+		name       string
+		mockDeps   func(workspaceRepository *workspacemocks.MockWorkspaceRepository)
+		wantResult []model.Workspace
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByUserID", mock.Anything, userId).Return([]model.Workspace{ownerWorkspace}, nil)
+			},
+			wantResult: []model.Workspace{ownerWorkspace},
+			wantErr:    nil,
+		},
+		{
+			name: "error when get workspaces",
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByUserID", mock.Anything, userId).Return([]model.Workspace{}, errors.New("some error"))
+			},
+			wantResult: []model.Workspace{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceRepository := workspacemocks.MockWorkspaceRepository{}
+
+			tt.mockDeps(&workspaceRepository)
+
+			usecase := workspace.New(&workspaceRepository)
+			result, err := usecase.GetByUserID(ctxWithUser, userId)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestWorkspaceGetByID(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		mockDeps   func(workspaceRepository *workspacemocks.MockWorkspaceRepository)
+		wantResult model.Workspace
+		wantErr    error
+	}{
+		{
+			name: "success as viewer",
+			ctx:  ctxWithUser,
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(viewerWorkspace, nil)
+			},
+			wantResult: viewerWorkspace,
+			wantErr:    nil,
+		},
+		{
+			name: "error when workspace is not found",
+			ctx:  ctxWithUser,
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(model.Workspace{}, sql.ErrNoRows)
+			},
+			wantResult: model.Workspace{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "workspace not found"),
+		},
+		{
+			name: "error when get workspace",
+			ctx:  ctxWithUser,
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(model.Workspace{}, errors.New("some error"))
+			},
+			wantResult: model.Workspace{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+		{
+			name: "error when user id is missing",
+			ctx:  context.Background(),
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.AssertNotCalled(t, "GetByID")
+			},
+			wantResult: model.Workspace{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "forbidden access"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceRepository := workspacemocks.MockWorkspaceRepository{}
+
+			tt.mockDeps(&workspaceRepository)
+
+			usecase := workspace.New(&workspaceRepository)
+			result, err := usecase.GetByID(tt.ctx, 1)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestWorkspaceUpdate(t *testing.T) {
+	updated := ownerWorkspace
+	updated.Role = ""
+
+	tests := []struct {
+		name       string
+		mockDeps   func(workspaceRepository *workspacemocks.MockWorkspaceRepository)
+		wantResult model.Workspace
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(ownerWorkspace, nil)
+				workspaceRepository.On("Update", mock.Anything, mock.Anything).Return(updated, nil)
+			},
+			wantResult: ownerWorkspace,
+			wantErr:    nil,
+		},
+		{
+			name: "error when role is not owner",
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(editorWorkspace, nil)
+				workspaceRepository.AssertNotCalled(t, "Update")
+			},
+			wantResult: model.Workspace{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "insufficient workspace role"),
+		},
+		{
+			name: "error when update workspace",
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(ownerWorkspace, nil)
+				workspaceRepository.On("Update", mock.Anything, mock.Anything).Return(model.Workspace{}, errors.New("some error"))
+			},
+			wantResult: model.Workspace{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceRepository := workspacemocks.MockWorkspaceRepository{}
+
+			tt.mockDeps(&workspaceRepository)
+
+			usecase := workspace.New(&workspaceRepository)
+			result, err := usecase.Update(ctxWithUser, model.Workspace{ID: 1, Name: "Platform"})
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestWorkspaceDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		mockDeps func(workspaceRepository *workspacemocks.MockWorkspaceRepository)
+		wantErr  error
+	}{
+		{
+			name: "success",
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(ownerWorkspace, nil)
+				workspaceRepository.On("Delete", mock.Anything, int64(1)).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "error when role is not owner",
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(viewerWorkspace, nil)
+				workspaceRepository.AssertNotCalled(t, "Delete")
+			},
+			wantErr: errs.NewErrs(http.StatusForbidden, "insufficient workspace role"),
+		},
+		{
+			name: "error when workspace still has tasks",
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(ownerWorkspace, nil)
+				workspaceRepository.On("Delete", mock.Anything, int64(1)).Return(repository.ErrWorkspaceHasTasks)
+			},
+			wantErr: errs.NewErrs(http.StatusConflict, "workspace still has tasks"),
+		},
+		{
+			name: "error when delete workspace",
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(ownerWorkspace, nil)
+				workspaceRepository.On("Delete", mock.Anything, int64(1)).Return(errors.New("some error"))
+			},
+			wantErr: errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceRepository := workspacemocks.MockWorkspaceRepository{}
+
+			tt.mockDeps(&workspaceRepository)
+
+			usecase := workspace.New(&workspaceRepository)
+			err := usecase.Delete(ctxWithUser, 1)
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestWorkspaceGetMembers(t *testing.T) {
+	tests := []struct {
+		name       string
+		mockDeps   func(workspaceRepository *workspacemocks.MockWorkspaceRepository)
+		wantResult []model.WorkspaceMember
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(viewerWorkspace, nil)
+				workspaceRepository.On("GetMembers", mock.Anything, int64(1)).Return([]model.WorkspaceMember{memberModel}, nil)
+			},
+			wantResult: []model.WorkspaceMember{memberModel},
+			wantErr:    nil,
+		},
+		{
+			name: "error when workspace is not found",
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(model.Workspace{}, sql.ErrNoRows)
+				workspaceRepository.AssertNotCalled(t, "GetMembers")
+			},
+			wantResult: []model.WorkspaceMember{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "workspace not found"),
+		},
+		{
+			name: "error when get members",
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(viewerWorkspace, nil)
+				workspaceRepository.On("GetMembers", mock.Anything, int64(1)).Return([]model.WorkspaceMember{}, errors.New("some error"))
+			},
+			wantResult: []model.WorkspaceMember{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceRepository := workspacemocks.MockWorkspaceRepository{}
+
+			tt.mockDeps(&workspaceRepository)
+
+			usecase := workspace.New(&workspaceRepository)
+			result, err := usecase.GetMembers(ctxWithUser, 1)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestWorkspaceInvite(t *testing.T) {
+	invitation := model.WorkspaceInvitation{Email: memberModel.Email, Role: model.WorkspaceRoleEditor}
+
+	tests := []struct {
+		name       string
+		mockDeps   func(workspaceRepository *workspacemocks.MockWorkspaceRepository)
+		wantResult model.WorkspaceMember
+		wantErr    error
+	}{
+		{
+			name: "success",
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(ownerWorkspace, nil)
+				workspaceRepository.On("AddMember", mock.Anything, int64(1), invitation.Email, invitation.Role).Return(memberModel, nil)
+			},
+			wantResult: memberModel,
+			wantErr:    nil,
+		},
+		{
+			name: "error when role is not owner",
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(editorWorkspace, nil)
+				workspaceRepository.AssertNotCalled(t, "AddMember")
+			},
+			wantResult: model.WorkspaceMember{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "insufficient workspace role"),
+		},
+		{
+			name: "error when user is not found",
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(ownerWorkspace, nil)
+				workspaceRepository.On("AddMember", mock.Anything, int64(1), invitation.Email, invitation.Role).Return(model.WorkspaceMember{}, sql.ErrNoRows)
+			},
+			wantResult: model.WorkspaceMember{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "user not found"),
+		},
+		{
+			name: "error when user is already a member",
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(ownerWorkspace, nil)
+				workspaceRepository.On("AddMember", mock.Anything, int64(1), invitation.Email, invitation.Role).Return(model.WorkspaceMember{}, repository.ErrMemberExists)
+			},
+			wantResult: model.WorkspaceMember{},
+			wantErr:    errs.NewErrs(http.StatusConflict, "user is already a member"),
+		},
+		{
+			name: "error when add member",
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(ownerWorkspace, nil)
+				workspaceRepository.On("AddMember", mock.Anything, int64(1), invitation.Email, invitation.Role).Return(model.WorkspaceMember{}, errors.New("some error"))
+			},
+			wantResult: model.WorkspaceMember{},
+			wantErr:    errs.NewErrs(http.StatusInternalServerError, "something went wrong"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceRepository := workspacemocks.MockWorkspaceRepository{}
+
+			tt.mockDeps(&workspaceRepository)
+
+			usecase := workspace.New(&workspaceRepository)
+			result, err := usecase.Invite(ctxWithUser, 1, invitation)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestWorkspaceUpdateMember(t *testing.T) {
+	tests := []struct {
+		name       string
+		memberId   int64
+		mockDeps   func(workspaceRepository *workspacemocks.MockWorkspaceRepository)
+		wantResult model.WorkspaceMember
+		wantErr    error
+	}{
+		{
+			name:     "success",
+			memberId: memberId,
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(ownerWorkspace, nil)
+				workspaceRepository.On("UpdateMember", mock.Anything, int64(1), memberId, model.WorkspaceRoleEditor).Return(memberModel, nil)
+			},
+			wantResult: memberModel,
+			wantErr:    nil,
+		},
+		{
+			name:     "error when changing owner role",
+			memberId: userId,
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(ownerWorkspace, nil)
+				workspaceRepository.AssertNotCalled(t, "UpdateMember")
+			},
+			wantResult: model.WorkspaceMember{},
+			wantErr:    errs.NewErrs(http.StatusConflict, "workspace owner role cannot be changed"),
+		},
+		{
+			name:     "error when member is not found",
+			memberId: memberId,
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(ownerWorkspace, nil)
+				workspaceRepository.On("UpdateMember", mock.Anything, int64(1), memberId, model.WorkspaceRoleEditor).Return(model.WorkspaceMember{}, sql.ErrNoRows)
+			},
+			wantResult: model.WorkspaceMember{},
+			wantErr:    errs.NewErrs(http.StatusNotFound, "member not found"),
+		},
+		{
+			name:     "error when role is not owner",
+			memberId: memberId,
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(editorWorkspace, nil)
+				workspaceRepository.AssertNotCalled(t, "UpdateMember")
+			},
+			wantResult: model.WorkspaceMember{},
+			wantErr:    errs.NewErrs(http.StatusForbidden, "insufficient workspace role"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceRepository := workspacemocks.MockWorkspaceRepository{}
+
+			tt.mockDeps(&workspaceRepository)
+
+			usecase := workspace.New(&workspaceRepository)
+			result, err := usecase.UpdateMember(ctxWithUser, 1, tt.memberId, model.WorkspaceRoleEditor)
+
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestWorkspaceRemoveMember(t *testing.T) {
+	tests := []struct {
+		name     string
+		memberId int64
+		mockDeps func(workspaceRepository *workspacemocks.MockWorkspaceRepository)
+		wantErr  error
+	}{
+		{
+			name:     "success as owner",
+			memberId: memberId,
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(ownerWorkspace, nil)
+				workspaceRepository.On("RemoveMember", mock.Anything, int64(1), memberId).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:     "success when leaving workspace",
+			memberId: userId,
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(viewerWorkspace, nil)
+				workspaceRepository.On("RemoveMember", mock.Anything, int64(1), userId).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name:     "error when removing owner",
+			memberId: userId,
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(ownerWorkspace, nil)
+				workspaceRepository.AssertNotCalled(t, "RemoveMember")
+			},
+			wantErr: errs.NewErrs(http.StatusConflict, "workspace owner cannot be removed"),
+		},
+		{
+			name:     "error when removing other member without owner role",
+			memberId: int64(3),
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(editorWorkspace, nil)
+				workspaceRepository.AssertNotCalled(t, "RemoveMember")
+			},
+			wantErr: errs.NewErrs(http.StatusForbidden, "insufficient workspace role"),
+		},
+		{
+			name:     "error when member is not found",
+			memberId: memberId,
+			mockDeps: func(workspaceRepository *workspacemocks.MockWorkspaceRepository) {
+				workspaceRepository.On("GetByID", mock.Anything, int64(1), userId).Return(ownerWorkspace, nil)
+				workspaceRepository.On("RemoveMember", mock.Anything, int64(1), memberId).Return(sql.ErrNoRows)
+			},
+			wantErr: errs.NewErrs(http.StatusNotFound, "member not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspaceRepository := workspacemocks.MockWorkspaceRepository{}
+
+			tt.mockDeps(&workspaceRepository)
+
+			usecase := workspace.New(&workspaceRepository)
+			err := usecase.RemoveMember(ctxWithUser, 1, tt.memberId)
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package cursor_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rzfhlv/go-task/pkg/cursor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursorEncodeDecode(t *testing.T) {
+	c := cursor.Cursor{
+		Sort:     "-updated_at",
+		Value:    "2023-08-15T12:00:00Z",
+		ID:       10,
+		Backward: true,
+	}
+
+	token := cursor.Encode(c, "verysecret")
+
+	result, err := cursor.Decode(token, "verysecret")
+	assert.NoError(t, err)
+	assert.Equal(t, c, result)
+}
+
+func TestCursorDecode(t *testing.T) {
+	token := cursor.Encode(cursor.Cursor{Value: "10", ID: 10}, "verysecret")
+	payload, signature, _ := strings.Cut(token, ".")
+
+	tests := []struct {
+		name    string
+		token   string
+		secret  string
+		wantErr error
+	}{
+		{
+			name:    "success",
+			token:   token,
+			secret:  "verysecret",
+			wantErr: nil,
+		},
+		{
+			name:    "error when signed with another secret",
+			token:   token,
+			secret:  "anothersecret",
+			wantErr: cursor.ErrInvalidCursor,
+		},
+		{
+			name:    "error when payload is tampered",
+			token:   payload + "x." + signature,
+			secret:  "verysecret",
+			wantErr: cursor.ErrInvalidCursor,
+		},
+		{
+			name:    "error when signature is missing",
+			token:   payload,
+			secret:  "verysecret",
+			wantErr: cursor.ErrInvalidCursor,
+		},
+		{
+			name:    "error when signature is not base64",
+			token:   payload + ".!!!",
+			secret:  "verysecret",
+			wantErr: cursor.ErrInvalidCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := cursor.Decode(tt.token, tt.secret)
+
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
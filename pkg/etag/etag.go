@@ -0,0 +1,87 @@
+package etag
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidETag = errors.New("invalid etag")
+
+type Match struct {
+	Present  bool
+	Any      bool
+	Versions []int64
+}
+
+func Exact(version int64) Match {
+	if version == 0 {
+		return Match{}
+	}
+
+	return Match{Present: true, Versions: []int64{version}}
+}
+
+func (m Match) Matches(version int64) bool {
+	return m.Any || slices.Contains(m.Versions, version)
+}
+
+func Format(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+func Parse(header string) (Match, error) {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return Match{}, nil
+	}
+
+	if header == "*" {
+		return Match{Present: true, Any: true}, nil
+	}
+
+	match, count := Match{Present: true, Versions: []int64{}}, 0
+	for header != "" {
+		header = strings.TrimLeft(header, " \t,")
+		if header == "" {
+			break
+		}
+
+		weak := strings.HasPrefix(header, "W/")
+		if weak {
+			header = header[2:]
+		}
+
+		if !strings.HasPrefix(header, `"`) {
+			return Match{}, ErrInvalidETag
+		}
+
+		end := strings.IndexByte(header[1:], '"')
+		if end < 0 {
+			return Match{}, ErrInvalidETag
+		}
+
+		value := header[1 : end+1]
+		header = strings.TrimLeft(header[end+2:], " \t")
+		if header != "" && header[0] != ',' {
+			return Match{}, ErrInvalidETag
+		}
+
+		version, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || version < 1 {
+			return Match{}, ErrInvalidETag
+		}
+
+		count++
+		if !weak {
+			match.Versions = append(match.Versions, version)
+		}
+	}
+
+	if count == 0 {
+		return Match{}, ErrInvalidETag
+	}
+
+	return match, nil
+}
@@ -0,0 +1,123 @@
+package etag_test
+
+import (
+	"testing"
+
+	"github.com/rzfhlv/go-task/pkg/etag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestETagFormat(t *testing.T) {
+	assert.Equal(t, `"3"`, etag.Format(3))
+}
+
+func TestETagMatches(t *testing.T) {
+	assert.True(t, etag.Match{Present: true, Any: true}.Matches(3))
+	assert.True(t, etag.Match{Present: true, Versions: []int64{2, 3}}.Matches(3))
+	assert.False(t, etag.Match{Present: true, Versions: []int64{}}.Matches(3))
+	assert.Equal(t, etag.Match{}, etag.Exact(0))
+	assert.Equal(t, etag.Match{Present: true, Versions: []int64{3}}, etag.Exact(3))
+}
+
+func TestETagParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		header    string
+		wantMatch etag.Match
+		wantErr   error
+	}{
+		{
+			name:      "success",
+			header:    `"3"`,
+			wantMatch: etag.Match{Present: true, Versions: []int64{3}},
+			wantErr:   nil,
+		},
+		{
+			name:      "success with surrounding spaces",
+			header:    ` "3" `,
+			wantMatch: etag.Match{Present: true, Versions: []int64{3}},
+			wantErr:   nil,
+		},
+		{
+			name:      "success with empty header",
+			header:    "",
+			wantMatch: etag.Match{},
+			wantErr:   nil,
+		},
+		{
+			name:      "success with wildcard",
+			header:    "*",
+			wantMatch: etag.Match{Present: true, Any: true},
+			wantErr:   nil,
+		},
+		{
+			name:      "success with list",
+			header:    `"2", "3" ,,"5"`,
+			wantMatch: etag.Match{Present: true, Versions: []int64{2, 3, 5}},
+			wantErr:   nil,
+		},
+		{
+			name:      "success with weak etag never matching",
+			header:    `W/"3"`,
+			wantMatch: etag.Match{Present: true, Versions: []int64{}},
+			wantErr:   nil,
+		},
+		{
+			name:      "success with weak etag in list",
+			header:    `W/"3", "4"`,
+			wantMatch: etag.Match{Present: true, Versions: []int64{4}},
+			wantErr:   nil,
+		},
+		{
+			name:      "error when wildcard in list",
+			header:    `*, "3"`,
+			wantMatch: etag.Match{},
+			wantErr:   etag.ErrInvalidETag,
+		},
+		{
+			name:      "error when list is empty",
+			header:    " , ",
+			wantMatch: etag.Match{},
+			wantErr:   etag.ErrInvalidETag,
+		},
+		{
+			name:      "error when not quoted",
+			header:    "3",
+			wantMatch: etag.Match{},
+			wantErr:   etag.ErrInvalidETag,
+		},
+		{
+			name:      "error when quote is unterminated",
+			header:    `"3`,
+			wantMatch: etag.Match{},
+			wantErr:   etag.ErrInvalidETag,
+		},
+		{
+			name:      "error when list is not separated",
+			header:    `"3" "4"`,
+			wantMatch: etag.Match{},
+			wantErr:   etag.ErrInvalidETag,
+		},
+		{
+			name:      "error when not a version",
+			header:    `"abc"`,
+			wantMatch: etag.Match{},
+			wantErr:   etag.ErrInvalidETag,
+		},
+		{
+			name:      "error when version is not positive",
+			header:    `"0"`,
+			wantMatch: etag.Match{},
+			wantErr:   etag.ErrInvalidETag,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, err := etag.Parse(tt.header)
+
+			assert.Equal(t, tt.wantMatch, match)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
@@ -1,12 +1,74 @@
 package param
 
+import (
+	"strings"
+	"time"
+
+	"github.com/rzfhlv/go-task/pkg/cursor"
+)
+
 type Param struct {
-	Page   int   `json:"page" query:"page"`
-	Limit  int   `json:"limit" query:"limit"`
-	Offset int   `json:"offset"`
-	Total  int64 `json:"total"`
+	Page          int            `json:"page" query:"page"`
+	Limit         int            `json:"limit" query:"limit"`
+	Offset        int            `json:"offset"`
+	Total         int64          `json:"total"`
+	Cursor        string         `json:"cursor" query:"cursor"`
+	NextCursor    string         `json:"next_cursor"`
+	PrevCursor    string         `json:"prev_cursor"`
+	Keyset        *cursor.Cursor `json:"-"`
+	CursorMode    bool           `json:"-"`
+	Sort          string         `json:"sort" query:"sort"`
+	Q             string         `json:"q" query:"q"`
+	Status        string         `json:"status" query:"status"`
+	Priority      string         `json:"priority" query:"priority"`
+	Labels        string         `json:"labels" query:"labels"`
+	LabelMode     string         `json:"label_mode" query:"label_mode"`
+	Assignee      string         `json:"assignee" query:"assignee"`
+	Overdue       bool           `json:"overdue" query:"overdue"`
+	DueBefore     *time.Time     `json:"due_before" query:"due_before"`
+	DueAfter      *time.Time     `json:"due_after" query:"due_after"`
+	CreatedBefore *time.Time     `json:"created_before" query:"created_before"`
+	CreatedAfter  *time.Time     `json:"created_after" query:"created_after"`
+	UpdatedBefore *time.Time     `json:"updated_before" query:"updated_before"`
+	UpdatedAfter  *time.Time     `json:"updated_after" query:"updated_after"`
+	AssignedAfter *time.Time     `json:"assigned_after" query:"assigned_after"`
+	Archived      bool           `json:"archived" query:"archived"`
+	Trashed       bool           `json:"-"`
+	ParentID      *int64         `json:"-"`
+	ProjectID     *int64         `json:"-"`
+	WorkspaceID   *int64         `json:"-"`
+	AssigneeID    *int64         `json:"-"`
+	Unassigned    bool           `json:"-"`
 }
 
 func (f *Param) CalculateOffset() int {
 	return f.Limit * (f.Page - 1)
 }
+
+func (f *Param) Sorts() []string {
+	return split(f.Sort)
+}
+
+func (f *Param) Statuses() []string {
+	return split(f.Status)
+}
+
+func (f *Param) Priorities() []string {
+	return split(f.Priority)
+}
+
+func (f *Param) LabelNames() []string {
+	return split(f.Labels)
+}
+
+func split(value string) []string {
+	result := []string{}
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
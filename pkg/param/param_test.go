@@ -18,3 +18,45 @@ func TestParam(t *testing.T) {
 	nweOffset := param.CalculateOffset()
 	assert.Equal(t, nweOffset, 10)
 }
+
+func TestParamPriorities(t *testing.T) {
+	tests := []struct {
+		name     string
+		priority string
+		want     []string
+	}{
+		{
+			name:     "empty",
+			priority: "",
+			want:     []string{},
+		},
+		{
+			name:     "single",
+			priority: "high",
+			want:     []string{"high"},
+		},
+		{
+			name:     "multiple with spaces",
+			priority: "high, urgent,,",
+			want:     []string{"high", "urgent"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			param := param.Param{Priority: tt.priority}
+
+			assert.Equal(t, tt.want, param.Priorities())
+		})
+	}
+}
+
+func TestParamSortsAndStatuses(t *testing.T) {
+	param := param.Param{
+		Sort:   "-updated_at, title",
+		Status: "todo,in_progress",
+	}
+
+	assert.Equal(t, []string{"-updated_at", "title"}, param.Sorts())
+	assert.Equal(t, []string{"todo", "in_progress"}, param.Statuses())
+}
@@ -0,0 +1,129 @@
+package rank
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	digits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	width  = 6
+)
+
+var ErrInvalidRank = errors.New("invalid rank")
+
+func Between(a, b string) (string, error) {
+	if !valid(a) || !valid(b) || (b != "" && a >= b) {
+		return "", ErrInvalidRank
+	}
+
+	switch {
+	case a == "" && b == "":
+		return midpoint("", ""), nil
+	case b == "":
+		return after(a), nil
+	case a == "":
+		return before(b), nil
+	}
+
+	return midpoint(a, b), nil
+}
+
+func valid(key string) bool {
+	for i := 0; i < len(key); i++ {
+		if strings.IndexByte(digits, key[i]) < 0 {
+			return false
+		}
+	}
+
+	return !strings.HasSuffix(key, digits[:1])
+}
+
+func after(a string) string {
+	value := pad(a)
+	for i := len(value) - 1; i >= 0; i-- {
+		index := strings.IndexByte(digits, value[i])
+		if index < len(digits)-1 {
+			value[i] = digits[index+1]
+			return strings.TrimRight(string(value), digits[:1])
+		}
+
+		value[i] = digits[0]
+	}
+
+	return a + midpoint("", "")
+}
+
+func before(b string) string {
+	value := pad(b)
+	for i := len(value) - 1; i >= 0; i-- {
+		index := strings.IndexByte(digits, value[i])
+		if index > 0 {
+			value[i] = digits[index-1]
+			key := strings.TrimRight(string(value), digits[:1])
+			if key != "" {
+				return key
+			}
+
+			break
+		}
+
+		value[i] = digits[len(digits)-1]
+	}
+
+	return midpoint("", b)
+}
+
+func pad(key string) []byte {
+	value := []byte(key)
+	for len(value) < width {
+		value = append(value, digits[0])
+	}
+
+	return value
+}
+
+func midpoint(a, b string) string {
+	if b != "" {
+		n := 0
+		for n < len(b) && digit(a, n) == b[n] {
+			n++
+		}
+
+		if n > 0 {
+			return b[:n] + midpoint(suffix(a, n), b[n:])
+		}
+	}
+
+	low := strings.IndexByte(digits, digit(a, 0))
+	high := len(digits)
+	if b != "" {
+		high = strings.IndexByte(digits, b[0])
+	}
+
+	if high-low > 1 {
+		return string(digits[(low+high+1)/2])
+	}
+
+	if len(b) > 1 {
+		return b[:1]
+	}
+
+	return string(digits[low]) + midpoint(suffix(a, 1), "")
+}
+
+func digit(key string, n int) byte {
+	if n < len(key) {
+		return key[n]
+	}
+
+	return digits[0]
+}
+
+func suffix(key string, n int) string {
+	if n < len(key) {
+		return key[n:]
+	}
+
+	return ""
+}
@@ -0,0 +1,133 @@
+package rank_test
+
+import (
+	"testing"
+
+	"github.com/rzfhlv/go-task/pkg/rank"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankBetween(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       string
+		b       string
+		wantKey string
+		wantErr error
+	}{
+		{
+			name:    "success with empty column",
+			a:       "",
+			b:       "",
+			wantKey: "V",
+			wantErr: nil,
+		},
+		{
+			name:    "success append",
+			a:       "V",
+			b:       "",
+			wantKey: "V00001",
+			wantErr: nil,
+		},
+		{
+			name:    "success append with carry",
+			a:       "V0000z",
+			b:       "",
+			wantKey: "V0001",
+			wantErr: nil,
+		},
+		{
+			name:    "success append after last key",
+			a:       "zzzzzz",
+			b:       "",
+			wantKey: "zzzzzzV",
+			wantErr: nil,
+		},
+		{
+			name:    "success prepend",
+			a:       "",
+			b:       "V",
+			wantKey: "Uzzzzz",
+			wantErr: nil,
+		},
+		{
+			name:    "success prepend before smallest key",
+			a:       "",
+			b:       "000001",
+			wantKey: "000000V",
+			wantErr: nil,
+		},
+		{
+			name:    "success between distant keys",
+			a:       "A",
+			b:       "Z",
+			wantKey: "N",
+			wantErr: nil,
+		},
+		{
+			name:    "success between adjacent keys",
+			a:       "V00001",
+			b:       "V00002",
+			wantKey: "V00001V",
+			wantErr: nil,
+		},
+		{
+			name:    "success between prefix keys",
+			a:       "V",
+			b:       "V1",
+			wantKey: "V0V",
+			wantErr: nil,
+		},
+		{
+			name:    "error when keys are not ordered",
+			a:       "Z",
+			b:       "A",
+			wantKey: "",
+			wantErr: rank.ErrInvalidRank,
+		},
+		{
+			name:    "error when keys are equal",
+			a:       "V",
+			b:       "V",
+			wantKey: "",
+			wantErr: rank.ErrInvalidRank,
+		},
+		{
+			name:    "error when key has invalid character",
+			a:       "V-",
+			b:       "",
+			wantKey: "",
+			wantErr: rank.ErrInvalidRank,
+		},
+		{
+			name:    "error when key has trailing zero",
+			a:       "",
+			b:       "V0",
+			wantKey: "",
+			wantErr: rank.ErrInvalidRank,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := rank.Between(tt.a, tt.b)
+			assert.Equal(t, tt.wantKey, key)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestRankBetweenRepeated(t *testing.T) {
+	low, high := "V", "V00001"
+	for i := 0; i < 200; i++ {
+		key, err := rank.Between(low, high)
+		assert.Nil(t, err)
+		assert.Less(t, low, key)
+		assert.Less(t, key, high)
+		if i%2 == 0 {
+			low = key
+		} else {
+			high = key
+		}
+	}
+}
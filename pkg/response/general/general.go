@@ -15,11 +15,13 @@ type Response struct {
 }
 
 type Meta struct {
-	Limit     int   `json:"limit"`
-	Page      int   `json:"page"`
-	PerPage   int   `json:"perPage"`
-	PageCount int   `json:"pageCount"`
-	Total     int64 `json:"total"`
+	Limit      int    `json:"limit"`
+	Page       int    `json:"page"`
+	PerPage    int    `json:"perPage"`
+	PageCount  int    `json:"pageCount"`
+	Total      int64  `json:"total"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
 }
 
 func BuildMeta(param param.Param, data int) Meta {
@@ -28,11 +30,13 @@ func BuildMeta(param param.Param, data int) Meta {
 		pageCount = int(math.Ceil(float64(param.Total) / float64(param.Limit)))
 	}
 	return Meta{
-		Limit:     param.Limit,
-		Page:      param.Page,
-		PerPage:   data,
-		PageCount: pageCount,
-		Total:     param.Total,
+		Limit:      param.Limit,
+		Page:       param.Page,
+		PerPage:    data,
+		PageCount:  pageCount,
+		Total:      param.Total,
+		NextCursor: param.NextCursor,
+		PrevCursor: param.PrevCursor,
 	}
 }
 
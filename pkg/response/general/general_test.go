@@ -30,6 +30,21 @@ func TestResponseBuildMeta(t *testing.T) {
 	})
 }
 
+func TestResponseBuildMetaWithCursor(t *testing.T) {
+	param := param.Param{
+		Page:       1,
+		Limit:      10,
+		Total:      20,
+		NextCursor: "next",
+		PrevCursor: "prev",
+	}
+
+	meta := general.BuildMeta(param, 10)
+
+	assert.Equal(t, "next", meta.NextCursor)
+	assert.Equal(t, "prev", meta.PrevCursor)
+}
+
 func TestResponseSet(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		msg := "Success"
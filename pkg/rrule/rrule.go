@@ -0,0 +1,254 @@
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FreqDaily   = "DAILY"
+	FreqWeekly  = "WEEKLY"
+	FreqMonthly = "MONTHLY"
+)
+
+const maxPeriods = 10000
+
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+type Day struct {
+	Weekday time.Weekday
+	Nth     int
+}
+
+type Rule struct {
+	Freq     string
+	Interval int
+	ByDay    []Day
+	Count    int
+	Until    *time.Time
+}
+
+func Parse(value string) (Rule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return Rule{}, invalid("rule is empty")
+	}
+
+	rule := Rule{Interval: 1}
+	seen := map[string]bool{}
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok || val == "" {
+			return Rule{}, invalid("malformed part %q", part)
+		}
+
+		key = strings.ToUpper(key)
+		if seen[key] {
+			return Rule{}, invalid("duplicate %s", key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			rule.Freq = strings.ToUpper(val)
+			if rule.Freq != FreqDaily && rule.Freq != FreqWeekly && rule.Freq != FreqMonthly {
+				return Rule{}, invalid("unsupported FREQ %s", val)
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(val)
+			if err != nil || interval < 1 {
+				return Rule{}, invalid("INTERVAL must be a positive integer")
+			}
+			rule.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(val)
+			if err != nil || count < 1 {
+				return Rule{}, invalid("COUNT must be a positive integer")
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, err := parseUntil(val)
+			if err != nil {
+				return Rule{}, invalid("UNTIL must be a date or UTC date-time")
+			}
+			rule.Until = &until
+		case "BYDAY":
+			for _, item := range strings.Split(strings.ToUpper(val), ",") {
+				day, err := parseDay(item)
+				if err != nil {
+					return Rule{}, err
+				}
+				rule.ByDay = append(rule.ByDay, day)
+			}
+		case "WKST":
+			if strings.ToUpper(val) != "MO" {
+				return Rule{}, invalid("only WKST=MO is supported")
+			}
+		default:
+			return Rule{}, invalid("unsupported part %s", key)
+		}
+	}
+
+	if rule.Freq == "" {
+		return Rule{}, invalid("FREQ is required")
+	}
+
+	if rule.Count > 0 && rule.Until != nil {
+		return Rule{}, invalid("COUNT and UNTIL cannot be combined")
+	}
+
+	if rule.Freq != FreqMonthly && slices.ContainsFunc(rule.ByDay, func(day Day) bool { return day.Nth != 0 }) {
+		return Rule{}, invalid("numbered BYDAY is only supported with FREQ=MONTHLY")
+	}
+
+	return rule, nil
+}
+
+func (r Rule) Next(dtstart, after time.Time) (time.Time, bool) {
+	n := 1
+	if r.exhausted(n, dtstart) {
+		return time.Time{}, false
+	}
+
+	if dtstart.After(after) {
+		return dtstart, true
+	}
+
+	for period := 0; period < maxPeriods; period++ {
+		for _, candidate := range r.expand(dtstart, period) {
+			if !candidate.After(dtstart) {
+				continue
+			}
+
+			n++
+			if r.exhausted(n, candidate) {
+				return time.Time{}, false
+			}
+
+			if candidate.After(after) {
+				return candidate, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (r Rule) exhausted(n int, candidate time.Time) bool {
+	if r.Count > 0 && n > r.Count {
+		return true
+	}
+
+	return r.Until != nil && candidate.After(*r.Until)
+}
+
+func (r Rule) expand(dtstart time.Time, period int) []time.Time {
+	hour, min, sec := dtstart.Clock()
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, hour, min, sec, dtstart.Nanosecond(), dtstart.Location())
+	}
+
+	result := []time.Time{}
+	switch r.Freq {
+	case FreqDaily:
+		day := dtstart.AddDate(0, 0, period*r.Interval)
+		if len(r.ByDay) == 0 || slices.ContainsFunc(r.ByDay, func(d Day) bool { return d.Weekday == day.Weekday() }) {
+			result = append(result, day)
+		}
+	case FreqWeekly:
+		monday := dtstart.AddDate(0, 0, -((int(dtstart.Weekday())+6)%7)+period*r.Interval*7)
+		if len(r.ByDay) == 0 {
+			return []time.Time{dtstart.AddDate(0, 0, period*r.Interval*7)}
+		}
+
+		for _, day := range r.ByDay {
+			result = append(result, monday.AddDate(0, 0, (int(day.Weekday)+6)%7))
+		}
+	case FreqMonthly:
+		first := at(dtstart.Year(), dtstart.Month()+time.Month(period*r.Interval), 1)
+		if len(r.ByDay) == 0 {
+			day := at(first.Year(), first.Month(), dtstart.Day())
+			if day.Month() == first.Month() {
+				result = append(result, day)
+			}
+
+			return result
+		}
+
+		days := first.AddDate(0, 1, -1).Day()
+		for _, day := range r.ByDay {
+			matches := []time.Time{}
+			for d := 1; d <= days; d++ {
+				candidate := at(first.Year(), first.Month(), d)
+				if candidate.Weekday() == day.Weekday {
+					matches = append(matches, candidate)
+				}
+			}
+
+			switch {
+			case day.Nth == 0:
+				result = append(result, matches...)
+			case day.Nth > 0 && day.Nth <= len(matches):
+				result = append(result, matches[day.Nth-1])
+			case day.Nth < 0 && -day.Nth <= len(matches):
+				result = append(result, matches[len(matches)+day.Nth])
+			}
+		}
+	}
+
+	slices.SortFunc(result, func(a, b time.Time) int { return a.Compare(b) })
+	return slices.CompactFunc(result, func(a, b time.Time) bool { return a.Equal(b) })
+}
+
+func parseDay(value string) (Day, error) {
+	if len(value) < 2 {
+		return Day{}, invalid("unknown BYDAY %s", value)
+	}
+
+	weekday, ok := weekdays[value[len(value)-2:]]
+	if !ok {
+		return Day{}, invalid("unknown BYDAY %s", value)
+	}
+
+	day := Day{Weekday: weekday}
+	if prefix := value[:len(value)-2]; prefix != "" {
+		nth, err := strconv.Atoi(prefix)
+		if err != nil || nth == 0 || nth < -5 || nth > 5 {
+			return Day{}, invalid("unknown BYDAY %s", value)
+		}
+		day.Nth = nth
+	}
+
+	return day, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	if until, err := time.Parse("20060102T150405Z", value); err == nil {
+		return until, nil
+	}
+
+	until, err := time.Parse("20060102", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return until.Add(24*time.Hour - time.Second), nil
+}
+
+func invalid(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidRule, fmt.Sprintf(format, args...))
+}
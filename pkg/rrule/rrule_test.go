@@ -0,0 +1,215 @@
+package rrule_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rzfhlv/go-task/pkg/rrule"
+	"github.com/stretchr/testify/assert"
+)
+
+var dtstart = time.Date(2026, time.January, 5, 9, 0, 0, 0, time.UTC)
+
+func TestRRuleParse(t *testing.T) {
+	until := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		value      string
+		wantResult rrule.Rule
+		wantErr    bool
+	}{
+		{
+			name:       "success daily",
+			value:      "FREQ=DAILY",
+			wantResult: rrule.Rule{Freq: rrule.FreqDaily, Interval: 1},
+		},
+		{
+			name:  "success weekly with prefix by day and count",
+			value: "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;COUNT=4",
+			wantResult: rrule.Rule{
+				Freq:     rrule.FreqWeekly,
+				Interval: 2,
+				ByDay:    []rrule.Day{{Weekday: time.Monday}, {Weekday: time.Friday}},
+				Count:    4,
+			},
+		},
+		{
+			name:  "success monthly with numbered by day and until",
+			value: "freq=monthly;byday=-1fr;until=20260301T000000Z",
+			wantResult: rrule.Rule{
+				Freq:     rrule.FreqMonthly,
+				Interval: 1,
+				ByDay:    []rrule.Day{{Weekday: time.Friday, Nth: -1}},
+				Until:    &until,
+			},
+		},
+		{
+			name:    "error when freq is missing",
+			value:   "INTERVAL=2",
+			wantErr: true,
+		},
+		{
+			name:    "error when freq is unsupported",
+			value:   "FREQ=YEARLY",
+			wantErr: true,
+		},
+		{
+			name:    "error when count and until are combined",
+			value:   "FREQ=DAILY;COUNT=2;UNTIL=20260301",
+			wantErr: true,
+		},
+		{
+			name:    "error when numbered by day is used weekly",
+			value:   "FREQ=WEEKLY;BYDAY=2MO",
+			wantErr: true,
+		},
+		{
+			name:    "error when by day is unknown",
+			value:   "FREQ=WEEKLY;BYDAY=XX",
+			wantErr: true,
+		},
+		{
+			name:    "error when interval is not positive",
+			value:   "FREQ=DAILY;INTERVAL=0",
+			wantErr: true,
+		},
+		{
+			name:    "error when part is unsupported",
+			value:   "FREQ=DAILY;BYHOUR=9",
+			wantErr: true,
+		},
+		{
+			name:    "error when rule is empty",
+			value:   "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := rrule.Parse(tt.value)
+
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, rrule.ErrInvalidRule))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantResult, result)
+		})
+	}
+}
+
+func TestRRuleNext(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		after      time.Time
+		wantResult time.Time
+		wantOk     bool
+	}{
+		{
+			name:       "daily",
+			value:      "FREQ=DAILY",
+			after:      dtstart,
+			wantResult: dtstart.AddDate(0, 0, 1),
+			wantOk:     true,
+		},
+		{
+			name:       "daily with interval skips past occurrences",
+			value:      "FREQ=DAILY;INTERVAL=3",
+			after:      dtstart.AddDate(0, 0, 4),
+			wantResult: dtstart.AddDate(0, 0, 6),
+			wantOk:     true,
+		},
+		{
+			name:       "daily on weekdays skips the weekend",
+			value:      "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+			after:      dtstart.AddDate(0, 0, 4),
+			wantResult: dtstart.AddDate(0, 0, 7),
+			wantOk:     true,
+		},
+		{
+			name:       "weekly on the same weekday",
+			value:      "FREQ=WEEKLY",
+			after:      dtstart,
+			wantResult: dtstart.AddDate(0, 0, 7),
+			wantOk:     true,
+		},
+		{
+			name:       "weekly by day within the same week",
+			value:      "FREQ=WEEKLY;BYDAY=MO,WE",
+			after:      dtstart,
+			wantResult: dtstart.AddDate(0, 0, 2),
+			wantOk:     true,
+		},
+		{
+			name:       "biweekly by day jumps over the off week",
+			value:      "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE",
+			after:      dtstart.AddDate(0, 0, 2),
+			wantResult: dtstart.AddDate(0, 0, 14),
+			wantOk:     true,
+		},
+		{
+			name:       "monthly on the same day",
+			value:      "FREQ=MONTHLY",
+			after:      dtstart,
+			wantResult: time.Date(2026, time.February, 5, 9, 0, 0, 0, time.UTC),
+			wantOk:     true,
+		},
+		{
+			name:       "monthly on the last friday",
+			value:      "FREQ=MONTHLY;BYDAY=-1FR",
+			after:      dtstart,
+			wantResult: time.Date(2026, time.January, 30, 9, 0, 0, 0, time.UTC),
+			wantOk:     true,
+		},
+		{
+			name:       "monthly on the first monday",
+			value:      "FREQ=MONTHLY;BYDAY=1MO",
+			after:      dtstart,
+			wantResult: time.Date(2026, time.February, 2, 9, 0, 0, 0, time.UTC),
+			wantOk:     true,
+		},
+		{
+			name:   "count is exhausted",
+			value:  "FREQ=DAILY;COUNT=3",
+			after:  dtstart.AddDate(0, 0, 2),
+			wantOk: false,
+		},
+		{
+			name:       "count has one occurrence left",
+			value:      "FREQ=DAILY;COUNT=3",
+			after:      dtstart.AddDate(0, 0, 1),
+			wantResult: dtstart.AddDate(0, 0, 2),
+			wantOk:     true,
+		},
+		{
+			name:   "until has passed",
+			value:  "FREQ=WEEKLY;UNTIL=20260115",
+			after:  dtstart.AddDate(0, 0, 7),
+			wantOk: false,
+		},
+		{
+			name:       "until date is inclusive",
+			value:      "FREQ=WEEKLY;UNTIL=20260112",
+			after:      dtstart,
+			wantResult: dtstart.AddDate(0, 0, 7),
+			wantOk:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := rrule.Parse(tt.value)
+			assert.NoError(t, err)
+
+			result, ok := rule.Next(dtstart, tt.after)
+
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.wantResult, result)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package workflow
+
+type Workflow struct {
+	initial     string
+	transitions map[string][]string
+}
+
+func New(initial string, transitions map[string][]string) *Workflow {
+	return &Workflow{
+		initial:     initial,
+		transitions: transitions,
+	}
+}
+
+func (w *Workflow) Initial() string {
+	return w.initial
+}
+
+func (w *Workflow) Has(state string) bool {
+	if state == w.initial {
+		return true
+	}
+
+	for from, targets := range w.transitions {
+		if from == state {
+			return true
+		}
+
+		for _, to := range targets {
+			if to == state {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (w *Workflow) Can(from, to string) bool {
+	for _, target := range w.transitions[from] {
+		if target == to {
+			return true
+		}
+	}
+
+	return false
+}
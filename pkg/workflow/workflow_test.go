@@ -0,0 +1,98 @@
+package workflow_test
+
+import (
+	"testing"
+
+	"github.com/rzfhlv/go-task/pkg/workflow"
+	"github.com/stretchr/testify/assert"
+)
+
+var transitions = map[string][]string{
+	"todo":        {"in_progress"},
+	"in_progress": {"todo", "done"},
+}
+
+func TestWorkflowInitial(t *testing.T) {
+	wf := workflow.New("todo", transitions)
+
+	assert.Equal(t, "todo", wf.Initial())
+}
+
+func TestWorkflowHas(t *testing.T) {
+	tests := []struct {
+		name  string
+		state string
+		want  bool
+	}{
+		{
+			name:  "initial state",
+			state: "todo",
+			want:  true,
+		},
+		{
+			name:  "state as source",
+			state: "in_progress",
+			want:  true,
+		},
+		{
+			name:  "state only as target",
+			state: "done",
+			want:  true,
+		},
+		{
+			name:  "unknown state",
+			state: "finished",
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wf := workflow.New("todo", transitions)
+
+			assert.Equal(t, tt.want, wf.Has(tt.state))
+		})
+	}
+}
+
+func TestWorkflowCan(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want bool
+	}{
+		{
+			name: "allowed transition",
+			from: "todo",
+			to:   "in_progress",
+			want: true,
+		},
+		{
+			name: "not allowed transition",
+			from: "todo",
+			to:   "done",
+			want: false,
+		},
+		{
+			name: "same state",
+			from: "todo",
+			to:   "todo",
+			want: false,
+		},
+		{
+			name: "terminal state",
+			from: "done",
+			to:   "todo",
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wf := workflow.New("todo", transitions)
+
+			assert.Equal(t, tt.want, wf.Can(tt.from, tt.to))
+		})
+	}
+}